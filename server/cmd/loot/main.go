@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"time"
+
+	"idlequest/internal/config"
+	"idlequest/internal/db"
+	db_combat "idlequest/internal/db/combat"
+
+	_ "github.com/go-sql-driver/mysql" // Import MySQL driver
+)
+
+func getConnectionString() (string, error) {
+	serverConfig, err := config.Get()
+	if err != nil {
+		return "", fmt.Errorf("failed to read config: %v", err)
+	}
+	host := serverConfig.DBHost
+	port := serverConfig.DBPort
+	user := serverConfig.DBUser
+	pass := serverConfig.DBPass
+	dbName := serverConfig.DBName
+
+	if host == "" || user == "" || dbName == "" {
+		return "", fmt.Errorf("database connection string is not set")
+	}
+	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", user, pass, host, port, dbName), nil
+}
+
+func main() {
+	npcID := flag.Int("npc", 0, "npc_types ID to report on")
+	name := flag.String("name", "", "Report on every NPC whose name contains this")
+	zone := flag.String("zone", "", "Zone short name: report on every NPC that spawns there")
+	itemID := flag.Int("item", 0, "Only show this item ID, with the kills needed to obtain it")
+	trials := flag.Int("trials", 0, "Roll the loot this many times instead of calculating exact rates")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "Seed for -trials; the same seed replays the same results")
+	asJSON := flag.Bool("json", false, "Print the reports as JSON")
+	flag.Parse()
+
+	query := db_combat.LootQuery{NPCID: int32(*npcID), Name: *name, Zone: *zone}
+	if query.NPCID == 0 && query.Name == "" && query.Zone == "" {
+		log.Fatalf("one of -npc, -name or -zone is required")
+	}
+
+	dsn, err := getConnectionString()
+	if err != nil {
+		log.Fatalf("failed to read connection string: %v", err)
+	}
+	if err := db.InitWorldDB(dsn); err != nil {
+		log.Fatalf("failed to initialize db.WorldDB: %v", err)
+	}
+
+	reports, err := db_combat.GetLootReports(context.Background(), query, *trials, *seed)
+	if err != nil {
+		log.Fatalf("failed to build loot reports: %v", err)
+	}
+	if *itemID != 0 {
+		reports = onlyItem(reports, int32(*itemID))
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(reports); err != nil {
+			log.Fatalf("failed to encode reports: %v", err)
+		}
+		return
+	}
+	for _, r := range reports {
+		printReport(r, *itemID != 0)
+	}
+}
+
+// onlyItem keeps just the given item in each report, dropping NPCs that never drop it
+func onlyItem(reports []db_combat.NPCLootReport, itemID int32) []db_combat.NPCLootReport {
+	var kept []db_combat.NPCLootReport
+	for _, r := range reports {
+		for _, item := range r.Items {
+			if item.ItemID == itemID {
+				r.Items = []db_combat.ItemDropRate{item}
+				kept = append(kept, r)
+				break
+			}
+		}
+	}
+	return kept
+}
+
+func printReport(r db_combat.NPCLootReport, showKills bool) {
+	fmt.Printf("\n%s (npc %d, level %d, loottable %d)\n", r.Name, r.NPCID, r.Level, r.LoottableID)
+	if r.Trials > 0 {
+		fmt.Printf("  Simulated over %d kills\n", r.Trials)
+	}
+	fmt.Printf("  Expected coin:  %.2fpp per kill\n", r.ExpectedCoin/1000.0)
+	if len(r.Items) == 0 {
+		fmt.Println("  No item drops")
+		return
+	}
+	for _, item := range r.Items {
+		source := ""
+		if item.Global {
+			source = " [global]"
+		}
+		fmt.Printf("  %-40s %7.3f%%  %.3f/kill  %s%s\n",
+			item.Name, 100*item.Chance, item.Expected, formatKills(item.ExpectedKills), source)
+		if showKills && item.Chance > 0 && item.Chance < 1 {
+			// Kills until the item has dropped at least once with 90% and 99% certainty
+			for _, certainty := range []float64{0.9, 0.99} {
+				kills := math.Ceil(math.Log(1-certainty) / math.Log(1-item.Chance))
+				fmt.Printf("    %.0f%% chance to have one after %.0f kills\n", 100*certainty, kills)
+			}
+		}
+	}
+}
+
+func formatKills(kills float64) string {
+	if kills == 0 {
+		return "never drops"
+	}
+	return fmt.Sprintf("~%.1f kills", kills)
+}
@@ -0,0 +1,366 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"os"
+	"sort"
+	"time"
+
+	"idlequest/internal/combat"
+	"idlequest/internal/config"
+	"idlequest/internal/constants"
+	"idlequest/internal/db"
+	db_character "idlequest/internal/db/character"
+	db_combat "idlequest/internal/db/combat"
+	"idlequest/internal/db/items"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/mechanics"
+	"idlequest/internal/session"
+	"idlequest/internal/zone/client"
+	entity "idlequest/internal/zone/interface"
+
+	_ "github.com/go-sql-driver/mysql" // Import MySQL driver
+)
+
+// roundSeconds is how long one combat round takes on the live server
+const roundSeconds = 1.0
+
+// CharacterSpec describes a hypothetical character to simulate, loaded from JSON
+type CharacterSpec struct {
+	Name  string  `json:"name"`
+	Level uint32  `json:"level"`
+	Class uint8   `json:"class"`
+	Race  uint16  `json:"race"`
+	Str   uint32  `json:"str"`
+	Sta   uint32  `json:"sta"`
+	Agi   uint32  `json:"agi"`
+	Dex   uint32  `json:"dex"`
+	Wis   uint32  `json:"wis"`
+	Int   uint32  `json:"int"`
+	Cha   uint32  `json:"cha"`
+	Items []int32 `json:"items"` // Item IDs to equip, each in the first free slot it fits
+}
+
+func getConnectionString() (string, error) {
+	serverConfig, err := config.Get()
+	if err != nil {
+		return "", fmt.Errorf("failed to read config: %v", err)
+	}
+	host := serverConfig.DBHost
+	port := serverConfig.DBPort
+	user := serverConfig.DBUser
+	pass := serverConfig.DBPass
+	dbName := serverConfig.DBName
+
+	if host == "" || user == "" || dbName == "" {
+		return "", fmt.Errorf("database connection string is not set")
+	}
+	return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true", user, pass, host, port, dbName), nil
+}
+
+func main() {
+	charName := flag.String("char", "", "Name of a character to load from the database")
+	specPath := flag.String("spec", "", "Path to a JSON character spec (level, class, race, stats, item IDs)")
+	npcID := flag.Int("npc", 0, "npc_types ID to fight")
+	zone := flag.String("zone", "", "Zone short name to pick NPCs from (used when -npc is not set)")
+	minLevel := flag.Int("minlevel", 0, "Minimum NPC level for -zone (default: character level - 5)")
+	maxLevel := flag.Int("maxlevel", 0, "Maximum NPC level for -zone (default: character level + 5)")
+	raid := flag.Bool("raid", false, "Include raid targets in the NPCs picked from -zone")
+	fights := flag.Int("fights", 1000, "Number of fights to simulate")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "Seed for the simulation; the same seed replays the same results")
+	maxRounds := flag.Int("maxrounds", 1000, "Rounds after which a fight counts as a loss")
+	retreat := flag.Int("retreat", -1, "Auto-retreat HP percent (default: the character's own setting, 0 = never)")
+	verbose := flag.Bool("v", false, "Keep server logging enabled during fights")
+	flag.Parse()
+
+	if (*charName == "") == (*specPath == "") {
+		log.Fatalf("exactly one of -char or -spec is required")
+	}
+	if *npcID == 0 && *zone == "" {
+		log.Fatalf("one of -npc or -zone is required")
+	}
+
+	dsn, err := getConnectionString()
+	if err != nil {
+		log.Fatalf("failed to read connection string: %v", err)
+	}
+	if err := db.InitWorldDB(dsn); err != nil {
+		log.Fatalf("failed to initialize db.WorldDB: %v", err)
+	}
+	if _, err := items.InitializeItemsMMF(); err != nil {
+		log.Fatalf("failed to initialize items: %v", err)
+	}
+
+	ctx := context.Background()
+
+	var charData *model.CharacterData
+	var spec *CharacterSpec
+	if *charName != "" {
+		charData, err = db_character.GetCharacterByName(*charName)
+		if err != nil {
+			log.Fatalf("failed to load character %q: %v", *charName, err)
+		}
+	} else {
+		spec, err = loadSpec(*specPath)
+		if err != nil {
+			log.Fatalf("failed to load spec: %v", err)
+		}
+		charData = spec.toCharacterData()
+	}
+
+	// A spec character has ID 0, so NewClient finds no inventory and we equip the spec's items ourselves
+	c, err := client.NewClient(charData)
+	if err != nil {
+		log.Fatalf("failed to create client: %v", err)
+	}
+	if spec != nil {
+		equipItems(c, spec.Items)
+	}
+	c.RestoreToFull()
+	if *retreat >= 0 {
+		c.SetAutoRetreatPercent(combat.ClampAutoRetreatPercent(*retreat))
+	}
+	ses := &session.Session{CharacterName: charData.Name, Client: c}
+
+	// Candidate NPCs
+	var npcs []db_combat.NPCForCombat
+	if *npcID != 0 {
+		npc, err := db_combat.GetNPCForCombat(ctx, int32(*npcID))
+		if err != nil {
+			log.Fatalf("failed to load NPC: %v", err)
+		}
+		npcs = append(npcs, *npc)
+	} else {
+		if *minLevel == 0 {
+			*minLevel = max(int(charData.Level)-5, 1)
+		}
+		if *maxLevel == 0 {
+			*maxLevel = int(charData.Level) + 5
+		}
+		npcs, err = db_combat.GetZoneNPCsForCombat(ctx, *zone, *minLevel, *maxLevel, *raid)
+		if err != nil {
+			log.Fatalf("failed to load NPCs: %v", err)
+		}
+		if len(npcs) == 0 {
+			log.Fatalf("no NPCs in %s between levels %d and %d", *zone, *minLevel, *maxLevel)
+		}
+	}
+
+	pet, err := combat.GetManager().SummonPet(ses)
+	if err != nil {
+		log.Fatalf("failed to summon pet: %v", err)
+	}
+
+	fmt.Printf("Simulating %d fights: %s (level %d class %d race %d, %d HP) vs %d NPC type(s), seed %d\n",
+		*fights, charData.Name, charData.Level, charData.Class, charData.Race, c.GetMaxHp(), len(npcs), *seed)
+	if pet != nil {
+		fmt.Printf("Pet: %s (level %d, %d HP)\n", pet.Name, pet.Level, pet.MaxHP)
+	}
+
+	// Fights write a lot of log lines (loot, combat stopped); silence them unless asked
+	if !*verbose {
+		log.SetOutput(io.Discard)
+	}
+
+	baseline := *charData
+	rng := rand.New(rand.NewSource(*seed))
+	var stats simStats
+	stats.itemDrops = make(map[string]int)
+
+	for i := 0; i < *fights; i++ {
+		// Every fight starts from the same character and a fresh pet, after resting off the
+		// previous fight's damage as a hunt would
+		*charData = baseline
+		c.UpdateStats()
+		c.RestoreToFull()
+		var fightPet *combat.Pet
+		if pet != nil {
+			p := *pet
+			fightPet = &p
+		}
+
+		npc := npcs[rng.Intn(len(npcs))]
+		stats.add(combat.RunFight(ses, &npc, fightPet, rng.Int63(), *maxRounds))
+		stats.rest += combat.RestTime(ses)
+	}
+
+	log.SetOutput(os.Stderr)
+	stats.print()
+}
+
+func loadSpec(path string) (*CharacterSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var spec CharacterSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &spec, nil
+}
+
+// toCharacterData builds character data for the spec, defaulting unset stats to 75
+func (spec *CharacterSpec) toCharacterData() *model.CharacterData {
+	stat := func(v uint32) uint32 {
+		if v == 0 {
+			return 75
+		}
+		return v
+	}
+	name := spec.Name
+	if name == "" {
+		name = "Simulant"
+	}
+	level := spec.Level
+	if level == 0 {
+		level = 1
+	}
+	if level > mechanics.MaxPlayerLevel {
+		level = mechanics.MaxPlayerLevel
+	}
+	class := spec.Class
+	if class == 0 {
+		class = constants.Class_Warrior
+	}
+	race := spec.Race
+	if race == 0 {
+		race = 1 // Human
+	}
+	return &model.CharacterData{
+		Name:  name,
+		Level: level,
+		Exp:   uint32(mechanics.ExperienceTable[level]), // Victories recalculate level from exp
+		Class: class,
+		Race:  race,
+		Str:   stat(spec.Str),
+		Sta:   stat(spec.Sta),
+		Agi:   stat(spec.Agi),
+		Dex:   stat(spec.Dex),
+		Wis:   stat(spec.Wis),
+		Int:   stat(spec.Int),
+		Cha:   stat(spec.Cha),
+	}
+}
+
+// equipItems puts each item into the first equipment slot (0-21) allowed by its slot bitmask
+func equipItems(c entity.Client, itemIDs []int32) {
+	for _, itemID := range itemIDs {
+		item, err := items.GetItemTemplateByID(itemID)
+		if err != nil {
+			log.Printf("skipping unknown item %d: %v", itemID, err)
+			continue
+		}
+		placed := false
+		for slot := constants.SlotCharm; slot <= constants.SlotAmmo; slot++ {
+			key := constants.InventoryKey{Bag: 0, Slot: slot}
+			if item.Slots&(1<<uint(slot)) == 0 || c.GetItem(key) != nil {
+				continue
+			}
+			instance := items.CreateItemInstanceFromTemplateID(itemID)
+			c.SetItem(key, &constants.ItemWithInstance{Item: item, Instance: *instance})
+			placed = true
+			break
+		}
+		if !placed {
+			log.Printf("no free slot for item %d (%s)", itemID, item.Name)
+		}
+	}
+}
+
+// simStats accumulates fight reports
+type simStats struct {
+	fights      int
+	victories   int
+	deaths      int // Including fights that ran out of rounds
+	fled        int // NPC fled and got away
+	retreats    int
+	rounds      int
+	winRounds   int
+	rest        time.Duration // Resting back to full after each fight
+	damageTaken int
+	damageDealt int
+	highestHit  int
+	exp         int
+	copper      int
+	items       int
+	itemDrops   map[string]int
+}
+
+func (s *simStats) add(r combat.FightReport) {
+	s.fights++
+	s.rounds += r.Rounds
+	s.damageTaken += r.DamageTaken
+	s.damageDealt += r.DamageDealt
+	s.exp += r.ExpGained
+	if r.HighestHit > s.highestHit {
+		s.highestHit = r.HighestHit
+	}
+	switch r.Outcome {
+	case combat.OutcomeVictory:
+		s.victories++
+		s.winRounds += r.Rounds
+	case combat.OutcomeNPCFled:
+		s.fled++
+	case combat.OutcomeRetreated:
+		s.retreats++
+	default:
+		s.deaths++
+	}
+	s.copper += r.Money.Platinum*1000 + r.Money.Gold*100 + r.Money.Silver*10 + r.Money.Copper
+	for _, item := range r.Loot {
+		s.items++
+		s.itemDrops[item.Name]++
+	}
+}
+
+func (s *simStats) print() {
+	if s.fights == 0 {
+		return
+	}
+	hours := (float64(s.rounds)*roundSeconds + s.rest.Seconds()) / 3600.0
+	perHour := func(v int) float64 {
+		if hours == 0 {
+			return 0
+		}
+		return float64(v) / hours
+	}
+
+	fmt.Printf("\nFights:           %d\n", s.fights)
+	fmt.Printf("Win rate:         %.1f%% (%d deaths, %d fled, %d retreats)\n", 100*float64(s.victories)/float64(s.fights), s.deaths, s.fled, s.retreats)
+	if s.victories > 0 {
+		fmt.Printf("Avg time to kill: %.1fs\n", float64(s.winRounds)*roundSeconds/float64(s.victories))
+	}
+	fmt.Printf("Avg damage taken: %.1f per fight\n", float64(s.damageTaken)/float64(s.fights))
+	fmt.Printf("Avg damage dealt: %.1f per fight (highest hit %d)\n", float64(s.damageDealt)/float64(s.fights), s.highestHit)
+	fmt.Printf("Avg rest:         %.1fs per fight\n", s.rest.Seconds()/float64(s.fights))
+	fmt.Printf("Exp per hour:     %.0f\n", perHour(s.exp))
+	fmt.Printf("Coin per hour:    %.2fpp\n", perHour(s.copper)/1000.0)
+	fmt.Printf("Items per hour:   %.1f\n", perHour(s.items))
+
+	if len(s.itemDrops) == 0 {
+		return
+	}
+	type drop struct {
+		name  string
+		count int
+	}
+	drops := make([]drop, 0, len(s.itemDrops))
+	for name, count := range s.itemDrops {
+		drops = append(drops, drop{name, count})
+	}
+	sort.Slice(drops, func(i, j int) bool { return drops[i].count > drops[j].count })
+	if len(drops) > 10 {
+		drops = drops[:10]
+	}
+	fmt.Println("\nTop drops:")
+	for _, d := range drops {
+		fmt.Printf("  %-40s %6d (%.1f/hr)\n", d.name, d.count, perHour(d.count))
+	}
+}
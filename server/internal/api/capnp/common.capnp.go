@@ -12886,12 +12886,12 @@ type TradeRequest capnp.Struct
 const TradeRequest_TypeID = 0xdbc10a1e045d6bed
 
 func NewTradeRequest(s *capnp.Segment) (TradeRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
 	return TradeRequest(st), err
 }
 
 func NewRootTradeRequest(s *capnp.Segment) (TradeRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
 	return TradeRequest(st), err
 }
 
@@ -12943,12 +12943,30 @@ func (s TradeRequest) SetFromMobId(v int32) {
 	capnp.Struct(s).SetUint32(4, uint32(v))
 }
 
+func (s TradeRequest) PlayerName() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s TradeRequest) HasPlayerName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s TradeRequest) PlayerNameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s TradeRequest) SetPlayerName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
 // TradeRequest_List is a list of TradeRequest.
 type TradeRequest_List = capnp.StructList[TradeRequest]
 
 // NewTradeRequest creates a new list of TradeRequest.
 func NewTradeRequest_List(s *capnp.Segment, sz int32) (TradeRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
 	return capnp.StructList[TradeRequest](l), err
 }
 
@@ -13502,12 +13520,12 @@ type WhoAll capnp.Struct
 const WhoAll_TypeID = 0xb513764d5512a334
 
 func NewWhoAll(s *capnp.Segment) (WhoAll, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
 	return WhoAll(st), err
 }
 
 func NewRootWhoAll(s *capnp.Segment) (WhoAll, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
 	return WhoAll(st), err
 }
 
@@ -13601,12 +13619,38 @@ func (s WhoAll) SetGmlookup(v int32) {
 	capnp.Struct(s).SetUint32(16, uint32(v))
 }
 
+func (s WhoAll) Zone() int32 {
+	return int32(capnp.Struct(s).Uint32(20))
+}
+
+func (s WhoAll) SetZone(v int32) {
+	capnp.Struct(s).SetUint32(20, uint32(v))
+}
+
+func (s WhoAll) Guild() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s WhoAll) HasGuild() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s WhoAll) GuildBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s WhoAll) SetGuild(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
 // WhoAll_List is a list of WhoAll.
 type WhoAll_List = capnp.StructList[WhoAll]
 
 // NewWhoAll creates a new list of WhoAll.
 func NewWhoAll_List(s *capnp.Segment, sz int32) (WhoAll_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2}, sz)
 	return capnp.StructList[WhoAll](l), err
 }
 
@@ -13858,12 +13902,12 @@ type Inspect capnp.Struct
 const Inspect_TypeID = 0x8169b9320fb13d79
 
 func NewInspect(s *capnp.Segment) (Inspect, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
 	return Inspect(st), err
 }
 
 func NewRootInspect(s *capnp.Segment) (Inspect, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
 	return Inspect(st), err
 }
 
@@ -13915,12 +13959,30 @@ func (s Inspect) SetPlayerId(v int32) {
 	capnp.Struct(s).SetUint32(4, uint32(v))
 }
 
+func (s Inspect) TargetName() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s Inspect) HasTargetName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Inspect) TargetNameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Inspect) SetTargetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
 // Inspect_List is a list of Inspect.
 type Inspect_List = capnp.StructList[Inspect]
 
 // NewInspect creates a new list of Inspect.
 func NewInspect_List(s *capnp.Segment, sz int32) (Inspect_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
 	return capnp.StructList[Inspect](l), err
 }
 
@@ -13932,6 +13994,88 @@ func (f Inspect_Future) Struct() (Inspect, error) {
 	return Inspect(p.Struct()), err
 }
 
+type InspectMessage capnp.Struct
+
+// InspectMessage_TypeID is the unique identifier for the type InspectMessage.
+const InspectMessage_TypeID = 0xe3788914da7f63bb
+
+func NewInspectMessage(s *capnp.Segment) (InspectMessage, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return InspectMessage(st), err
+}
+
+func NewRootInspectMessage(s *capnp.Segment) (InspectMessage, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return InspectMessage(st), err
+}
+
+func ReadRootInspectMessage(msg *capnp.Message) (InspectMessage, error) {
+	root, err := msg.Root()
+	return InspectMessage(root.Struct()), err
+}
+
+func (s InspectMessage) String() string {
+	str, _ := text.Marshal(0xe3788914da7f63bb, capnp.Struct(s))
+	return str
+}
+
+func (s InspectMessage) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (InspectMessage) DecodeFromPtr(p capnp.Ptr) InspectMessage {
+	return InspectMessage(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s InspectMessage) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s InspectMessage) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s InspectMessage) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s InspectMessage) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s InspectMessage) Text() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s InspectMessage) HasText() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s InspectMessage) TextBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s InspectMessage) SetText(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// InspectMessage_List is a list of InspectMessage.
+type InspectMessage_List = capnp.StructList[InspectMessage]
+
+// NewInspectMessage creates a new list of InspectMessage.
+func NewInspectMessage_List(s *capnp.Segment, sz int32) (InspectMessage_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[InspectMessage](l), err
+}
+
+// InspectMessage_Future is a wrapper for a InspectMessage promised by a client call.
+type InspectMessage_Future struct{ *capnp.Future }
+
+func (f InspectMessage_Future) Struct() (InspectMessage, error) {
+	p, err := f.Future.Ptr()
+	return InspectMessage(p.Struct()), err
+}
+
 type InspectResponse capnp.Struct
 
 // InspectResponse_TypeID is the unique identifier for the type InspectResponse.
@@ -18665,12 +18809,12 @@ type WhoAllPlayer capnp.Struct
 const WhoAllPlayer_TypeID = 0xc20152b889987299
 
 func NewWhoAllPlayer(s *capnp.Segment) (WhoAllPlayer, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 3})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 3})
 	return WhoAllPlayer(st), err
 }
 
 func NewRootWhoAllPlayer(s *capnp.Segment) (WhoAllPlayer, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 3})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 3})
 	return WhoAllPlayer(st), err
 }
 
@@ -18824,12 +18968,20 @@ func (s WhoAllPlayer) SetAccount(v string) error {
 	return capnp.Struct(s).SetText(2, v)
 }
 
+func (s WhoAllPlayer) Anon() int32 {
+	return int32(capnp.Struct(s).Uint32(32))
+}
+
+func (s WhoAllPlayer) SetAnon(v int32) {
+	capnp.Struct(s).SetUint32(32, uint32(v))
+}
+
 // WhoAllPlayer_List is a list of WhoAllPlayer.
 type WhoAllPlayer_List = capnp.StructList[WhoAllPlayer]
 
 // NewWhoAllPlayer creates a new list of WhoAllPlayer.
 func NewWhoAllPlayer_List(s *capnp.Segment, sz int32) (WhoAllPlayer_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 3}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 3}, sz)
 	return capnp.StructList[WhoAllPlayer](l), err
 }
 
@@ -20108,12 +20260,12 @@ type GuildMemberEntry capnp.Struct
 const GuildMemberEntry_TypeID = 0x820db271715b4199
 
 func NewGuildMemberEntry(s *capnp.Segment) (GuildMemberEntry, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 2})
 	return GuildMemberEntry(st), err
 }
 
 func NewRootGuildMemberEntry(s *capnp.Segment) (GuildMemberEntry, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 2})
 	return GuildMemberEntry(st), err
 }
 
@@ -20265,12 +20417,20 @@ func (s GuildMemberEntry) SetZoneId(v int32) {
 	capnp.Struct(s).SetUint32(36, uint32(v))
 }
 
+func (s GuildMemberEntry) Online() int32 {
+	return int32(capnp.Struct(s).Uint32(40))
+}
+
+func (s GuildMemberEntry) SetOnline(v int32) {
+	capnp.Struct(s).SetUint32(40, uint32(v))
+}
+
 // GuildMemberEntry_List is a list of GuildMemberEntry.
 type GuildMemberEntry_List = capnp.StructList[GuildMemberEntry]
 
 // NewGuildMemberEntry creates a new list of GuildMemberEntry.
 func NewGuildMemberEntry_List(s *capnp.Segment, sz int32) (GuildMemberEntry_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 48, PointerCount: 2}, sz)
 	return capnp.StructList[GuildMemberEntry](l), err
 }
 
@@ -33837,12 +33997,12 @@ type NPCData capnp.Struct
 const NPCData_TypeID = 0xe2a2a4743dccd2e6
 
 func NewNPCData(s *capnp.Segment) (NPCData, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
 	return NPCData(st), err
 }
 
 func NewRootNPCData(s *capnp.Segment) (NPCData, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
 	return NPCData(st), err
 }
 
@@ -33944,12 +34104,48 @@ func (s NPCData) SetGender(v int32) {
 	capnp.Struct(s).SetUint32(20, uint32(v))
 }
 
+func (s NPCData) ConColor() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s NPCData) HasConColor() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s NPCData) ConColorBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s NPCData) SetConColor(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s NPCData) DangerRating() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s NPCData) HasDangerRating() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s NPCData) DangerRatingBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s NPCData) SetDangerRating(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
 // NPCData_List is a list of NPCData.
 type NPCData_List = capnp.StructList[NPCData]
 
 // NewNPCData creates a new list of NPCData.
 func NewNPCData_List(s *capnp.Segment, sz int32) (NPCData_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
 	return capnp.StructList[NPCData](l), err
 }
 
@@ -34383,12 +34579,12 @@ type ChatMessageCapnp capnp.Struct
 const ChatMessageCapnp_TypeID = 0x8222d6a0ebfb470b
 
 func NewChatMessageCapnp(s *capnp.Segment) (ChatMessageCapnp, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
 	return ChatMessageCapnp(st), err
 }
 
 func NewRootChatMessageCapnp(s *capnp.Segment) (ChatMessageCapnp, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
 	return ChatMessageCapnp(st), err
 }
 
@@ -34486,12 +34682,48 @@ func (s ChatMessageCapnp) SetTimestamp(v int64) {
 	capnp.Struct(s).SetUint64(0, uint64(v))
 }
 
+func (s ChatMessageCapnp) GuildName() (string, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return p.Text(), err
+}
+
+func (s ChatMessageCapnp) HasGuildName() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s ChatMessageCapnp) GuildNameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s ChatMessageCapnp) SetGuildName(v string) error {
+	return capnp.Struct(s).SetText(3, v)
+}
+
+func (s ChatMessageCapnp) Channel() (string, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return p.Text(), err
+}
+
+func (s ChatMessageCapnp) HasChannel() bool {
+	return capnp.Struct(s).HasPtr(4)
+}
+
+func (s ChatMessageCapnp) ChannelBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return p.TextBytes(), err
+}
+
+func (s ChatMessageCapnp) SetChannel(v string) error {
+	return capnp.Struct(s).SetText(4, v)
+}
+
 // ChatMessageCapnp_List is a list of ChatMessageCapnp.
 type ChatMessageCapnp_List = capnp.StructList[ChatMessageCapnp]
 
 // NewChatMessageCapnp creates a new list of ChatMessageCapnp.
 func NewChatMessageCapnp_List(s *capnp.Segment, sz int32) (ChatMessageCapnp_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
 	return capnp.StructList[ChatMessageCapnp](l), err
 }
 
@@ -34509,12 +34741,12 @@ type SendChatMessageRequest capnp.Struct
 const SendChatMessageRequest_TypeID = 0x8f6fd8b5e6c742f5
 
 func NewSendChatMessageRequest(s *capnp.Segment) (SendChatMessageRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
 	return SendChatMessageRequest(st), err
 }
 
 func NewRootSendChatMessageRequest(s *capnp.Segment) (SendChatMessageRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
 	return SendChatMessageRequest(st), err
 }
 
@@ -34586,12 +34818,30 @@ func (s SendChatMessageRequest) SetMessageType(v string) error {
 	return capnp.Struct(s).SetText(1, v)
 }
 
+func (s SendChatMessageRequest) Target() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s SendChatMessageRequest) HasTarget() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s SendChatMessageRequest) TargetBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s SendChatMessageRequest) SetTarget(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
 // SendChatMessageRequest_List is a list of SendChatMessageRequest.
 type SendChatMessageRequest_List = capnp.StructList[SendChatMessageRequest]
 
 // NewSendChatMessageRequest creates a new list of SendChatMessageRequest.
 func NewSendChatMessageRequest_List(s *capnp.Segment, sz int32) (SendChatMessageRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
 	return capnp.StructList[SendChatMessageRequest](l), err
 }
 
@@ -34991,12 +35241,12 @@ type StartCombatRequest capnp.Struct
 const StartCombatRequest_TypeID = 0xef0730519259eaed
 
 func NewStartCombatRequest(s *capnp.Segment) (StartCombatRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
 	return StartCombatRequest(st), err
 }
 
 func NewRootStartCombatRequest(s *capnp.Segment) (StartCombatRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
 	return StartCombatRequest(st), err
 }
 
@@ -35032,13 +35282,28 @@ func (s StartCombatRequest) Message() *capnp.Message {
 func (s StartCombatRequest) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s StartCombatRequest) NpcId() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s StartCombatRequest) SetNpcId(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s StartCombatRequest) SpawnGroupId() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s StartCombatRequest) SetSpawnGroupId(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
 
 // StartCombatRequest_List is a list of StartCombatRequest.
 type StartCombatRequest_List = capnp.StructList[StartCombatRequest]
 
 // NewStartCombatRequest creates a new list of StartCombatRequest.
 func NewStartCombatRequest_List(s *capnp.Segment, sz int32) (StartCombatRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
 	return capnp.StructList[StartCombatRequest](l), err
 }
 
@@ -35261,32 +35526,138 @@ func (f CombatNPC_Future) Struct() (CombatNPC, error) {
 	return CombatNPC(p.Struct()), err
 }
 
-type CombatStartedResponse capnp.Struct
+type CombatPet capnp.Struct
 
-// CombatStartedResponse_TypeID is the unique identifier for the type CombatStartedResponse.
-const CombatStartedResponse_TypeID = 0x9099feab65cf05fe
+// CombatPet_TypeID is the unique identifier for the type CombatPet.
+const CombatPet_TypeID = 0x9b165192c3123c99
 
-func NewCombatStartedResponse(s *capnp.Segment) (CombatStartedResponse, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return CombatStartedResponse(st), err
+func NewCombatPet(s *capnp.Segment) (CombatPet, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return CombatPet(st), err
 }
 
-func NewRootCombatStartedResponse(s *capnp.Segment) (CombatStartedResponse, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return CombatStartedResponse(st), err
+func NewRootCombatPet(s *capnp.Segment) (CombatPet, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return CombatPet(st), err
 }
 
-func ReadRootCombatStartedResponse(msg *capnp.Message) (CombatStartedResponse, error) {
+func ReadRootCombatPet(msg *capnp.Message) (CombatPet, error) {
 	root, err := msg.Root()
-	return CombatStartedResponse(root.Struct()), err
+	return CombatPet(root.Struct()), err
 }
 
-func (s CombatStartedResponse) String() string {
-	str, _ := text.Marshal(0x9099feab65cf05fe, capnp.Struct(s))
+func (s CombatPet) String() string {
+	str, _ := text.Marshal(0x9b165192c3123c99, capnp.Struct(s))
 	return str
 }
 
-func (s CombatStartedResponse) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s CombatPet) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CombatPet) DecodeFromPtr(p capnp.Ptr) CombatPet {
+	return CombatPet(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CombatPet) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CombatPet) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CombatPet) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CombatPet) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CombatPet) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s CombatPet) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CombatPet) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CombatPet) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s CombatPet) Level() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s CombatPet) SetLevel(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s CombatPet) Hp() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s CombatPet) SetHp(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s CombatPet) MaxHp() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s CombatPet) SetMaxHp(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+// CombatPet_List is a list of CombatPet.
+type CombatPet_List = capnp.StructList[CombatPet]
+
+// NewCombatPet creates a new list of CombatPet.
+func NewCombatPet_List(s *capnp.Segment, sz int32) (CombatPet_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[CombatPet](l), err
+}
+
+// CombatPet_Future is a wrapper for a CombatPet promised by a client call.
+type CombatPet_Future struct{ *capnp.Future }
+
+func (f CombatPet_Future) Struct() (CombatPet, error) {
+	p, err := f.Future.Ptr()
+	return CombatPet(p.Struct()), err
+}
+
+type CombatStartedResponse capnp.Struct
+
+// CombatStartedResponse_TypeID is the unique identifier for the type CombatStartedResponse.
+const CombatStartedResponse_TypeID = 0x9099feab65cf05fe
+
+func NewCombatStartedResponse(s *capnp.Segment) (CombatStartedResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return CombatStartedResponse(st), err
+}
+
+func NewRootCombatStartedResponse(s *capnp.Segment) (CombatStartedResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return CombatStartedResponse(st), err
+}
+
+func ReadRootCombatStartedResponse(msg *capnp.Message) (CombatStartedResponse, error) {
+	root, err := msg.Root()
+	return CombatStartedResponse(root.Struct()), err
+}
+
+func (s CombatStartedResponse) String() string {
+	str, _ := text.Marshal(0x9099feab65cf05fe, capnp.Struct(s))
+	return str
+}
+
+func (s CombatStartedResponse) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
@@ -35358,12 +35729,62 @@ func (s CombatStartedResponse) NewNpc() (CombatNPC, error) {
 	return ss, err
 }
 
+func (s CombatStartedResponse) Pet() (CombatPet, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return CombatPet(p.Struct()), err
+}
+
+func (s CombatStartedResponse) HasPet() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s CombatStartedResponse) SetPet(v CombatPet) error {
+	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
+}
+
+// NewPet sets the pet field to a newly
+// allocated CombatPet struct, preferring placement in s's segment.
+func (s CombatStartedResponse) NewPet() (CombatPet, error) {
+	ss, err := NewCombatPet(capnp.Struct(s).Segment())
+	if err != nil {
+		return CombatPet{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s CombatStartedResponse) Reason() (string, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return p.Text(), err
+}
+
+func (s CombatStartedResponse) HasReason() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s CombatStartedResponse) ReasonBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s CombatStartedResponse) SetReason(v string) error {
+	return capnp.Struct(s).SetText(3, v)
+}
+
+func (s CombatStartedResponse) RespawnSeconds() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s CombatStartedResponse) SetRespawnSeconds(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
 // CombatStartedResponse_List is a list of CombatStartedResponse.
 type CombatStartedResponse_List = capnp.StructList[CombatStartedResponse]
 
 // NewCombatStartedResponse creates a new list of CombatStartedResponse.
 func NewCombatStartedResponse_List(s *capnp.Segment, sz int32) (CombatStartedResponse_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
 	return capnp.StructList[CombatStartedResponse](l), err
 }
 
@@ -35377,6 +35798,9 @@ func (f CombatStartedResponse_Future) Struct() (CombatStartedResponse, error) {
 func (p CombatStartedResponse_Future) Npc() CombatNPC_Future {
 	return CombatNPC_Future{Future: p.Future.Field(1, nil)}
 }
+func (p CombatStartedResponse_Future) Pet() CombatPet_Future {
+	return CombatPet_Future{Future: p.Future.Field(2, nil)}
+}
 
 type CombatRoundUpdate capnp.Struct
 
@@ -35384,12 +35808,12 @@ type CombatRoundUpdate capnp.Struct
 const CombatRoundUpdate_TypeID = 0xf70ec2d8ba38e7dd
 
 func NewCombatRoundUpdate(s *capnp.Segment) (CombatRoundUpdate, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 0})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 88, PointerCount: 3})
 	return CombatRoundUpdate(st), err
 }
 
 func NewRootCombatRoundUpdate(s *capnp.Segment) (CombatRoundUpdate, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 0})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 88, PointerCount: 3})
 	return CombatRoundUpdate(st), err
 }
 
@@ -35513,12 +35937,157 @@ func (s CombatRoundUpdate) SetNpcDied(v int32) {
 	capnp.Struct(s).SetUint32(40, uint32(v))
 }
 
+func (s CombatRoundUpdate) PetHit() int32 {
+	return int32(capnp.Struct(s).Uint32(44))
+}
+
+func (s CombatRoundUpdate) SetPetHit(v int32) {
+	capnp.Struct(s).SetUint32(44, uint32(v))
+}
+
+func (s CombatRoundUpdate) PetDamage() int32 {
+	return int32(capnp.Struct(s).Uint32(48))
+}
+
+func (s CombatRoundUpdate) SetPetDamage(v int32) {
+	capnp.Struct(s).SetUint32(48, uint32(v))
+}
+
+func (s CombatRoundUpdate) NpcTargetPet() int32 {
+	return int32(capnp.Struct(s).Uint32(52))
+}
+
+func (s CombatRoundUpdate) SetNpcTargetPet(v int32) {
+	capnp.Struct(s).SetUint32(52, uint32(v))
+}
+
+func (s CombatRoundUpdate) PetHp() int32 {
+	return int32(capnp.Struct(s).Uint32(56))
+}
+
+func (s CombatRoundUpdate) SetPetHp(v int32) {
+	capnp.Struct(s).SetUint32(56, uint32(v))
+}
+
+func (s CombatRoundUpdate) PetMaxHp() int32 {
+	return int32(capnp.Struct(s).Uint32(60))
+}
+
+func (s CombatRoundUpdate) SetPetMaxHp(v int32) {
+	capnp.Struct(s).SetUint32(60, uint32(v))
+}
+
+func (s CombatRoundUpdate) PetDied() int32 {
+	return int32(capnp.Struct(s).Uint32(64))
+}
+
+func (s CombatRoundUpdate) SetPetDied(v int32) {
+	capnp.Struct(s).SetUint32(64, uint32(v))
+}
+
+func (s CombatRoundUpdate) Specials() (SpecialAttackHit_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return SpecialAttackHit_List(p.List()), err
+}
+
+func (s CombatRoundUpdate) HasSpecials() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CombatRoundUpdate) SetSpecials(v SpecialAttackHit_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewSpecials sets the specials field to a newly
+// allocated SpecialAttackHit_List, preferring placement in s's segment.
+func (s CombatRoundUpdate) NewSpecials(n int32) (SpecialAttackHit_List, error) {
+	l, err := NewSpecialAttackHit_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return SpecialAttackHit_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s CombatRoundUpdate) NpcStunned() int32 {
+	return int32(capnp.Struct(s).Uint32(68))
+}
+
+func (s CombatRoundUpdate) SetNpcStunned(v int32) {
+	capnp.Struct(s).SetUint32(68, uint32(v))
+}
+
+func (s CombatRoundUpdate) NpcFleeing() int32 {
+	return int32(capnp.Struct(s).Uint32(72))
+}
+
+func (s CombatRoundUpdate) SetNpcFleeing(v int32) {
+	capnp.Struct(s).SetUint32(72, uint32(v))
+}
+
+func (s CombatRoundUpdate) Ranged() (RangedAttackHit, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return RangedAttackHit(p.Struct()), err
+}
+
+func (s CombatRoundUpdate) HasRanged() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s CombatRoundUpdate) SetRanged(v RangedAttackHit) error {
+	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+}
+
+// NewRanged sets the ranged field to a newly
+// allocated RangedAttackHit struct, preferring placement in s's segment.
+func (s CombatRoundUpdate) NewRanged() (RangedAttackHit, error) {
+	ss, err := NewRangedAttackHit(capnp.Struct(s).Segment())
+	if err != nil {
+		return RangedAttackHit{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s CombatRoundUpdate) NpcClosing() int32 {
+	return int32(capnp.Struct(s).Uint32(76))
+}
+
+func (s CombatRoundUpdate) SetNpcClosing(v int32) {
+	capnp.Struct(s).SetUint32(76, uint32(v))
+}
+
+func (s CombatRoundUpdate) NpcTarget() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s CombatRoundUpdate) HasNpcTarget() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s CombatRoundUpdate) NpcTargetBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s CombatRoundUpdate) SetNpcTarget(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
+func (s CombatRoundUpdate) GroupDamage() int32 {
+	return int32(capnp.Struct(s).Uint32(80))
+}
+
+func (s CombatRoundUpdate) SetGroupDamage(v int32) {
+	capnp.Struct(s).SetUint32(80, uint32(v))
+}
+
 // CombatRoundUpdate_List is a list of CombatRoundUpdate.
 type CombatRoundUpdate_List = capnp.StructList[CombatRoundUpdate]
 
 // NewCombatRoundUpdate creates a new list of CombatRoundUpdate.
 func NewCombatRoundUpdate_List(s *capnp.Segment, sz int32) (CombatRoundUpdate_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 48, PointerCount: 0}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 88, PointerCount: 3}, sz)
 	return capnp.StructList[CombatRoundUpdate](l), err
 }
 
@@ -35529,6 +36098,9 @@ func (f CombatRoundUpdate_Future) Struct() (CombatRoundUpdate, error) {
 	p, err := f.Future.Ptr()
 	return CombatRoundUpdate(p.Struct()), err
 }
+func (p CombatRoundUpdate_Future) Ranged() RangedAttackHit_Future {
+	return RangedAttackHit_Future{Future: p.Future.Field(1, nil)}
+}
 
 type CombatEndedResponse capnp.Struct
 
@@ -35536,12 +36108,12 @@ type CombatEndedResponse capnp.Struct
 const CombatEndedResponse_TypeID = 0xb3307c029a338d76
 
 func NewCombatEndedResponse(s *capnp.Segment) (CombatEndedResponse, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 1})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2})
 	return CombatEndedResponse(st), err
 }
 
 func NewRootCombatEndedResponse(s *capnp.Segment) (CombatEndedResponse, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 1})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2})
 	return CombatEndedResponse(st), err
 }
 
@@ -35667,12 +36239,30 @@ func (s CombatEndedResponse) SetBindHeading(v float32) {
 	capnp.Struct(s).SetUint32(32, math.Float32bits(v))
 }
 
+func (s CombatEndedResponse) Outcome() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s CombatEndedResponse) HasOutcome() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s CombatEndedResponse) OutcomeBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s CombatEndedResponse) SetOutcome(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
 // CombatEndedResponse_List is a list of CombatEndedResponse.
 type CombatEndedResponse_List = capnp.StructList[CombatEndedResponse]
 
 // NewCombatEndedResponse creates a new list of CombatEndedResponse.
 func NewCombatEndedResponse_List(s *capnp.Segment, sz int32) (CombatEndedResponse_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 1}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2}, sz)
 	return capnp.StructList[CombatEndedResponse](l), err
 }
 
@@ -39633,2424 +40223,6905 @@ func (f CraftRecipeResponse_Future) Struct() (CraftRecipeResponse, error) {
 	return CraftRecipeResponse(p.Struct()), err
 }
 
-const schema_cdefabcdefabcdef = "x\xda\xe4\xbd\x7ft\x13\xc5\xf7?\xbc\x93\xb4\x94\x1f-" +
-	"i\xbb\xc1\xf2\xd3\x02\x82\xd2\x0a\x0a\x05\xacT\xb1\xb6\xb4" +
-	"Bk\x0bM\xd2\x0a\xad\xa2n\x93m\xbb4\xd9\xa4\xc9" +
-	"\xa6\xb4\x08\xef\x02\x82\x02\x82\x02\x82\x8a\x82\x08\x82\x82\x8a" +
-	"\x82\x82\x80\x8a\x8a\x8a\x02\x1f@P@@\x10\x10\x11P" +
-	"Qx\x0boA\x81>\xe7\xdeI\xb2\x9btJ\xd3\xf7" +
-	"\xf7\xf3|\xcfy\xces\x0e\x7f\x90W\xef\xce\xde\xb9s" +
-	"\xe7\xce\xbdw\xee\xcc\xf6\x1d\xff\xdc\xbd\x11\xfdb\xbe~" +
-	"\x92\xd3Yz\xb7\x88lQ\xff\xfa7/\x19n}C" +
-	"?\x913u%\xa4\xfe\x8f\x9do\xe2?.2\"\x8a" +
-	"\xe3\xfa\xef\x1c\xf2\x01\xe1O\x0e\x81\xff\x1e\x1f\x92H8" +
-	"R\x7fW\xc2\xb2\xf5\xa9\x05\x85\xa1\xe4H\xdd*\xdbL" +
-	"\xf8.\xd9Q\x1c\xc7w\xc8\x1e\xcb\x91zr\xc5\xd4\xeb" +
-	"\xa3\xd21H\xac\x0b!\xf6f\x1f\"\xfc\x1c$\x9e\x95" +
-	"\xfd\x0eG\xea\xcf\x0e\x1f_\xbct\xe3\xfa\x06\x8c\x10\xa0" +
-	"\xees\x9f\x99\xf0\x19\xf7\x01\xf5\xe0\xfb\xa0\xe9E\xfa#" +
-	"\x07_\xbd\xfa\xc8\xa4Pj\x1dP\xaf\xbco\x06\xe17" +
-	"#\xf5\xa6\xfb\xa0\xed_O\xccu\xd6\xbfn\x9d\xc4\xe2" +
-	"z\xf4\xd01\x84\xaf\x1a\x0a\xc4\x8e\xa1\xd0t\xed\xe05" +
-	"\x86\x94\x8d\x12\x93x\xcf\xd0L\xc2\x1fG\xe2#H\xbc" +
-	"y\xfa\xb5)\x9f<3a\x12\x17w#\xa9\xbfis" +
-	"\x10\xd3\x19\xc3\xc6\x10\xbeh\x18\x10\x9b\x86\xa5s\xa4^" +
-	"\xd7{\xbe\\\x18\xf7\xf7$.\xaek\x03\x9e'\x0d3" +
-	"\x13~>\x12\xcf\x19\x06-/\xc8x\xb0\xaa\xea\xdd\x98" +
-	"\xc9\xc0Fd(\xf5\xd5a3\x08\xdf.\x07\xfe\x1b\x97" +
-	"\xf3\xba\x8e#\xf5m\x86\xfe\xf3\xeb+\xfb\xbbO\x0e\x15" +
-	"\x88\x1e\x07\xef\xfe\x19\x84\xbf|?4~\xf1\xfeS\x1c" +
-	"\xa9\xef\xf5\xca{\xeb\x16\xd7\xbc>\x99\xd5\xc7}y\xb9" +
-	"\x84?\x93\x07\xc4'\xf3\x80\xedm\xa6\x8f\\S\x7f\xfe" +
-	"p2S\xd6\x91\xf9?\x12\xbe[>Pw\xc9\x87\xa6" +
-	"O\xfc\x8f\xf7\xebe\x8f\x7f1\x999\x8e\x91\xc3K\x09" +
-	"\xdfa8P\xb7\x1b\x0e#\xf3\xfb\xdc\x1d\xad'|y" +
-	"zr\x88H\x90x\x15\x10oF\xe2M\xc3\x81\x91\xdd" +
-	"_\x0e+82\xa4\xe6qh:\"\x84\xeb#\xc3?" +
-	"#\xfcE \xee\x7f~\xf83\xa0\xaa\xe9\x97'f\x96" +
-	"\xcbO!\xb5>\xb4\xed\xa9\x05f\xc2/(\x80\xff\xce" +
-	"/@r\xcf\xaf\xff~r\xe4\xf7#\xa6\xb0D\xd2\xc7" +
-	"\x9cK\xf8\x0c3\xaa\x9f\x19\x06\xe7\xdf\xff\xde\xf4\xdbo" +
-	"\xfd\xdf\x9e\xc2\x94\xf6\x02s\x1a\xe1W\"\xf5r3\x88" +
-	"\xe4\xda\xe8\xc7\x0f]M\xa9\x9f\xc2\xead\xad%\x8d\xf0" +
-	"\xd3-@<\xd5\x02\x9d\xec\x91<\xa3\xad\xa0\xeb=\x95" +
-	")\xbfu\x96\xee\x84\xdf\x82\xd4\x9b-\xc0\xc8\x07\x07\x0a" +
-	"*\x9e)\x1b05\xa4\x93\xc8u\x97\xc2\xa5\x84\x1fX" +
-	"\x08\xc4\xfd\x0a\x81\x8f)\xfdo\x9cq\xfb_\xe9SC" +
-	"\xf8h\x03\xc4=\x8bf\x10~p\x11\xfcwP\x91\x13" +
-	"4\xca\xb6\xee\xe0M\xe7W\x99\xa72ud\xa4\x9b\xf0" +
-	"gF\xa2\x8e\x8c\x04>\xfe:\xfd\xa5m}\xc6b&" +
-	"q\xf6\xa8\xc9\x84/\x1e\x05\xc4E\xa3\xa0\x8b7\x1e\xae" +
-	"-\xedd\xd0=\xc1\x99n$Q\xf5\x0f\x0c\x18\xf1\xb0" +
-	"c\xe6c/r\x91mQ \xa3R\x08?}\x14\x0e" +
-	"\xd2\xa8[Z\xc0L\xb8\xf5\xc2\xbcw\x9ez\xff\x09V" +
-	"\x1f\x8f?\xf2\"\xe1/?\x02\xff\xbd\xf8\x08Z\xa8n" +
-	"\xe9q\xb9_\x9c?\xf7\x04\x8b\x938a.\xe1\x93\x04" +
-	"\xe0\xa4\xa7\x00\x9c,\x7f\xe9\xf6\x07\xf6\xde2\xe8IV" +
-	"\xd3\xd9\x82\x99\xf0\xc5@\xdc\xbfH\xc0\xa6'\xe4\xfc\x95" +
-	"\xba,\xb1\xe2I\xe6D\x10K\xc7\x10\xbe\xb6\x14\xda\xf6" +
-	"\x96\x82\xb4\x8flz\xd6v!\xf9\xbb'C'p$" +
-	"P\x9b\xac\xb9\x84\x17\xad\xf0_\xc1\x9a\xa0\xe7\xc8'\xf3" +
-	">\xcc<1\xb0z\x1a\x83\xeb5e\xc9\x84\xdf\\\x86" +
-	"\xf3\xa0\x0c\xb8>6\x8a?\xb9\xc9n\x98\xc6\xe4\xe3x" +
-	"\x99\x99\xf0\x17\x91\xfa|\x19L1\xf1\xc6\xa5\xef\xafH" +
-	"\xef8\x8d%\x909\xe5\xa5\x84_^\x0e\xc4K\xca\xa1" +
-	"\xe9\xa2\x8a\xab\xcfw\xf9\xd7\x0c&\xf1\x16 >\x88\xc4" +
-	"\xfb\xcaa\xd07u\x1dvf\xff\x93k\xa6\x85\xf6\x10" +
-	"Uu`E)\xe1s*P\x92\x15\xa9\xa0P1Y" +
-	"G\x8a\xe7}\xf8%\xb3\xed\xe9c\xdc\x84_4\x06\xda" +
-	"^0\x06\xd7\x8ec\xdbN?\xf6l\xd2t\xe64\xb8" +
-	"8&\x97\xf0\xad*\x81:\xb2\x12\xa8G\x1e\x14?\x1e" +
-	"\xd0\xff\xe5\xe9\xac\xa6\xab*\xdf%\xfct$\x9e\x8a\xc4" +
-	"-\xea\xb2\xea^,<?\x9de\x86OVf\x12\xfe" +
-	"\"\x12\x9fG\xe2\xab\xdd\xdc\x9bg\x9c\xeb2\x83\xa5!" +
-	"&\xfbR\xc2Kv\x1c}\xfbH\xd0\x90\xc3\x17\xd2:" +
-	"\xfc\x8ft\xc7\x0c\xd0l\xa2j6Rot\x98\x09\xbf" +
-	"\xd3\x01Mos@\xd3\xf1\xcf\xe6\xe5\x1e\xb8\xb9`\x06" +
-	"gJ$\xa4>6EK\x9c$\x97\x10~\xb0\x0c\xc4" +
-	"\x83d\x18\x98\x89\x89\x9b\xdaO\xda\xb1c\x06\xcb\x86\x14" +
-	"\xcb\x93\x09\xef@b\x09\x89\x07\xacM\xed(\x94\xdd\xfc" +
-	"\x14K\x1c\x0b\xe4\\\xc2\xaf\x92q\x9d\x94Q\xadWw" +
-	"\xb4\xadz\xb5\xee\xccSL\x17`\x9bs;\xe1O:" +
-	"Q\xb3\x9cH\xbe\xe0\x97\x1dSs\x0e^\x98\xc9\xe2\xa4" +
-	"]U.\xe1\x93\xaap\x82U\x01'\xd1\xf7$?\xb6" +
-	"s\xe4\xc1Y!&\x9b\x0a\xbb\x08\xa8\xa5*\x94_U" +
-	"=\xb4\xfdgNi\xc1\xe1]YO\xb3\xa4]\xeby" +
-	"\x97\xf0s<\xf0\xdfY\x1e\x94\xf6\xa7[?k\xb5%" +
-	"\xe3_O\xb3\xbayR\xf9\x8c\xf0W\x15\xe0\xe4\xb2\x02" +
-	"\x9c\xec\xf9K8P\x1a\x9f\xfa\x0c\x8b\xb8\x837\x85\xf0" +
-	"I^d\xdb\x0bCs1\xf3\xab\x9f\xd7\x1dp>\xc3" +
-	"R\x91\xe9\xde\x0f\x08\xbf\x04\x89\x17!\xf1\xb6\xee\xab\x9e" +
-	"\xbe\xb2m\"\xb6\xdc\"T\"W\xa1\xe9\x98j\xf4\x8f" +
-	"\xaaq\xa5\xee\xbc\xe5\xc4\xd1\xc4\xbe/=\xc3\xd4\xec=" +
-	"5n\xc2\x9f\xac\x81\xc6\x8f\xd7\xc0\xec\x1dv\xea\xa5\xa8" +
-	"\x1ff\x1af\x87R\xb7\x00\xea\x09\xb5\x1f\x10~~-" +
-	"\xce\xe4Z\\\xc5V[\x8b\xae]\\\x9f5\x9b\xc5\xf8" +
-	"\x9c\xc7\xc6\x11~\xf9c8\xd9\x1f\x03\xc6\x1f\x9d?\xe1" +
-	"\xaa\xf4[\xcdl\xe6\xc0\xc7\x8c\x7f\x83\xf0=\xc7\xc3\x7f" +
-	"\xbb\x8d\xc7\x81\xbf\x16\xf9\xb5\xf8\xe6\xb5\x05\xb3\x99fg" +
-	"\xd0\x84w\x09o\x9a\x00\x8d\xe7O\x00\xc6w\xbf\xf9\x92" +
-	"\xee?\xba\xba9!\xce\x1f\xed\xe6\xc9\x09s\x09\x7f\x15" +
-	"\xa9/O\x00c\x195c\xf1\x83+7\xbc<'\xc4" +
-	"\x94\xd0\xc5\xe6_\x99\x84?\xf9/T\xc1\x7f\xa1\x9a8" +
-	";\xf5^\xf8[\xf5\x9bs\x98\x03?q2\xe1/O" +
-	"D_g\"\xf4\xf2\xf7\x851/\xc7\x9c\xfa\x9f9," +
-	"/\xd44i2\xe1\xc5I@,L\x02\xaeo\xd9\xda" +
-	"\xf1\xf5\xc4v\x09sY-\x93\xc9\xb9\x84o7\x19\x88" +
-	"\xe3&C\xcbO\xcf\x9a2Uwf\xee\\\xa6\x8bV" +
-	"5y\x0c\xe1\xa7NF\xdfn2\x0e|\xf2G\xbf=" +
-	"\xfc\xe1\x7f>y\x965qLS\xdf \xbc4\x15\xda" +
-	"\x16\xa7\x82\xba\xce\xeeo\xdb\xba\xc9\xf0\xc3\xb3L\xefe" +
-	"\xfe\xd4q\x84_\x09\xd4\xfd\x97O\x85\xa9\xf0\xc9\xfe\xdb" +
-	"\x9e\xf91w\xc1\xbc\xe0\x96\xd1\x1b\xb9\xfc\x84\x99\xf01" +
-	"OB\xcb\xad\x9e\x84.v\xf0\x96'\xac\xf9\xb4\xf5<" +
-	"\xa6\xfe-\x7f\xd2M\xf8\x8dH\xbd\xeeI\xe8c\xe1m" +
-	"\xbf\xbf9\xa4G.\x9b:n\xdag\x84O\x9a\x86\xf3" +
-	"f\x1a\xb4]\xdduR\xeb\x9f\x07\xedfS\xef\x996" +
-	"\x8e\xf0'\x91\xfa\xf84h\xfb\xce\xbb\x0f\x9c\xdfu\xf8" +
-	"\x85\xf9\xac\x91\x19<\xddLx\xd3t\xd4\xa7\xe9\xa0!" +
-	"C\x1e\x9cpk\x87\xa1\xdb\xe7\x87\xcc2j-g\xa4" +
-	"\x11~\xd0\x0c\\vf\xa0\xf3\xf2\xc6\xa7O\xfd\xb8\xf8" +
-	"\xb0\xed\xb9P\xbb\x83\"\xd9<s2\xe1\xf7\xcdD\x9e" +
-	"f\"\xb9\x92\xc7\xbf\xd4\xaa_\xec\xf3Li\x8f~\xe6" +
-	"\x0d\xc2{\x9f\xc1A}\x06\x0dO\xca\xf2\xce\x7f>r" +
-	"\xe2\xe6\xe7Y\x8co\x99\xfd\"\xe1\x8f\xcc\x06\xc6\x0f\xce" +
-	"\x06\x99\\\xbc\xbd\xffc\xd6\x91\xbf>\x1f<\xec\xd4\xa4" +
-	"\xcd\x99K\xf89s\xa28}\xfd{s\xa5O\x1e\xec" +
-	"\x16\xbb\x80)9\xc7\x9cq\x84\x9f4\x07\xda\x9c0\x07" +
-	"\xda\xccL\xfc\xed\xbd\x9b\x9f\x10\x16\x84N.\xd4\xbcn" +
-	"s'\x13~\xe0\\\xf8o\xbf\xb98s+\xbb\xdfv" +
-	"\xb2x\xf9\xcc\x05\xcc\xee\x99\x9e\x9dKx\xe9Y\xb4\xc2" +
-	"\xcfb\xf76\x9c\xfa.\xe2\xae\xa5\xb3_dN\xf4\x8d" +
-	"\xf3&\x13~\xe7<\\\xc6\xe6\x01/\xfft\xfb\xad\xe7" +
-	"\x03\xbf\xeb^\xe2\xe2\x125\xab\x18m[\x9c\x9fIx" +
-	"\xef| \xae\x9a\x0fj\xdd\xee\xc2\xe7\x11\xeb\xcb\x87\xbe" +
-	"\xc4lz\xce|\xf0F\x90z\xc9|h:\xeb\xd1A" +
-	"\x919\xc9\xcf\xbe\xc4\xb2f\x83\x9e+%|\xfes@" +
-	"\x9c\xf3\x1ch\xd3m#\xb6\xbdt\xe7\x80\xf7^b\xc8" +
-	"y\xd5s\xef\x12~\xcbs \xe7~u\x7fV\x8d\xdb" +
-	"\xa1[\xc8\x9a\xe0K\x9e\xfb\x8c\xf0\x1b\xb1\xc9u\xd8\xe4" +
-	"\x82e3\xf6\xff\xbcy\xeaB\xe6\xa0ty\xdeM\xf8" +
-	"~\xcf\x03u\x9f\xe7\x81\xdb\xbb\xb6\xcf\xfbpx\xd4\xe2" +
-	"\x85\xac\xf0d\xdb\xf3f\xc2\x1f\x01\xe2\xfe\x07\x9fGK" +
-	"\xfd\xfdS3\x97\xbf<e\xc9B\xe6\x98x\x17\xb8\x09" +
-	"?}\x01:\xc1\x0b\x90<\xf6\xbd\x8a\xabOo=\xb9" +
-	"\x90%\x8a\xb8\x97\xc6\x11\xbe\xe7K\xc0I\xb7\x97P\x14" +
-	"\x0f\xf5\xea\xf9\xefVO.bur\xc2Kf\xc2\xcf" +
-	"A\xe2YH\xac\x9c\x9e\xf3y\xa7O.0\x89\xcf@" +
-	"\xcbW\x91\xf8\xf2K\xe9\x1c9\xfa\xa1\xf7\x97]\x7f%" +
-	"\xbf\xcc\x92G\x87\x85%\x84\xef\xb3\x10h\x93\x16\x82<" +
-	"&\xdc\xfc\xe2C\x05\x1bo}\x19\x9c\xa1\x88\x10gh" +
-	"\xcb\xc2w\x09\x7fd!\xcac!\x0d\xd7\xfe\xee\xfe\xdd" +
-	"\x93#\xdd/\x03\x1b\xad\x1a8\xd7/O&|\xed\xcb" +
-	"(\x9a\x97\xcb# \xf0\x19\x18\xff\xfd_\xd6\xf1/3" +
-	"\x97\xf3W\xc7\x10\xbe\xcf\xab\xc8\xc9\xab\xd0\xc5\x9c\xe5m" +
-	"\xc4\xa1\x1b\xbfy\x999\x8eS_}\x97\xf0\x8b\x90z" +
-	"\xc1\xab\xc0\xf7\xe2\x11o\xfd9\xa6\x7f\xd2b\xa6\x8ef" +
-	"/\x1bG\xf8\xe2e\x18\xcc,\x03\xbbd1;\xa3\x06" +
-	"\x8cLY\xcc\x0c\x053\x96O&|\xd1r\x9cf\xcb" +
-	"q*\xbe\xb4\xa0\xcd\xa9\xa7^\x1f\xb5\x98\xe9\x8d\xbf\xf6" +
-	"\x19\xe1W\xbe\x86\x81\xe3k0[v\xd5vi5\xc5" +
-	"\xb2p1SG\xb6\xbd\xf6.\xe1\x8f\xbf\x86\xc1\xefk" +
-	"(\xc2\xfbkO\xbd1k\xf7\x81\xc5,\xb3\xb4|E" +
-	"\x09\xe17\xae@\xdd^\x01|\xdf.\x16\xb6}\xfc\xca" +
-	"\xb5Pb\xca\xf7\x9c\x95f\xc2/_\x89\x93b%\xda" +
-	"\x84\x8aQ\xc3\xb6w\xfc\xb9\xf5+LV.\xbe\x01\xfe" +
-	"\xf8\x9b\x18\xe1\xbf\x89\xe4\x97M\x19\xbd\xe7\\\x88\x7f\x85" +
-	"\xb9\xe8\xbee&\xbc\xf8\x16.\xbao\x01+\xdbk\xee" +
-	"Ypv\xde\xf2W\x98\xc33x\x95\x9b\xf0\xa6U\xb8" +
-	"\x10\xac\x82\xc1\\5g\xe8\x95.\xed~{\x855\x13" +
-	"V\xae*%\xfc&$\xde\x88\xc4Y\xe3o\x8chy" +
-	"\xef\x9c%,q\xb7{{\x0c\xe1\x93\xde\xc6\xd5\xebm" +
-	"\x10\xf7\xea\xa7jOT\xcc\xbc{)S$9o\x8f" +
-	"#\xfc\xe8\xb7\xd1\xdf~\x1b\xfb\xf8\xf8\x8e\xe9\xf7\x7fy" +
-	"\xe1\xdaR\x8e\xb1\xea\xaey\xe7]\xc2o{\x07\xda\xde" +
-	"\xf2\x0e(U,\xf1\xdc\xfe\xe8\xaa\x88W\x99)\xa8\xd5" +
-	"\x93\x09_\xb5\x1aSP\xab\x81\xeb\xfd\xfb\xf7>\xf6\xca" +
-	"\xc1\xb1\xaf\xb2\xa4\xb7mu\x0a\xe1\x0f\"\xf1\xbe\xd5\xd0" +
-	"rL\x9f\xbdG\xc6L\xbe\xccl\xd9\xb1\xa6\x84\xf0\x93" +
-	"\xd6\xe0\xc2\xb1\x06Z~\xbc\xbcC[^\x89]F\x89" +
-	"\x07|\x16$\xea\x83k&\x13\xfe,R\x9fA\xeaE" +
-	"\x9f>\x91z\xee\xe3\x7f\x96\xb1,Z\xce\xbbf\xc2\x8f" +
-	"~\x17\xe5\xf1\xeeW \x8f7\x9e\xfe\xf5\x95\x1d\x17\xaf" +
-	"1\xa9\xfb\xac\x1dC\xf8\x8c\xb58\xa0k\x91z\xbb\xbd" +
-	"\xe3\xdf}\x16v^\xce\x9c7\xed\xde\xdfN\xf8~\xef" +
-	"\xe3\x93\xef\xe3\xbc\xf9\xea\x8f\xed=:\xacU\x96s\xa6" +
-	"\xce\x91-\xeb\xa7\xd9\x8f\\3uN\xde\xc3q\xa4\x7f" +
-	"\xcez\x1d\xe1\x8b\xd6Gq\x9c\xa5`\xbd\x1eh\xa3\xd7" +
-	"\xfc\x1a\xfb~\xaf)\xcb\x99\xabc\xc6\xfa1>\xf2\xfe" +
-	"\xa6\xf58\x8e1=\x8e\x15\x14\xbd\xbb\x82\xcd\xc9\xa6\x0d" +
-	"\xa5\x84\xdf\xb3\x01d\xb2s\x03(k\xfaV\xcf\xfb\xf9" +
-	"\xef\xdc\xfa\x1aP\xc7\x87\x86\xf5\xeb6\xce \xfc\xce\x8d" +
-	"8N\x1b\xbfj\xc9\x91\xfa'\xe6m\xacK\x98b}" +
-	"\x8d\xe5\xf4-\xf8<\x93\xf0+?\xc7\xf9\xfe9(`" +
-	"\xc7\xa8\xda\xe1wtz\xfb5\xa6\xe5\xd9\xf2\xf9\x0c\xc2" +
-	"\x1fA\xea\x83\x9f\x03'\xfd\xbe\xde\xf1\xea\xb9\xb3\x85\xaf" +
-	"3\xbb\xb9\xea\x8b\x12\xc2o\xfe\x02\xbb\xf0\x05v\xf3;" +
-	"9\xff\xcb;\x0e\x8e\x7f=t\x06c7\xe3\xbe\x9cL" +
-	"\xf8\x9e_\xa2\xfb\xf0%\x8e\xcf\xdaaWn9\xb0\xec" +
-	"\xb9\xd7Y\x8c\x9f\xff*\x97\xf0\x91[\x81\x15\xb2\x15\x18" +
-	"\xbf\xe9\xa6\x01[?\xba\xf5\xcd\xd7\x99\xce~\xbf\xad%" +
-	"\x84\xcf\xde\x8a\xc2\xdf\x8a\x83\xb9\xea\x85\xca\xee\x92\xeb\xc7" +
-	"\xd7Y\xfa-ns\x13\xbev\x1b\xe6Q\xb6A7\x9f" +
-	"]\xb5\xb9\xbeWky\x053\x844mw\x13^\xdc" +
-	"\x8ey\x94\xed\x98e(J|\xee\xde\x9d\x13f\xad`" +
-	"\x1a\x93M;\xd2\x08\xbfs\x07:/;\xd0\xbd}q" +
-	"\xed\xc2?\xde\xb9a%k\xf2\x0c\xdci&|\xceN" +
-	" \xce\xde\x09\xbd4\x8e0\x8b\xcf\xfe4e%\xb3i" +
-	"i\xe78\xc2O@\xea\xda\x9d0/?\x1f\xf6H\x9b" +
-	"\x84\xb7\x9e\\\xc9\xead\xdc.3\xe1{\xee\xc2\x15{" +
-	"\x17t\xb2\xf5\xf9\x03w\x9c{|\xcc\x1b\xcc\x91\xbf\xba" +
-	"k;\xe1;|\x8dY\xd3\xaf\xa1\xe9\xf1\x0fw\xf9\xe9" +
-	"\x8f\xca.o\xb2\xb2\xce\x9b\xbe\x1eC\xf8=H\xbc\xf3" +
-	"k\xe0z\xe5\xdd{\x9f\xbc\xe7\xa3\x1b\xdfd\x0d\xe4\xd5" +
-	"\xaf\xdd\x84\x8f\xdb\x0d\xc41\xbb\x81x\xcd\x0d\xc2\xc2;" +
-	"O.{\x93\xc9\xc7\xe0\xdd\xa5\x847!u\xfen\xe0" +
-	"Z\xe8\xb3u\xd4\x13O\xac|\x8b\x993\xdd3\x8e\xf0" +
-	"\x19{0g\xba\x07D}m\xca\xf3\xadv\xb6\xf8f" +
-	"U\x88;@\x19Y\xb4'\x97\xf0k\xf6\xa0\xe6\xeeA" +
-	"\xfd[1\xac{J\x8b5O\xbe\xcd\xf4a\xbe\x1dC" +
-	"\xf89\xdf\xa2\x0f\xf3-\xb4\xdd\xa5\xcf\xd0\x16\xf3\xdf\x9c" +
-	"\xf56\xcb\xf4\x9c\xf96\x85\xf0\x97\xbf\xc5u\xea[\\" +
-	"';\xf5x\xc4}\xbb\xf8\xfe\xdb\xac\x91\x99\xb5\xcfM" +
-	"\xf8%\xfb0\xba\xdf\x07\xb2\xae\xbc\xfd\xe4\xb0\xcds\x8f" +
-	"\xbc\xcd\x94\xc8\xc0\xfd/\x12>\x7f?:\xa1\xfb\xdf\xe1" +
-	"\xc8\xd5^\x8b\xa6Ny\x84\xbc\xc3\xe0\xf9\xfc\xfeR\xc2" +
-	"G~\x87s\xe6;\x10\xf5\xa1\x9bn;\xfe\xc2\x93\xd3" +
-	"\xdfa\xce\x99n\xdf\x95\x12~\xe0w8}\xbe\xc39" +
-	"3m\xc3\x03\xbf\x0f\x13\x94\xd5\xaca\xcc>0\x99\xf0" +
-	"\xc5\x07\xd0)9\x00m'\xb5\xcb\xb7\xbe|\xe3w\xab" +
-	"Y=\xac=\xe0&\xfc,$\x9e~\x00z\xf8\xda;" +
-	"\x17gn\xdc[\xbd\x86\xa9\xd6=\x0f\xbeH\xf8\xc1\x07" +
-	"1\x11u\x10\xa8_t\x97\x0d\xdf|\xa4\xf2]V\xd3" +
-	"\x07\x0f\x96\x12\xfe,\x12\x9f9\x88+\xfb\xb0\xb5\x11\x09" +
-	"\x8f\xfd\xf2.\x8b\xe9\xcd\x87^$\xfc\xc1C\xb8\x90\x1d" +
-	"\x02\xa6\xabg\xf5\x7fQ7\xbe\xef{\xcctb\xe4\xf7" +
-	"K\x09\xdf\xe5{t\xef\xbe\xc7\x89\xfe\xc7\xbf\xdf\xbf\xe7" +
-	"\x85\xcf\xdd\xef\xd1\\[`\x16 #\xd2\x914\xc2\xd7" +
-	"\x1eA#r\x04\x14\xe4\xd2\x8eW_\\vy\xc5{" +
-	",F\xf6\x1c)!\xfcI$>~\x04\x18\x99[h" +
-	"\xfd\xf0\xb7k\x97\xdec\xa6\x90\x7f\x98A\xf8\xa4\x1f\xd0" +
-	"i\xf8\x01Z\x16\xe2\xf4-\x7fO\xce^\xcb\xccj\xfe" +
-	"`&\xfc\"$^\x80\xc4\x03^\x8d/\xca\xaf\xe6\xd7" +
-	"1\xbd\xa8\xcb?\xa4\x11\xbe\xd5Q\xec\xedQ0\xd9\xff" +
-	"\xfcp4\xe6\xc1\xc3\xbd\xd7\xb1\xd6\xa5\xa2c\x93\x09/" +
-	"\x1d\xc3\x84\xc11\x10\xb5\xf5\xdck\xb5\xbb\xde|m\x1d" +
-	"\xcb\x1b\xc99\x0ek\xf5q .>\x0e\x83\xd8j\xd1" +
-	"\x01\xcb\xe3\xf7\x1c]\xc7\xd4\xbd\x8b\xc7? |\xcc\x8f" +
-	"\x981\xf8\x11\x9a\xcei\xd1\xf7\x9bg\xe7\x8cy\x9f5" +
-	"\xe4\xc7\x7f\x1cC\xf8\x8bH|\xfeGh\xfa\xd5\xaf[" +
-	"w2n\xd8\xfa>S\x9b\xe6\x9f(%\xfc\xca\x13\xb8" +
-	"\xe2\x9d\x00\xea\xb2\x09\xa3\xbf\xbd?\xed{f\xd3\x83\x7f" +
-	"\x02s\xf3\x13\x9a\x9b\x9f\x80\x8fS\x7f-\xac}n\xe7" +
-	"m\xeb\x99\xe6\xe6d\x09\xe13N\xa2\xb99\x09\xa2\x9e" +
-	"3\xe7\xf33\x13\xde\xc8f\x12/:\x99L\xf8UH" +
-	"\xbc\x12\x89\xef\xbd\xfa\x9f\x0e\xde\x1bG\xacg\xa9G\xcc" +
-	"\xcff\xc2w\xfb\x19w\xb8~\x06\xf5\xf8!\xe1\xb6\xaf" +
-	"\x1e\xf1\xac]\xcf\xeca\xc6\xcf/\x12\xbe\x18\xa9\x8b~" +
-	"\x86\x1e\xa6:\xdex\xfa\xaeg\xbeZ\xcfrW\xc9\xa9" +
-	"4\xc2\xc7\x9dB\xf3{\x0a\xf88\xe3n\xf9r\xe6\xa1" +
-	"\xcbL\xa6\xa5S\xa5\x84\x9f\x80\xc4\xb5H\xdc\xf5\x17\xc7" +
-	"\xf9\x8f\xe7\x196\xb0Z\xdew*\x97\xf0g\x90\xf8$" +
-	"\x12\xdf}\xef-\x95u\x8f\xa4n\x80\x96S4\x8e\x1f" +
-	"\x8f\xfaq\xdaM\xf8\xd1\xa7\xd1\x97;]\xde\x81#\xf5" +
-	"\xf2\xb7\xd9+\x0a6\x14n`\xf51\xb56\x92\xe4\x92" +
-	"\x84Y\xf8#az$\x81nnz\xf2\xf3\xdfO~" +
-	"$l`p\x9e\xda\xb3\x05)%\x09\x83Z \xfd\xc0" +
-	"\x16\x04\xf8I\xe8q\xf2\xf8\x97\xef\xafe\xbf`\x01>" +
-	"\xb0\x8a>\xb0\xb2\x05\xbe\xe0(y\xe1\xae\xf8\xdb\xff\xd8" +
-	"\xc0\xd0\x94\xd4\x8c(\xa0/\x8aBzS\x14\xd2\xf3\x95" +
-	"\x7f\xee\x1b}\xaa\xddF\x16C\xe7\xa3\x88\x99$D\xb6" +
-	"Dz\xd2\x12\x19\x1a1\xe2\xe7[F\xbeP\xcd\xa4\x1f" +
-	"\xdd\x92\xcc%\x09^J_E\xe9\xf7M\xef\xb1\xff\xfb" +
-	"\xb5\xe3?`M\xa1\xd4=-\xc9R\x92p\x06\x1fH" +
-	"=\xd9\x92\xa0\x05\xbfc\xd4\x9a}{7<\xf3\x01c" +
-	"\xbcR/\xb7\"cHBLk|C\xab\xd6\xf8\x86" +
-	"\xb4\xc8\xa7\xbf\xeb|\xe9\x1b&\xbd\xd8\x1a\x86\xa0\x96\xd2" +
-	"{)}\xd5\xb5\x91\xa7\xf3\xaf\x1d\xfa\x80)\xd2=\xad" +
-	"\xa1\xcb'\xe9\x03\xc7[\x83\x88\xfey\xed\xf4\x8c\xb7?" +
-	"?\xf1\x01\x8b|V\x1b\xe2&\x09K\xda \xf9\xa26" +
-	"(\xd1\x09\xc9\xd7\x06\xef^{\xdb\x87\xac\x11\x18\x1c\x0d" +
-	"\xfc\x98\xa2\x91>?\x9a\xc0t-\xf8i\xeb\xbc\xcd\xb1" +
-	"\x1f|\xc4X\x94S\x93b\xa0\xbf\x83c\xf0e\x83b" +
-	"\x08.\xf9\xff\x18\xc5\x977\xe8\x87ob\xcc\xc2\xd4H" +
-	"\x03y\x91$t1\xe0\x0b:\x18\x08L\xc4\xa3\xdb;" +
-	"=u\xd7\x9ey\x9bXC6\xd8@\xba\x93\x84|J" +
-	"\x9fC\xe9S\x1f\xfe\xf8\xce\xb9/\xfe\xcc\xa4\x97\x0cd" +
-	"\x06I\x98D\xe9'\x18P\xa0\x0f\x7f\xfa\xa5\xf1H\xc4" +
-	"\xf9M\xac$b\xea\x11\x03\xf4\xe0<>\x90z\xd6@" +
-	"pM:\xf4\xcf\xc5\xfe{\x7f\xfdk\x13\x17w\xa3N" +
-	"uq8\x92\x9a\x1d\x0f\xd4\xc5\xf1\xd8|Q<I\xe5" +
-	"H\xfd\xe4\xa8\xa1\xed\xdb\xfcR\xf81k1H\x15\xe2" +
-	"\x81\x9fZ| \xd5\x1bO\xd0\x85\x1f~!\x93\x8f," +
-	"*\xfa\x98\xd5\x81-<\x8c\xd8A\x1e\xdf\xb0\x8f\xc7\x0e" +
-	"\x9c}3\xa6\xbeS\xec\xe2\x8f\x99\x1a1\xd0H\xde%" +
-	"\x09\xf9F*!#\x0e\xf1\xb1[ko\x8a\xad[\xf9" +
-	"1DYD\x1be\xa5^4\x82<#\xdb\x01\xf5\x03" +
-	"\xed\x08\xc6Yw9\xb6X_x\xf3\x9b\x8fY\x01H" +
-	"\xeay#\x19\xe7\x7f \x95\xb4\xa3S\xc0\xd4\xbfl\xe6" +
-	"\xe6\xde%\x9f\xc0\x13-C:\xd0\xee\x06\xe8q\x9f\x1b" +
-	"\xf0\x81\xa4\x1b\xc8\x93z\x08,z\xfcz\xf8\xe0\xbff" +
-	"~\xc2\xf2\xbeR\x07v\x84Y\x96\xdf\x91\xf6\xa0#\xf6" +
-	"\xe0\xc1\xa4\x15\xb3?\xff\xf6\xfdOX:t\x1e\xe9[" +
-	"uB\xfa\xc8N\xa8\x13\xed\xfe~\xab\xd3w\x9b[~" +
-	"\xca\xb4[\x9d\xc8d\x920\x88\xd2\x0f\xecD'\xa5g" +
-	"\xe4\xb7E?\x9f\xf94T'\xf0\x05\xf3;\xc1$[" +
-	"\x89\x0f\xa4.\xefDp\xb3\xa2\xb0\xdd\xbd?\xdf\xff\xf2" +
-	"\xbd\x9bYoX\xd9\x85\x94\x90\x84M]\xf0\x0d\x1b\xbb" +
-	" GW\xef8z&\xf5\xe4\xd8\xcd\xaci\x7f\xb0\x0b" +
-	"\x8c\xd9yJ\x7f\xb6\x0br\xd4?\xefy\xe7\xe7+\x9f" +
-	"\xd8\xccX\xf8SG\xdf\x08\xedW\xdd\x88\xf4\x8e\x1bQ" +
-	"B\x0b\xdc/L\xdf`&\x9f1\xb5\xbaU\"\xe8i" +
-	"\x97D\xecA\x87D\x82\xd9\xf1\xca{\xf2/u\xd3\xa7" +
-	"|\xc6\xe2hy7\xe0hS7\xda\x83n\xc8\xd1\xf9" +
-	"Y\xb7'\xc4?\xba\xf13nO\xe7\xc8k\x86 5" +
-	"\xea\xd2\x9d\xb4&\x09}\xba\x03\xf9\xc8^\xdd\xa9\x1e\xfd" +
-	"\xfaz\xda=\xbb\x9c\x9b?c\xaai\x87\xee0\x08\xf4" +
-	"\x89\x84\xa4\xee\xd8\x85m7\x0f=zb\xd4\xe5\xcfX" +
-	"\x96\xe8`w\x92B\x12\xceP\xfa\x93\xdd\xd1\x12\xcd=" +
-	"\xb9w\xeb[\xb6\x94\xcf\x99/\xd8t\x13\xf4`\xdfM" +
-	"\xf8\xc0\x9e\x9b\xb0\x07\x1f\xd7m\xdc\xf6b\xcd\xc2/X" +
-	"/\x18\xd8\x03\x16\x83\xfc\x1eT\xebz\xe0\x0b\x1e\xff(" +
-	"\xfb\xf0\x17\xe7\xde\xfe\x829\x95\x93z\xc2 \x0c\xeeI" +
-	"m]O:\x95\xbfK\xaav_z/~\x0bK+" +
-	"\xe6\xdf\x0cSg\xe5\xcd\xf8\x86\xe57#G\x8fm\x8d" +
-	"r\x16\x0f\xcc\xd8\xc2\xe2\x88\xdc\x02\x1cu\xb8\x05\xe9\xdb" +
-	"\xdd\x82\x1c%\x98\x9fH\xb0\xf6\xaff\xd2G\xf6\x025" +
-	"\xed\xd0\x8b\xd2\xf7B\x91>\xfc\xecb\xd3\xa6\xfd3\xb6" +
-	"\xc0\xcc\x1f\x104d\xebz\x11\x1dI\xd8\x8c\xd4#?" +
-	"\xeaE\x87l\xf5O\xdb>\xff\xf3\xe1\xf9[\x98\x12]" +
-	"\xd5\x8b|C\x12\xb6\xd1\xf6\xb7\xd0\xf6WX\xf6v\x88" +
-	"\x1d\xb2\x95\xfd\x80\x90\x04\x1d\xa8M\xa2\xabY\x12v\xb8" +
-	"\xeb\x1d\xcb\x07|\xd6\xfb[\xa6\x80\xf6%\x91L\x92p" +
-	"\x92\xd2\x1fG\xfa\xbfn\x8fZ]_\xfa\xeb\x96\x10S" +
-	"\x14\x89kA2\xcc\xfb\xa2d|\x97)\x99N\xcb\xff" +
-	"iu\xe4p\xc9\xe6\xac/!|h\x11\x9c\x9dN\x95" +
-	"zC\xfb\xb5\xbd\xa9\xf1\xed\x8d\xb3\xe0j\xd1\xd4\x87\x0e" +
-	"l\xf8\xfaK\x863\x9ez\xf16\xf0?Z\xddN\x0d" +
-	"\xcb\xed8\x00\xad\x7fw9\xd6\xbf|\xcfW,\xfe/" +
-	"\xde\x0e\xb6\xbaU_J\xdf\x17\xfb\xeb\xbe\xb2\xe4\x86\xc5" +
-	"\xe3G}\xc5\x1a0\xb1/\xf0\xe3\xa5\xf4U}Q\xa0" +
-	"\x85\xc3u\xf2\x82Kc\x98\xed\xc7\xf4\x83\x01\xee\xd6\x0f" +
-	"\xe9\xbb\xf4\xc3\xf6\x93\xa2\x8a\x1f\xf7&\x1f\xff\x8a\xed\xd1" +
-	"\xf5#o\x90\x849\xf4\x81Y\xfd\xf0\x05\xeb\xfa\xdd\xfc" +
-	"\xf1\xfc!\xad\xb76\xdcOI\xed\x93\x02\xe3\x95\x9dB" +
-	"\xa28}\xfd\xa1;\xbe\x95LKb\xb6\xb2\x18\xe9\x86" +
-	"\x84\x83R\xa8\x05MA\xfb\xf6\xd2\xab5\x83v\x7f\x92" +
-	"\xb0\x95\xd5\xd1\xe2\x14\x98\xec\x0eJ/\xa5 \x1f\xd3\xcb" +
-	"\x7f\xe9s\xcb\xd2\xc2\xadL\xaf\xa0?\x98\xab\x0e\xfd\xa9" +
-	"&\xf7\xc7\xf6\xe3\x8f\x99\x7f\xab}\xa2zk\x83La" +
-	"jv\x7f\x12O\x12\x8a\x90zdA\x7f\xaa\xc9u\xc3" +
-	"\xfe\xbd\xf0\x13i7\x93\x9d\x8c\xfe\xb0$\x15\xd3\xe6\x8b" +
-	"\xfa\xe3\xb8v4\xe9\xbcc\xfbVoc\xaeH\xfd\x06" +
-	"\x00?\xd9\x03\xf0\x81\x8c\x01\xf8\xc0\x82\xe2\x1d\xb7\x8ex" +
-	"\xf2\x85m\xac\x17t\x1b\x08/\x184\x90\xcag \xd2" +
-	"o\xba\x7fg\xe5\x0f\xcfTl\x07\xfa\xd6\xa1\x1d\x8e\xbb" +
-	"\x03lI\xcf;\xf0G\xb7;\xc8\xe2\x08\x8e\xd4\xdf\xb6" +
-	"\xe4\x8e\xca\xd9\xbd_\xdc\xced)\xee.`\xa9\xe7]" +
-	"\xf8\x8anw\xa1HGm\x1f|-\xb7\xe3\xcb\xf8@" +
-	"[M0\x80\x0f\xec\xbc\x0bt\xe1$>\x90z\xfc." +
-	"\xf2|\x0b\x8e\xd4w\xce\\\xd0~\xaa\xbcn;\xab\x13" +
-	"\xe73\xe1\x0d\x91C\xa87=\x04\xdfp\xef\x94\xd2\xe7" +
-	"?\x1f\xf2\xc5v\x96R,\x1a\x02\xb3e\x0d\xa5_5" +
-	"\x04\xb5sa\xd2\xef7m\xca\xffg;k\x90\xe3\xb2" +
-	"\x80\xbeg\x16\xedA\x16\x0er\x8f\xc5\xab\x1e\xac\xf2f" +
-	"\xef`\xd1gg\x91d\x92PD\xe9M\x94\xfe\xd39" +
-	"\x0f\xef\xd8\xd0\xd1\xbc\x83\xb5\x84Ue\x81\xef:\x95\xd2" +
-	"O\xcaB~\x9e\xd8\xf7\xdck\xb7\xbc\xf6\xc2\x0e\x16\xff" +
-	"G\xb2@I\xcfS\xfa\xb3\xb4}\xb1\xc7\xd3##\xbb" +
-	"\xc5\xee\x0c]\x00\xf0\x051\xd9`\x7fzf\xd3A\xcb" +
-	"&\x98\x84\xfa\xbd\xdd\x92\xd9s_\x18\xb43t\xd0\xd0" +
-	"bm\xbe\x0f\xbc\xdd\x83\xf7\xe1\x13\xfb\xee\xa3K\xc6=" +
-	"\xbf:\xee\xf8\xc8qt'k\x0c\x84a\xc0\x93w\x18" +
-	"\xb5\x10\xc3p\x0cV\xbfe|$\xe3\x99;w1\x17" +
-	"\xa5V9`\"\xba\xe4\xd056\x87\xbe!_r\x1e" +
-	";T_\xb4\x8b%U)\x174oB.\xbe\xa16" +
-	"\x97:\xe0\xf3\xa3\xbbl~\xf8\x8e\xaf\x991\xd0\xca\\" +
-	"\x98\xfb\x9b\xe9\x03\x9br\xe9B\xbc(\xae\xea\xda\x14\xd7" +
-	"\xd7,_e\xce\xfd0\xcc\xcb\xefG\xfa%\xf7c\x17" +
-	"*\xefqo\xfc\xbd\xe3\x89\xaf\x99!G\x1e\x18QS" +
-	"\x1e\x0d9\xf2\xb0\xfdG_8\xd8c\xe7\xdas_\xb3" +
-	":\xd03\x1f\x1c\x83\x81\xf9H\xdf/\x1f;0f\xff" +
-	"\x98Y\x9f9\xda\xeff\xd88S>\x0c\x81\x94\x8f6" +
-	"\xee\xd9\xa4\x1fJH\xd7\xd9\xbb\x99\xea\x96\x0f\xc6\xb9\x88" +
-	"\xb6k\xa2\xed\x8e\xe8p1\xe2\xfb\xb8\x8b\xbb\x99\x82\x99" +
-	"\x94\x0fc5\x9f>0'\x1f\x19\x8fy\xf8\xdf\xb6\xc8" +
-	">\x91{X\xa58\xa9\x8e\xe10\xc1&\x0d\xc7\xa7'" +
-	"\x0c\xa7\xae\xf4\xca{\x16\xce\x8fz\xb2\xfa\x1b\xa6\xc6\xad" +
-	"\x1a\x01*\xbdy\x04upFP\x8d{\xaej\xc2\x99" +
-	"{.\x98\xbee\x9a\x89\x1c\x13I#\x09\xc5&j\xea" +
-	"L(\xfdg/\x1fz\xb1|K\xc9\xb7\xcc%#\xce" +
-	"\x8c\xb3\xd2Lg\xa5\x19g\xcd\xda\x09\xaf\xff9\xfe\xf6" +
-	"\x0f\xbfe\x94o\xa5\xce2C'\x96 }\xea\"3" +
-	"\xed\xc4\x96\xd6\xcf\xa6\x9d\xb9\xf5\xe7\xbd\xacx`\xa3\x05" +
-	"\xbc\x88}\x16\x1a\xbeZ\xc8\x08\x88\x07N\xdd\xb0\xa3|" +
-	"\xe2\xa4U\xfbX\x0aQ4\x12\xde \x8dD\x8e\xc4\x91" +
-	"(\xd7\xdf\xef\x1cZ\xb9+\xfe\xfb}\xcc>\x0f\x1a\x05" +
-	"]\xc8\x1fE=\xb9Q\xf8\xc0\xe7\xdb\x06(e\x03\x8d" +
-	"\xfbY\x13?\xbb\x18\xac\xf5\xe8b\xa4/.\xc6.\x8f" +
-	"\xfe>u\xab<\xfa\xc1\xfdL\x19m,&\x1f\x90\x84" +
-	"=\xf4\x81\x9d\xf4\x81\x9c\xcfb\xbe\xd8\xd2j\xf2~\xa6" +
-	"\xfb\xdd\xa7\x04feF\x09>=\xb8\x04\x83\xca\xbf\xf6" +
-	"M+|\xf0\xec\xaa\xfd\x0c\x99N\x7f\x08\x18Z\xf2\x10" +
-	"\x95\xe9CT\xa6\xcb&w/\xfa\xb1S\xeew,\x99" +
-	"\xae\x19\x0d\xaa\xb7e4>\xb0y4\x8d\xb1\\\x9d\xef" +
-	"\xea\xf6\xe2\xb2\x92\xef\x98y\x06\x01\\\xd1Z\x81zf" +
-	"\x02\xf6\xe0\xb6\xde;\x07\xadX-}\xc7\x94\xe9\x1e\x01" +
-	"dz\x92>p\\@=\xba\xb4\xac:\xad\xfd-'" +
-	"\xbec\xcahB)L\xe39\xa5\xd4\xf5(\xc5\x07\xee" +
-	"J\xd8rd\xf1$r\x80\x19d\xf5\xb4\x92\xed$!" +
-	"\xc3Jed\xa5\xa9\x839\xfb:^zY\x89:\xc0" +
-	"\x9cp1\"x\xf8=Ej\x7fE\x14\xd3\xb5\xda\xca" +
-	"\xec\x9b\x8e\xba\x0e\xb0lcv\x19\xb0T\\\x86?\x8a" +
-	"\xca\xe8\xec\xd91\xb2\xd3\x94_k_8\xc0\xd2\xed\xe3" +
-	"\xe5\xf0\xc0\xc5r\x1ae\x96\xe3\x0b\xfe\xd1W<\xb3|" +
-	"\xf2\xeb\x07L7\x92B\xcd\x1eM\x0b4\xbe\x12\xd8\xd2" +
-	".\x125\xbe\x129\xda\x93#\xf5K{]+\xef\xf2" +
-	"\xd4\xc6\x03\xact\x89\xf0,<\xe0}\x16\x1f\xa8z\x96" +
-	"r\xf4\xd5}\xf3w=\xf8\xf5\xdf\xf8@\xa7\xd0\x15$" +
-	"f>X\xbb.\xf3\xe9+\xe6\x93\xf5\xd1\x1c\xa9\xbf\xd2" +
-	"\xb2_\xea\xa8\x15\xd6\x83L\x9bqv)X\x80\xabK" +
-	"\xf1\x89\xcbK\xe9;\x8c\xd2M#\xb3oZt\x905" +
-	"\x1d\xa6.\x03\x0b\xb9h\x19\x8e\xdc\x82eh\xf8\xfa\xec" +
-	"\x8a\xfa\x8c?\xba\xf3 k~nZ\x06\xf3s\x0f\xa5" +
-	"\xdf\xb9\x0cG\xda \x7fy\xef\xe9\xf8\x8e\x87\x98^\xef" +
-	"r\xd0\xee\x09\xcb\xe9\x8a\xb3\x9cf\x01\xd7\x1ez\xba\xb8" +
-	"\xf0\xadC,~\xe2^C\x8b\xf4\x1a\xb5H\xaf\xa1\xae" +
-	"~\xf0\xfc\x17R\xcc\x1b;\x91\xbeM\x03\xd5{\x0dz" +
-	"<\x0b\x1fH\x9d\xfe\x1a\xf9\x0e\x9c\xa9\xd1\xb1\xd6\xbe\xe9" +
-	"\x1b\x1e\xf8\x9e\xb5\xdd\x98\xba\xe7M\xe8\xf2\x997i^" +
-	"\xefM\x9a\xf4\x995\xec\xdc\xf9\x88D\xf1{\x16OI" +
-	"o\xc3\xe20\xe8m\xea\xe0\xbd\x8d<\xdd\xf2\xbe\xfbg" +
-	"[\xfc\xa4\xefY\x8b\xc9\x9c\xb7q\x11\xa4\xf4K\xdeF" +
-	"\x99\xcek\xbf\xd8\xfb\xf7\xa9\xb9\xdf\xb3\xe6\xe7\x9e\xb7a" +
-	"B\x9f\xa4\xf4\xc7i\xfb;_~:\xdf\xfa\xf0\xdbL" +
-	"~\x06\xbf\x03\xed\x9b\xde\xa1\x8b\xe6;4KT9:" +
-	"\xe2\xc6\xd6\x9b\x99\xf4\xab\xde\x811\xdbL\xe97Q\xfa" +
-	"\xa7+\x0ft\xebv`\xe1a\xe6\\\xeb\xb2\x1a\xa6B" +
-	"\xbf\xd5\xf8@\x9f\xd5hS\x1fxe@\xde\x13o^" +
-	"9\xcc\x9c\xff\xad\xd6\x80\x85\xe9\xb2\x86&\xf6\xd6\xe0(" +
-	"\xaf\xff\xfc\xa1\xcfFZ\x12\x8f0=\x975k\xa0\x0b" +
-	"[\xd6P\x1b\xb6\x86z.K6\xfe\x92\xba\xd7=\xfb" +
-	"\x08\xab\x0f]\xde\x83\xc5\xb0\xdf{\x94\xa5\xf7P\xa6\x93" +
-	"\xdf\x98\xb8e\xe2\xc7\xa6\x1fXc`z\x0f\xc6L\xa0" +
-	"\xf4\xa3)\xfd\xeb\x13\x97\x96\xb5\xdc\xb5\xec\x07\x96\x9eN" +
-	"z\x0f\xba<\x9f\xd2\xcfy\x0f\xba\xfcO\xdb\xc5O\xf5" +
-	"\xb6\xef\xfb\x81Q\x18\x9a:u-\xf0\xbf`-\xcd\x18" +
-	"\xad\xa5\xc1\xe5\xaa\xb6\xdbn\x8e\x8f>\x1a\\\x09N\xd9" +
-	"\xe9\xb3\x1e\xd7\x84\xf5\xd8\xfc\xe0\xf5(\xa0\xf9\x93\xde\xf8" +
-	"\xea\x1f\xafp\x94)\xd1#\xeb\xd1?\xa5\x0f\x9c]\x8f" +
-	"c\xf6\xfc\x92\xec\xbf\xbeL\xaa<\xca\xeao\xfe\x06\x18" +
-	"ca\x03\xed\xef\x06\x1a\xa4\xd9\x9f\x9fG\xae\x8d;\xca" +
-	"\xb4\xf1\xb36\x80?\xbb\x9c>\xb0d\x03r\xf4\xe6\xf9" +
-	"\xc9W\x9e\xd8`8\xc6\x1a\x80\xfc\x8d0\xc4\xc2F\xfa" +
-	"\x82\x8d\xf8\x82\x8f\xfe\xbd\xf7\xa6?\x94\x1eL\xfa\x09\x1b" +
-	"a\x00fQ\xfa\xe9\x1b\xb1\x03\xd9\xaf\x1fx\xe1\xe2\xc4" +
-	"\x95\xc7\x98=>\xb9\x11\\\xcd\xab\xf4\x81\xcb\xf4\x81\x99" +
-	"k;\xc4w\xf9f\xf51VUGj\xf1\x070\x06" +
-	"\x8e\x0f\xa8g\xfb\x01]8s\x9e}\xee\xc3q\xb3w" +
-	"\x1dc\xd5\xc6\xa4N\xf8\x10\x84:\xe7C\xbaL}H" +
-	"\xd7\xfe?\xban\xcc\xee\xf8\xe31\x8e\x95t \x1f\xc1" +
-	"\xb0\xb5\xfb\x88:K\x1f\xd1\xa4\x83u\xd6\xc2i\x1f?" +
-	"\xd0\xfa8s\xea$}\x0cz4\xf8c\x9a'\xfa\x98" +
-	"2\xb5.\xab\xed\xcd\xe4\xfd\xbe\xc7\x1bfd\x8b>!" +
-	"\x1dI\x82\xf8\x09F\xb3\x8f~B\xa3\xd9\x9f\xbf\xd91" +
-	"XY\xb6\xf4G\xe6\xcc1}\x82\x8a\x8dO\xa4\x8e\xfe" +
-	"\x84Z\xf8\xc9e\xbd7\x0c\xfd\xf8\xa6\x13\xcc\x91>\xf9" +
-	")\x8c\xf4\xd5O\xa9`?\xc5\x91.X8'\xd6=" +
-	"\xe7\xed\x13\xac\x91[\xb2\x99|F\x126nF\xfau" +
-	"\x9bq\xa4\xfb\x92\xa9\x13\x1e\xfb\xbb\xefO\x8c\xd2\xee\xd4" +
-	"\x83\x9bA3\xce\"}\xea\x99\xcdTH\x97\xd6\xfcz" +
-	"\xd7\xbb\xbf\x9f\xfa\x89\xf5\x82\xb3\x9f\x83T\xc9\x17\xf8\x82" +
-	"\xab\x9f\xe30\xfc\x90f\xdbUT\xdc\xf5$s\xe7\xe6" +
-	"\x0b\x18\xe8*J\xef\xf8\x02\xe9/\xe8>-^\xf3\xc5" +
-	"\xe1\x93L{\xfa\x05\xcc\x85\x93\x94\xfe8\xa5?\xbf\xf1" +
-	"ZM\xa1\xf5\xd6\x9f\x99A\xc8\x16Xf\xf3\xb7P\x17" +
-	"r\x0b\x0a\xe8\xaa\xfb\xd2\xe8\xbf\xe7f\xfe\xcc\x9ak\xc7" +
-	"\xb7\x80\x1a]\xa4\xf4\xe7\xb7\xa0\x80z.\xb2\xae=\xf4" +
-	"\xc7hl?N\xa3wtQ\xfb\x12$\xd4\xf3K\xea" +
-	"\xbb|I\x12[r\xa4~{\xb7\xad\xf7-{g\xc2" +
-	"\xcf\xac0\xea\xec.\x08\xdf#\xbf\xa6\xd1\xf8\xd7\xc8\xd1" +
-	"\xd8\x1d\xc7\x84W>ly\x8a\xd5\x83\xe5_\x83R\xac" +
-	"\xa3\xf4k(\xfd\x8a\x99\x8bK\xba\xdf|\xcb)\x96D" +
-	"sv\x83DG\xef\xa6N\xf0n\x94\xd0\x84\x93\xb5{" +
-	"\xac]o;\xc58\xc9\x92\xban7\xf0\xb3\x93\xd2o" +
-	"\xdb\x8d=^|dD\xc2\x1b/\xdfw\x8a\xb9qp" +
-	"f7\x88\xe8\xean\xea\x87\xec\xc6i\xf0G\x97\xfe\xe7" +
-	"\xe5\x98\x97N\x85\xd0\xe3\x0b:|\x03nB\xbfo\xa8" +
-	"\xb1\xfc\x86\xfa\x83\xdd\xdb\xad>\xfc\xd1\xef\xbf\x9dby" +
-	"k]\xf6\xe2z\xb0\x97>\xb0\x97\xce\xb3)\xc2\xaf\x99" +
-	"\xdb\x7f\xbf\xe74e)0\x08\xb4\xcb\xfbH2I(" +
-	"\xdeGc\xa3}\xb8\xa6\xad\x7f\xfa\xf4\x9f\x03{\xbet" +
-	"\x9ai\x8f\x92\xf6\x03K\x19\xfb\xa9\xc9\xdeOW\xd9\x9a" +
-	"?\xde\xbd\xa9\xdd\xfa\xd3\xcc>\xaf\xdcO\x0e\x91\x84-" +
-	"\xfb\xe9\x9a\xb6\x9f\xb2tg\xe1\xf7\x9d\x0f\x8f>|\x9a" +
-	"5\x0a\xc7\xbf\x83Q\xb8\xf8\x1d\xd5\xa3\xefP\xaaY5" +
-	"\x1e\xc3\xe9\xd7\xfb\x9ea\x8dr\xbb\x030\xca=\x0fP" +
-	"\xdf\xe8\x00\x8er{s\xcd\xa0\x98\xe1\xaf0\xe97\x1e" +
-	"\x00[\xb4\x93\xd2o\xa3\xf4qI\xfc\xfb\x9f\x9c\xce\xfa" +
-	"\x85Qp\x91*\x1e\xc4\xfd\xc8\x834N8\x88=\xbe" +
-	"ae\xcd\xa4>w\x7f\xfc\x0b\xab\xfd=\x07q\x9eQ" +
-	"\xfa\xe3\x07i\xc2q\xdd\x17\x87\xb7\\\xb8\xf6\x0bk^" +
-	"N=\x84\x8b\xe6!\xa4\x9f\x7f\x08\xdb\xffi\xe9\x95\xbd" +
-	"\x1f\xbdV\xf2\x1bs\x08.\x1e\x02\xdf.\xf2{:\x0d" +
-	"\xbe\xc7\x07\xa6L\xa8\xda\x13\xf5\xd8C\xbf\xb1\x18r|" +
-	"\x0f\x1e\xf6$J?\xe1{\x1c\xe3^c\x1c\xebw\xce" +
-	"\xd9\xf4\x1b\xcb#/:\x0c\x1d\x96\x0e\xe3\xcb\xc4\xc3\xd4" +
-	"\x96\xbe\xa7\xfc{\xcfcI\x13\xce\xb2^p\xf1\x08X" +
-	"\xaeV?\xd0\x9c\xef\x0f\xd8c\xc7\xabm&\x8d\x7fm" +
-	"\xd5Yf\x0f\x16\xfd\x00\xf3`\x0d}`\x15}\xa0z" +
-	"\xd4\xe4)i\x8b\xdef\xbe \xfb(,\x82\xa3\x8f\xd2" +
-	"\x89y\x14{\xb0\xf8\x8f.\xbf\xfeq\xf4\xc2\xef\xcc}" +
-	"\x8cc\xd0\x83\x9ccH\x9f}\x0c\xe9\xcf\xfeR<\xd7" +
-	"\xd47\xea\x0fF>$\xe98\xb8\xbe\x19\xc71\x1f\x92" +
-	"\xf7\xd6\xbfV\xac\xfa\xb2\xea\x0fV\xa5lj\x97\xe3\xe8" +
-	"\x02\x1e\xa7\xf3\xeb8:hG\x9f\xfc\x9f\x92\xe1}N" +
-	"\xfe\xc1\xea\xe8\xd4\x1f!J^\xf4#\x8d#~\xc4\xa1" +
-	"Z\xf0\xd3\x84\xa5\x0b\xe3\x8d\xe7X\xba\xdf\xea\x04\xa6\xae" +
-	"NP\x8f\xf1\x04\xd2\xb7\xd9\xba\xa4\xdf\xb4%\x83\xcf\x85" +
-	"\x9e\xc1B\x86jO\x00C\xb3NP?\xff\x04\xcd$" +
-	"tQv\x0d\xe9V3\xf4\x1cK\xdb\xc8)P\x9e\xb8" +
-	"S\xf8\x86\x98S4\xb7!$]\xfd~\xf1\x03\xe7X" +
-	"\xa2\x94N\x81vN\xa0\xf4\xb5\xa7P\x94B\xc1\xd7\xce" +
-	"\xf5_?\xc5\xec\x81\xe94\x04\xa4\xd2i\x9a\xa98\x8d" +
-	"\xb3\xb7\xfc\xddIS\x8f\xa7\xfdz\x8e\xb5jL?\x0d" +
-	"C\xbb\x84\xd2/\xa2\xf4\xaf\xfdY\xfcL\xde\xb9E\xe7" +
-	"\x99\x91\xd6i\xe8\xf0\x1eJ\xbf\xf34\xf2s \xe6\x9d" +
-	"S\xba\xff\xd9\x81\xf4\xd1\xa1k\xc0\x19\x98\x8d\x1b\xcf\xe0" +
-	"\xcb\xd6\x9d!C#9R\xdf\xe3\xd5g~\xe8\\u" +
-	"\xe2<\xcb\x84\x0e\xfe\x03,b\xfe\x1f\xf8@\xce\x1f\xd4" +
-	"^}\x17]\xf2\xec\xd2\x0f\xfe:\xcf\xeaq\xf19x" +
-	"\x83\xe3\x1cM\xec\x9fC\x89\xcej\xb3\xeb\xf8\xc7Q\xfd" +
-	"\xff\xcd\xea\xf1\x9es0\x02\xc7)\xfd\x91s\xd8\xe3\xba" +
-	"\x9b\xdf\xf9\xdc01\xe2O\xe6l\xb9z\x0e\xe6o\xdc" +
-	"y:d\xe7\xf1\x05=N\xd6\xef\xbf4\xe8\xa6?\x99" +
-	"\xceP\xedyXXg\x9d\xa7\x02>O'\xf0\xddw" +
-	"\x15\xae\x10v\xb6\xbf\xc0\xca\x9d$\xfd\x09\x83\x90\xf1'" +
-	"\xcd;\xfcIs'\x13\x13\xde\xb9\xff\x9b\xac\xe4\x0b\xec" +
-	"m\xad\xbf\xc0{\xaa\xfd\x8b\x1a\xc5\xbfp\x06\xd7\xd5\xe6" +
-	"\xddq\xc7\x91\x09\x17X\xc3\xd6\xf3\x12\x08i\xd0%\x1a" +
-	",^B\xfa^\xcf\xa7|=\xab\xdf\xe7\xec\x17\xec\xbc" +
-	"\x04}8N\x1f8B\x1fpG\xf7^\xfcm\xfbQ" +
-	"\x17\x99\x0fx/\x83\xa2N\xbf\x8c\x0fL\xbd\x8cRZ" +
-	"\xb2{\xee\x90W\xeaV\\d\xf9g\xc7\x91\xfe\xe2e" +
-	"\x9a\xd8\xb8L\xfd\xb3_\xc7\xbcb{I\xc7\xff\x87m" +
-	"w\xff\x81qhu\x85\x9a\xb9+\xc8\xd2\x80\x7f9\x7f" +
-	"\x9dx\xfc^\xf6\x03+\xaf\xc0+6\xd1\x076^A" +
-	"\x96r\xd7F\xdc~e\xdf\xe1\xff0\xd7\xca\xa4\xab`" +
-	"\xb7\x06_\xa5n\xf2U\x1a\xff\x8d\xa8\x1d\xfc\xcd\x86K" +
-	"\xbf\xb2_1\xeb\x1a\xc6+\xd7h\xbcr\x0d_q\xe4" +
-	"\xd4\x9d\x1f\x1c\xf8\xac\xed_\x8c\xe3d\xa9W\xaf\xc1H" +
-	"\xb7\xab\xa7\xae{=\xdd\x04\xff\xee\xf0K\xdf\xccu\xd7" +
-	"\xfc\xc5\xf4\x93W\x12\xdd\\\x92\xb0\x99\xe80L&:" +
-	"\x98p[\x84\xed+\x1fo\xf5\xce_\xcc-\x10\x9d\xae" +
-	"\x94$\xac\xd1!\xfd*\x9d\x0e8\x8a\x18\xf5`\xeb'" +
-	"\x1eX\xff\x17\xb3\x0b1z\xdd\x0c\x92\xd0S\x8f\x0ft" +
-	"\xd3\xeb@\xac\xef\xfd\xd1c\xf8\x83UG\xffb\x06\x13" +
-	"\x9b\xf4\xba\xed$\xe1 >\x90\xbaO\xaf\xa3\xa9\xc1\xef" +
-	"\x17^\xfe\xc1\xfb\xafK\xcc>\\\x8e\x00\x9eb\xf0G" +
-	"B\xabH\xec\xc3\xa8]\xfc\xf3\xd1\xfb\x17^b\xf5\xe1" +
-	"L$\xf4\x99\xb4@\xfa\xab\x91:\x98\xa2\x17\x1fy\xae" +
-	"\xfb\xce-\x87\x99\xf4\xddZ\xe8JH\xc2@J\xdf\xaf" +
-	"\x05\xf6\xf9\xb3%\xb7\xd8\xd3\x17F_f\xcd\x86\x05H" +
-	"\xbf\x8a\xd2\xafl\x81\xfcHm\xd6\xc4?1<\xfb2" +
-	"\xeb\xa8m\xea\x84(]2I\x98\x15\x85\xbd\x99\x1e\xa5" +
-	"\xc3C\x97\xcfv\xbd\xfd\xd8\x8bb\xec\xdf\x10?u\x0d" +
-	"\x8a\x9f\xf2[\xeb\xd2H\xc2\xe8\xd6@>rTk\x1d" +
-	"\xc6O\x07o\xbb\xe5\xc9a\xbb\x07\xfe\xcd\x1c\x84\x9c\xd6" +
-	" S\x11\x9fH\x10Z\xe3 \xc4}y\xcb[3\xc7" +
-	"\xe4\xff\xcd\xdc\x97o\xa3s\x93\x84vm\x90>\xae\x0d" +
-	"\xd2\xdf\xbf\xf7\xf0\xc6\xf57?\xf07\xb3\x1a\xa4\x0d\x8c" +
-	"\xc0&J\xbf\xb1\x0dJT\xf8\xe1\xd1\x13w\x8f\x9a\xc8" +
-	"f\xe8H\x1b\x10\xd1y\xfa\xc0Y\xfa\x02o]\xff." +
-	"\x86\xa5\xeb\xfef\x95\xf9\xa7\xce\x8a\xd6\x8d#\x09K\xa2" +
-	"QD\x8b\xa2u\xe8+\xaf\x9e\x96q\xf5\xdb\xa4\x16\xff" +
-	"\xb0\xecpm[\xddd\x920\xab-\xbeaz[d" +
-	"\xe9\xe8m-\x1e\xfe\x8f\xa3\xf8\x1f\xf6tn\x0b\x8a\xba" +
-	"\x99>\xb0\xa9-\xb2\xf4\x97a\xde\xac,{\xd6\x15\xa6" +
-	"\xa2\x16\x1b\xa0\xd3\x0e\x03> \x19p\x98/\xfe\xb4\xff" +
-	"\xdcWc\xaa\xaf\xb08\x1a\x1c\x0b}6\xc5\"}~" +
-	",r\xf4\xe3s\xba\xdc9KG]e:v\xb1\xd0" +
-	"\xe5I\x94~B,2\xb4\x9f\x8f\x1d\xdb\xe7\xa6i\xd7" +
-	" ^\xd1\x85\xe6[:\xc4\x01C}\xe2\xf0\x81\xa48" +
-	"dh\xc5\xe9\x15\x91\x09\x9d\x9e\xbd\xc6\x1c\xe5x\x1d\x04" +
-	"\xfa\xf1t\x94\xe3\xf1\x05\x87f\x1c\xa8\x9b\x9f\xf2p=" +
-	"\xb8\xca\xfa\x90\x0a\xfd\xd4\x95\xf1\xc0\xd1&| uc" +
-	"\xbc\x0e\x0dX\xcb%\x8b\x9f\\g\xbfR\xcf\xd4\xec\x18" +
-	"\xa3.\x97$t3\xe2\x13]\x8c\xa0\xd9C\xea%Y" +
-	"\x11\xdd\xb2`\x8f\xbc]pI\xb7[\x05\x97\xec\xba\xdd" +
-	"\xeat8\x9c\xf2m\xf8#m\xa8\xa8\x0c/\x18\x92%" +
-	"\x09vg\xb9W4\x8b\xe9\x1e\x97S\xf6\x88\x05\x84\x98" +
-	"\x8c\xfa\x08\x8e\x8b \x1c\x177!\x93\xe3L5zb" +
-	"\x9a\xa2#\x84\x18\x09`\x93R8\xce4^OL\xd3" +
-	"t$NG\x8cD\xc7qqSs9\xce4EO" +
-	"L\xb3u$N\xaf3\x12=\xc7\xc5\xcd2s\x9ci" +
-	"\xa6\x9e\x98V\xe8H\\\x84\xdeH\"8.n94" +
-	"\xf9\x8a\x9e\x98\xde\xd2\x91:\x8f\xd7j\x15=\x1e\x12\xc1" +
-	"\xe9H\x04G\x12E\xb7\xdb\xe9&\xd1\x9c\x8eDs\xa4" +
-	"\xde\xe6c\x8e\xe3\xb8\x00\xe6\x16)\x9f\x1c\xf1\x90\xb6\x1c" +
-	")\xd0\x13\xfcS[\x8e\xd4\xc9.\xebp\xc1!\x06H" +
-	"\xfd\"\x88hL\x04\xd95\xae\"\x97MP\x08\xf6\xba" +
-	"e\xa0\xd7I\xdd9\xce\xd4COL}u$\xce\xdf" +
-	"\xed>\xc9\x1cg\xea\xa5'\xa6\x01:\x12%\xd6\xb8\xfc" +
-	"<\x1b\x04A\xfd\x11xg\x8b\xc6\xde\x99U+\x0b\x0e" +
-	"\xc9Z\xe2\x94\xc5!\x15N\xa7G\x84\xff\x99E\x97\xde" +
-	"^\x0bLD\x07\x98\xc8\x06\x89f\xe9\x89\xa9@\xc3D" +
-	"\xfe\x18\x8e3\xe5\xe9\x89i\x14\xc8^Ge_\x94\xc6" +
-	"q\xa6\x02=1=\xa4#\xf5\xb6q\xd0`\x8e\x0dd" +
-	"\xe6g\xca6.G\xf6(\x82\xcc\x19\xacb\x8e\xcd\x0f" +
-	"\xa7\xdb\xc6\x15\xd6\xba\xc4\x06\xac7*\xaeBI\xb1\x8b" +
-	"y\x92\x87(!\xe2JQ\xc5\x15\x90V\x9aOZY" +
-	":\x92huze%\xf0Z\x05\x9a\x09\x0c^\xacz" +
-	"v\x95#\x006\xad\xb9\xf9^\xbb\"\xe5;\xab\xc5\x1c" +
-	"EtX\xbc\xa5\x1c\xd7\xb8\xe4\x02\x82sk\x05G\x18" +
-	"\x82+s;\x1d\x16\xbbS\x01\xc1\xc5\xaa\xd1/GH" +
-	",G\xeae\xaf\xa3Tt\xe7\xc8\\\xa2E\x11\xac\x95" +
-	"jg\x9c\xf0\x0c\xe3\x81&\xa5\x99\xe5\x15\xedf\xd1\xe3" +
-	"2\xf8g\x9dF\xa0\xb9\xaa\xaa\x05\x86\xbe\x1f\x80}\xf5" +
-	"\xc4t\xb7\x8e\xd4+\x82\xbb\\T\x82GY\x94\x15I" +
-	"\xa9\x0d\xc6\x9a\xe4\"G\xf6\xb8D\xab\xc2\xfdo0\xe0" +
-	"\xb2\x0b\xb5\xa2\xbbi\x06$Et\xf8^\x9f\xe9\xb5W" +
-	"\xc2(\x16\x08\x06k\xa5\x88z\x15\x11\xe0\"\x06\xf4\xaa" +
-	"\xa5\x9e\x98z\xe8H\"<\xa4*\x8d\x7f\xfb,De" +
-	"\x1a\xedf\xa6 \xdb\x9cvI$\xee\x90\x8e&\xb3f" +
-	"z\x8a\xaa\xbb\x06YcQB\x98Pw.\xc3\xd5\xdc" +
-	"\xa1^\xc9n\xcb\x17A\x95\xb2e\xc5]K5\xb7\xaf" +
-	"\x9f\x1d>\x83$s\x9c\xe5n\xa2'\x96a$\xa0\xbc" +
-	"|6I\xe18\xcb\xbd\x00\xe7\x11U\x7f\xf9\x1c\x92\xc6" +
-	"q\x96,\xc0\x0b\x88jw\xf9|b\xe68K\x1e\xe0" +
-	"\xa3\x88jz\xf9\"l\xbe\x00\xf0\x87\x00\x8f\x8c0\x92" +
-	"H\x8e\xe3\x8bI\x09\xc7YF\x01n\x03\xbcE\xa4\x91" +
-	"\xb4\xe08^ n\x8e\xb3<\x0a\xb8\x1d\xf0\xa8\x16X" +
-	"\x0e\xccKd\x0c\xc7Y*\x00W\x00o\x19e$-" +
-	"9\x8e\xaf\"\xa5\x1cgq\x01>\x1e\xf0V\xc4HZ" +
-	"q\x1c_\x8b\xed\xd7\x00>\x05\xf0\xd6-\x8d\xa45\xc7" +
-	"\xf1\x93\xb0\x9d\x89\x80\xcf\x04\xbcM+#i\xc3q\xfc" +
-	"t\xec\xd7\x14\xc0g\x93\xd01\xb0\x8b\xd5\xa2=0\xff" +
-	"J\x05\xb9Rt\x07\xd4\xcdZ!\xb8\x87\xd8\x05\x0f\xac" +
-	"\x0e~\xfb\xec\x16\xe4\xc0|\xadW$\x87\x98'x\x14" +
-	"N?BVA\xb7T\xeaU\xc4l.Q\x16J\xed" +
-	"\xaaET\x9c\x8a`/tK\x9c\x01\xfe\x1c\x80\xed\x82" +
-	"G)tK\xa5\\\x94\x16uyK\xed\x92u\xb8\x93" +
-	"\xd3+\xea\x124\xce)\x8b\x92\xecQ8\x83 [\x03" +
-	"\xb4\xe9\xe3\xd0D7\x98$\x8d\xea\xcd\x90\x0aA\xc9\x17" +
-	"=\x1e\xa1\\\x1c\x02\x00\xd5\x9b\xd8\x80\x1a\x0b\xa0\xc6\x0f" +
-	"\xe9\x89\xa9B\xa3\xc6b)\xc7\x99lzbri\xd6" +
-	"\x0aG\x09\xc7\x99\xeczb\xaa\xd1\x11\xa2\xa7\xcb\xb4\x17" +
-	"\x96iEOL\x13u\xc4\xa0\x885J\x80}\x07}" +
-	"g!\x17\x05\x0b\x85\x1f\xf5\x88\xb2Mt\x0f\x178\xbd" +
-	"f\xb1\x05\xc1z\x14\xc1\xc1\x11\x17\x89\xe4t$2\x9c" +
-	"i94_q\x96\x97\xdbE\xda\x1f\xcd\xccO\xf3\xcd" +
-	"|\xa3\x0eL,\x90\x84\xbf\xc2f\xd7\xb8D\x9b\xa4H" +
-	"N9\xcfi\xadtz\x95B\xc9\x01\xf3-Jq\xd7" +
-	"\x86\x88m\x1c\xc7\x99\x1e\xd5\x13\x93]](\xa4\x19\xaa" +
-	"\x80\x02\x0b\x85VBqzB\xc56\xc1\xac\xfaA\xf5" +
-	"\xa2\xef\xa5\\\xbaS\x0erB<\xa2\xd5)\xdb<f" +
-	"\":\x04I\x96\xe4r\xad\xc5\xae\x16e\xa5\xb0\xd6\xc5" +
-	"\x111\x18\x1b\x0erl\x86#\x93\xe9tV\x9a\xc5*" +
-	"o\x94\xe8QB\xd6B\x90\xe4\xbdzb\xca\xd3hF" +
-	"N\xb2f\x81\xf4)F>\xb8e\xc3\xf4\xc4T\xa8#" +
-	"\xe9c%\xd9\xe6\x1c\x1b\x98A\x8a\xc6M\xa8Sj\x94" +
-	"2\xc9\xde\x0c\xe6\x86\xe6g;\x9c\x8aX\x12\xe5\x94\xc5" +
-	"\x90aNV\x879X\xf1\x9a\xe5F9\x1d.\xc1\xe3" +
-	"\xa1\x06\x15^\xd09\xf0\x82u\xb0d\xad\xd5\x13\xd3\xa7" +
-	"\x9a\xdeo\x02\x1f\xea#=1m\xd5\xcc\x8b- \xa7" +
-	"O\xf5\xc4\xb4\x03F\xd871\xb6\xc1d\xd9\xaa'\xa6" +
-	"\xbd`D#\xa8\xff\xba'\x9e\xe3L;\xf4\xc4t\x00" +
-	",h$Z\xd0\xb8}\x00\xee\xd6\x13\xd3a0\x9f-" +
-	"\xd0|\xc6\x1d\x04p\xaf\x9e\x98\x8e\xfd\x1f\xf9e\xb6q" +
-	"\x96\xb1\x92b\xad\xe0\xf4*\x0d\xa9%\xad9\x1di\xcd" +
-	"\x11R\x13\xf8\xdf8\xff\xff\xc2p\xe5\xdc\x82Mtg" +
-	"zIm\x88\xc0@\x0c\xab\xf5\xc4\xf4\x91F`\x1bS" +
-	"4R\xf4\x0blS\xae*E\xbf!\xd9\x02\xd8\x17z" +
-	"b\xda\xad\xf1\xf7w\xa6i\x84\xe8[q\xe2\xf6\x94j" +
-	"\xe5\x15\xe9\x93\x17<~@OL?\xe9H\xba`\x85" +
-	"\x19\x1c\x08\x0c\\n\xc9\xaa1\xcc\xc8~\x88\xa7\xa1\x88" +
-	"\x0e\x98y\x9a`!\x1d0\x8d\x9d\x15\xecnQ\xb0\xd5" +
-	"Z\xb8(\xa7]E\xab\xbc\x02zN\xcd\xf2\x9b\xc0\xfd" +
-	"\xccr:\xdd\\\xa8\xe7\x94\xc6r(\xd2Tw*\xdd" +
-	"\xe6t\xba%u\xb0\x83\xfb\x19x\xb1\xbe\xb1\x17\xa7\x0f" +
-	"\xcd\x87^\x86\x18\xb2L\x96\xfdOS\xad[`\xd8\xa4" +
-	"LuQ\xf0\x0f\x9b\x03\xb0\x0a=1):R\xe7\xb4" +
-	"\xdb\xb4\xcbmz\xb9C\xfb\xb3N\x16\xc7\x06\xfd.\x15" +
-	"(y\xf8\xfc[\\\xc2X\xd9\xd3\x88\xbd\xef\xa1#\xe9" +
-	"\x1e$P\xbd\xac@!S\x88\x97\xd5\xe8+\xa2\xf2\xee" +
-	"\x1b\x1aF\x84\xa2\x89\xe7\x12\xab\x05\xbb7\xd0\x89 \x7f" +
-	"\xa3\xe9\xc59O\x04e\xf4TH.\x1aOF\x09J" +
-	"\xe8\xf0\xbc\xa8Y\x89\xc1\xe0\x02\xe8x\x97\xe3L.=" +
-	"1\x8d\xd7\x0cO\xed\\Ml\xed7CS\xdf\xe08" +
-	"\xd34=1\xcd\xd3\x91\xfar\xb7\xd3\xeb\xca\x13\x05\xe2" +
-	"\x7f\xa7\xbe\xc6E\xdap:\xd2\x86k\xf8\xc7\x82D\xa7" +
-	"$+\x01W\xa8\xde-H6`\x97\xf8\xf9%\xea\xb3" +
-	"!\x7f+p\x1a\x82\x1emR\x0c\x16\xa16O\x92+" +
-	"3\x9d\xb6\xda\xfb\xdc8\x0fA\x08\xbd\x03\xbemO\x92" +
-	"\xcbq\x96\x1e\xe0\xd4\xf5%\xaa\x9a\xf2}\xd0\xd9\xeb\x05" +
-	"\xf8\x00\xa2\x8a\x82\xef\x87\xf4}\x01\xbf\x9b\xa8\xd2\xe0\x07" +
-	"!~'\xe0YD\xb5\xcb|\x06\xe2\xaa\x93\xec3\xcd" +
-	"|\x0e\xe2\xc3\x00/$\xaau\xe6M\x88\xab\xcepT" +
-	"\x14unC\x9d\xe1\x96-\xa9s+\xa0s\xab:\xc3" +
-	"\xadZQ\xe7VB\\u\x86[\xb7\xa6\xcem\x15:" +
-	"\xdbv\xc0k\x88\x8e\xd4\xd3\x89NmV\x88}\xf2\xab" +
-	"\x9a\xe0-w\x88\xb2\xd2O\x9b\xef\xf0a)\x0c\xac?" +
-	"\x03\x1b\xc0\xc0\x06j1\xc9\x93]\xed\xb4WK\x9c^" +
-	".\x0f\x80\"@\xe2P7\x17\xe5\xf4\xbaB\xd0<\x91" +
-	"\x8b\x02\x8f\xdb\x87\x1a*\x04OE\xf8\x8b\xbfEr\xb8" +
-	"\xecb\xbe\x98\x88\x8ed8Q&L\xd5\xdezb\xba" +
-	"SG\xea=\x8a[\x92\xcb\x83\xec|\xa2\xd5iw\xba" +
-	"\xc3W\xcb\xa1\xa2\x92]\xe5Q\xdc\xe0\x1e\x89\x1e%\xd4" +
-	"\xd1\x04\x16\xa2\xf5\xc4\xd4\x9e\xfd6\xa6\xb1\x01\xef\xdd\xd7" +
-	"\xfap1ql\x89\xcf\xafq\x05T\x9d\xe8s9\xce" +
-	"\xac\xd7\x13K\xb4^\xa3\xe9\xad\xf4\x10\x96\xb5\x04\xdc\xa8" +
-	"\xd7hz\x1c\x90[b\x01\xef\xac\x0f\x98e\xbe\x83\x1e" +
-	"\xa2>#\xc0]\xf5\x9a(\xae\x8b\x1e&L{\xc0\x07" +
-	"\xe85Q\\?l\xa6/\xe0yzM\x14\x97\xa3\xcf" +
-	"\x84(\x11\xf0G\xf5\x9a(n\xb4\x1e\x14\xfd!\xc0k" +
-	"\xf4\x9a(\xce\x8b\xb8\x02\xf8l\xbd&\x8a\x9b\x85\xedL" +
-	"\x03|\x1e\xe0\xaduT\xd1\xe7\xe0{g\x03\xbe\x10\xf0" +
-	"6-i\x14\xb7\x00\xdby\x01\xf0\xb5\x80G\xb72\x92" +
-	"h\x8e\xe3\xd7\xe8!\xea[\x0d\xf8\x0e\xc0cZ\x1bI" +
-	"\x0c\xc7\xf1\xdb\x90~+\xe0?\x01\xde\xb6\x8d\x91\xb4\xe5" +
-	"8\xfe8\xd2\x1f\x03\xfc\x0a\xe0\x06\xbd\x91\x188\x8e\xbf" +
-	"\xac\xef\xceq\x96\x0bz=1G\xe8H\\l\x84\x91" +
-	"\xc4r\x1c\x7fU?\x97\xe3\xcc\x11 |\x80\xe3\"\x8d" +
-	"$\x0e\x84\x1f\x01\xd2\x8c\x00<\x16\xf0\xf8\x16F\x12\xcf" +
-	"q|\x0c\xe2-\x017\x02\xceG\x19\x09\x0f\x83\x82x" +
-	"4\xe0\xed\x017\xb64\x12#\xc7\xf1\xed\"`Z\xc7" +
-	"\x02\xde\x19\xf0v\xad\x8c\xa4\x1d\x8cV\x04p\xdf\x1e\xf0" +
-	"\x1e\x80\xdf\xd0\xdaHn\xe08\xbe[\x04H\xad3\xe0" +
-	"\xbd\x00Ohc$\x09`\x0e\x11\xef\x0axo\xc0\xdb" +
-	"G\x1bI{\x8e\xe3\x93\"\xc6\x819\x04|\x00\xe0\x1d" +
-	"\xa2\x8d\xa4\x03\x8cn\x04H\xa1/\xe0w\x03\xde1\xc6" +
-	"H:\x829D\xfcN\xc0\xb3\x00\xef\x14c$\x9d\xc0" +
-	"\x1c\"?\xf7\x02\xfeP\x84\x8eF\xc6!\xeeQ\xbd\xa7" +
-	"\xc2\xe9\x0e\x8d5\xecN\xb9<\x84.q\x9c6\x00H" +
-	"/s\x96\x9bE\x9b\x7f}\x8e\xa0\xc9\xd7\xfa2g\xf9" +
-	"P\xb7(\xca\xf0\\\xf0\x9f\xea\xca\x9c\xe5\x99\xb0\xbc6" +
-	"|\"_\x92\xadvN/\xb9\xfc\x7fk\xad\xf9\x9bP" +
-	"\xc3\xfc[]\xb9[\xa8\x96\x94\x80\x03\x8c\x91'8\xcc" +
-	"\xdai\xeb\x16$yH\x85 szk\x83\xf7\xc2\xdf" +
-	"\xb2\xbcn\x813\xa0\xe7\x15\xf2W\x8f\xec\x1c\xdb\xd8\x93" +
-	"\xf0\xb7F\x9f\x8c\xf2T\xd6\x06\xde\x0f&\"\xbb\xc6\x95" +
-	"O\xbcvEra\xe2\xc9\xcfo\xa2G(\x13\x8b\x83" +
-	"~\x8d\x0a\xfaU\xe2\xffep\x085\x81\x1f\xf5^\x08" +
-	"\xba\xc7:\xdd\x9c\xden\xf3\x83u\x0e\x10\xa0\xe4R\x7f" +
-	"\x83\xd0\xd4\xdf\xc8\x87\xa5\xc2\xe9\xe6\xd2a\x9c\xc5\x94\xa0" +
-	"\xc4D\x8em\xb8\x9738J5\xf9\x13\x84\x1b\xe4+" +
-	"\x10.pJ2\xa7W4\x8eY\xe0\x8cd\xb8\xe9\xaf" +
-	"\xbc\xfb0\x01f\x11\x05\xb7\xb5b\xa8W\xd2\xdbm\x8d" +
-	"o7\xc4\xb1\xf7\x1bt\xac\xfd\x06\x9f\xa34+\xd7\xb7" +
-	"\xdf\xf0\x82&^\x9b\x0fa\xfa<=1\xbd\xa2#u" +
-	"\xc0\x8e \x07\xbc\xef\xe0dR\xbd \x14\xa0\xbf\xa4\xd5" +
-	"$\xd0.\xb0\xf0Z\x8c\x91ej\xba\xf3\x99\xc28A" +
-	"p\x8f\xc4\xc8\xda\xa2\x08n\xa2\\'\xe7\xd1HTp" +
-	"\x9d\xd4\xbcC\x1cQ\x96%\xd0xN#\xd0d\x96@" +
-	"\xd3X\x02\xed\xceq\xa6\x89zb\x9a\xa9\x11\xe8\xf4\x14" +
-	"\x8d\x94\xfd\x02\x9d\x95\xac\xba\xa3\x86\x0a\xa77\xa0=\xe9" +
-	"\x0eI\xd6d\xc4\xa2lB`B$:\x9c\xb2R\x11" +
-	"p\xb0kE\xc1\x1d~\xe7Pi\xacN\x87\x01\x86." +
-	"\xc4\xb36kb\x9c@\x0a'M\x13\xe3\xf8\xf3^f" +
-	"MZ\xc7\x9f\xc1\xf1f\xaa.x\xbdS\xa9\x006\xb4" +
-	"\xc60\xddQ\x1b\x14\x0a\x94\x03#b\x95\xc4\x91\x80\x06" +
-	"\xd59\xcb\xca$\xab\xd8\x8c\xde\x14\x88\x0a&\xa7\xa8\x7f" +
-	"|g\xc0i\xa8\xc5\xa4\xad\x9a$\xf5{\x0d\x93\x08," +
-	"\x14\xe3\x01\x9fF\x02\x1d\xe2\xa7\"y G\xea\xef\x13" +
-	"?\x0b\xdd\xe9i\x80\xcfC\xf7XG\xbd\x869\xe8\x8e" +
-	"\xce\x04\xfc\x05t\x8fu\xd4k\x98\x8fn\xf0<\xc0_" +
-	"A\xf7XO\xbd\x86E\xd8\xfeB\xc0W\xa0{\x1cA" +
-	"\xbd\x86\xe5\x88/\x03|5\xba\xc7\x91\xd4kX\x85\xed" +
-	"\xbc\x05\xf8\x06t\x8f[P\xafa\x1d\xd2\xaf\x05\xfcS" +
-	"t\x8f\xa3\xa8\xd7\xb0\x09\xbb\xb5\x01\xf0/\x88\xc6k\xd8" +
-	"\x8c\xed|\x0a\xf8\x0e\xc0\xa3\xf5\xd4k\xd8\x86\xb9\xe2\xad" +
-	"\x80\xef\x05<&\x82z\x0d{\xb0\xbf;\x00?\x00n" +
-	"\xb6KTp\xaf\x86#\x811\xa9\xf3\xba\xcbE\xd9\xaa" +
-	"Zg\xc1\x8a;R8\x8e\xfe\xa1\xb6\x0b\x1e\xa5\xdc\x11" +
-	"\xf0r\xc1\xe2\x05\xcdw9d\xed\x04\x0c\xac\x07G\xec" +
-	"At\xd6\x10\xbb\x00\x98[\xb0\x86\xd8\x0f\x11\x13\x93\x1a" +
-	"\xba:\xaf,T\x0b\x92\xda\x96G\x94\x15\xb0=A\x1b" +
-	",~\xd5\x09J\x96\xa5\x97;\x98\xb9\xb3F\xe3c\x83" +
-	"E\xf1\xca\xd7\xf1\x83m^\xb7@5\xb4\x19\x09\x91\xa1" +
-	"\x10z\xe6:%\"7\x9e\x80\x0c\xe4\x1fs\xd5\\c" +
-	" \xc7j*Q7\xe3B\xed_\xad\xd3\xdb`\x00\x1c" +
-	"\xb8\x8d\"\x07g\xa1\xc3I|Y+\x0b\x05wTy" +
-	"\x83\xdd&\xad\x08\xe8^\x9a\xd4\xbc\xbd\xb4,\xd1.*" +
-	"\xa2\xc5\x15%\xda\xed!\x91\x8e\x99\x15\xe9dj#\x1d" +
-	"\x97h\xb7[\xecN\x8e\x046JC7\xc7\xc3\x1b\x03" +
-	"\xd9fqE\x09cq\x14z\x05\x8cK+\x9c\xfd\x11" +
-	"0Kb\xb5\xc6%\x06\xf1\x96\x80\x1b\xb5\xc1w\x1c\xe2" +
-	"\xd1\x80\xb7\xd7\x06\xdf\xed\x10\x8f\x05\xbc\xb36\xf8\xee\x80" +
-	"\xb8\x11\xf0\xae\xda\xe0\xbb\x0b\xce\xf2\xf6\x80\xf7\x00\xe3\xe5" +
-	"\x8b\xbd\xbb!yg\x80{i7\x96z\"\xde\x15\xf0" +
-	"\xde\xda\x8d\xa5$\x8c\xc9\xd5\x1cA\xab\x96\xd4\xb8\xf4C" +
-	"\xa3\x10\xc8\x11\x80\xc34J\xeb=\x15\xab?$y\x94" +
-	"\xf6G1\xd3\xc9\xaa\xab\x10\x05\x9b$\x97\x07\xfe\xa85" +
-	"\xff\x06\x88\xd6\x03\x83\xe1\x10j2\xecv\xe7XN/" +
-	"\xaa\xa9D\xb7\x88\xc9+\x853H\x8e\xe6\xac\x09y\xf7" +
-	"\x0d\xcdp\xb9D!\xd1\x0dnW\x88\xeed\xb22\x8a" +
-	"\xddU\x85\xaa\xc3w\xaai\xce({Yy3<\x07" +
-	"\x98\x10\xb8-\x11F\xca,\xb3\xb1M\xfd:QV\xdc" +
-	"\x92vW?\xf0A\x85p\x9dCp\xe1\xed\xa2\xc7\x93" +
-	"#W\x8b\xb2\xe2t'\xd6Z\xecN%\x9c\xed\xda\\" +
-	"u\x1e\x19<v\xa7\xa2\xdaPoi\x8el\x13k\x9a" +
-	"5\x8b->S\xd3\xdc7\x07y\x0a\xb0\x9a\x84\x1a\xac" +
-	"\xe6\xb9\xc7\x05v!\xaaV\xc4\xed\xea\xf6\x01.\x16d" +
-	"\xaa\xbel\x80\x8bE`\\\x16\xfa\xaal\xfc\xee\x9c\xb6" +
-	"\xca&\xe0\xce\xad\x841]\xa6'\xa6\xd5\x1awn\x15" +
-	"t\xe2-=1m\xd0\xecg\xac\xcbT\xf7\x01B\x9d" +
-	"f\xac\x94\xc8\x0b^\xfa\xea\x14g\x9e\xd6\x95NtH" +
-	"r\x86p='\xba\x0e\x16J\x8fx}\xeb\xa6\xc9\xb4" +
-	"\xc0\xe3\x16\xd1\xe3\x91\x9ct\x85i*\xe5^\xa2\x0eM" +
-	"\xc3\xedV\x8f\x02\xf3L\xbb\xa1\xc2|\xfdX\xa7\xdbn" +
-	"\xf3\xbd?wd\xa1\x19K\x8f\xf4\x1e\xf1:\x8e\xbbG" +
-	"\x11\x14os\xb2\xa6\xa2b\x11\xdd\xd5\xa2\xfb>\xc9\xae" +
-	"\x88\xee\xd0\xf4T\xa6\x9a\x17\xaf+C\x0aOhP\x1a" +
-	"\xd6VE\x8e\":\xe8\x0c\xd7\xc4\x06\xb9\x9a0\xc0/" +
-	"\xb7\xa9i\x9a0\xc0\xbf4Ow\xab\x1e\x7f\xa0\xb8k" +
-	"N)\xc7\x99f\xeb\x89i\xa1f\xb3g\x01\xe8\xe2\x0b" +
-	"zbZ\x16RQ\xa3\xe3tD\xa7\xd6\xcb\xf8~6" +
-	"VV\x83\x8ff\x0a\xe5\x16.JK\xad8\x01\xa3\x8b" +
-	"\xa4\x1fkr\xa7p\xa8\xa8\x98E\xab\xe4\x12\xb3DE" +
-	"\x90\xec\x1e\xb3\xbf\x80\x8c\xfboK\xdd\xb4\"\xf2Kc" +
-	"z\x89OD\xcb4\xd2X\x92\xe9\x9b\x99\x1b\x9a*u" +
-	"Kw#\x8b$V\xbd\xb6\xd9WGdu:\\N" +
-	"Y\x0c\x09\xbd\x03_x\xa0\xd6\xb5\xce\xe9U\\\xde\xeb" +
-	"\x10\x84\x11\x96`\xf1NFF\xa3[\xf1=\xc0-\x13" +
-	"\xf2$\x8f\xa2\xbe%p\x0bS\xb8o\x81Y\x9c%(" +
-	"\x02}K\x8f\xc0[\xcev\xe48\xd3i=1]P" +
-	"\x85\x7f\x1et\xe9\x9c\x9e\x98\xaeh\x84\x7f\x19\x94\xf6\x92" +
-	"\x9eX\"\xb4A\x0f\x01?\xc0\x0cn@\xb46\xe6i" +
-	"\x85u3\xaa\xd7\x13\xa9\xa7^I\x0c\xe2\xaa\xd7\xd3\"" +
-	"\x82\xba%q\x88\xab^OT$uK\xdaaL\xa2" +
-	"z7-[P\xb7\xa4\x0b\xe2\x017F\xafn\xeb\x85" +
-	"\x9bZ\xa3\x05\"69$\x0f\xc3\xc8\x0a\x15\xb3sD" +
-	"\xf5\x0eIF\xcb\xab]\xe3\x1cBM\x03,\x9c\x1a\x13" +
-	"\xf7\x10\xb7((\xe2\x10\xa7\xa3T\x92\x131\x06\x08Y" +
-	"\x7f\xc6\xa8\xd3;`2\x96$\xb3\xd6\x9f\x94\xb0\xd7\x1f" +
-	"3k\xfdq\xab[\xce\xf5\x82\xdd\xee\xb4\x0a\x8a\xc4\x19" +
-	"\x9c\x1a7\xc7\x00Q\x95\xba+\x00\xabI\xe0\x97M\x94" +
-	"\x145\xdc\xf3(\x82[)q\xcaAe\x165.A" +
-	"\xf6HN.Q\xf6\x98\xc5\xaa\xf0\xabK\x86\x8aJ\x86" +
-	"m\x8c`\x15ehR\xf44\xb2\xaf\xa0]\x13\x1a\xa9" +
-	"\xf6i4>KD\x9f\xac\xb9\x15y\xac\x90\xc5&\xf9" +
-	"V:\x8ekP\x1bp\x9d%I\xb6i\x0a\x8e\xcc\xe9" +
-	"\xb4\x87\xe1\xf8C\xa5\x1a\x7f(\x8cB\xa2\xa6%\x91\xe1" +
-	"v;\xc7^\xb7F.N-\x92K\x0e.\x92\xd3\xf9" +
-	"\x8b\xe4\x92\x83\x8b\xe4\xf4\xfe\"\xb9\xe4\xa0}A\x7f(" +
-	"\x13\xba/\xe8\x0fe\x8aq\x9fOS$\xd7\xc2_$" +
-	"\x07\xed<\x04x\x85v\x1fQ$\xdd\x83\xf6\x0b\xfd\xfb" +
-	"\x88\x12\xb6\xaf\xee\x17\xfa\xf7\x11\xab\x10W\x8b\xe7\xfc\xfb" +
-	"\x88\xb5\x98\xe0P\x00\x9f\x08!T\x1b\x9a'\x99\x80y" +
-	"\x95@Z(\xa8F\xc7\xe0q[\xd5\x00\xc7\xe3\xb6\x8e" +
-	"\xd2\xfePmG\xb5hwZ}\x95\x0f~\xcc.x" +
-	"ekE\x86\xccE\x95\xdb\xc5\xc0c\x8adW\xfc?" +
-	"\xa2\x04\xb75@\xeeqz\xdd\xd6\x90\xb2\x16Fmh" +
-	"h%\x86\xc3i\x13\xed\xcd\xadr\xca\x01\x02\xb7\xd7\x95" +
-	"\xa8\x0c\x11\x1a\xd49e\xb2\xea\x9c\xccj\x9e\xc1\x9f\x08" +
-	"4e\xaa\x85\xc04r\xd2Xm\x9f\x9a\x06%\xf8|" +
-	"X\xf8n\xbcjHa\xa5\x0b*\xac\xd7\x14\xdah\x1c" +
-	"lv\x9d\x8do\xc1\xdb\xf4\x81\xaf0\xe9\xb0\xc6\xdb8" +
-	"x\x88\xe3L\xc7|K\xa3\xdf\xdb\xb8\xfc\x19\xc7\x99\xae" +
-	"\xf8\xd7.\xff\xae`;\x0c\x9d\x8d\x81mw\xff\xae`" +
-	"\x1f2\xc3\x1f:\xc3ti\xa2\x12\xdf\xea\xeb\x13\xf1\xaf" +
-	"\x0e\xe9\xb8:h\x1c\x8e\xc0\xb7\xf9|\xae\x80\xff\x09\x9d" +
-	"\x82;\x07J\x86\xcf\x88;\xf5\xda\xa7\x027<\xf9\x9f" +
-	"\xc2\xc6\x05E'9\xe5,\xd1cuK.|\x0f\xa7" +
-	">\x12\xb8\x9c\xdc\xf7H\xc0\xba\xeb\xb5\xe1g\xe0\xdb$" +
-	">*\xb0\xc1\xd0 \x09\xb4\xa8i2\xf0E\xabp\xdd" +
-	"\x98!4\"\xcaO\x17Y\xdb\xda\x99\xd7;=\x90\xa5" +
-	"\x09\xa8\xfc\x89\x05\xc1]\x1ez\x98\xa1i53\xfb\xea" +
-	"5h\xb9F\x91\xcb`\xf3\xd5\x9dh\\\xda4\x86K" +
-	"[\xc2r\xf0KX\x0e~\x8av7\xc5\xa7d\xf3\x93" +
-	"U\xaf?4KG\xeb\xbfC\xeaB\xed\xc8b0\x98" +
-	"\x88u*$V=PI\x9d]\x83[\x90l$V" +
-	"\xbd\x078\xa4\x96>\xf2:\xe3Q*(\x16\xd0\x04\xd1" +
-	"f\x16=\x89\x81\x19\xc7\xb6\x10\x01\x03\x91\xa29c\xe1" +
-	"\x17F~w\xd5j\\\x7fZD\xc9.+\x89U/" +
-	"\xfd\x0d\x97]M\x19\xb8\xe6\xfc\x09\xbb\x9c+P\xcdU" +
-	"\xa2\xad\xe6\xf5\xb1\xeaHS+\xb7\x02\xe3VU\xa2\xee" +
-	"j\xd4\xe1\x96\x85\xd6\xf0\xe2[\x83\x87\xe3\xbf\x8e\x8f\x83" +
-	"\xc3L\xa1R,\x10\x95P\xc7>Eu\xec\xe3\xd8\x9e" +
-	"\xbd\xcf0_\x06\xcd\xba\xa0'f\xcd\"\x1dw5S" +
-	"\xeb\xee\xfb\x97hB2}\xa6\xae\xa5v\x85\x8e\x84\x95" +
-	"X\x8d\x02\xfc\x0bth\xee\xd3\xbf@\xc7\xe0\xc2\xaaF" +
-	"\x01\xfe\x05:\x0e\xf1@\x14\x10\xb2G\xc8\xae0\xb7j" +
-	"\xeac\xc5\x1a\xc5\xebV\x7f\xbbD%xm\x96\xc6i" +
-	"\xd6U\xed\xc6\xbaC\x92\xb3\x1c\xe5\xeaO\xa1F\xf3\xb3" +
-	"i\xad\xca\xb0U\x8b2\xbc\xfa>I\x96<\x15\\8" +
-	"VIS\x98X7V\x92\xf3\x9c\x1e\x95\x1d\x97\xb3y" +
-	"\x05`\xf9\xa2\xdbZ!\xc8J\x96h\xc7tC\xc8\xf4" +
-	"Ka-\xd0A\x1b\x01\xfe\x15:W\xdd\x08H\x94]" +
-	"V\xcd\xfaL-\x8c\xd4\xa0\xee\xd3\xe3\xcb2\x84\x9f\xe4" +
-	"\x13\x15<\xcd\xc4\x85u\xf0E\x9b\xa8\x97<\x16oY" +
-	"\x99\xa4\xcd)\xd6\xe1\x89\xa6\xe6\xcc\x15\xba\x17l\x11\x0d" +
-	"\x98\xe9\x0bqo\xcdZ7\xd6\xef\xdd\x86V\xb1\xf9\x8f" +
-	"\x80\x98\x90\\\xf5V\xfdG@\x8aQ\xe9\x0b\x01\x7fT" +
-	"{\x04d4\xe2\xaa\x17\xebw\x13B\xbdX\xbf\x9b " +
-	"\"\xae9\x02B\xfcG@\x00\xb7\x01\xee\xd2\x86\xc4\x0e" +
-	"\xe43P\xf5\x16\xd7*\x8az\xb7^\xc4\x03^l\xe0" +
-	"\x08\xc8\x04\xc4\x03nl\xe0\x08\xc8T\xc4\x03\xdb\x9b\xf5" +
-	"\xa5b\xb9$\xcb\x92\xcc\x91r\x12\xab~\xc5\xdbgq" +
-	"i=p\xb0^45Q\x0d\x1eEP\xd4\x1f\x9a\xbc" +
-	"q\xb0/\x1d\x94\xd7uH2V\"\x87D\xdb\x0d1" +
-	"I\xb63\xa2r{\xb3\xa3\xf2l\x8c\xea\x0a\x9c\x1e\xdc" +
-	"\xf8\xd3\xac\xf2\x8d$\x09\xbd\xb0\x9eh\x1d\xa1\xc0\x87\xd4" +
-	"\xc2\xf6m\xec\x92\xb5rD\xe9\x98t\xd1\xaad\xd00" +
-	"T\x93\x00\xc8e%\x00\xd2X\x09\x80dV\x02 \x99" +
-	"\x95\x00\x00p\x85\x9e\x98\xd6\xea\x08\xf1\xc5\xffkJT" +
-	"\xf7\x98u\xae,\xdd\xe6v\xba4\xb9\x00\xa7K\x94\xd9" +
-	"\xc3'Y5\x1e\x8a\xb3t\x8chU\x867s\xcf\x90" +
-	".\xd7#\x0aIV\x88IKn\"\xe6PMZ\xb2" +
-	"\x1at\x04+\x94GTJk\x83\x03!\x83\xc3\xa9\xd8" +
-	"\x9aq\xf6\x03mI\x8e\x9c\x88\x07\xf9\x1aO\x8d\xb3\xea" +
-	"\x9eC\xc22v\xe1s3\x0f\xdax\xb8p\xce\xb1\xa6" +
-	"0\"\xb34\x9f\x90*t\xa4\xdej\x97DY\x09\xad" +
-	"\xfc\x0c>F\xea\xd0d\xc3c\xeb\xb7\x99>rM\xfd" +
-	"\xf9\xc3\xc9\xcd\xcaEZDwu\xba\xe8\xce\x91\xcb\x9c" +
-	"!\x92\xebx}\xc9\xe9\xa5@\x9d\xac\xc1\xe5t+\xe1" +
-	"\xdb\xfe\x8c\x8c\x8cR\xc9.)\xa46\x9c\x1a~s\x13" +
-	"g\xb8\x02S\xcb\x9b\xac\xf1\xfa<\x95\x92\xdd\xaeY\x90" +
-	"J\x05\x8f\xf8\x80`\xf7j3`v\xc9!)\x0f\x08" +
-	"vN\xaf\xa9|\x0f\xda>k\xba\x96?\xc3\xea\xcf\x11" +
-	"j\xeb\xbc\xd3\x82\xf6p\xfd+X\x12\xe2j\xfd\xb7?" +
-	"?\xd3\x07\x93\xae\xea\xde\xae??\xd3\x0f\x0f\x1f\xaa\xf5" +
-	"\xdf~\xe7o\x10\xd2\x0f\x00\xfc^\xad\xf77\x18\x03]" +
-	"5/\xe4w\xff\xb2q%Q\xf3?~\xf7/4\xff" +
-	"\xe3w\xffL\xd8\xbezx\xd2\x9f\x9f)\xc2\xf6\xd5\x15" +
-	"\xd5\x9f\x9f\x19\x8dx`\xe5L\xa7\xd9\x8f\x80\x9a\xd2\x04" +
-	"I#\x05g\xe0i\xbb\xbd\x0e\x91K\x94\x95|\xa7Z" +
-	"\x96V\xe6\x84g\xfc9\x96\x0aI\x19&\x0a6Z\xb2" +
-	"\xad\x01\x0b\xf0T\x90\x9a\xbb\x092|\x89XQ\xa0\xe6" +
-	"@\xe1W\x9eX\xcd\xe95\xaf\x17\xcb\xcaD\xabr\x9f" +
-	"\x9d\xd3\x0b\xe5\xcd\xaa\xa8\xa6g\xd6}\xfb(\xa1\xc3\x9f" +
-	"\xa9\x1d~u\xf4S\x82G\x9f\xf8G\xbfc\xf0\xe8\x13" +
-	"\xff\xe8\x9b\x83G_\xe7\x1f\xfd\xe0\xea\x7f\x7f\x19S\x06" +
-	"V\x02\xa8\xd9?\x7f\x19S\x0e\xbeW3\xfa\x11\xfe\xd1" +
-	"O\x09\x1e\xfd\xc8FF\xbf\x85\x7f\xf4s\x83G?\xca" +
-	"?\xfa\xb9\xda\xd1\xbf~\xbc\xf8\xff\xf1\x0d\x024\xc9\x0c" +
-	"W\xa4)\xf7 \x9e\xe5\x1e\xc4\xb3\xdc\x83x\x96{\x90" +
-	"\xc9p\x0f\xcc\x1a\xf7\x80\xb1Th\x0e\xc5\xd56<\x1e" +
-	"\x17Z\xe5Q/\xc8\x92CP$'G\xe4\xf0\xd3|" +
-	"\x18\xb9\x8bj\x06F\x9f\x91\x11\xb2\xf0\x99Y\x0b_&" +
-	"\xcb=\xd0\x94>\xd5\x0b\xb8:\xd4\xe6hs\x90\xb28" +
-	"\xd6\xac=\xc5LC3\xbb\xc8\xe9\xcb\x94f\xec5+" +
-	"\x82\"YiR\xb2\xca+\xd2{\x1c\xc2\xa8\x10\x11<" +
-	"\x95\xf9\xa2\xa34]t\xe7I\x0d\xf2\xae\xcc\xd5\xbdT" +
-	"uw\xfc\xab{\x11`\x85zbzTG\xea\xcb\x9d" +
-	"\xae\x8a\xd0\xf3{431\xc4\xc9EiV\xf9z;" +
-	"\xbc\xd1)\xc9\\\x94\"\xba\xc3\x1f\x1c\xba\xe3;\xc4\xb7" +
-	"w\xaapa\xe4\xc5rYy\xb1\\m^\xac\xa5/" +
-	"/\x96\xab\xdd\xf8&\xbe\x8do\x8d\xe6\x87&\xbb\x19'" +
-	"\x13\x11\xcb\xb1\x06\xd7\xd8i\x0f\"\x06\xb6\xb7=#p" +
-	"k\x97\xdeJ\x11\xf8\xaeE\xd8\x891\x9f3\x8f\xbe\xbc" +
-	"\xe2\xaf\x9d\xf8\xff\x87?\x1fy\xddMh5\xb9\xcc\x85" +
-	"Q\xb0X\xca*X\xd4\xec$\x84\xa6\xd0l\xbe\xe6\xb9" +
-	"(\xc9\x19\xb0)ucE\xbb\xd5\xd9D\xe4\x11Rs" +
-	"\x92\xe7,\x97d\xae\x91{1\x8c:\x92\xa88+E" +
-	"\xb9\x19\xb1\x8c\xdb\xe9u\x0d\x11\xe4(\xabh\x0f'A" +
-	"\x93\xc28)\x9e\xa6\x0a#\x11\"\x86~\x81\xbc\xae\xac" +
-	"=c\xd0\xd8\xc1\xfd\xeb\xf3\x96#WGIJhJ" +
-	"\xbd\x94\x95\x96)\xd5\xec~Jr\xb5\xa4\x88\xe2p." +
-	"*8\x84\x01\xd4\xdd\x00m\xe6\x0eN\xa2\x7f\x03\xb49" +
-	"1Qv\x8dKr\x8b#\x057\x1e\xfa\x0f'\xcd4" +
-	"\x83\xe3L\x03\xf4\xc4t/{Q\xab\xa7\xe5\xf5\xec\xab" +
-	"\x04\xc2\x0c\x13G\x8a\x89\xa8\x82\x8d\xafT\xec\x85\xca\xaf" +
-	"\xf2)\xaa\xca_?%SGS2\xaa#D\xafN" +
-	"\x09?A\x07\xfej\xa6\xb7\x8c\x94\x85\xec\x9b\x95\xb0N" +
-	"\xf4\x03[\x1b\xf4\xc4\xf4\x85\xc6`m\x1e\xc3:\xd1\x9f" +
-	"\xa9=\xa1\xee3X;sY'\xfas\xd5\xc3\xfb\x81" +
-	"\x13\xfdG\x00<\xac'\xa6\xd3:\xbf\xf7\\X\xcb\xe9" +
-	"]\x8d\xf9\xf7\xa5\x82\xdb\x96\xef\xb4I\x9c\xa1LR\x9d" +
-	"\xb7:t\xc65\xbe \xab\xe0\x1a\x83]\x88\xa6\xff\x8b" +
-	"\x0bv\x82\x0b{\x1e(\x80\x85\xdf\x93\x88\x17$\x84H" +
-	"39\xdc]\xc8d\x8d\x84\xfd\x1b\x0d\x9b\xcd\x1a\x09\xfb" +
-	"7\x88\xb6%k$\xec?\xee\xbf3Ys\x07\x80\xff" +
-	"\xb8\xff\x9e4U\xec\xd7\xb9\xe2%(i\xc7\xcc\xeai" +
-	"k\xf5\x0d\x8a\xe4h2\x85\xdd\xe4\x0dW\xe9J@X" +
-	"M\xd5\x19fj\xb2).\xb7X&\xd5\x04\xcc\xbd\xcb" +
-	"\xe9Q4\xbf\xc3\x98\xa3\x92l\x1b\xe9\xf4\xca\xc4\xd6x" +
-	"2\x82y\x1f\x99^q2W\xcb0l\xae\xa8\xe4(" +
-	"\xa2C[\xe4\xd1H\x15K\xa8S\x13\x96,\xb3eE" +
-	"\xef\xae\x0dg\xab\xbe\x84\x91\x10J\xd1n\xd5\x87\xa44" +
-	"\xf0wH\xfa\"\x11\x13\xf1\xd7\xb5\xf3\x9a\x8aR_\xe5" +
-	"\x0e\x0dk\xf0\"\x91\xc4\x0aA.\x17\xc3\xb9\x11\xa3\x84" +
-	"ep\xe25\xf7\x8a\xf8\x0d\xce\x96x\xed\x1c\xf1\x19\x9c" +
-	"m\xf1\xda9\xe238;3\xb5s$\xda7G\x92" +
-	"\xd59\x12\xce6\xdd\xf5\xef\x05iP\xde\x8e\xbabP" +
-	"\xbfK\xcb\x11b\x083\x05\x80Z\xa3M\x01\x14\x04R" +
-	"\x00\xddtx\xe4U\xa7'\x96^:5\x05\xd0S\x07" +
-	"\xa1uW\x80{\xeb4)\x80$\x1d&\x86\x00\xef\xab" +
-	"\xd3\xa4\x00\xfa\xe8\x929\xce\xd2\x0b\xf0\x01:M\x0a\xa0" +
-	"\x1f\xe2\xbd\x01\xbfS\xa7\xa9\xea\x1b\xa83s\x9ce\x00" +
-	"\xe0\xf7\xea4U}\x83\xb1\xfd;\x01\xcf\xd2i\xaa\xfa" +
-	"2\x90\x9f\xbb\x01\x1f\xa6\xd3lad#~/\xe0y" +
-	":\xcd\x16F\x8e\xae#\xc7Y\xb2\x00/\xd0i\xb60" +
-	"\xf2\xb1\xfda\x80\x17\xea4[\x18&l'\x0f\xf0Q" +
-	"\x80G\xb7\xa6'\x99\x8a\xb0\x9d\x02\xc0\x1f\x02<\xa6\x0d" +
-	"=\xc9T\x8c\xfd*\x04\xfcQ\xc0\xdbF\xd3\xf3\xcf\xa3" +
-	"Q\x9c\xa3\x00\xb7\x01n\x88\xa1\xe7\x9f\x05l\xff!\xc0" +
-	"+\x00\x8fmK\x0f@\x8b\xba\\\x8e\xb3\xd8\x00w\x01" +
-	"\x1eg\xa0'\xa0\x1d\xd8N\x05\xe0\x0a\xe0\xf1\xb1\xf4\x04" +
-	"t\x15\xd2\xbb\x00\x1f\x0f8\x1fGO@\xd7\xea\xc6p" +
-	"\x9c\xa5\x06\xf0)\xba&\x0bX\xaf\x93I\x0e\xf6\xd9\x81" +
-	"0\xe4\x90T\xfaXQ*\xafP\xd4\xe4\x86\xdd\xa9Z" +
-	"\xed\xe0\xab_\xf4\x82U\x8d\x19\x04\x87P.j\x8a\xfe" +
-	"\xec\xea\x81C}\x85zA\xa3C\x90\x85\xc6\xca\xce\x13" +
-	"au\xf1h\x16\xecr|\xb9vw\x0f0i\x9c\x18" +
-	"\xd4\x01%\xe4\xbc\xb1\xc7\xeav\xda\xedb\x19g\x00\x9f" +
-	"\xa0\x19^\x99\x08\xdeX\xa1\xdb\x00\xceRs\x8d\xbe\xea" +
-	"A\x18\xacN\x9b\x18~\"@sy\x12V!$\x86" +
-	"\x1d\xd8\xa7\\\xa7\xa0*\xac\xb4}\x1d\x0d\xf35y\xfb" +
-	"\xc0'\xd2\xc2\xcd\xdb\x17\xd2\xdb\xda2\xd2i\xa2$\x9c" +
-	"\x03\xa1f\xb5L\xc2\xcfvU\x8a/\x89>Ms " +
-	"tj\xb2\x9a\x04\xf0\xdf\x0a\xa7M\xc4\xd4+\x92\xe8\x1e" +
-	"\xe2\xf4\xca\x9acZ\x89\x80i\xfa\x13\xf8\x16&\xedO" +
-	"3/\x93\xf1\xdd\xc7\x18dY\x9b*\xd9\xd7\x1c\x85\xf6" +
-	"\xf7.\xe8\xe6U\x7f\xef\x96\x9b}\xd1\xfa\xa7\xaa5\x0d" +
-	"\xf2\xec\xaes\xabc\xc3=w<\xdeB4\x1d\x0f\\" +
-	"\xcb\xeb\x1fHEt\xc0\xcc\xd7\x10\xf9\xce,4\xf3\xae" +
-	"\xaf\x91bi\x81]\xa8\xcd\xae\x16\xdd\xb8b\x87s\x95" +
-	"\x07\x9e\xa9`{\xcaM\x17P\xd0S\xe0E\x89.\x7f" +
-	"\x86S\xe3\x0e,U\xc5\xe5\x1f\x80\x80\x1f|X\xe31" +
-	"\x1ft\xab\x97Y\x05<\xe6\xe3s9\xce\xf4\x93\x9e\x98" +
-	"\xcei<\xe6\xb3K5\xd50~\x8f\xf9\xf2Rm\x85" +
-	"\x8b\x7f7>\x12\x8b\xf6\x02%+\xf5v\x9bS\xce\xa8" +
-	"\x16$b\x17J\xed\xc0v\x94\xd6\xdd\xf5\xca\x95\xce\xb1" +
-	"r\x86\x8b#\xde\xd0C#\xf0\xe0Poe\x01\x17r" +
-	"A\x10\xe0\xf9\x92\xdbK\xca\x05;J\x81\x84\xfe\xcd\xa3" +
-	"\x10\x87\xd3\xe9n\xf02\xf8\xab\xd9;F \xeeJI" +
-	"\x90Y\x7f-\x14*%\xe2\xa9\xf0\x1f\xb1\x0f\xdfH\x8e" +
-	"\x14\x05\xf7\x90\x0aA\xd6\x97\x87u\xedU.k\xcb," +
-	"E[(\xa5g\x14J\x85\x1c\xa2\xabw\x08\x8a\xe8\x96" +
-	"\x04{\xe3W\xcf\x8c\x15\x057\x1eoiV\x99T\x96" +
-	"()\xb59r\x19q\x86d\xe2:\xaaG\xbb\x02'" +
-	"\xbb\x925\xd99\xbfb\x05\x0e\x95\x04M\xedRm\"" +
-	"N\xd70s\xee\xdf(\x89[\x93\xab\xfa\xb3\xda\x15$" +
-	"\xf8V/Iq\x08\x9e\xca&\xd2Y\x82]\x09\xbe\xea" +
-	"\x10\xc2ZO3\xc3\xd3<\xa7SQO'i\xe4\x91" +
-	"\x16\xae<\xb4\xc7\x0f\xfc\x13-\xe8\xf8\x9b\x7f\xa2\xad\xcc" +
-	"\xd4\x08\xc9?\xd1\xb4\xd9\xca\xeb:1\xda\xadQ\xf0\x09" +
-	",\xda\xdf\x10\x96\x96\xab~D\x88\xc7\x13V\xc9E\x96" +
-	"\xd3Io\xa7\xd5\xe8w\x1aK\xbf\xdd,\xfdNc\xe9" +
-	"w\xae\xaa\xdf!\xd7\xce\xd5\xbb$k\xa5\xddi\xad\xe4" +
-	"\x121\x90j\xacN\xfb\xbf\xca8< *\xa2[\x90" +
-	"\xcd\x89\xe2X\xc1mk\xbc\x0c\x8d\xe5\xd4\x80\x7f&i" +
-	"\xc7\x00\x0f\xdd\xc6\xaa\x1f\x88\x0f\xf7ze,\xda\xc8\x12" +
-	"\x1dQ\xce\x06\xc9\xc4\xe4&*\xe1\x82\xc6\xdd\xbf\x9f\x1b" +
-	"v\x10O\xe3\xc9B\xc1\xe0nx\xc4\xdc\xacY\xa2d" +
-	"ql!4\xadq%\xc2h\xbcB\x90e\xd1\xae-" +
-	"<\xd6L\x99\x92&\x92\xf9~\xef`y\xaef\"\xf8" +
-	"MH\x90\xb5\xf0\x9b\x905\x935\xd1\xaf\xdf\x84l\xcc" +
-	"\xd4\x1c\xce\xa1\xe2\x09>\x86\x9fNo\x88\xd5\x9c\x86\x95" +
-	"\xcb\xbdBy\xf0\xf1\xcf\x0aA\x1e\xeeu\x04\xc7\xf39" +
-	"r\x1e\xf1\xd3\xfe\xf7\x05\xf88\xf4t{\xae\xc0[j" +
-	"\x97\x12\xad\xc3}J\xd0T\xedN\x9a\xb6\x1c\xd8\x9f\xee" +
-	"NV}\xde\xebi\x86Av*\xcd`\xd2\x7f#\xb5" +
-	"\x9b\xde\x1a\xd0\xeck$\x83k\xaf\xd3\xe9A\xcaf\x9c" +
-	"1*\xf2\x88\x0d6,\x99;\x00\x99,\x99hJ\xa4" +
-	"\x131\x0f\x1c\x18-\xdf\xf6e\xe00\xba(\xdb\x9au" +
-	"7\x0a\x96\xd6\xebe[s\xabWC\x0e)\x87\x94a" +
-	"0\xc7\x83\x1a7M\xce\xdf\"\xdaE\xab\x92]\xe5\x95" +
-	"\\\xd7I\xd83o\xaak\xdc]\x88U?\x1e\x17\xae" +
-	"\xed\xb2\xf8\x8e4\xd0\xba\xf0\xae\x01.\x82\xae\x9e\xf5\xb3" +
-	"\x11t\xf5\xac\x7f\x80\xb4W\xcf\x06\xd6\x83#\x99\x1a\x97" +
-	"\xd4\x9f\x8b:>\xc6w\x94\xe47M.\xeaL\xa9\xa6" +
-	"j\xdb\x9f\xfc>_\xaa\xf1S}U4q\x97K4" +
-	"\x05\xda\xcd\xdcvo\xe4\x0e*:,C\xec\\\x94\xe6" +
-	"H\x9f\x0f\xcd\x12\xb9(\xed\xd1>\x8a\x9a\x05\xbc\xad\xab" +
-	"\x19{!r\xa5\xe8\x1eRa\xf0\xa7\xfb4\"\xce\xd5" +
-	"H\xd3/\xe2\x83\xc9\x1ai\xfaE|$M\xeb\xe0\xfb" +
-	"D|<M\xdd \x08\x88\xf8\xe4\x18\x8d\xd7\xef\x17\xf1" +
-	"Yx\xd1ozb\xba\xa4\x11\xf1\xc5\x12M\x0d\xbc_" +
-	"\xc4WK4\xa1\x00\xf4X\x91d\xafC\xa3h\x86r" +
-	"\xcdu\xb6\xe9\x1e\xc9^\xadJ3\xdd\xeat\xb9\x82\x85" +
-	"K\x1f7dj\x0b\x0a\xa0\x05\x00\x82r\x1a\xd8P\xa6" +
-	"\xc0\xe95\x84\xb4\xb9\x10\xb0\xe9]\xb1\x90*V0\xcd" +
-	"\x99B\x98g\xb0\x9b\xde\x98\xf7\xad`s2\xb5\x07V" +
-	"t\x8c\xeb\xbfB\x9d|\x97\x8f#\xba\xbb\x1e\xf8\x0e\xa6" +
-	"o\x9ej\x8f\xc75\xf8k\xb3\x8aH\xb4f\xb8\xdc\xe9" +
-	"\x13\x8a\"\xe0\xb6 \x10\x87\x11\x03U83\xecv\xb3" +
-	"hP\xbcn\xb9\xf1\xc8A\xbd\x14b\x86\xea\x00\xfb\x97" +
-	"\xfd\xa0\xddz\xbf\xa7\xbcj\xa9f\x85\xf7{\xcaA{" +
-	"@\x91\x84j\xec\xa64_\xe8\xbb7\xf8\xa8\xb3/A" +
-	" \x13\xb1\x8a\xde\xa0\xa9\xd1L\xbb$\xabk\"%\xf4" +
-	"HD\x86\x99\xefQ\xdcQ ?vu'\xa5U\x93" +
-	"\x0b\x0b\xdc/L\xdf`&\x9f\x85\x9d%2\x0b\x12\x1e" +
-	"\xb1\x89\x12\xdd\x9e0N\xd7\x94\xb2N\xd7\xf8\xefE\x9e" +
-	"\xa2\x91\x97\xf6J\x80:\x1b\xbdR\x80\xc4\xaa\xdf\xce\xf2" +
-	"\xe9\x0e\xb3\x9a\xa5a\xf2+\xf0\x0d:\xda\xadD\xd1\xe1" +
-	"RjC\xf0\xd803\xf2\xb8e\x1a|\xbb\xc1+\x81" +
-	"\x9c\xbc\x09\xaf\x9b\xcc\x8b\xd0\x13\xcb\xa8\x085'_\x84" +
-	"\xb7\\\x16\xf8o\x89\x0c\xe4\xe4\x8b#:r\x9c\xa5\x10" +
-	"\xf0G#49\xf9\xd1x\xfb\xe5(\xc0m\x11\x9a\x9c" +
-	"\xbc\x10\x91\xcbq\x96G\x01\xb7Ghr\xf2\x12\xdeN" +
-	"Y\x01\xb8\x12\xa1\xc9\xc9W\xe1\xed\x94.\xc0\xc7Gh" +
-	"r\xf2\xb5H_\x03\xf8\x94\x08MN~\x12\xbew<" +
-	"\xe0\xd3\"49\xf9\xa9\x88O\x04|f\x84&'?" +
-	"\x1d\xbb;\x05\xf0\xd9\x11\x9a\x9c\xfc\xac\x88\x19\x1cg\x99" +
-	"\x0d\xf8\xc2\x08MN~\x01\xe2\x0b\x01_\x11\xa1\xc9\xc9" +
-	"/G|\x05\xe0k#49\xf95\x11s9\xce\xb2" +
-	"\x16\xf0O#49\xf9M\x88\x7f\x0a\xf8\x8e\x08MN" +
-	"~\x1b\xe2;\x00?\x10\xa1\xc9\xc9\xefC\xfew\x03~" +
-	"8B\x93\x93?\x88\xf8^\xc0\x8fEhr\xf2G\x10" +
-	"?\x00\xf8Ox+i<\xbd\x95\xf48\xca\xff\x18\xe0" +
-	"\xbf\xe1\xad\xa4<\xbd\x95\xf4\x0c\xe2\xa7\x01\xbf\x80\xb7\x92" +
-	"\x1a\xe9\xad\xa4\xe7\x11?\x07\xf8\x15\xbc\x95\xb4\x1d\xbd\x95" +
-	"\xf42\xe2\x97\x00\x8f\x88\xd4\x91\xb8\xf67\xd0[II" +
-	"d.\xc7\x99#\xf5\xc4\x12\x0dp\x87\x04z)i+" +
-	"\x80--\x017\x02\xde\xb1=\xbd\x944\x0e\xf1X\xc0" +
-	";\x03\xde\xa9\x03\xbd\x94\xb4\x03\xe2\xed\x01\xef\x01x\xe7" +
-	"\x8eF\xd2\x99\xe3\xf8n\x88w\x05\xbc7\xe0]:\x19" +
-	"I\x17\x8e\xe3\x93\x10\xef\x05\xf8\x00\xc0o\xecl$7" +
-	"r\x1c\xdf\x0f\xf1\xbe\x80\xdf\x0dxb\x17#I\xe48" +
-	"~\x10\xe2w\x02\x9e\x05x\xd7\x1b\x8d\xa4+\xc7\xf1\x19" +
-	"\x91x\xa8\x06\xf0<\xc0\xbb%\x1aI7\x8e\xe3s\x10" +
-	"\x1f\x06x!\xe0\xdd\xbb\x1aIw\x98-\x88\x17\x00\xfe" +
-	"\x10\xe07u3\x92\x9b`Z >\x0ap\x1b\xe0=" +
-	"\xba\x1bI\x0fP\x7f\xc4\x1f\x05\xdc\x0exO\x9d\x91\xf4" +
-	"\x04\xf5G\xbc\x02p\x05\xf0\x9b\xf5Fr3\xa8\x7f$" +
-	"\xa8\xa7\x1d\xf0\x1a\xc0o\x890\x92[8\x8e\xf7F\xe2" +
-	"\xd5\xbb\x80O\x8clF5ip\x92\xd9*x\xb0\x14" +
-	"?\xe8BT\xd1\xea\xac\x16\xdd\xb5\x9c\xa1P\xb3A\x0e" +
-	"0\x12c\xf4\x16\xd8\x04\xf1\x96\x95\xd9\xd4kNY\xdb" +
-	"(A\x09\x8e:\x9b\xe8\xb1\xca\x9aX\x8eVFd\x0a" +
-	"\x84V\x9d\x8b\xfd\xb4\xdf\xbc\x08\xf9[\xcau\xfe\xd6\xbf" +
-	"\xc1\xdf\xf2$B\xab\xd6\xbd\"\xe9w\x9d\xbf\xa5\\\xe7" +
-	"o\xfd5=\xaa\xe9\xa7\xfd\x91\xa2\xfd\xd1_\xbd\x8c\xc6" +
-	"\xe9vx\xedB?\xad<}X\x0a\x03\xeb\x1fT\xc6" +
-	"A\xb7\x9b\xfa1\xb0\x14\x06\xc6zv\x00\x03\x1b\xc8\xc0" +
-	"\xee``\xa9\x0c\xecN\x066\x88\xc93\xe9\xcb\xc0\xfa" +
-	"1\xb0\x14\x06\xd6\x9f\x81\x0dP1\xc1\xa3\x8c\x90\x8b\x9d" +
-	"\x1c\xf1\x06\xf2\x89\xb5N\xef\x10\xc1\xa3\x04\xe7\x13\xef\x13" +
-	"lx\xb2;\xfc\xfc\x0b,\x7fr\x83\xdd\x01\xed\xa7F" +
-	"\x9a\xb7%\xa3\xde \xae]Z\x9b\xf0'R\xb4\xdf^" +
-	"\xf0\xf9\x13RG\x8d\x93\xe1wZ\x1d\xc9\x9a\x8f/\x84" +
-	"=\xdf\x99\x1b(\x11\xd7\xaf\x86\xcd\x12\xf4\x8a\xd0\xdc\xa4" +
-	"\xb3\xcfu\\R\xc2\xaa\xfe,\xd5f\x8c|\xc1\xce\x9a" +
-	"L\xedu.>\xd7Q[D\xd1\xa8\xd9\xc2\x8a1O" +
-	"\xa5\xc4\xe9\xedv\xa2\xe7tD\xefO\x0b\xc9\xa2\xc8E" +
-	"\xd9D\x9b\x1f\xadS\xdcR\xb5$\x04\xa8\xd2eg\x99" +
-	" \xd9\xff\x9b\x02\xd9B\xc1S\x19Z\x0b\xaa\x89\x06\xdd" +
-	"\xach0\x8d\x15\x0dvgE\x83\x1d\xd5h\x90\xf8\x83" +
-	"\xc1dm\xbc\xed\x93\xcf\x99dM\x84\xe8\xf3\x91\xe2\xce" +
-	"\x96j\"\xc4(\x1d\x0d\x06\xb5\x11\"~R\xa0\x1a\x02" +
-	"\x94\xc4!\xc1G\xa3\x04O\xa5*\xe5(\xaf\x1a\xaa5" +
-	"&|\x03\x98p\xcd7\x16\xc7\x0an[h)6E" +
-	"\xf3$\x0c\xfd\xc2/8\xa5\xa5\"\x1e\x8e\x0b\xf7\xe3n" +
-	"\xe1_l&\xc8\x82f_\xa8\xa9k\xa43Y\xb7\x1e" +
-	"g\xb2n=.U\x83K\xff\xb0\x05b\xcb\x15:," +
-	"\xc7\xcf\xd7\xd6\x14x\x14\xc1!i\x7f\x83\xe1\xd2\x84\x99" +
-	"\x95\xa2\xe8\x02\x83\xc7i\x03\x9e:\x97`\xc3\x00\xb2\xd9" +
-	"\xdd\x16\x1dN\xb74NLD\x87?\x9c\x0f\x81e\xb2" +
-	">\x04\x93\xcb\xda10\xab;\x06\xc1\x1b\x1e\xa1]\x82" +
-	"\x19S\x8a\xb1\x9e\xd6\xc7\xb0y\xad\xbe\x088\xfc\xe3p" +
-	"\xd5\x82]\x12J\xed\x89\"L\xc5\xd0[K\xcdA'" +
-	"\xf4\xfdQK\x1c\x1e\xf6Qo!\xf5\x1f%\xeb\x80G" +
-	"\xcc\xd4{\xba\xfcG\xc9\xba\xe0Q\xb2\xc0=]\xbe1" +
-	"mp\xdb\xa8\xff,t\x12\x19\x17t&\xc9\xbf\xfb\xda" +
-	"\x0fOz\xa9g\x92\xfc\xb7\x96\x0eBv\xd43I\xfe" +
-	"[KC\xcf$\xf9?\xa4\x10r\x96\xbb\x1e\xa6k\x8e" +
-	"l\x139R\xa3\xb9C\xc7S\x99\x0f*c\x10\xdd9" +
-	"\xb6\xa0\x99\xad\xfd\xa0\x11\xdb\x00\xb0fs\x81\x9dK\xa7" +
-	"\xa9\x9f\x90\x09=\xd4\x897\xca\x07\x83\x16\x893h\xd3" +
-	"H\xaaI0\x04\xa5\x93<N\x878\xbc\x91{.\x1b" +
-	"?6\x88\xa5\x81\x9e\xff\xcd\x8f\x88\x1e\xfd\xd0\xfb\xcb\xae" +
-	"\xbf\x92_\x0e7;`\xa9\x90D\xbbM\x92I\xf9u" +
-	"\x8a\x08X\xd5\x10M\xb6\x0c\xd2\x18*\xcab\xba;p" +
-	"\x7fZ3v\x1d\x08k\xd3A[\x00\x9b^N\xb7V" +
-	"\xae{b\xb7q\xdfH\xf0`\x9a\x80\xfc\xf7f\xc3\xdd" +
-	"\xc4F\xe3\xf5\xcd\x86\xe4\xbb\xdc\x95K\xac\x0d:jz" +
-	"=Q7\xe3*6\xad\x83\xf6\xdf\xdc\xfc\xe2/o\xb2" +
-	"5\xe5\x8b%b\x06K\xcd\xe3t4\xe9\xbcc\xfbV" +
-	"o\xbb^z*\xe4\xb3\xa7t\xdf\xc7\x80_?\x0d\xf7" +
-	"\xb3\xa7\xf5qI\xfc\xfb\x9f\x9c\xce\xfa%\xec4\x98\xff" +
-	"[\xb9\x89b\x13/r8\xab\x83\xeeO\xd2\x1f9\xf8" +
-	"\xea\xd5G&5+\xdf\x06z\x1f\xe5\x16\xec\x8d\xefb" +
-	"_\xff6\x9b\x12\xd6\x1d\xfd\x9aO/\xfe\xd7\x17\x0e\xd5" +
-	"\xbb\x04\xb7\xe0\x10\x15\xed\xad\xf0a\xb9.x\xd0\x95}" +
-	"\x01\x1es\x87nL\xe3\xb7\xce\xb2\x0fr\x86s\xed\xed" +
-	"\x10,K\xe6\xb8\xc6w*\xd8\xae\xa9O\xacGJ4" +
-	"{\x12\xfe4\xe6\xc9x\x8d\x1b\xeaO\xfb\x9e\x89\xd7n" +
-	"T\xf8\x0a$\xce\xc6k\xf7\x82|\xb5\xfb\xe7K\xb4{" +
-	"A-|{A\x99\x1a\xdf\x94\xf1\xbd\x99\xff\xf3\x02f" +
-	"\x14\xa2Y\x14<\x9c^\x93~h\xf6=\xe9\xfe\xbdW" +
-	"\x83\xdb\"*\xff\xb77^\xb3DA\xa9h\xfc28" +
-	"\xb5\xc6<\x97Uc\x9e\xcb:\xd4R\xca\xfaLe&" +
-	"\xebPK\x09\xebP\x8bf#\xab\xc1\x0e\x09\x84_\xa1" +
-	"\x05 V\xa7\xdb\xe5\x11\x83+\x02\x05E\x11\xac\x95\x96" +
-	"J.JSM\xd2\xc0\xfc\x97J\xb2\x0d\x86\x90\xd3\xc4" +
-	"!!e\xbca]\xbeV*(\x19\xa5\x89\x81\xf2\xcf" +
-	"\xa6\xce\x16$\xb3\xb6\xb05\xa7\xd8\xea\x1c\x85A\xbb\xc5" +
-	"\x06G\x86\xa2~\xe2\xdba\xd1V\xc8\x84\x95/\xa0\x01" +
-	"\xb7'\xe8\xc2\xe8\xfff=\xca\x0cw=\xaa\xa3\xf7\x07" +
-	"k\x0c\xb8\xf6\x1e\xe1\xb6\xcd\xfaz\x81\xef\xe3\x01\x8c\x0b" +
-	"f\x8c\x0c\x05i\xba]Zl<2J\xa0\xc1\x0a\xfb" +
-	"\xe34\x81(-\x9eu\xe3r<\xeb\xc6\xe5x\xed\xb7" +
-	"i|\x16lV\x89\xba\x05\x18\xe4\x1b5aW\x9c\xb2" +
-	"8\xcc+\xdb\xdcA\xb7\xf9\x87y\"\x1eL\xb4\xfa\xf1" +
-	"\xd9F|I\xd6\xe7\xb7\xc2\xf8r.\xaa\x9e\xe6\\J" +
-	"S\xc5\x1a\xcc\xcbg4\xe7TC\xee\xd3\xc2\xecJ\xa9" +
-	" s\xa4R\x9d\xb3!\x07m\xc2\xb8/\xd6.Z\x15" +
-	"_U\xb6Yt\xe9\xed\xb5\xe1\x94\x92\x9b\x19g\xc4\x93" +
-	"5\xe7\xe0Y' \x19%\xd9\xc1\xb1N\x18\x97\xa1\xcb" +
-	"b-\xfb#\x9d\xb9\xac\xca\xf1\xe4\xb0\x8b\xed\xd2T\xe7" +
-	"\xe5\xfft\x93\xbe\xb9\xb7\xc6g\xe8\xe9=M\x8d\xb8v" +
-	"\xa5vA\xael,\xcd\xd0\xc4\x9d\x8a\xd9\xb2\x0doT" +
-	"t9\xa3|7*j\xc3\xf3L\xed\xc5z\xea7E" +
-	"2\x83\xbf)B\xfc\xdf\x141\x07E\xed\xfe\xab\xca:" +
-	"`8\x1c\xf8FH\xe0\xaa\xb2nx\xe1.+</" +
-	"i$<O\xe18Ko\xc0\xef\xd4\x86\xe7\x03\x11W" +
-	"\xc3v\x7fx\x1ez\x91\x8c\xff\xa3\"\x83\xf1\xbd\x81\x8b" +
-	"d\xea\xaa%\xab\xe2t\xd7\xaa\x971\xb8\xacAe\xaf" +
-	"b\x8dk\xa8 \xc9\xa2\xf6\x9c\x00\xf5L\x87\xb9\x1a\x16" +
-	"\xd2\xe7\x0b\\T\xcd0W\xd0\x92\x88\x9fF\xd08A" +
-	"\x89\x00\xaa\xb7{\xc0\xaf\xe2\xa0_\xea\xed\x1e\xf0k\x98" +
-	"(\xd8hj)\xf4\x8eg=;\xfc\xb0\x88\xe9v\xbb" +
-	"?\x1eh\xaa0R\xf3\xa5\x11\x1a\xdb\xf9\xae&\x88*" +
-	"\x15\xca\x1b\xdc\xc2\x7f\xfdrLO\x9e\xa4o\xf4\x8ca" +
-	"\x0f\x1dI\xc7\xdb*\xafw\xa4\xa0\x99\xb6\xa8*\xbc\xfb" +
-	"\xfd\xcc\x9a\x83\xe4a\x9a\x9dp\x8f\xf6\x0c/ C\xc2" +
-	"9\xd7\xa3\xf9\x92\x88\xb6\xb6\xe2zw\xc65\x9e_\xa1" +
-	"\xc5\"!\xa9\xfedF\xaa?\x85UO\x9d\xc6\xaa\xa7" +
-	"N\x0b\xbb\x9eZ\xf39\x11\xc3\xd8\x0ag\xe0\x93Z\x89" +
-	"c\x83\xf2\x18c\x83nlO\xb7W\xdb\xed\xea\x17\xe7" +
-	"\xeb\xec\xd5\xf6\x0a\xa9\xbcB\xadOr\xd8\x9d\xceJ\xaf" +
-	"\xaby\x17\xd6\xd0\x9d\xa0\xa0\xb34\xff\xcdI!\xcd1" +
-	"\x81\x80@jsU\xdf\xa5\xbe\xdc-\xc8J\xf0\xa7\xe3" +
-	"\xea\x9dc\xe5\xd0\xcf\xc9\xd5\xbbD\xb7C\xf2x$m" +
-	"\xf4\x82!\x8d\xdc\\\xcf \xdfYj\x86\xa7\x88\x18\x8e" +
-	"\xff;\xa6\x09\xaf\xa0\xcei\xb7\x05\x994\xdf\xef\x0c\xce" +
-	"P.H\xb2\xf6\xe3\xdf\xc3\x9bw\x91\x82\xef:g\xff" +
-	"m\xce\xe9t\x97%\x9c*\xf7\xccp?^^\xaa\xae" +
-	"\xbb\xe9\xa0a\xaa\x19\xa5g\xfd4\xbf\xf1\xb3\x00M\xdd" +
-	"\xd2\x11\xf6\x01\xb4|\x03\xf8\x12\xe1\xb8:\x93UQ\x07" +
-	"\xfaR\xe4\xd6\xdc\x87\xc3\xf0\x17\xfcF'\x9f\x08\x1e\x05" +
-	"#\xb0\xd0\xbf48\xbc\xd3t\xae\xdd\xe5r;\xab\xc5" +
-	"\x92(\xdf\x17\x8b\xd9\x89Iv5thH\xd2\xa0\x1a" +
-	"\x9a\xe67\xd4:`\xfa\xae\xe6|\x88\x8a\x1a\xd9(\xdf" +
-	"\xba\xd4Tv\x92y\xccg2\xebf<\xb7\x9aA\x0a" +
-	"\xbe\xe0\x8e\xf5\xe5\xff\xff\x17\xc4>4\xbf\xd0-Hr" +
-	"v\xc3\xaa\xe6\x94&\x96\x82p\xae\xbfm\xf2k\x7fY" +
-	"^1\xf4stn\xd6*X\xa2Y\x05m^\xd1\x9e" +
-	"#K\x0a\x97(\x09\x8a\xe6\xcc\x13\xe0\x10+szQ" +
-	"i\xce\x19\x9frtW\xe9\xf9\x16\xcd\xda?N\x13!" +
-	"\xe1\xc57\x9e\x0a'\x97\xeeV\x9a\xb7A\xaf9\xce:" +
-	"\xc4\xe9p\x09z\x8f\xe7\xff\xceY\xd6\x06\x1f=\xdb\xfd" +
-	"\xe5\xb0\x82#Cj\x1e\x0f\xf5W\x1a_\xaa\x87\xe6\xdf" +
-	"/5\xf8\xd6G\xf3\x0e\xa8\x94;\x9a\xbb\x09 [E" +
-	"{\xa1;J\xb0\x89\xcdM~\x95\xb9\x9d\x0eM\xea\xa6" +
-	"\xb9\x9f\xa9\x1d)\x96\xd2\x0b\x8d\xb8\xe6~\xdd\xc4\xeb\x11" +
-	"\x1b|\x01\xd2%x<c\x9dn[S+gP\x9d" +
-	"?\xfd\xceQ\x81;\xd1Y&\xd9\xa1\xff\x96\xdd$\x10" +
-	"\xd1,\x89N\xe38\xcb\xc2h=\xb1\xac\x88\xd6l8" +
-	".\x8fN\xe68\xcb+\x80\xbf\x15\xad\xd9p\\\x19\x0d" +
-	"!\xcd\x0a\xc0\xd7Fk6\x1c\xd7DCh\xf1\x16\xe0" +
-	"\x1b\xa2\xd5\x0d\xc7u\x08\xaf\x06xG\xb4&\xa2\xd9\x86" +
-	"\xf8\x17\x80\xef\x8e\xd6D4;\xa3\xc7p\x9ce\x07\xe0" +
-	"\x07\xa25\x97/\xef\x8b\x9e\xc1q\x96\x03\x80_\x88\xd6" +
-	"TI\x9e\x8f~\x91\xe3,\x17\xa2\xf5\xc4\x1c\x03\x01\x8d" +
-	"\x8e\x064W\xb1\x99+@\xde2Fswad\x0c" +
-	"\x04^\x111\x10\xbf\x01\xdeFO\x8b$cb0\x80" +
-	"\x03\xbc\x07\xe0\xd1-i\x91d7\xf0\xd5-\x9d\x01\xef" +
-	"\x05xL+Z$\xd93\x06\xf7Q\x01\xef\x0dx\xdb" +
-	"\xd6\xb4H2)\x06\xef|\x04\xbc/\xe0\x866\xb4H" +
-	"\xb2OLw\x08\xe0\x00\x1f\x00xl4-\x92\xec\x87" +
-	"xo\xc0\xef\x04<.\x86\x16I\x0eD\xbc/\xe0w" +
-	"\x03\x1e\xdf\x96\x16I\x0eB|\x00\xe0\xf7\x02\xce\x1bh" +
-	"\x91\xe4`|\xef\x9d\x80g\x01n\x8c\xa5E\x92\x19H" +
-	"\x7f7\xe0\xc3\x00o\x17G\x8b$\xb3\x11\xbf\x17\xf0<" +
-	"\xc0o\x88\xa7E\x929\xd8\xaf,\xc0\x0b\x00O\x88\xa0" +
-	"E\x92\xf910\xeay\x80W\x00\xde>\x92\x16I\x8a" +
-	"\x88\xdb\x00\x9f\x08x\x07\x9eVIN@9\x8f\x07|" +
-	"\x1a\xe0\x1d\x8d\xb4Jr*\xb6?\x11\xf0\x99\x80wj" +
-	"G\xab$\xa7\xa3\x9c\xa7\x00>\x1b\xf0\xce7\xd0*\xc9" +
-	"Y\x88O\x03|\x1e\xe0]\x12h\x95\xe4\x1c\xb0\xa3\x96" +
-	"\xd9\x80/\x04\xfc\xc6\xf6\xb4JrA\x0c~\x80\x1f\xf0" +
-	"e\x80'v\xa0U\x92Kb@\x1f^\x01\xfc-\xc0" +
-	"\xbbv\xa4U\x92+\x11_\x01\xf8Z\xc0\xbb\xb5\xa0U" +
-	"\x92k\xf0\xbdo\x01\xbe\x15\xf0\xeeQ\xb4Jr\x0b\xd2" +
-	"\x7f\x01\xf81\xc0o\xeaD\xab$\x8f`\x7f\x0f\x03~" +
-	"\x1a\xf0\x1e\x9di\x95\xe4\xc9\x18\x08\xac\x7f\x02\xfc\x1c\xe0" +
-	"=\xbb\xd0*\xc9\xb3\x88\xff\x06\xf8%\xc0onI\xab" +
-	"$/\xe28\x9e\x03<\xba\xad\x8e\xc4\xdd\xd2\x8aVI" +
-	"\xb6j\x0b\xf4-\xdb\xea\x89\xc5\x08x\xaf\xd6F\xd2\x8b" +
-	"\xe3\xf8\xb8\xb6X\x16\x00xo\xc0\x93n4\x92$\xd0" +
-	"\xc3\xb60.\xbd\x00\x1f\x00xr\xa2\x91$\x83\xbe\xb5" +
-	"\xc5\x1bf\x01\xbf\x1b\xf0[\xbb\x1a\xc9\xad\xa0Wm\xb1" +
-	"\x8a\x14\xf0,\xc0{\xb71\x92\xde\xa0?m\xf1\x93\xfa" +
-	"\x80?\x04x\x9fh#\xe9\xc3q|1\xe2\xa3\x00\xb7" +
-	"\x01~[7#\xb9\x8d\xe3x\xa1-\x8c\xcb\xa3\x80\xdb" +
-	"\x01\xbf=\xc6Hn\xe78^j\x8bw\xa7\x03\xee\x02" +
-	"\xbco[#\xe9\xcbq\xbc\x03\xdfk\x07\xbc\x06\xf0~" +
-	"\xdd\x8d\xa4\x1f\xc7\xf1\xde\xb60\x1f]\x80\x8f\x07<\xe5" +
-	"&#I\xe18\xbe\x16\xe9k\x00\x9f\x02x\xff\x1eF" +
-	"\xd2\x9f\xe3\xf8I\xd8\xdf\x89\x80\xcf\x04|@O#\x19" +
-	"\x00z\x85\xfd\x9d\x06\xf8<\xc0\x07\xdel$\x03A\x7f" +
-	"\xda\x82\xfe\xcf\x04\xfc\x05\xc0\xef\xb8\xc5H\xee\xe08~" +
-	">\xf29\x1b\xf0\x85\x80\xa7\xf62\x92T\xd0\xab\xb6\x1d" +
-	"9\xce2\x0f\xf0W\x00\xbf3\xc9H\xee\xe48~\x11" +
-	"\xbew!\xe0+\x00\x1f\x94l$\x83\xc0z\xe2x-" +
-	"\x03|5\xe0i\xb7\x1aI\x1a\xc7\xf1\xab\xf0\xbd+\x00" +
-	"_\x0b\xf8]\xbd\x8d\xe4.\xd0\xb7\xb6\xefr\x9ce-" +
-	"\xe0\x9f\x02~\xb7\xc1H\xee\xe68~\x13\xb6\xff\x11\xe0" +
-	"\x07\x00\x1f\xdc\xc7H\x06\x839l\x1b\xcfq\x96\xdd\x80" +
-	"\x1f\x06\xfc\x9e\xdb\x8c\xe4\x1e\x8e\xe3\x0f\"\xbe\x17\xf0c" +
-	"\x80\xa7\xdfn$\xe9\xa0\x9f\x88\x1f\x00\xfc'\xc0\xef\xed" +
-	"k$\xf7r\x1c\x7f\x1c\xe5|\x18\xf0\xd3\x80g\xf43" +
-	"\x92\x0c\xd0[\xd4\xab\x9f\x00?\x07xf\x8a\x91d\x82" +
-	"\xde\xa2\xfc\x7f\x03\xfc\x12\xe0C\xfa\x1b\xc9\x10\xd0[\xd4" +
-	"\x87\x0bm\xf5\xc4l\xd0\x91\xb8\xac\x01F\x92\x05f\x18" +
-	"\xe1+@\xde\x12\xf0\xec\x81F\x92\x0df\xd8\x00j\x12" +
-	"a\x003\x0c\xf8}w\x18\xc9}`\x86\x0d@\x1f\x0d" +
-	"x{\xc0\x87\xa6\x1a\xc9P\x8e\xe3\xdb\x19@\x0cF\xc0" +
-	"\xbb\x02>\xecN#\x19\xc6q|\x17\x03L\xd3\xf6\x80" +
-	"\xf7\x00<g\x90\x91\xe4\x80\xd96\x00\xfb]\x01\xef\x0d" +
-	"xn\xac\x91\xe4\xc2\xb4@\xbc\x17\xe0Y\x80\xdf\x1fg" +
-	"$\xf7\x83\x9a\x1b`\xb8\xee\x05<\x0f\xf0\xbc4#\xc9" +
-	"\x03sh\xc0\xb2\x15\xc0\x0b\x01\xcf\xbf\xcbH\xf29\x8e" +
-	"7a;\x05\x80?\x04\xf8\xf0\xbb\x8dd8L\x0b\xc4" +
-	"G\x01n\x03|\xc4`#\x19\x01\xd3\xc2\x80\xf7\xd6\x00" +
-	"^\x01x\xc1=FR\x00\xe6\xd3\x00\xabZ\x05\xe0\x0a" +
-	"\xe0\xa6t#1q\x1c_e\x98\x0c\xea\x0f\xf8x\xc0" +
-	"\xcd\xf7\x1a\x89\x19\xd4\x1f\xe9\xc7\x03>\x0dpK\x86\x91" +
-	"X\xc0\xac\"\x9fS\x00\x9f\x0dxa\xbc\x91\x14\x82\xf9" +
-	"D9\xcf\x04|\x05\xe0E\x99FR\x04\xeai\x80a" +
-	"\x7f\x05\xf0\xb7\x00\x7f`\x88\x91<\x00\xe6\x10\xe5\xb9\x0c" +
-	"\xf0\xd5\x80\x8f\xcc2\x92\x91\xa0\xb6\xd8\xfe[\x80o\x00" +
-	"|T\xb6\x91\x8c\x82\xd5\xdd\x00\xd3b5\xe0\x1f\x01^" +
-	"|\x9f\x91\x14s\x1c\xbf\x11\xe5\xb9\x01\xf0/\x00/\x19" +
-	"j$%\x1c\xc7o\xc6\xf1\xfd\x14\xf0\x1d\x80?8\xcc" +
-	"H\x1e\x04o\x00\xf1\xad\x80\xef\x05\xfc\xa1\x1c#y\x88" +
-	"\xe3\xf8=(\xcf\xdd\x80\x1f\x06|t\xae\x91\x8c\x065" +
-	"7\xc0\xb4>\x00\xf8O\x80?|\xbf\x91<\x0c\xea\x8c" +
-	"r>\x0c\xf8i\xc0\x1f\xc93\x92G@\x9d\x91\xff\x9f" +
-	"\x00?\x07\xf8\xa3\xf9F\xf2(\xa83\xf6\xf74\xe0\x17" +
-	"\x00\x17\x86\x1b\x89\x00^\x05\xea\xdb9\xc0\xaf\x00^:" +
-	"\xc2HJ9\x8e\xbf\x8c\xfc\\\x02<\"VG\xe2\xac" +
-	"\x05Fb\xe58\x9e\xc4\xba9\xce\x1c\x0bV\x1b`\x9b" +
-	"\xc9Hl`\xb5c?\x00u\x06\xbc=\xe0\xa2\xd9H" +
-	"DP\xe7Xh\xc6\x08xW\xc0\xcb,FR\x06\xea" +
-	"\x8cxg\xc0{\x01^^h$\xe5\xe0m\xc4\xe2\x05" +
-	"\xd3\x80\xf7\x06\xbc\xa2\xc8H*@\x9dc\xd1\xca\x03>" +
-	"\x00p\xe9\x01#\x91\xc0\xca\xc7\xc2\xb0\xf7\x05\xfcn\xc0" +
-	"\xc7\x8c4\x921`\xe5\x91\xfeN\xc0\xb3\x00\xaf\x1ce" +
-	"$\x95\xa0\xfe\xb1\xa8\xfe\x80\xe7\x01n/6\x12;\xa8" +
-	"?\xb63\x0c\xf0B\xc0\x1d%F\xe2\x00\xf5\x87\xeeZ" +
-	"\x0a\x00\x7f\x08p\xf9A#\x91A\xfdcqU\x00\xdc" +
-	"\x06\xb8\xf3!#q\x82\xfa\xc7\xe2Q\x19\xc0\xed\x80\xbb" +
-	"F\x1b\x89\x0bV\x05\xec\x97\x0dp\x17\xe0U\xb7\x1bI" +
-	"\x15\xac\x0a\xb10\x8c\x15\x80+\x80\xbb\xfb\x1a\x89\x1b\xa6" +
-	"\x05\xd2\xdb\x01\xaf\x01\xdc\xd3\xcfH<\xb0Z\xa0\xdc\x14" +
-	"\xc0'\xc6\xea\x1a\x14\x0c5u\x9b^\xf0\xe5{\x98m" +
-	"\xd6\x9eWz\xf5\x99\x1f:W\x9d8\xef?\xaf\x14\xfc" +
-	"\xa1EIV\x9c5\x925\xe4\xe0@\xe0\xdb\xe6D1" +
-	"\x8ben\xd1S\xa1~{\xcb\xbf\x1b\xe1;5l!" +
-	"v'%\xd2{*\x82n\x9b\xc9\x17\x14\x913\xb8%" +
-	"\xc1Nb\xeb\xfb\x92\xa9\x13\x1e\xfb\xbb\xefO\x81*_" +
-	"Et\x14J\xb2\xf6\x130u\x82\x90\xe1v\x0b\xb5\x8d" +
-	"~\xab4\xe4n\xc0\xa0\xd3\x0d\x89V\xaf[M\xd1G" +
-	"y\x14\xb7\xe6\xff\x01\xaa(k\x85\xfa\x7f\x9bX\xa3^" +
-	"u)+\xaa\x04\xa3\x84r)\xf0\xff\xb1\x92\xfa\xc22" +
-	"\xed@\xa0\x882\x9dN\x8e4\xd8\xa8q\x88\x0e,\xf4" +
-	"lx\x8d\xce\xff\xdaY\xd0\xf4!^\xb7G\x13\xe5C" +
-	"\x1b\x00qz\x0dH\xdb\x1a\xe2\xe5\x0cA\xb4\xb4\xcdP" +
-	"8\x1dw\x11\x19%\xbd\xb2\xa0\x88\x96J\xce\xc0\xfa+" +
-	"*Oh:\xa6Br{\x94<\x91\x8b\xd2\xde\xabY" +
-	"\xe1\x95\xcbEw\x08\x9aX\xea-+\xd3\xe8\xea]\xdb" +
-	"\xe7}8<j\xf1B\x7f0n\x93<V\xc9e\x97" +
-	"\xb8(Y\xf4\x90\xd8\xfa[\xdew\xffl\x8b\x9f\xf4\xbd" +
-	"_\x8b\x02ga\x0c\x0e\xc6g\x96E\xd9\xe6u\x0b\xb2" +
-	"U\xfb\x09\x05A\xa0J\xc4%Z\\\xa2\xa6R:\x80" +
-	"k:R\xea\xab9\xe1\xf4\xda\xf3\x7f\xba\xde\xf3\xe5\xc2" +
-	"\xb8\xbf\x03eV.'\xcc\x9cR\x91\xd3\xdb\x15\x12[" +
-	"\x9f/9\x8f\x1d\xaa/\xda\xe5gQ\xa8\x16\xfe\x1f\xf6" +
-	"\xde>H\x8e\xea\xca\x17\xcc\xacju\x09\x1b\\R\xab" +
-	"$@O\xb8\x04\x166\xb4\xc1\x96\x1a\xc9\x86\x1e1\xa5" +
-	"\xfe\x10t\xf7t\x8bVW\x0b\xa46\xbc\xa7\xdb\x95\xb7" +
-	"\xbbS\xca\xca,ef\xf5\x87,\xa6\x05\x06,<b" +
-	"\x0c\x1e1\x16\x1ea\x0bF\x8c\xc1\x86X\xf0B<x" +
-	"!baC\xc4\x82\x03\xbd\x15/`\x036D\x04L" +
-	"\xc0\x04\xec\xe2x\x10\x0b\xfb`\x16Oo\x9cs+\xf3" +
-	"\x9e\xcc\xca\xaa\xca\x12\x8c'6\xf6\xfd\x03\xca_\xdf\xba" +
-	"\x95\x95y?\xce=\x1f\xbf\x9fn\xb0qC\xc9\xf1<" +
-	"\xe5H\xab\x16\x1f\x0f\xc5\x9a\xabt\xc0\xc6u\xdb\x9d\xd2" +
-	"X\xe0Y\xc3\xe7\x0ckR\xa7\xf9\xbb\xae^\xe4pd" +
-	"V\xb2\\\x1b\x92e\xfe\xa9\xd2\xb4\xe4Wc\xa6\x9c\xec" +
-	"\xc9I\x99_\x8a\xdfi\x07\xa2\xcb\x02\x1bg\xa6\x92\xda" +
-	"-Gb\x8a\xcf\x96\x02\xdf\xc8MWW]\x83k\x96" +
-	"\xc9\x0aA\xa7\x8b$\x93\xa8\x9a\x0fM\x96\x9f\xff\xab\x94" +
-	"B\xcb9\x95\x9fb6I&\xf0\x05g\x93\xa6t\x1d" +
-	"\xb2\xb2k90$%\x07HT\xdaX\xbf\xe9\xb8J" +
-	"\x9a\x99d\xc1@\x05\xbd!^T\xd2\xc1\x82\xd2p\xc4" +
-	"\x0c\xdb\x0dr\xa6\xa4(!G\x94V\xac\xc1\x99\xd6\xc5" +
-	"\xba\x0a\xb0x\x13\xbf-\xd3\xed\x11^d\xba\x926i" +
-	"\xb5.\x9f-\x11}w\xfc\x96\xae\xb2\xabZ\x85J\xf5" +
-	"\x0d\xc9\x0ag\xba\xd6Uv-\xd5\xfbSp,\xd4\xf8" +
-	"\x943e\xcdLq\xa3\x18tuV\x92Gss\xfd" +
-	"\x81\xb4\xc8\xff'9\xf5\xd3\x87n\xfd\xf5\xeb\x151\xec" +
-	"P\x8aJX\x93\x9d\xb1\xe1\xf0\xec$\xac\x82\x0bE6" +
-	"\xa9\x17F\xb8\xa3\xa4tG\x8e\xba\x09\xdd\xe6#\xdc\xd1" +
-	"\x95$\x01\x0b\x96\xa1U\x81%Kw,s\x84+i" +
-	"\x87v\xa0\xe9\x0eg\x0e\x1fQ\xb2\x9c\xe2\xd9)\xe6\xb8" +
-	"\xe4i\x17\x0ae\x9b\x15\xe8\xb4\xcc\x89\xd4*\xd9d\xda" +
-	"\xd2\xb5\xd0BT0\x98nO[s\xa1AR\x10!" +
-	"\xfc\x09%;\xc1\x0b\xc4c-2\xae\xf2S\xaa\xce\x0d" +
-	"mHw\xf5\xdc$\x0b\xec\xd8^\x03%\x0d-$l" +
-	"\xb9\x988\xad\xa45Z\xc80U\x1a\xe1\x93\x92\xaf}" +
-	"\x01\xb6R@\xe8:\xe2/\x9f9\x1el\xecT2\xb1" +
-	"\x15u2\xb8\x1b\xf6\xb2\xa2\x92\xa2\x89a\xc2\x8c\x98\xd2" +
-	"\x95\x1c~\x804wm}7w\xa7\x94\xacm\x95I" +
-	"\xd0\xd0q\xcbf\xd5\xeb\x19g\x85\xdd\x8e\xcb\xc6\x03\xb6" +
-	"\x82Q\xb0\xa6,\xb9\x91\x14\xd9l_I]\xa4$\xd4" +
-	"E\x8a:_d\xb3X\xedQ\xb9^(\xb2\xd9\xcd\xf0" +
-	"k*\x0f\xdb\x83\x1b\x07\x84-k\xf7(\x9fu\x95:" +
-	"\x84\xf3\x919k~R\xf8\x00!\x9c\x9f\xd1MM\x06" +
-	"L\x83\xac\xbf\xe3\x96\xb5\xdb\xc5oj\"\xa4\xd8\xd5\xb5" +
-	"y\xb6D2c\x88w\xf6\xd6F\xec\xeb\xde\x86\xa7n" +
-	"3\x9dR`\x8e36[\x1a\xe6vAI\xd1\x0d2" +
-	"V\x16}W\xa9d\xa7\xac\xe98\x91\xaa\xf6\xa8HU" +
-	"g\x8dHU\xbd|\xfa\x18\x8f\xc9p\xbb\xb4\xe9\xbc\x9b" +
-	"bn8\xca0vFz\x1f|\xb6\xc4m\x9d\x9b\x94" +
-	"\xc2d\xbe,\x9e\xa3\\T\xb8]\xe0\xa6\xcb\x94d3" +
-	"\x99\x92C\xd6x\x1fg\x86\xabN\xc5IAXI\xa8" +
-	"E%{*\xcdFh\x18\x89\xb9F7\xb5an\xe3" +
-	"\xfa\xe7+\x8c4\x0cx\x8d\x04\x03^d\xf3+T$" +
-	"g\xc8\xf9\xa4\xf1M\x0c^\x83\xc9&\xc2\xd1\xefZ\x93" +
-	"\xa9I\x83\xc7\xa0\x19\xe9\x8eJ<$\xd4\x9a~\xe8\xff" +
-	"\xaeN\xc2=\xe2\xe5BP\xee\x910\x17\x11^\xc3\xdb" +
-	"\xf4+\x1d\xf5\"\xc7\x9c\x1f\xb2\xca\x07\xc5\x12\xe69\xaa" +
-	"\x078M\x8a'\\\xcb\xcd4\xb7\xf5B\x9c\xa7\xde!" +
-	"_w=\x15\x87\x98L\x1b\xd7\x8d\xf6*J3:\xc8" +
-	"\xeb\x13\xea\xfc$7\xb9\x8d'\xba'V\xb0#W\xbe" +
-	"{\xec\xb7\x9e\x10ps\x12x\x95\x00+Wy\x93\x9a" +
-	"\xa3Q\xd2s\x8dc\xae5\x0f71EG\xb3#\xbc" +
-	"T/G2*B)%\x18\x88:j\x94\x18i\x88" +
-	"\x03]XzM\xd60uu\x09a\xb7\xaaJ\xe7\xce" +
-	"/V]H\xf9\x08\x83\xa1\xc30mXdA\x10\x9f" +
-	"-a\x05\x7fS\xbfE\xa41\xf6\xb2t\x91Uq=" +
-	"uF\xd1iuF\xd1i\xb5\xc7\xa6\xd3\xa2\x04P^" +
-	"\xda\xfd\xbb\x1d\xa4\xd8\xc2K\xbb\x7f\x7f\x8c\xd4Ux\\" +
-	"O\x1f\x0d\xc8\xba\x8a\xfaJr\x81\x1d?\xac(\x11\xa6" +
-	"\xc6>3=\xb9\xd8Rp[\x86{\x1c\\\xf0\xab\xb3" +
-	"\xfbh\x0e4\x1ce\x9a.\x16L\xf5\x9bn\x1d\xf9\x9b" +
-	"i\xa4t\x88\xbd7\x08\x15\x94\x11\xee\x96m\xb3\x17W" +
-	"\xe08\xa1w\x92\x91\x114\xb4\xa2tgc\xe9i\xc1" +
-	"\xfcJOW\xca\x17hF\xad]\xa3\xe0u%\x95\xa4" +
-	"\xf6\xe3\xcf\xcb\xb1\xe0\x95\xa4\xd4&\xbd\x94\xda\xb1`J" +
-	"m\x8b\x97R\xbb+ \xce\xb7\xc8\xcb\xa8\xed\x0e\x88\xf3" +
-	"\xb5\xaa\"\xfe|9f\xe6\x92\x8c\xdaE^F\xedH" +
-	" svqB\xc4\x9f\xaf\xc6\xee\xa5\x04\xa3'\x9e\xb7" +
-	"\x19\xdb\xfb\"|\xb5\xcaViQH\xb0\x84]~\"" +
-	"\x19U\xfd\x9a\x1e\xa5s\xa1h\x8d\x07\xb3\xd4\"\xe4\xb7" +
-	"&-f\x84x\xbcIw\x81\x8fk\x98V\x12l\x1a" +
-	"\xbbh\x0b\xef\x0c\x87\x18\xae#KG`\x83\xc3Y\xb1" +
-	"0a[\xc5\x1b,\xdbPT\x0d\xff]1\x08b\xd0" +
-	"T\x0b\xd1M\xbd\xb0{\xd8*\xec\xe6n\x15sn$" +
-	"\x1fX$\xabC\x80J\xb8\xb2\xac\x05\x84\xbe\xbce\x8d" +
-	"R\x87y\x9a^4\xd3\x93\x0a\x90\xc0\x8f\x91/c." +
-	"\xc0/\x1b\xd4\xf2*X\xbaY\xcd)\xde\xd4\xa1\xa5P" +
-	"\xe0%w\x0b\xcf\xcex\xd5\xe3\xcdT\xe8\x85\xaa\xa9\xa3" +
-	"k\xae\x9b\xcb?\x9a\xb2,'\x8b\xc6\xdd\x9f&\x05\xa9" +
-	"0e\xe9\x05\x9a\x82\xf4\x87\xd9\xff\xfa\xbbo,\xff\x8f" +
-	"\xef\xd5KA\xf2)\xe8J\xac\xb0;%\xf6\xc5x4" +
-	"t\xd7\x09-I\xca?K\xcc\xea\x8e(\xda\x85vi" +
-	"W\xab\x91\xac\x0b\x95B\xcf\x00\xa5\x9f\xc7\xdeG\xcd\xea" +
-	"\xa0e\x13\xa0@\x99\x1f/\xbb\xaeE\x1ck\x11\x8aJ" +
-	"\xf3%\xabT.5\xf3^\xc1\xb0\x95\xfa\x8e]]b" +
-	"\xa2m\xf2W\xeb'p\xb5~\x1c\x96\xb5\xe3t\xb5~" +
-	"F\xbdUQ\xf2O\x03~\x82\xae\xd6\xcf\xab\xbfW\x94" +
-	"\xfc\x8b\x80\xbfJW\xebW\xd4\xdf)J\xfeU\xc0\xdf" +
-	"\xa2\xab\xf5\x9b\xb8,\xbf\x0e\xf8;T\xe9\xf6m\xc4O" +
-	"\x03\xfe\x1eU\xba}\x17\xf1\xb7\x00\xff\x80*\xdd\xbe\xaf" +
-	"\xfeFQ\xf2\x1f\x00\xfe)U\xba\xfd\x04\xef\xe7s\xc0" +
-	"\x17'\x88\xd2\xed\xa2\x04\xdc\xcf\xe2\x04\xecB\x09\xa2t" +
-	"\xbb4\xf1?+J>\x03\xf8j\x14:\xf9\xaa\xc8\x17" +
-	"\xba \xf1\xfb\x80\x80\xcb\xd9g\x8b|\xa1\xcb\x13\xbfP" +
-	"\x94\xfcZ\xc07\xa2\xd0\xc99\"_\xe8\xaa\xc4?\x06" +
-	"\x04V\xbe\xf65\x91/\xd4\x8fB$RH%\x9d\x16" +
-	"\xf9B[\x11\xf7\x05S\x84G\x91\x0d1%k\xef\xde" +
-	"R*\x04=\x8d\xcc,\xa9\x05<\xf5N\x85|\x90\xac" +
-	"\x97'\x0c>\xc9\\>\xc4t\xb3\xcbqt\xa7\xcaO" +
-	"\xc9z\xb9\xea\xb5\x09\xf6>/\xfe\xbe>t\xbd!t" +
-	"\xfd\xbdP\x7f\xf9\x92\xca\x0d\xa3k\x86\xd9<m\xd2\"" +
-	"U\xf1\xe7\xeb&\x12\x13\xdct\xf8fs\x8a\x99\x05^" +
-	"\x8cp\x9d\xb2!\xa6\x9aL4\xc8b\x8b\xd0\xdf\xfbx" +
-	"\x02~-\xed\"\xba\x05:\xde\xb871Bm\xae\xd1" +
-	"US\x1bf\xee\xd4\xa8\x95\x1c.Dwp\xdd\x84\xa8" +
-	"\x93tF\x99\x9d\xa2\xec\xba\xa2\xd5\xba\x00SU\x05\xdb" +
-	"\xd0T\xca\xfe\xb5\xdc\xed2\x0cY\xcd\xef\x15\x1d\xfdI" +
-	"\x8b\xf9\xcf\xfe\xf3\xf6\x1f\x9e\xbc\xe1\x8d\xbbbW\x9d\\" +
-	"\xcf\x0c]c.\x1a\xb7`\x07\xa7\xaa\x95\xb4\xce\x9c~" +
-	"j\x14m\xcc\xfc\x945#xt\x9a5X\x03\xd20" +
-	"\xc2`m\xf28(\x8eP^%\xcb\x1a\xff\xbb\xff\xb0" +
-	"R\x9e`\xbc\xaf\xfe\xa8\x9d\x90\x16y\xaf\xe2\x93\x0eR" +
-	"-\xee\xb9Q>[I\xb8n=7\xca\x1f;\x08\x9d" +
-	"\xb0\x9f\x1f\xa9\x82\xf9\x1b\xa5\x86\xd1\xa9(#\xb0|\x9d" +
-	"M\xeb\xbd\xceB\xa3\xd8/K\xf3\xeb\xbd\xce\xc1\xba." +
-	"\xdf\x88\xaeE\x85\x14\x0c\x80\x13OX\xc5-\x1c!D" +
-	"T\xd4\xcd\xde\xe2\xa4\xbcd\xb3\xe4\xb2R\x1a\xdd\xcb\x95" +
-	"\x14\xd1'\x8a\xe5-\x18)\x9bCVRd\xe9\xd6\x18" +
-	"K\xc5\xa6\x84\x7f\xae\xe5\xee\x96\xe1\x9e^\x9d\x19\xd6d" +
-	"\x99\x13a\xcb\x86n\x9b[+#\xaa/Q]\x00\xa7" +
-	"U\xfaS\xfbt\xc7\xb5\xec9EN\xa5\xdf_\xf8\xe2" +
-	"5\xc7\xfe\x87\x9b\xff)v\x15\xf2\xb5C\x83\x18\xbdL" +
-	"\x16\xcf\x9c\xb0\x9d\xb8\xc9\xebfJ\x1b\xccq\x9b\xb68" +
-	"+\xb5UF\x1a\xd3\x88C\xd2\xe7\xbb\x02\xc7(\xcf\x1e" +
-	"\xb8\x1c\x8f?\xfe1\xaab\x05-\xdb\x80\xf4C\xb2." +
-	"\xd1\xab\x87\xbc\x0a\x0fo\x92N\xa8\"1\xbc\xac\x0b\xeb" +
-	"\x15\xe5\xe9\xca\xe3X\xdd\x8c\xed\xa5\xc4\xb9\xc7\xb1\x1a\x16" +
-	"\xb8\xf7No[\xf1v\x86\x01\xbfQ%\xd9\xc3;\xb0" +
-	"\x7f)q\xeeq\xac\xde\x84\xfdl\x07\\C\xe9\xf3\x84" +
-	"0\x07\x18\x9a\x1b\xbe\xf49\xd2\x1f2\x17\xccg\x9bF" +
-	"gJ\xba\x86\xb4\xc0$\xc0\x13\\\x01mf\xee\x86\x16" +
-	"\xe8\x8e\xf0\xe6\x1a\xc6\x06\x03\"\xe8\xe1&\x01\xd9\xc7\xc6" +
-	"\x99,\x01\xaf\xff<+\x083:\xf6*,u]\x91" +
-	"\xa0\xde\xccj\xf9\x19V\x8aSVa\xd38\x89&\xdc" +
-	"\xd2J\xd6\x0e\xcc \x9b\x17#\xf1\xba\xc6\xbb\x18\xbaa" +
-	"\xd3\xbd\xb6\xb2\x94\xcb\x8b\xd7\xeb|\x86\xaa'5P=" +
-	"\x1e\x90;\xa8\xbf\x96\xd32\xe9\xb0Z\x08+O\x82\xf9" +
-	"\x81\x91\xd5\xb0\xe0\x90n\xee\xeec\xceTS\xa5+\xd9" +
-	"|\xc9\xd0\xdd\xff\xef\x96i\x93\x83!\x9e#\xc4\xdb\x8d" +
-	"\xa1,0\xd6\xa0 5\x92\xe3&\xa6(zW\xd9\xb5" +
-	"D]\x9e\x1a\xae\x80\x1f\x8bz\xb4\x9dQ\x8fv \xaa" +
-	"\xce\x8a0\xf5T\x94\xb9\x83\xe2\xb89\xc7*\xd2\xd4\x06" +
-	"\xc1Y\x11\xfce6/\x19s=\x96F\xa3\xdb\x8de" +
-	"#*\xbe\x90\xa4`s\xf97p\x85x\xda)'\xbe" +
-	"\x0cW\xc8<lL\x8e>\xdb|\x80\xa1\xc4f\xcc\xbe" +
-	"\xe1m9$\xd8\xef\x88\xb3\xaf\x93\xa0_8o\x82\x98" +
-	">q\x0a\xaf\xedin\xe7u\x8d\x0bJ\x025L\xba" +
-	"\xfe \x19F\xde\xb7\x17\x7fB*k\xbd71\xf73" +
-	"\x12\x88\xf3\xde\xc4\xed{e \xae2#\x98\xab\x82]" +
-	"5\xa1\x1bn\x8a\x08M/\x98\xa5\x02Z\\*\xfe\x09" +
-	"\x15\x94C\xf1\xc4\x02S\x1dw\xa2r\x9b\xf4s\x05\xe6" +
-	"\xb8\x13JN|\xac\x99J\xefI\xdd\xeca\x95hg" +
-	"#\x9fOd@\x98\x06u\x0a^\xe5!\xf1]\x84\x89" +
-	"t\xa2(\x9e\xebn\x16\x9aU\xa8\xda*Z\xe3\xeaC" +
-	"\xa2<\xe4\x96\x88j\xe3F?\xd0wj\xb5\x13\xdd\xf0" +
-	"\x88\x05m\x9ei\xa2\x00\xb9.\xad\xdd\xa2F\x82S\xc8" +
-	"Q\xd8\xefr\xb5X\xdb\x13\x18uF\xaa\xd26\x8e\xf0" +
-	"\x18\xc7\x09\x9a\xd96\x9bS\xe2\x84\xdaI\xec5\xc9d" +
-	"\xdai\x93\xc1\x0c\xaf\x9a\xee\x06\xcb6\xb4<\xb7S\xd3" +
-	"\xc2\"%'\xb4N\x12c\xf2\x8fh+\xe9\x11\xad\xf2" +
-	"\x86>\x19 \xa71o\xd6\xfdq\x9c\x1e\xbb<\xb7\xd4" +
-	"\"4s%\xedG\xc5+u\x0e\x9a\xa12F\xe1\x05" +
-	"\x11\x96c\x10A\x92rV\x98l\x97]\x80\xc74\x19" +
-	"\xa3X\x9c\x14f\xe8\x85\xe8U\xf3c\x14\xb9\xf1\xf2\xc4" +
-	"\x04'$\xc8%i\xbf[\xe6d\xf85\xa1Yg\xcf" +
-	"\xf5()8\x18U\x09P\xa5{(,T\"\x83\x9b" +
-	"T\x94td8\x83\xac\"dq\x9d\x925Q\xe0\xa2" +
-	"\x89\xb0\x00\xa6\x9e)\xe1s\xc3@\x8dsC[\x80\xe8" +
-	"\xc4\xf3#\xaeC\\\x86e<?\xe2\x06\xc4\xe5\x81\xc2" +
-	"{aW\xa1\xa1.\xc35\x9e\x1f\xf1j|\x03\xf2\xa0" +
-	"\xe1\xf9\x11\xc3\xbc\xa5\x9e\x1f\xb1\x1f\xdb\xcb\x83\x86\xe7G" +
-	"\x1c\xc2\xfe\xe5A\xc3\xf3#nE|\x10\xf0\xed*\xf1" +
-	"#nC\xdc?\x80\xc0t\xb3\x99k\xd9\xe4\x897\x97" +
-	"\xca\x19/g2D\xd56/\xc2\xab>\xcdJ\xe5z" +
-	"G\xe8z\xac*\x1aZ?\x05#\x9a[(\x8a\x02q" +
-	"\xa0\x1e\x05\xe2\x01\xe2)\xb9}\\n~\xe1\x92\xdb9" +
-	"\xab\\U\x17+TF*\x92l53C\x85\x0d\xe9" +
-	"\x98J\xaa\xa9\xeaa\xe1\x8e\xea\xe5\xd9(\x02\x99\xce\x06" +
-	"\xce\xa8\x9ccX.\x09\xebE\x15\xe0\xc7\"x/\x17" +
-	"\xb9\x12\xc7?\x10\xc9|A6\xa3 \x1f\x00C\xd3\xd8" +
-	"t\x94t\xb9\xc8\xb53T\xd4\x1fa\xa6f\x15G\xb8" +
-	"\xba'\xf4p.j\x90\x0e\x93\"\x04(\xe9\x00\xfbI" +
-	"\x93\x0eH\xa7\x94\xb6\xaa\xfd\xa6\x8d\xf8\xc2\xfc\xbdz\x97" +
-	"\xb4E`G\xa2QX/\xfd\x9c\x18\xe7\xe8I\x1d\xe2" +
-	"\x8e\x92nN\xa4/\xefZ%\xe1X$\xa9b\xcd\x91" +
-	"\xeb\xc9\x0c3\xe2\x1c\xdbE\x09|=F\xf94\x1aO" +
-	"\x1e\xa7|\xe3{\x03C\xb8\xabT\xe2\xccF~\xca3" +
-	"\xe6\x18$\x1cga\xeb:8\xaa\xce\x88\x9ft\xd0b" +
-	"Z\xbe\xc4\x8d\xb4\x91\xe7n\x1d\xfe/4\x1ek\x1d&" +
-	"\xa2]\x0b\x96\xcb\x90\x1aYI6~ZC\xdc.L" +
-	"1\xd3\x1d.\xc3\xff#\xf4\x11:b\xd3wD\x1e+" +
-	";\xe4!!\x94\x9b\x85\xe4\xb8\x86E\xeb~\xa2\x96\x94" +
-	"\xa0\x82|\x0cK\xaeB*\x9c\xf6\xc2\xb8\xe4\xb7\xac\x8c" +
-	"X\xc8G\xa2\x16\xf2\x81(.\xdb]\xf2\x84L\xf3*" +
-	"\x9d)\xcb\x06\xfb\x9ar\x05E\x197\x0d(d\x1b\x84" +
-	"\x10`\xbd\x16\xd2kUo(\xd2\xa7BS\xb8\xbc7" +
-	"D\xdd'\xf2\x0du\xcb7\x14\x99\x12\x03\xc7\xe0\xd0{" +
-	"\xca\xb9\x96SG@7\x96\x12\xaa\xddcs\x96\x14{" +
-	"\xed\xa8oM]\x9d\x18Q\x94\xfc\xc6DR\xcd\xf7%" +
-	"\x885\xb5\x19\xf1^\xc0\x87\x13\xc4\x9a\x1aJ\x80\xdd:" +
-	"\x08\xf8\xf6\x04\xb1\xa6\xb6%:h\xd4\xd1\xb7\xa6v$" +
-	"\xc0\x0a\x1a\x05|g\x82XS7%\xd0M\x0a\xb8\x96" +
-	" \xd6\x14\xc3\xef\xdd\x09\xb8\x91 \xd6\x94\x8e\xf8\x14\xe0" +
-	"n\x82XS{\xf0{\x0d\xc0giT\xb6\x9c\xb8H" +
-	"Q\xf2%\xc0\xf7\xd1\xa8\xec\x1c\xe2.\xe0\xfbiT\xf6" +
-	"f\xc4g\x01\xbf\x8dFeoA|\x1f\xe0\x07hT" +
-	"\xf6v\xc4\xf7\x03~\x90Fe\xef\xc4\xfb\xb9\x0d\xf0\xbb" +
-	"iT\xf6.l\x7f\x00\xf0C\x80/Y\"X\x1c\xee" +
-	"\xc1\xe7p\x10\xf0\xc3\x80/]*X\x1c\xee\xc5\xdf{" +
-	"\x08\xf0\xa3\x80\xb7\xb5\x09\x16\x87\xfb\x11?\x02\xf8\xc3\x80" +
-	"/[&X\x1c\x1e\xc2\xa8\xef1\xc0\x1fO$T5" +
-	"#H\x1c\x1e\xc3\xee\x1f\x06\xf8\xc9D\"\xd2\xf1\xbb0" +
-	"\xc5t\xbb`\x19\x16]M\xc79\xb3\xb5\x82e\xd0\x9a" +
-	"\xb6,\x82\xb5\xa8\xd5\x03\x95\x92NE\x92\x93n}\xf0" +
-	"5\x8e;G\xb7\xc3`Ad\xcd\xcaAZ\x13H*" +
-	"\x07i}`\xa8\x8a\x90\xd4\x1a\x06+\x07\xf9\x1cG\x9d" +
-	"Q*\xcc#1)\xcc\xe3\x96]+$P\xda$y" +
-	"<fU\x0f\xf1\"V\x1c\xd5N}\xaf\x9f\xbdJ\x12" +
-	"m#\xe9\xbbC4\xff\xc2\x94%2\x80]?\xd8\xb3" +
-	"\xe7w\xe7\xdcZ/4[%\xeb\xca\x0a.\xf7\xb4]" +
-	"\xd3p:\x84\x9b\x9f\xf5\x17\x8c\xab\x920\xa2\xd6'\xe1" +
-	"x\x94\x944\x96W'q\x1d\x01\xb8/Ih,7" +
-	"c\xf3M\x80\x0f&I\xd8\xa6?\x09\xf3\xa4\x17\xf0\xe1" +
-	"$\xa1\xb1\x1c\xc2\xf6}\x80\x8f&IPsk\x12\xb3" +
-	"\x1a\x00\xbf1I\xa2\x9a;\x92\xb8\xbe\x00\xbe3I\xc2" +
-	"\x9a7a?\xdb\x01\xd7\x00_\xac\x8a\xf5\x82\xe1\xf7\xde" +
-	"\x08\xf8l\x92\x84m\xca\x88\x97\x00\xdf\x97$\xd2xs" +
-	"I8_\xcf\x02~[\x92H\xe3\xdd\x92\xbc\x15\xe6?" +
-	"\xe0\x07\x93D\x1a\xefN\xbc\x9f\xdb\x00\xbf;I\xa4\xf1" +
-	"\xeeJ\xc2)\xee\x00\xe0\x87\x92D\x1a\xef\x1e|nw" +
-	"\x03~$I\xa4\xf1\xee\xc3\xfb9\x04\xf8\xd1$\x91\xc6" +
-	"\xbb?\x09\xeb\xc8a\xc0\x8f%\x894\xde\x03\xc9\x95\xb0" +
-	".\x00\xfep\x92H\xe3=\x84\xfd\x1c\x05\xfc\xd1$\x91" +
-	"\xc6{\x04\xef\xe7\x18\xe0\x8f'\x894\xdec\x88?\x0c" +
-	"\xf8\x93I\"\x8d\xf7\x04\xf6\xff(\xe0O'\x894\xde" +
-	"Sx?\x8f\x03~<I\xa4\xf1\x9eA\xfcI\xc0\x9f" +
-	"K\x12i\xbcg\x11\x7f\x1a\xf0\x13I\xa2\x8d\xf7<\xe2" +
-	"\xc7\x01\x7f1I\xb4\xf1^@\xfc9\xc0_N\x12m" +
-	"\xbc\x97\xf0w\x9d\x00\xfcT\x92h\xe3\x9d\xc4\xf6/\x02" +
-	"\xfej\x92h\xe3\xbd\x82\xf8\xcb\x80\xbf\x9e\x0cg\x15D" +
-	"\xac\x8a\x81\xf5,\x14\x1b\x0b\x84\xd2|Zv\xb2[\x87" +
-	"\x96F\xba\x02\x85$\x0a\x82\xb5x\xa1\x02\xf1\x92\xad\x17" +
-	"\x99=\xd7\xafd\xb5kt\x83\xfa\\\x0a\x96\xa91[" +
-	"\x9d\xeb\xc7?\x90/\xb6\xfa\xac\")\x8b0\xd9\xb8A" +
-	"\xaa*\x0d\xe6\xb8\x83\xc1\x92\xd5P\xf16)-%&" +
-	"W0\x96\xef\x97x\xf9\xd6H\xd9\xa6\xd7$\xd6\x9fb" +
-	"\x92\x19\xfc_\xa70<6S\xde`\x1a\xdea\x9cS" +
-	"\x9e\xdd\xe0\x14\x1c\x1c\x0d\x92\xee4\x1bp\x8b\xa6\x0b\x96" +
-	"\xe36s\x97`\x08\xf6\x18\xa9j~\xb3\x86l_Q" +
-	"F\xa4 \x14dFS\xbe\x02\xb0\xe0\xb7\x99l:\xc5" +
-	"t\xa3Af\xba\xd9lfz\xba\xdb\xb2\x8c\x86\xa9\xe9" +
-	"\xb55\xc9\xea\x16\xd4l\xb1\\\xfee\x16\xd44'N" +
-	"/\xd5de\xac\xb8&\x1f<o\x8e.\\\xa4\xe0\xe7" +
-	"9\xb3\x0bS#\xdc)\xa7\x0cQHG\xe4\x10F\xa4" +
-	"\xfa\xb0\xf73\x9f\x85\xd7u<\xa9n}\x91X\x13/" +
-	"\x00x\"\xa9n=E\xbce'w\x91\xaa\x8eJ\xee" +
-	"\xc4\xd2\xd7\x06H\x01G%qb\xe9\x9b\xed\xa4\x80\xc3" +
-	"S]{{@\xd6j,\xa0\xb4\xbd)r\x17\x96," +
-	"\\\x98[:p\xe2\xa3\x0f\xef\xf0^\xa4\xc3\xab\xb5\x11" +
-	"\xccr\xb1\"w\xa6P\x8f\xb2\xce\x8c*\x9d\xf3\x88(" +
-	"Ch\x8a\xb9\xbc\x98w\x99\xdbT\x06\x1d|@/\xf4" +
-	"2\x97\x05\xd4\x07HL\xb4;.\x11pG%$\xfa" +
-	"4y\xbaOuT\xa4*^&Y[/uW\xde" +
-	"\xc3[\x84\x08\xd8/\x8f\xf9\xf8\x0b\xe6\xdfea\xe3\"" +
-	"\x7f\x1ex\xb2\xe5\xbb\x9f\xbfv\xfa\xff\xae\xfcy\xbe\"" +
-	"/)\x1b\xdc\xf4_W?\xb3y\xe5?\xbe\xe55\x80" +
-	"=(@\xd3\xf8]>\xfa\xb5\x1f}\xfe/\xbf\x8a\x9d" +
-	"\xe0'\xeb\x1a\xa5\xff\x9e,\xbamQ\x8bn[\x94C" +
-	"\xa8\x8d\xf8\xdb\xea+\x12\xc43\xc7\x07,]5k\xbf" +
-	"\xdf\xa5\xd1/\xd8\x8by\x8fD\x89:R\x91p/\xe6" +
-	"\xfdX\xa7Tz\xf4b\xdeO\xb4\xcb\xf4\x7fA/A" +
-	"\xb6\xd7\xe0\x86\x12m\x8dH\xd6\x84\x10wl\x93\xe7\x92" +
-	"a\xe4\xcc\xe8\xe6I\xc3\x8d/\xa4t\xfd\xd1\xf5\x83w" +
-	"\xfc\xf6\xf3\xd3\xb1\x87\x808\xfd0\x93Mr\x98be" +
-	"\xb5\x8e\xaa\xf8\x19\xb0\x18S\x15\xd0\xd0\xc3\x9c\xb7\x90E" +
-	"C*4\x98|\x86^7\xab\x01\x86\xe1\xd7\xcdv\xca" +
-	"\xb6\xec8.t\xbb\x01y\xb4pdR\xc3\x13\xce[" +
-	"\xa1L\xa6\xf9\xa2(\x18h\"\x9c\xaaMs\xd3-\xdb" +
-	"Y\x9e\xafV9\x8c\xf4(\xb67x\xd6\xfe\x10/\x8e" +
-	"H'V\xd0\xa3\x98\xae\xeb\x93\x82\xf5~\xd8\xd6\x0bQ" +
-	"\xb9)\xb5s\x986\x17-\x97\xd7\xd9@k=\x99x" +
-	"9\xd2~\xd1]\x9c\x00\xf5(\x18\xcfJ\x1d\xfe}\xc6" +
-	"\x06u\xc7\xad\xc9\x99\x14\xc7s\xce\x0d\xa3\xbb<11" +
-	"\xcc\xb04\xa9\xb6\x9f1:uK\x8dJ\xddJT\x97" +
-	"\x8fF1\x98\xa4\xc7\xcb\x13\x13!\xde\x9f%JU\xcc" +
-	"\x09ZM0\xad\xb9*R/\xb4\x97\xf6\xf2jH\xd5" +
-	"KgD1\xf9\x00)p\xf1~\xd4\x9dc\x95\x02\x97" +
-	"c\xe4G=0\x1eE\xac?\"\x97\xdb\x7f\xed\x80\x9f" +
-	"@\xbb\x18\xac\xcdK\x166\xfe\xd9\xe8\xc3\xec\xe4y\x1f" +
-	"\xc7\xb6U\xaf\x1d\xca\x97\xe1R\xd8\xaa\xc4\x86\x1b\x906" +
-	"\x9c?E\x9f\xed\x94\x92V^\xb8\xe9\xf9nb\xd8y" +
-	"\x8e\xf2\x17:\x89\xcc\x95W\x0b\xf4R\x1b\xb1\xf6<\x1b" +
-	"\xeed\x1b\xd1\xbe\xf2l\xb8W\xda\xa4\x09\x88+R\xe8" +
-	"\x81\x85\x12\x81\xc3\xd6I(\x9c\xac\xce\x91\xa8\xb4\xf7\xaf" +
-	"\xbdM\xc81\x88\x93P\x13\x84\xeb;\xc9\xa8\xb9i\xa0" +
-	"\xb2\xd6\xcd\x86r\xf91iE\xd7\xaa\xe2:./\x82" +
-	"\x15\x19\x91\x83\x19\xab2\xb5Ow\xdc\x94%|d\x0d" +
-	"\xd49\xbbk\xa9sV\x13c\xb7l\xff\xc1W\xee\xb8" +
-	"\xfe?\xfe\xb7\xf8\xba\x85\x95\xa0R\xda[\xfd\x1b\x95\x99" +
-	"\x05f\\el\x05J\xca\xbc\xd5\xff\x1e\x00\xefN\xaa" +
-	"[\x8f\x10\x03\xe7\xbe\x0ei4\xc5!]\xffB\x81\xa7" +
-	"\xd6F1\x0dW\xa4\x89t\x19\x86U`\xaen\x09\x13" +
-	"o\xa3\xef\xb1\xbc\x17\xcb\x81\xefV\x93j\xfe\x08M\x18" +
-	"\xb9\x0f\x13+\x0e\x01~\x94&\x8c\xdc\x8f\xf8a\xc0\x8f" +
-	"\xd1\x84\x91\x07\x10?\x02\xf8\xc34a\xe4!\xc4\x8f\x02" +
-	"\xfe(M\x18y\x04\xf1c\x80?N\x13F\x1eC\xfc" +
-	"a\xc0\x9f\xa4\x09#O \xfe(\xe0O\xd3\x84\x91\xa7" +
-	"0\xe1\xe5I\xc0\x9f\xa3\x09#\xcf\"~\x1c\xf0\x17i" +
-	"\xc2\xc8\x0b\x88\x9f\x00\xfc\x94JB\x1c'\x11\x7f\x19\xf0" +
-	"\xd7U\x12\xe2x\x0dqYP\xe7\x858\xdeD\\\x16" +
-	"\xcey!\x8ew\x11\x7f\x07\xf0\x0f\x83\x95!\xf3\xe3\xcc" +
-	"\xe1y\xe9\xe6\xa9\\\xb3\xc0u\xaft\xf1\xe0u\x97t" +
-	"\xec\xe0u?\x99\x19p}\x83t\xf6\xe0u\x8ft\x17" +
-	"-0x\xe7y\xd7\x0ex>\x04\xc6\xaa\xb0^>[" +
-	"\x85uM\xeaUX\x7f\x80\x1aQ`7\xe8N\x15\xd6" +
-	"3\xc5\x9a\xda\x15\x85\xc4M\x8f\x95\xb6K\x0e\x8fc\x0e" +
-	"\xb7E\x99hmQ&Z\x1bU\xc5\x0fF\xce\xbf\xe8" +
-	"\xc1\xc9\x17\x9a0'\xac&\xd2t\xf6\x93E\xd9_\x82" +
-	"\x0e\x91M\xeb\x9e[\xe5\xc2\x82\xbb\xf64\x0f\xbb\xd4\"" +
-	"2\xe3\xb3\xae\x1e\xc16X[\x85\xa2qyx\xc5p" +
-	"\xe6\x9e\xf3\xa6\xe9\x8a1[wv\x13\xe2\xae\xda\x04\"" +
-	"\x8b\xe2&\x90\xe6\xec\xcd^\xdc%\xfaF\xa2\xca\xb3\x83" +
-	"\xe7\x1a\x0bS\xed\xf2\xae\x92\x0e\xa4\xe25v\xa6q\xa7" +
-	"l\xdb\xbc \x12E(\xd5B\xb0JL\xaa\x97\x8d\xd5" +
-	"\xa0Zh\xabA\xb5\xd0\x16Ho\xf4\xd6\xd0\x0b\x10\xf7" +
-	"\xd3\x1b\xbd,\xc9\x0bq\xa9\x91\xa2f^\x96dX\xd4" +
-	"\xcc+\xd6Y\x87K\xa8\xcc\xf5\xf3\xa8\x166`{\"" +
-	"^\xd6\xea\x89\x97\x05r\xfa\xbe\x04\xc9U08\xc3\x89" +
-	"\x086\xdf\xbb7\x1c\xb9\x0bs\x90TdB\x83\x8dj" +
-	")F\xd4N]\xe0\xc5\x92g\x0b\x0c\xea\xdehn\xa2" +
-	"\xe6\\J\xfd\xb7GI\xfdwS\x11\xc9\x8a1p\xd7" +
-	"\x00):\xaf}>L\x83\x15P\xf3\xb0h\xd9\xfad" +
-	"\xd8DhL]\xc5\xa7\xb9\x11J<\xa2\xf5\xe6\xc1\xed" +
-	"\xd4\x1b\xb2Oa\xe1\x96\xacC\xf7\x86\xec3\xd8^n" +
-	"\xb3\xde\x90}\x16qY\x9f\xee\x0d\xd9\xe7\xb1\x1f\xb9\xfd" +
-	"z\xdb\xfe\x0b\xd8\xfe9\xc0_\xa6\xdb\xfeK\x88\xcbm" +
-	"\xd9\xdb\xf6Ob?\xb2\xce\xdd\xdb\xf6_\xc1\xf6r\xbb" +
-	"\xf6\xb6\xfd\xd7\x10?\x05\xf8i\xba\xed\xbf\x81\xfd\xc8m" +
-	"\xdc\xdb\xf6\xc3u\xf1\xde\xb6\x1f\xae\x8b\xf7\xb6\xfdw\xb1" +
-	"\x1fY\x17\xefm\xfb\xefc{\x7f\xdb\xf73\x1b\xfe\x80" +
-	"\xf8{\x80\x7f\xacV\xef?\xd9\x12\xb3\x8b~\xb0}\x1e" +
-	"\xdd\xfc\xebX\xe8z<\xd0\xba#\xf8\xd7\x8eP\xeb\x8e" +
-	"`\xeb+\x82\x7f\xbd\"\xd4\xfa\x8a`\xeb\xf5\xc1\xbf\xae" +
-	"\x0f\xb5^\x1fl\xbd!\xf8\xd7\x0d\xa1\xd6\x1b\xc6\x9b\x8b" +
-	"\xad\xf4\x9by\x9e3\xb5\x88\xb2\xa6\xf6\x06y\xef\xc1\x9a" +
-	"\xdb&)\xc7\xd0\x197\xc2\x8b\x11\xf4\x80\xb1\xc9\x14w" +
-	"\x112\xc5\x0a\xd3\x92\xe7\x857\xab\x0f\xc8N\xd3\x1b\x8f" +
-	"'2\x9d\xb5\xbdDYr\x97+\xe3\xdee\xbb\xf4\xbe" +
-	"\xd1\x82e\xbd \x17\xd1h\xff_k\\\xfb \xa8\x9a" +
-	"O\xd8\xb1\xda\xe5!\xda'\xc7\x1a\x8f\xd2\x17\x1f\x88\xd2" +
-	"\x17o\xffb\xfa\xe2m\xa4F\xc1\xd3\x17\xffd\\\xd6" +
-	"(\x04\xe8?\x90P\xcf\xb5\xb8\xa9\xa4\\\x12`\x01\xd4" +
-	"\xe0\x13t\x09\x0e\xd86\xf5\x93\xcc\x91\x82\xb6`\x15K" +
-	"J\x8a9\xcd\xd0\x0fZ\x96{\xad\xe0Y@\xe5\xd4l" +
-	"\xc9K\xc6\x8d\xd8\xb5\x0e\xc9g\x1b8\x97z\xcf\x96\xaa" +
-	"6\xfa\xcf\xf6\xfeN\xe2\xcc\xf7\x9e-\x95m\x0c;\xbb" +
-	"\xa7\xb6\xf7\xfd~\xe5?}\xe5\xa8\xcf2\xfd\xe5\xd4=" +
-	"\xd6q\x0a\xa1n>)/\x8fv\x0cE\xf9\x85\xfc\x9f" +
-	"\xfe|\x1bu\x0cU~\xfa\x0bm\xd41\x94\x8cr\x0c" +
-	"U\x86\xd5\xc9n\xea\x18\xaa\x0c+*\x8a\x1e\xe9\x18\x0a" +
-	"y~\x9a\xaaBh^\x87\x1e\xc5\x88\xaf3{\xb2\x96" +
-	"w\x80\"Cd\x80\xd80\xde\x83\x0a\xf0Qz\x86\xcd" +
-	"]\xedQ^\x8eNi\xc3\xf8\x86\xcd\xbd\x9dr\x88-" +
-	"\xd8\xfe\xbcW\xaa\xd9\xab\xbf\xbc\x81Adga\x1d\xec" +
-	"G\x034Y@\xe5\xad'\x13\xbem\xf3f[;\xec" +
-	"\xe9m\xb0\xa7\xb7\xc9$\xac\xb7\xdbpK\x07\xf8\xbd6" +
-	"\x92\x84\xf5n\x1b\x9e\xd8\x01\xff\xb0\x8d$a\xfd\x01\xbb" +
-	"y\x0f\xf0\x8f\xdbH\x12\xd6Gm+\x15%\xff\x01\xe0" +
-	"\x9f\xb6\x91$\xacO\xb0\x9f\x8f\xdb\x92\xea\xc82\x92\x83" +
-	"\xf5G\xec\xe6Sh\xde\xb2\x8c\xe4`\xa9\xcb\x00\xff\x1c" +
-	"\xf0\xc5\xcb\x08\xb5\xc4\"\xc0G\x96%\xd5\xfc\xd9\xcb\x88" +
-	"\x92\xf0Y\xcbv)J~1\xe0\x19\xc0\xbfrV\x85" +
-	"H\x07\xbb9\x1b\xf0\xf3\x00\xff\xeaW\x84a\xb3\x1c\xf1" +
-	"%\x80\xaf\x02\xfc\xec\xaf\x0a\xc3\xe6\xfce`\xd4g\x00" +
-	"_\x0d\xf89g\x0b\xc3\xe6\x82e\xe3\x8a\x92_\x05\xf8" +
-	"%\x80\x7f\xed\x1ca\xd8\\\x8c\xdf\xbb\x06\xf0\xb5\x80\xa7" +
-	"\xbfV\x11\xdeZv+\x1c&\x00\xbf\x12\xf0%i\x91" +
-	"\x82\xb5\x01\xdb\xaf\x07|\x13\xe0K\x97\x88\x14\xac\xab\xb1" +
-	"\xfdF\xc0\xfb\x00o[*R\xb06c\xfb^\xc0\x87" +
-	"\x01_\xd6&R\xb0\x86\xb0\xfd \xe0\xdb\x01\xcf,\x13" +
-	")X\xdb\xb0\xfd(\xe0;\x01_\x9e\x11)X7a" +
-	"\xfb\x1b\x01\x9f\x02|\xc5r\x91\x82\xc5\xb1\xbd\x06x\x09" +
-	"\xf0sW\x88\x14\xac\"\xb67\x00\x9f\x05\xfc\xbcsE" +
-	"\x0aV\x19\xdb\xbb\x80\xef\x07\xfc\xfc\xf3*\xc2[\xd8~" +
-	"\x1f\xe0\x07\x00_y~Exk\x19\x8c\xaa\xfd\x80\x1f" +
-	"\x04\xfc\xdf\xad\xac\x08o-\x1bQ\x94\xfc\x01\xc0\x0f\x01" +
-	"\xbe\xea\xdf\x89\x14\xac{\xf0\xbd\x1c\x04\xfc0\xe0\x17\xac" +
-	"\x12)X\xf7b?w\x03~\x04\xf0\xaf_P\x11\xde" +
-	"Z\x06\xa3\xea0\xe0\xc7\x00\xcf~\xbd\"\xbc\x85\xed\x8f" +
-	"\x00\xfe0\xe0\xab\xb3Bx\xeb\xa1e\x98\xd2\x06\xf8\xa3" +
-	"\x80_\xb8Z\x08o=\xb2\x0c\x0ey\x0f\x03\xfe$\xe0" +
-	"\x17](\x84\xb7\x9eX\xb6\x17\x0c~\xc0\x8f\x03\xfe\x8d" +
-	"\x8b\x84\xf0\xd638\x1e\x9e\x06\xfc\x04\xe0k\xbe!\x84" +
-	"\xb7\x9eG\xfc9\xc0_\x06\xfc\xe25Bx\xeb%\xbc" +
-	"\xcf\x17\x01\x7f\x15\xf0o^,\x84\xb7^\xc1\xe7p\x0a" +
-	"\xf0\xd3\x80\x7f\xeb\x9bBx\xeb\x0d|\x0e\xaf\x02\xfe\x16" +
-	"\xe0\x97|K\x08o\xbd\x89\xfd\x9c\x06\xfc=\xc0/\xbd" +
-	"D\x08o\xbd\x8b\xfd\xbc\x03\xf8\x87\x80\xb7_*\x84\xb7" +
-	"\xfe\x80\xcf\xe1=\xc0?\x06\xfc\xdb\xedBx\xeb#|" +
-	"\x0e\x1f\x00\xfe)\xe0\x97\xa9Bx\xeb\x93e6\xcc\xd2" +
-	"eIu$\x93P\x97^\xfem\xa1\xbb\xf5G\x84?" +
-	"\x87\xe6\x8b\x01\xff\xceeBwkQ\x06~nK\x06" +
-	"\x8e\xf6\x80\x7f\xf7r\xa1\xbbuN\x06\xba_\x0cx\x06" +
-	"\xf0\xb5\xdf\x11\xba[K3\xc8\xae\x08\xf8y\x80\xaf\xfb" +
-	"\xae\xd0\xddZ\x9eAvE\xc0W\x01\xde\xb1V\xe8n" +
-	"\x9d\x9f\x81\xe1v\x1e\xe0k\x00\xbfb\x9d\xd0\xdd\xba\x10" +
-	"\xfb_\x05\xf8%\x80\xaf\xef\x10\xba[\x17#\xbe\x1a\xf0" +
-	"\xcb\x00\xdfp\x85\xd0\xdd\xba\x14\xfb\xb9\x04\xf0\xf5\x80\x7f" +
-	"o\xbd\xd0\xddZ\x87\xf8Z\xc07\x02\xfe\xfd\x0dBw" +
-	"\xeb*\xbc\xcf\xf5\x80o\x02\xfc\xca\xef\x09\xdd\xad\xab3" +
-	"\x98\xa9\x0ax\x1f\xe0W}_\xe8nm\xc6\xe7\xd0\x0b" +
-	"\xf80\xe0\x9dW\x0a\xdd\xad!\xc4\x07\x01\xdf\x0e\xf8\x9f" +
-	"]%t\xb7\xb6e`\xb8\x8d\x02\xbe\x13\xf0\x8d\x9dB" +
-	"w\xeb&\xbc\xff\xed\x80k\x80_\xfdgBw\x8ba" +
-	"?;\x017\x00\xff\xf3\x8dBwKG|\x0ap\x17" +
-	"\xf0\xdc\xd5Bwk\x0f\xe2%\xc0\xf7\x01\xbe\xe9\xcf\x85" +
-	"\xee\xd6\x1c\xe2\xb3\x80\xdf\x06xWN\xe8n\xdd\x82\xf8" +
-	"~\xc0\x0f\x02\xde\xbdI\xe8n\xdd\x89\xf8\x01\xc0\x0f\x01" +
-	"\xde\xd3%t\xb7\xeeA\xfcn\xc0\x8f\x00\xde\xdb-\x84" +
-	"\xb7\xeeC\xfc0\xe0\xc7\x00\xdf\xdc#\x84\xb7\x1e@\xfc" +
-	"(\xe0\x8f\x02~M\xaf\x10\xdez\x04\x9f\xf31\xc0\x1f" +
-	"\x07\xfc\xda\xcdBx\xeb\xb1\x0c\x0a?\x01\xfe4\xe0}" +
-	"\xd7\x08\xe1\xad\xa7\xf0\xf9<\x0e\xf8q\xc0\xfb\xaf\x15\xc2" +
-	"[\xcf\xe0\xf3|\x1a\xf0\x13\x80\x0f\xf4\x09\xe1\xad\xe7\xb1" +
-	"\xff\xe3\x80\xbf\x08\xf8_\xf4\x0b\xe1\xad\x17\xb0\x9f\xe7\x00" +
-	"\x7f\x19\xf0\xc1\x01!\xbc\xf5R\x06\xa6\xdd\x09\xc0O\x01" +
-	">\x94\x10\xc2['q|\xbe\x08\xf8\xab\x80o\xf9\x0b" +
-	"!\xbc\xf5\x0a\x8e\x87S\x80\x9f\x06\xfc\xbaA!\xbc\xf5" +
-	"\x06\xde\xff\xeb\x80\xbf\x03\xf8\xf0\x90\x10\xdez\x1b\xbf\xf7" +
-	"4\xe0\xef\x01\xbe5)\x84\xb7\xde\xc5\xef}\x0b\xf0\x0f" +
-	"\x00\x1f\xd9\"\x84\xb7\xde\xc7\xfe\xdf\x03\xfcc\xc0\xf3\xd7" +
-	"\x09\xe1\xad\x8f\xb0\x9f\x0f\x00\xff\x14\xf0\xd1a!\xbc\xf5" +
-	"\x09\xf6\xf3!\xe0\x9f\x03\xbem\xab\x10\xde\xfa\x0c\xfb\xf9" +
-	"\x14\xf0\x96\xe5\x09u\xe9\xf5#BxK]\xbeWQ" +
-	"F\x96\xc3n\x0a\xf0\x0dy\xa1\xbbu\xd6r\xb8\xfd\xc5" +
-	"\x80g\x00\xdf>*t\xb7\x96.\x87U~\x09\xe0\xab" +
-	"\x00\xdf\xb1M\xe8n\x9d\xbf\x1cIP\x01_\x03\xf8\xd8" +
-	"\xf5Bw\xeb\xc2\xe5\xf0\xf8W\x01~\x09\xe0?\xb8A" +
-	"\xe8n]\xbc\x1c\x1e\xe7j\xc0/\x03\xfc\xc6\xedBw" +
-	"\xebR\xc4\xd7\x00\xbe\x16\xf0\x9bv\x08\xdd\xad\xcb\xf1{" +
-	"/\x03\xfcJ\xc0\xff\xfd\x98\xd0\xdd\xda\x80\xfd\xaf\x05|" +
-	"#\xe0\xff\xe1\x07Bw\xeb*\xbc\xff+\x01\xef\x05|" +
-	"\xe7\x8dBw\xabk9\xf2\xfa\x00\xde\x078\xbbI\xe8" +
-	"nmF|\x13\xe0\x83\x80\x8f\xff{\xa1\xbb\xd5\x8f\xfd" +
-	"\xf4\x01>\x0ax\xe1?\x08\xdd\xad\xad\x88\x0f\x03~#" +
-	"\xe0\xdaN!\xbc\xb5\x03\xf1\xed\x80k\x80\xf3uBx" +
-	"\x8b!\xbe\x13p\x03\xf0\x09&\x84\xb7\xf4\xe5X\x98\x02" +
-	"\xb8\x0b\xf8\xa4&\x84\xb7\xf6,\x87\xd7h\x00>\x0b\xf8" +
-	"\x14\x17\xc2[\xe5\xe5\xb8\xfb\x02\xbe\x1fp}B\x08o" +
-	"\xdd\xbc\x1c\x96\xe7}\x80\x1f\x00|\xd7\xa4\x10\xde\xba\x1d" +
-	"\x7f\xd7~\xc0\x0f\x02\xbe{J\x08o\xdd\xb9\x1c\xa75" +
-	"\xe0\x87\x007t!\xbcu\x0f\xe2w\x03~\x04\xf0\xe2" +
-	".!\xbcu\x1f\xf6\x7f\x18\xf0c\x80\x9b\xbb\x85\xf0\xd6" +
-	"\x03\xf8\xde\x8f\x02\xfe(\xe0\x96!\x84\xb7\x1e\xc1\xfb|" +
-	"\x18\xf0'\x01/\x15\x85\xf0\xd6\x13\xd8\xff\xe3\x80\x1f\x07" +
-	"|\x8f)\x84\xb7\x9e\xc1\xfb|\x12\xf0\xe7\x00\xb7-!" +
-	"\xbc\xf5,\x8e\x87\xa7\x01?\x01\xb8S\x12\xc2[\xcfc" +
-	"?\xcf\x01\xfe2\xe0\xee\x9e\x8c\xea\xc2\xf4\xc5\xe7\xfc\"" +
-	"\xe0\xaf\x02^\xb63j\x19\xa6)\xb6?\x05\xf8i\xc0" +
-	"\xa7\x9d\x8c:\x0d\xd3\x14\xef\xffu\xc0\xdf\x01|\xc6\xcd" +
-	"\xa830M\x11\x7f\x0b\xf0\x0f\x00\x9f-g\xd4Y\x98" +
-	"\x8e\x88\xbf\x07\xf8\xc7\x80\xcfMg\xd49\x98\x8ex\xff" +
-	"\x1f\x00\xfe)\xe0{[2\xea^\x98\x8e\xcba\xf7\xfd" +
-	"\x10\xf0\xcf\x01\xff\xe1LF\xfd!LG|\xef\x9f\x02" +
-	"\xde\xb2\"\xa1.\xdd7\x9bQ\xf7\xc1t\\1\xa6(" +
-	"#+`:\x02|\xf3\\F\xbd\x19\xa6\xe3\x0a\x18\xfe" +
-	"\x8b\x01\xcf\x00\xfe\x97{3\xea_\xc2t\\\x01\xdd," +
-	"\x01|\x15\xe0\xf3?\xcc\xa8\xf30\x1dW\xe0t\x04|" +
-	"\x0d\xe0\xfb\xf7e\xd4\xfd0\x1d\xb1\xfdj\xc0/\x03\xfc" +
-	"\x96\x9b3\xea-0\xed\xb0\xfd%\x80\xaf\x07\xfc\xd6\xbf" +
-	"\xcc\xa8\xb7\xc2\xee\xb8\x02\x1e\xe7Z\xc07\x02\xfe\xa3\xf9" +
-	"\x8c\xfa#\x98^\xd8\xfeJ\xc0{\x01\xbfm\x7fF\xbd" +
-	"\x0d\xa6\x17\xf6\xbf\x09\xf0A\xc0o\xbf%\xa3\xde\x0e\xd3" +
-	"\x08\xdb\xf7\x01>\x0a\xf8\x1d\xb7f\xd4;`\x1aa\xff" +
-	"\xc3\x80\xdf\x08\xf8\x8f\x7f\x94Q\x7f\x0c\xd3\x08\xdbo\x07" +
-	"\\\x03\xfc\xc0m\x19\xf5\x00L#\xec\x7f'\xe0\x06\xe0" +
-	"w\xde\x9eQ\xef\x84i\x84\xf8\x14\xe0.\xe0?\xb9#" +
-	"\xa3\xfe\x04\xa6\xd1\x0a\xdc\x05\x01\xdf\x07\xf8_\xfd8\xa3" +
-	"\xfe\x15\xec\x82\xd8\xff,\xe0\xb7\x01~\xf0@F=\x08" +
-	"\xbb\xe0\x0a\x18\xb6\xfb\x01?\x08\xf8]wf\xd4\xbb`" +
-	"\xba`\xfb\x03\x80\x1f\x02\xfc\xaf\x7f\x92Q\xff\x1a\xa6\x0b" +
-	"\xb6\xbf\x1b\xf0#\x80\xff\xf4\xaf2\xeaOa\xba\xe0\xf7" +
-	"\x1e\x06\xfc\x18\xe0w\x1f\xcc\xa8w\xc3t\xc1\xf6G\x01" +
-	"\x7f\x14\xf0{\xee\xca\xa8\xf7\xc0t\xc1\xf6\x0f\x03\xfe$" +
-	"\xe0?\xfb\xeb\x8c\xfa3\x98.+\x90\xf5\x14\xf0\xe3\x80" +
-	"\xff\xcdO3\xea\xdf\xc0tY\x01\xd3\xe2I\xc0\x9f\x03" +
-	"\xfc\xd0\xdd\x19\xf5\x10L\x97\x15\xe8m\x06\xfc\x04\xe0\xf7" +
-	"\xde\x93Q\xef\x85\xe9\xb2\x02\xbd\xcd\x80\xbf\x08\xf8\xdf\xfe" +
-	",\xa3\xfe-\xec\x82\xd8\xff\x09\xc0O\x01\xfe\xf3\xbf\xc9" +
-	"\xa8?\x87\xdd\x0e\xfb\x7f\x11\xf0W\x01?|(\xa3\x1e" +
-	"\x86i\x84\xf7\x7f\x0a\xf0\xd3\x80\xdfwoF\xbd\x0f\xa6" +
-	"\x11~\xef\xab\x80\xbf\x05\xf8/\xfe6\xa3\xfe\x02\x8cU" +
-	"|\xbf\xa7\x01\x7f\x0f\xf0\xbf\xfbyF\xfd;\xd8\xed\xb0" +
-	"\x9fw\x00\xff\x10\xf0#\x873\xea\x110V\xf19|" +
-	"\x00\xf8\xa7\x80\xdf\x7f_F\xbd\x1f\xa6\x11\xde\xe7\xc7+" +
-	"\x92\xea\xc8\xb9\x09u\xe9/\x7f\x91Q\x7f\x09F)v" +
-	"\xf394_\x0c\xf8\xaf\xfe.\xa3\xfe\x0a\x8c\xd2sa" +
-	"\xba\xb4\x9c\x0bF)\xe0G\x8fd\xd4\xa3`\x94\x9e\xfb" +
-	" L\x17\xc0W\x01\xfe\xc0\xfd\x19\xf5\x01\x98.\xe7\xe2" +
-	"t\x01|\x0d\xe0\x0f\xfe2\xa3>\x08\xd3\xe5\\\xb0\xfd" +
-	"W\x03~\x19\xe0\x7f\xff\xab\x8c\xfa\xf70]\xb0\xfd%" +
-	"\x80\xaf\x07\xfc\xd8\xd1\x8cz\x0c\xa6\xcb\xb90\x0c\xd7\x02" +
-	"\xbe\x11\xf0\x87\x1e\xc8\xa8\x0f\xc1t9\x17I!\x00\xdf" +
-	"\x04\xf8?<\x98Q\xff\x01\x8c\xc9s\xe1\xf1l\x04\xbc" +
-	"\x0f\xf0_\xff}F\xfd5\xecF\xd8O/\xe0\xc3\x80" +
-	"?|,\xa3>\x0c\xc6$\xb6\x1f\x04|;\xe0\x8f<" +
-	"\x94Q\x1f\x01c\x12\xdb\x8f\x02\xbe\x13\xf0\xdf,\xca\xa8" +
-	"\xbf\x01c\xf2\\,c\x02\\\x03\xfc\xb7\xff\x90Q\x7f" +
-	"\x0b\xd3\x08\xf1\x1b\x01\x9f\x02\xfc\xd1_g\xd4G\xe1," +
-	"\x88\xf7\xb9\x13p\xe3\\Ax\xe8\xf8!'/\xe8\xb2" +
-	"XI\xa8\x8bC9\x18\x15,\x10\xb2\xa1:O\x11R" +
-	"KiF\xcb\xec\x18)\xddH3\x9d\xea\xbb\xd9\xae>" +
-	"\xc1\x0a\xae\x92\x9e0\xd8\xa4\xffM\x8c\xd4\x80\xc0\x85]" +
-	"S\xc2\xa9<is\xc7\xb5\x95\x94^\xa0\xec\x03\x180" +
-	"Z\xa7\x04K\xc3=X\x9d\xd6\x1d}\x9c\xd4\xc6x\x7f" +
-	"\xe9\x88\xfe@G\xcd\x0f\\\x11\xfd\x81+j~`}" +
-	"\xf4\x07\xd6\xd7\xfc\xc0\x86\xe8\x0fl\xa8\xf9\x81\xefE\x7f" +
-	"\xe0{U\x1f\x98g\xe5\xc9`\xcbj9\xac4\x9b\x09" +
-	"dXL:\xfa^\"\x8e\xc4\xb0k\x9a\xfd\x00mh" +
-	"\xa7\xd9q69cS\xe9>\xae\x15'\x99\x92,\xba" +
-	"a\xd0Vr\xac\xc0Y\xf5\x1f\xc6\x95\x94\xa5\xcdU7" +
-	"O\xd1\xe2\xcdqfk\xf0\xc5A\xa1@[\x9bfF" +
-	"\x99\xfe\xa0q\xcb\xda\x1d\xe0~rC\xdcO>&+" +
-	">\xfd\xbcxI\x1cnX\xb6?U\x846\x18\xf7\xb5" +
-	"\xc1|\xc1\x90Y\xd7fZqR\xc9\x06\xd8\xca$\x9e" +
-	"\"?6\x98\xe0\x94,\xd85\x04(*\x82bNH" +
-	"P,X\x1d\x96\xd58%He\xe5IMw\\$" +
-	"o\xe0\xf2\xbe5\xcbu\xaa5\xc8\x92\x9aMI\xbe\x0a" +
-	"\xbb\xdd\xb9RP M/\xec6\xf8\xb4\x92\xe2\x86\xac" +
-	"\x89\xe5\x06/j\xc5IWI\xd1\x11UA\x03\xaf;" +
-	"\x8b\xfae\xb2\xa0]\x84\xb1\x99\x92*\xba\xeb\"\xd1\x8e" +
-	"H\xf4\x8aHt}\x18-\xc2\xd8Y\x17\x89vD\xa2" +
-	"WD\xa2\xa4_\xabPv\xf8\xc4\x84\x92\xe2r\xc1I" +
-	"N\x90\x1f4mZ\x9am\x95h\xf2ZP\x99\xce\x7f" +
-	"\x82\xc9hr\\\x9b\xaa\xba\x05\xe2S9]\x9b\xd0\x8d" +
-	"\xa0\x1eC!T\xb1\x0dXh\"d\x0d}rJ\x06" +
-	"\xdb\x0d\xcb\xa6\xe4\x086\x9f\xb4\xad\xb2\xa2Rv\xdeI" +
-	"\xbd\x10)\xe7\x8a\x82tvmA:;\xa4^\xe7\xf2" +
-	"P\xa1\xf4\xc2\x14\xb7-g\xc2\xb2\xd5I^\xb44n" +
-	"(\xa4\xf5,nBJR\xce\xb4dQ\xce\x03\xf1X" +
-	"\xc9\xa5\x1d\x94\xf52a\x14\x1b\xaaes\xd8J\xfc]" +
-	"+Y\"2\xad\xb6U\xb0\x91\xb5A>\x1bQ\x12#" +
-	"\xafL2\xd7l^\xc0\xf2\x8b \xadb\x01\xa7s\x10" +
-	"\xdbS\xd5\xce\xe1\x86\xe1}\xd7W\x95\x84\xfa\xd5h\x85" +
-	"\xbet`E\xc5\x9e\x8b\x96\x16Z\xc3}8\xc8k\x96" +
-	"u\xa8r\xa9\x18W\xe1\xa1)\xb4\xfe\x9c)\x1d\xe6\xab" +
-	"\x16K\xe8\xcf\x0e\x0b\xfd9\x98\x97\xcc\xb5\xe0&-9" +
-	"aR\x86!\x9f\xe0\x04\x9b&\xe2\xb53\x9c\x0e\xbd\x85" +
-	"qn\xf2\x09\xdd\x9dPR\xd0Q@d/\xb4v\x0b" +
-	"\x09Y-D\xf5,P7H3\x86E\x1e\x13l:" +
-	"\xa0\xb1\xcb\\\xb7lr6\xae$eql\xd6\xb4J" +
-	"\x92j}\xbe\x8c\x0c\x1e\x92\x1a\x17\x85\xf7B\xab]@" +
-	"E6\x0c\xaa\x86+\xf6?\xcamP\xd8\xed\xe8{i" +
-	"\x17\xa6\xe5\xda\xcct&\x94$\x89\xef`\xc3\x10\xff\x0f" +
-	"\x8cN>1\xc1\x95$y}\x00\x86\x9f\x0d`0\xde" +
-	"\x94$Y\x81}P\x95;\xcd\x8ce\x9bU=\x02\x18" +
-	"\xee\x11\xb0\xaa\x1e}\x90\xf4\x88\xcb_\xe8\x19!V\xb5" +
-	"#\xf8(Y\xe5\x16\x9c\x82m\x19\x06\x9fP\xd2\x13\x81" +
-	"1\x8ap\xe8\xb5\x0a\xd0\xe0Jz\x9a\xf6\xeb\xc3\x01\x19" +
-	"cg\xba`\xd9v\xb9\x14\xd6\xcb\xf6&N\xaa\xc8f" +
-	"I~1w\\\xdd\xe5J\xb68A\xc6a\xaeT\xb6" +
-	"\xc9&:\xcf\xa7-\x9a\x97\x94\x85k\xa2B;m\x19" +
-	"\xd3\xba9\xa9\x04+\xb4r|\xda\xa2\xdf\xa59E\xdd" +
-	"\xd5'CB\xde\xf3S\x9c\x19\xd4\xd4\xc1Y\x0a6A" +
-	"\x80b3R\x8a\xd4\x97\xddLi\x84\x1a\x9d\x1bp\xaf" +
-	"\xccU\xb2\xb8\xe4\xd2\xc7\xa5\x97\xdc\x09\xd8\xfb\xb9\x1e\xd0" +
-	"\xd0\xe5\xa6\xc6\xc6U\x833\xdb\xb6f\xc8\xe0\xb2f\xb8" +
-	"\xedXe\xd5.\xf0\x02+\xb1B\x8a\x96\x9d\x83IU" +
-	"5\xa2|0Wp\x83\xea\x99\xcc\xd6\xaa\x86\x95\x0f\xca" +
-	"a5\xef\x94\xc7\x03\x1f\x9c\xe2\xbamXV1\x14Q" +
-	"-\xf00eVI/\xc0\xef\xa6\xed\x8a\x98\x99\xe7\x96" +
-	"i\x89\\@\xb4\x92\xb1@V\xf1d\x9e\x12\x975\x8c" +
-	"4_\xc3\x0a\xa8H\x94\x14\x9a-\xd1\xb5\xb6\xb2Nc" +
-	"\x8c\x16\xdbV\xc2\xcc/\x8c\xd0b\xdbJ\x98\xf9\xe4\x08" +
-	"-\xb6\xad\x84\x99_\x1b\xa1\xc5\xb6\x95r\xc17;h" +
-	"\xb1mE\x03\xed\xedv\x99:\x12\x9b\xb5%6\xe5I" +
-	"\x14?K\x80\xf1%\xc8\xa4\x12\x87\x8e\x9a\xcd`*\xfe" +
-	"{~\xdc\xba\xbfu%\x1c\x8b[\xe1X\xdcJr\xf2" +
-	"\x86Z;\x15%\xdf\x07\xf8h+\xc9\xc9\xdb\xda\x0a\xc7" +
-	"\xdcA\xc0\xb7\xb7R\xb6!\xc4\x87\x01\xbf\xb15\xa1\xaa" +
-	"\x1e\xd9\x10\xc2\xa3\x00\xefl%q\xeb\x9bZ1$\x03" +
-	"\xb8\xd6J\x02\xd7\x0c\xdb\xdf\x08\xf8T+\x09\\\xf3\xd6" +
-	"\x8b\xe0\x14\x0d\xb8\xd1J\x02\xd7:\xf6\xa3\x01^j%" +
-	"\x91\xebb+\x9c\xde\x0d\xc0g[I\xe4\xba\x8c\xedK" +
-	"\x80\xefk%\x91\xeb9\xc4]\xc0\xf7\xb7\x92\xc8\xf5\xcd" +
-	"\xad\x03\x8a\x92\xdf\x07\xf8\x81V\x12\xb9\xbe\xbdu\x97\xa2" +
-	"\xe4o\x03\xfc\xeeV\x12\xb9\xbe\xab\xb5MQ\xf2\x07\x00" +
-	"?\xd4J\"\xd7\xf7 ~\x10\xf0\xc3\xad$r}/" +
-	"\xde\xe7!\xc0\x8f\xb6\x92\xc8\xf5\xfd\xd8\xfe0\xe0\xc7Z" +
-	"I\xe4\xfa\x01|-G\x00\x7f\xb8\x95D\xae\x1fB\xfc" +
-	"(\xe0\x8f\xb6\x92\xc8\xf5#\xadX\xf1\x00\xf8\xe3\xad$" +
-	"r\xfd\x18\xb6\x7f\x18\xf0'[I\xe4\xfa\x09\xbc\x9f\xc7" +
-	"\x01?\xdeJ\"\xd7\xcf\xe0sx\x1a\xf0\x13\xad$r" +
-	"\xfd<>\xb7\xe3\x80\xbf\xd8J\"\xd7/`?'\x00" +
-	"?\xd5J\"\xd7'[\xc7\x14%\xff2\xe0\xaf\xb7\x92" +
-	"\xc8\xf5k\xd8\xcf)\xc0O\xb7\x92\xc8\xf5\x1b\xad\xe3\x8a" +
-	"\x92\x7f\x1d\xf0wZI\xe4\xfaml\x7f\x1a\xf0\xf7\x00" +
-	"\xff\xba*\"\xd7\xef\xe2\xefz\x0b\xf0\x0f\x00\xcf^ " +
-	"\"\xd7\xef\xe3\xf7\xbe\x07\xf8\xc7\x80\xaf\xfe\xba\x88\\\x7f" +
-	"\x84\xf7\xf9!\xe0\x9f\x03~aBD\xae?C\xfcS" +
-	"\xc0[R\x09u\xe9EY\x11\xb9VS\x03\x8a2\x92" +
-	"J\xaa\xf9\xb3\x01\xfe\xc6j\x11\xb8>+\x05\xc3\xb3\x05" +
-	"\xf0%\x80\xaf\xb9P\x04\xae\xcfI\xc1\xe3_\x0cx\x06" +
-	"\xf0\x8b\x93\"p\xbd4\x05\xb7\x7f6\xe0\xe7\x01\xfe\xcd" +
-	"\x8bD\xe0zy\x0a\x13(\x00_\x05\xf8\xb7\xbe!\x02" +
-	"\xd7\xe7#\x9e\x01|5\xe0\x97\xb4\x88\xc0\xf5\x05p;" +
-	"\xf9U\x80_\x02\xf8\xa5kD\xe0\xfa\xe2\x14\xdc\xfe\x1a" +
-	"\xc0\xd7\x02\xde~\xb1\x08\\_\x8e\xdf{\x09\xe0\xeb\x01" +
-	"\xff\xf67E\xe0z\x1d\xe2\x97\x01~%\xe0\x97}K" +
-	"\x04\xae7`?\xeb\x01\xdf\x04\xf8\xe5\x97\x88\xc8\xf5\xd5" +
-	"\x88o\x04\xbc\x0f\xf0\xef\\*\"\xd7\x9b\xf1\xf7n\x02" +
-	"|\x10\xf0\xef\xb6\x8b\xc8u\x7f\x0a\x99W\x01\x1f\x06|" +
-	"\xed\xb7E\xe4z\x08\xef\x7f\x10\xf0\xed\x80\xaf\xbbLD" +
-	"\xae\xb7\xa50\x92\x0b\xf8N\xc0;.\x17\x91\xeb\x9b\x10" +
-	"\xbf\x11\xf0)\xc0\xaf\xf8\x8e\x88\\s\xfc\xde\x9d\x80\x1b" +
-	"\x80\xaf\xff\xae\x88\\\xeb\xa9\xbd\x8a\x92\x9f\x02\xdc\x05|" +
-	"\xc3Z\x11\xb9\xde\x93\xb2a\x19\x00|\x1f\xe0\xdf['" +
-	"\"\xd7s\xf8\x1e]\xc0\xf7\x03\xfe\xfd\x0e\x11\xb9\xbe\x19" +
-	"\x9f\xcf,\xe0\xb7\x01~\xe5\x15\"r}\x0b\xe2\xfb\x00" +
-	"?\x00\xf8U\xebE\xe4\xfav\xc4\xf7\x03~0\x95P" +
-	"\x93\x93E\xe9Jc\xa8\x02\xe6\xbbzL\x92\x08I\x97" +
-	"\xf3`~i\xc0\xd1 N/\x95\xac\xad\x94)5\x9e" +
-	"\xb2\xba9\xad\xd7\xa5\x11\x0b\x92\xcb\x04Yd\x16&t" +
-	"0N\x8c\x80\x8d\xaaq\xc3e}\x9c\x05\xdd\x15\xa4\x90" +
-	"\x908<L\xbd\xc8\\\xdd\"\xa7TYf\x98\xc3\x8e" +
-	"v\x04/\xb7S\xcb\x8c\xf6/\xfe<Vw\xc7\x8cP" +
-	"\xd8\xcf\xea\x0e\xd5\xd3\x8a\xd8?\xa3i\xd4\x82\xd5\xfe\xa2" +
-	"`.\xef*)\xe6\xd6\xd8xs\x0e\xca\xd6\xcaC\x0c" +
-	"w\xaf\x9b1\xb9\x1d\x90w\xc4\x13\x92\xcdLE%\xd5" +
-	"({\xcaz\xa9\xc8Q\x8cq\xc9\xc2Z\xf5\xf6\x9b\x7f" +
-	"\xf8\xcfk\xdf\xf1\xaaUm\x94\xcd\xe6\x1a\xd10M\xb1" +
-	"\x09Y8\x8f=\x92D\xee\xa0\xa0\x1c<\x8ch\xf27" +
-	"\x03%i\x82%\x103\xcc\xd8\x0d_\xa6\xa8\x9a\xf7]" +
-	"Y\xdd\x19\x96'\xb5\xa0\xff\"\x92\xa7.\xc2h\x99\x9f" +
-	"0\xe6\xa8\xa6OX\x9f|\xdc\xd2\xe6\xc2\xe7 |(" +
-	"=S<p\xfc-Ze\xd3\xed\x09\xbe\xa8p&\xfb" +
-	"\xc2\xb8UF\x07\x84\x92\x1ba\x9a^v\xfc\xdcW\xff" +
-	"9g\xddQ\xe2\xf9N\x19\x13R\xa2\xa5\xc0\x0dc{" +
-	"\xe0jG\xe0j\xac\x89\x12\xd9k\x87\xae\xb5\\\xeb\xff" +
-	"\xef%\xc45s\x8a{l6Q\xe1\xbd\x8d\xa1\xb3\x1b" +
-	"\xa5\xab\x11\xbf\xe0vPw\xdc&\xb3\xd5%\xbfI\x8d" +
-	"t\xf5\x9ae31\xb9K1Q\x96r\x976\x91\xf0" +
-	"/o\xaeS\xde\\N0LE\x92\xf1\xe6X\x91\x16" +
-	"3\xc7\x90\xdd\x80\xf3:\xe1\xfdn\xc4\xd8\xd4A57" +
-	"\xe0\xb3dI\x8a\x96\x00\xa8=q\xf2\xdc\xd4\xba\xba\xe0" +
-	"[\xb7\xfbg\x92\x1d\x89\x95\x01\x86R\xefLr\x132" +
-	"m\xde\x08\xf8\x14e@\xe5\xc8\x80\xaa\x01^\xa2\x0c\xa8" +
-	"Ed\xe0\x94L\xa4^\x9dP9\xd1\x1d`\"\xf5\xea" +
-	"\x84\xe6\xb0\x7f\xc98\xea\xd5\x09\xdd\x82\x94\x9d\x92q\xd4" +
-	"\xab\x13\x0a3\x8ezuBw&v\x05\x98E\xbd:" +
-	"\xa1{\x12\x98\x08\x02\xf8\x11\xca\x80z_\xe2V0\xf6" +
-	"\x01?F\x19P\x1f\xc0\xef\x95\xcc\xa2^\x9d\xd0Cx" +
-	"\xffG\x01\x7f\x942\xa0>\x82\xf7\xefS\x8b\xfauB" +
-	"O\xe0\xfd<\x0e\xf8q\xca\x80\xfa\x0c\xf6\xf3$\xe0\xcf" +
-	"Q\x06\xd4g\xf1\xb9\x1d\x07\xfcE\xca\x80\xfa\x022\xc7" +
-	">\x07\xf8\xcb\x94\x01\xf5%\xc4O\x00~\x8a2\xa0\x9e" +
-	"D\xe6\xd5\x17\x01\x7f\x15\xf0L\x85\x02\xf5\x15\xc4_\x06" +
-	"\xfc\xf5DBU\x97\x8b3\xc9kx\xfb\xaf\x02\xfca" +
-	"\"H0<e\xb9\xbb\xf9\\^\x87\xcd\xaa\x0aK\x92" +
-	"\xa0\x02\xee\x88\xf9@\xb1\xfb\xbc\xc6\x9dB\x9e\xd6\xf5\x19" +
-	"\xccqF\x03\xde\xb4\x00oU\xca\xe1{d\xe3\xb2m" +
-	"s\xd3\x1dT\xd2A\x1b\xc1\xe66\xdf\x93\xdf\xad\xa4h" +
-	"\x8cI\xa0C\xbaj\xa2WS\xba(\xeb\x8a\x9d\x0b7" +
-	"T\xf0\x86\x046\xc2'\x94\xb4\xcd\x9d\xa9Z1\xb1\x85" +
-	"\"\x9b\x1d\x0c\xf9\xc0s\xb0\xdb\xcb\x19\x993\xf9,\xb9" +
-	"\xcc\xc2/\xed\x88\x16\xe3]\x10\"\xf6:\x87=\xde/" +
-	"\xbe\xb8r\xe3\xeb\x1f\xfd\xe7\xd3\x87\xef\x8d\xcd\xa12x" +
-	"\x0d\x967\xe1\x7f\\kr2i\xf0p\xe9jw@" +
-	"\x80\xc3\xaf\\\xed\x0e\x88\x87{\xb9\xf2KQ\xef\x8dT" +
-	"\xae&\xbc\xca\xd5\xee`\xe5j\xd2\xab\\\xed\x0e\x08s" +
-	"x>\x87\x0b\x11_\x05\xf8%T\x861,_\xe1\xf9" +
-	"\x1c.\xc7RTY\xd2\xea\xf9\x1c\xc2%\xadg\xa9b" +
-	"z\x87\xf4\xf0\xe6\x0b(\xb4&K\xf1\xe1\x9a\x13\xcd\xb6" +
-	"\x09\xdb*\x0e\x86\\r\xae5H\xc7Y\xf8}\xcf3" +
-	"\x16P\x1c\xc7\x12\xa1\x8a\xf06a\xa6\x9e\x9c4\x08\xe5" +
-	"\xe4lI\xb7\xb9s\x86lKy\x97\x15u\x93)\xcd" +
-	"V\xc7MX\x96\x1cs3\xccm\xa6\xfc\xa6Ww\x0a" +
-	"z\xc9\xd0S&w\xea\x90\xfd\xe0V\xe3\xd4\"\x09i" +
-	"D\x84\xb5[H\x9a\xd9\xb1~Yg\x8d\xba\xbfP\xe1" +
-	"]\x0c\x0e\xa3R\xc9\x98\x1b\xb6\xf4\x94c\x99q\xc4\xf7" +
-	"\xbae9\xf9\x82nNs\xd3\xb5l%;G\x9d\xa7" +
-	"\xcd\x97\xed\xa0I2\xc2\xf7\xa4\xe3\x8ag\x8e\xc8\xbb\x98" +
-	"w\xad!k\x9c\x98\xe00\x8a\x01\xa1Ks,\x86\xc8" +
-	".M\x1b\xca\"\xf1Om\xe6\x85\xfa\x14\xf5\x1dQ$" +
-	"K\xb6$YBI\xe3k\xb9YM'\xd9Xnq" +
-	"Aw\x903iP\xc9rji\xc5\xa7s\xcb\x19\xee" +
-	"\xbfI\xe1dK}\xf9\xbc\x11\x9e\x82!\x18\xa4\xdc\x1b" +
-	"#\x05y\xde]\xd1\x82<\xdf\x06}h/a\xd7\xf3" +
-	"\x9c\xe8TH\xdfw\xa2?\xd5]!Z<.)\xf7" +
-	"\x9e\x19\x93\x87\x9fX\x0a{\x05\xabX\xb2Ln*9" +
-	"7\xb8\xeeE\x1c\x11\xe6][\x9f\xa6A\x18\xd1\xa6\x86" +
-	"\xc8`\xcd\xa7\xb4\xd9\xe7\x8b\xa8sB\xa9G*Q{" +
-	"`\x18lnh\xf8\x8az\xfdN\xe8\x06oZ\xd1\x15" +
-	"\xd6\xb1\xcd\xb3\xa5mY<_\x84&\xd3/\xa2d\xeb" +
-	"~\x17[\xb6\xee7\xb24oa\x12\xe7\x03gjE" +
-	"P~sRR\x13W\xfdq8\x8b\x16P@M\x1b" +
-	"nT\xad|vVf>\x84\xff6l\xa5\x03\x1fm" +
-	",ik\xd9\x86v\xdd\xf8\xae\x14/\xb8ae\xaa\xbd" +
-	"\xde\xd6\xbe\x89X\x1aa\xe1(\xcf\xd2\xe8B\\*\xd4" +
-	"z\x96\xc6f\x14\x94\x92\xc2Q>5zH8\xca\xa7" +
-	"FG\xee\x0c)\x1c\xe5Y\x1a\xdb\x10\x97\xca\xb5\x9e\xa5" +
-	"\xb1\x03q\xa9\\\xebQ\xa3\xdf\x84$\x19\xbeB\xadO" +
-	"\x8d\xce\x11\xd7\x00/\xa9\x84\x1a\xbd\x88\xf73\x05\xb8\xab" +
-	"&\x84h)\xd7\x06u%\xe7\xb8]\x9af\x87\xf6\xca" +
-	"\x9cf[%b)\xc6R\xa1\x0aW\x8d\xcaz\xd2\xa8" +
-	"j\xd3\xca,\x0fM\xc2\xa8\xa9_\xe5\xdb\x89z\xed3" +
-	"\xf0\xa6\xe5D\xe56\xbczU\x8bAM6\x10E\xe6" +
-	"?&\x95\x82\x82ki\x84\xd8\xc0\x82\xcd\xdd\xb2m\xf6" +
-	"Y\xf8Kb\x1f\xff\xc5r\xd2Uq\x8d\x9au\x18\x91" +
-	"#w\xdb\xf0C\xa1>\xd6\xf8l\x03\xb6U6\xb5|" +
-	")\xcdfL\xa7\xae\xce\x0e\x9b1\xa5\xe1\xff\xec\xea\xbe" +
-	"\xf7\xff\xb7\x1f?q \xb6\xe1\x1f\xf0\xf2|A\xf1{" +
-	"[\xea,\xd4'\xdf](\xd9\x96V.pM\xc9\xf6" +
-	"\x07\xab\xc6\xbb\xb3\x1f\xfc\x8f\xdf\xbc\x83\xdd\x17\x9b\xb3\x0d" +
-	"\xb7k\xdb*\xe5\xb8\xed\xeaU\xa6'\xe5\xbclZ\xed" +
-	"\xa5\xc2\xd9\xd44!v\x85GI\x9e#\xf5fts" +
-	"\x08\xe7\xeb\x08/Z\xd3j\xd8\xbd3\x12\xa1\xdd\xdbI" +
-	"LNt8\xf3=\xf4\xdc\xedIZ\xc4?A\x94x" +
-	"Ag\xc6P\x8a;\x931\x98'\xbb)\xf5Me4" +
-	"\xdcn\x93\xb2q\xcfAzW\x07)\x1b\xf7\x1c\xa4\xf7" +
-	"t\xcb\xb2\xf1\xdc\x94\xb0\xdc|\x0aWgr\x94\xa6;" +
-	"\x08\xdb=_R\xb2Az\x14\x87\xcd\xd1O\xc5\xa07" +
-	"\xad\xa7\xd8\x11~\xe5{\xeb\xc8%\x0f&\x08\x01m\xce" +
-	"\x0e\x9a<\xfe\x1f\x926\x19\xe4\xdb\x7f\x7f\xf5\xbf\x0c\xac" +
-	"\xfc\xe5\xefc\x0fr\xb0z\x9d~sB\xb5BR\x9b" +
-	"+\xa3\xa46\xbb%\x8d\x85g\x99~\x02\xe3\xf3\xc3\xa4" +
-	"\xba\xf5s\xf22>\x83\x91\xf4iR\xcd\xb7\xa8\xf2m" +
-	",Sa\xdf\x1c\x81\xed\xe8l*\xf8~\x96:\x1e8" +
-	"\xe8\xb7&\xc4\xf6\xb8\x14\xb75y\xd0O%\xc5\xf6x" +
-	">\xb6'J\x9b-\x9e\xd2\xe6\x18\xe5\xa2\x0a\xd0\xde\xe9" +
-	"n\x9195(\x86\xa3\xe4\xaa\x0a\x15\xdaB%h{" +
-	"\xa0\x1bfPw\x94\x94K\xcf<\xba\xed\xb8\xa3\xba\x8b" +
-	"inR\x8c\xb3`\x99\xda\xa8\xae\xa4HPf\xc1\x9d" +
-	"\xd2m-\xdc4\xa6\xa9\xee\xe4\xb3\xc82\x1f\x9a5c" +
-	"Q\xc4\x0a\x9dQ\xf4\x91cQ\xc4\x0ac\x92CA\x8d" +
-	"`\x8f\x8ce\x97\x17u\x13\xadm%I\xec\xed\"\x9b" +
-	"\xad\x02\xb3{\xca\xdc\x9e\x8b\xa7\x07\xaf\x17KV\xd2v" +
-	"\xc3*\xbf5\x1f\xd2\x96\xe1\x9e^\xe6\xb20q\xebJ" +
-	"r\xee\xa8<\x9dg\xdaI$\xc6[T\x9e\xed \xa4" +
-	"\x1d\xde\xf1\xf1\xf9v\x12\x8a\xf1\xf89^\xe8 \xa1\x18" +
-	"\x8f\x9f\xe3\xa5\x95$\x14\xe3\xf1s\x9c\xec\x94\xa1\x98d" +
-	"\x0dB\xf0:\xb2\xfeYt\xf7Dd'\x87\xa3hq" +
-	"8\x96\xc7,\x93o\x19\xeeq\xbe\xf0V\xdc.U\xf4" +
-	"\xebo\xc5i\xb3T K\xd3?\xfd\x97\x97\xafv\x8f" +
-	"=\xf8\x8f\xe1\xa5\xa9\xb5\xa16b/w\x99n85" +
-	"\xd4\x1b\xbep\xa8h\x94\xcf\xbae\x9b\x0f\xe7l\x0b\x0e" +
-	"\\\xa1u\xb0=j\x1d\xec\x88\x92\x1c\x0e,\x84\x95\xe9" +
-	"\xf5Y\x07\xd1!\xf6\xce\xc2\x7f\xec\xa0\xab\xa3\x17nP" +
-	"\xd5v*O\xecE\x1b\x16\x01,\x17M/\xd8\x10r" +
-	"\x9a\xfa\xc1\x86s\xd0;\xea\xf3\xfd\xa5a\xd3\x93\xe3i" +
-	"\x8aK\xbfv\x9a\xd9E\xf9\xe6fl]\xfe);\xc5" +
-	"LM\xba\x08\x0d>)/&8\xc9\xde\xad\x08\xe0T" +
-	"\x09\xdf($\x0f\xa0\xb1E0\xc3J\xf9\x127T#" +
-	"\x8e\xae\x0a\xb5G&l\xab\x98\xaf\x12\xe2k.\x95\xb0" +
-	"\xab<Y\xe4\xa6\xdb\x9f\xaa\x96\x7f\x8dt\xc1\x8d\x13]" +
-	"\x97\x82e\xbaL7\xb9\x92\xb5\xf3A\xe1U\xec3\xaf" +
-	"\xa4\x9a\xba\x15\xe1\x81t\xa6\xd2zI\xc4\xc0\xa2\x09\x8b" +
-	"#M\xc3,\x9e\xb8\xab)\xaf\xd3p\x9aV\x97,l" +
-	"\xbdb\xe2\xe0\xf3\x97\x8d\xfdOa&\xec\xda\xd9\x81`" +
-	"\xb8\x14\xe3H\x85vD\x05'\x89j\xfa\xbc5cr" +
-	"\x9b\x12\xceq\xb2{\x86\xfd\xe2q\xb5\xf9a=C\x16" +
-	"\xceZ\x04\xf0\x99\x84\x9a\x13\x96\x98\xbad\xe1\x7f\xb9\xe6" +
-	"\xde\xff\xfc\x83\xff\xf5\x9f_\xafG\x04\x1ei\xb6\xe5\xec" +
-	"\xbc[q\xa3\x1c\xf2\xfd\x08\x7fD\xad\xadO\x930\x85" +
-	"[\xa4\x1fAmA:\x1d\x80\x17\xb7\x10?\xc2\xa2\x96" +
-	"\x01\x98\xab-0W[\xa4\x95\xb4\xec\x9c\x16\x9c\xab\x80" +
-	"\x9f\xd7B\xec\xa4\xe5\xd8\xcf\x12\xc0W\xb5\x10C\xe9\xfc" +
-	"\x96\x0eE\xc9g\x00_\xdd\"\x83\xfb\xcb.hA\xe9" +
-	"q\xc0\xd7\xb4\x10\xb2\xcd\x0b\xb1\x9fU\x80_\x02\xf8\xe2" +
-	"V\xb1F\\\x8c\xfd\xac\x06\xfc\xb2\x16\xe2G\xb8\xb4\xe5" +
-	"\"E\xc9\xaf\x01|m\x0b\xf1#\\\x8e\xfd_\x02\xf8" +
-	"\xfa\x16\"\xb1\xb6\xae\x05\x89=\x00\xdf\xd8B$\xd6\xae" +
-	"jiS\x94\xfcz\xc07\xb5\x10\x89\xb5\xab\x11\xbf\x12" +
-	"\xf0\xde\x16\"\xb1\xd6\x85\xf8F\xc0\xfbZ\x88\xc4\xda\xe6" +
-	"\x16L\x1f\x03|\xb0\x85H\xac\xf5\xe3\xfd\xf7\x02>\xdc" +
-	"B$\xd6\x86\x10\xef\x03|\xb4\x85H\xacm\xc5~\x06" +
-	"\x01\xdf\xdeB$\xd6\xb6!>\x0c\xf8\x8d-Dbm" +
-	"\x07\xbe\x97\xed\x80k-Db\x8d\xe1\xef\xdd\x09\xb8\xd1" +
-	"B$\xd6\xf4\x16\x18\x0e\x1a\xe0\xa5\x16\"\xb1V\xc4\xe7" +
-	"9\x05\xb8\xdbB$\xd6\xf6 n\x00>\xdbB$\xd6" +
-	"\xca\x88\x97\x00\xdf\xd7B$\xd6\xe6\x10w\x01\xdf\xdfB" +
-	"$\xd6nF|\x16\xf0\xdbZ\x88\xc4\xda-\xf8\x1c\xf6" +
-	"\x01~\xa0\x85H\xac\xdd\x8e\xed\xf7\x03~\x10\xf0\xaf\xaf" +
-	"\x12Y\x92w\"~\x1b\xe0w\xb7\x90,\xc9\xbbp\xdc" +
-	"\x1e\x04\xfcp\x0b\xc9\x92\xbc\x17\xc7\xd5\xdd\x80\x1f\x01\xfc" +
-	"\xc2\xac\xc8\x92\xbc\x0f\xc7\xc9!\xc0\x8f\x02~\xd1j\x91" +
-	"%y?\xe2\x87\x01?\x06\xf87\x12\"M\xf2\x81\x96" +
-	"\xbd\x8a\x92?\x0a\xf8q\xc0\xd7$E\x9a\xe43\xd8\xfe" +
-	"I\xc0O\xb5\xd4\xb6\x9c\"\xf3\x98\x1a*\xc5\x05R\xe7" +
-	"\xea\x0a\xc1\x05\x0c3*\xbc\x16\xcf3\xd6\x1c\xdbZ\xbd" +
-	"L\xbc\xb0~[X\xdfM\x16\x7f\x91\x88q\x91\xcdn" +
-	"\x068xS\x7fZ\xe9\xb7/\xcc\xbfV\x89p\xe5\xe6" +
-	"\xfa\xeb\x88\xf2\xe50\xe9\xa4\xf9\xc8_\xaf\xce\x0ck\xb2" +
-	"\xcc\xfbt\xc7\xb5\xec9\xa9\xa5I6\xbcq\xb2\xb7\xf9" +
-	"J\xa0{%\xd5\xa6\xbf\xe1m\x03ceT\x88\x1d," +
-	"\x98\xa5\x02v\xae\xa4&\xcbr\xb8\x8a\xfdekY\xc9" +
-	"q\x07\xebP\xfcc\x903\x8c\x7f\x82\xc7t\x06\xfaj" +
-	"\xd7\xf3\x82k\xd9W|9*N\xf59$c\x980" +
-	"\xd3\xbcF\xeeP\xa4\x05s\x11\xb1`j\xce\xb7\xc8W" +
-	"I}\xbf7\xf0\xf1~Swu\xe6\xf2\x1e\xcb4y" +
-	"\x01\xeb|\xeah\xd9\x19\xd6\xa4n\xaa\xaa\x92P\xd5&" +
-	"\xf9\xbf\xfb\xcd\x09K9cj\xc31\xe9\x8e\xf2\xa4\x92" +
-	"(\x89\xa1\xaf\xefF#p\x0b\x05T8\x0a\x1ej\x98" +
-	"\xe3\xe8\x93&\x0f`E6\x8b\xe3\x08=B~\xe6\xec" +
-	"\xde-\xd5\xec\xaf5\x82`\xb5\xbd\xd6\xb3%\xae\xe9\xf0" +
-	"L\xfb\xcdi\xdd\xad\xe6V\x85\x1f{*\xa9n=-" +
-	"\x0f\x8fo\x8c\x93\x02\x19\xef\xf0\xf8\xf6^B\xa3\xea9" +
-	"\x8b\xde\x1f G,\xcfs\xf7\xd1\x009My\xa9\x8d" +
-	"\x9fu\x8b\xd3\x948\xf2x~\xa2s0aCR\x9c" +
-	"{~\xa2\xe5\x18\xa6\xf1\x13B\"\x9f\xa3\x8e\xbf\xc7\xde" +
-	"\x12\x14\x91\xe1\x95\xdf\xab\xe4,3\xf0\xf8\x9c\x19V*" +
-	"\xe9f\xa0B\x0c\xb0\xd0.4_b\x1a.\xee\xa1%" +
-	"I\xdb\x8bC(p\x07\xda^\xc1!\xa9\xa4\x0b\xbc_" +
-	"k\x86ls\x9a\xf7X\xba\xa9\xc4\x1a\x8d\x91\xfe\xa0\x91" +
-	"(\x7f\xd0H\x94\x9cH'q\x08\xc58t\x15,]" +
-	"T7\x92\xfcl\x89u4\xcaLL\xd62\xca\xd3\xa3" +
-	"\xba\xe9\x86\"\x9a\xedQ\xc2\x0c\x1d\xb1\xb5\xb3\xbae\xd2" +
-	"@z\x9c\x96\xf5N\xda\\VQ\xcf\xdb\\\xd3\xd8\x9c" +
-	"L\xbc);\x9c&\x127#\xb97\xc2\x9d\xb2QW" +
-	"\xc6\xa0\xea|\xd7\x9bP\xd3\x1a=\xba\x97\x98;Uk" +
-	"\xbfk\x8d\xb1\x8a\xf5LY\x96\xc3+G\xa8\xa4\xd8\xf5" +
-	"\x1a\x0d\xa1]Q\x0bZ\xa7\x1cB\xde\x82v\xcfx\xa3" +
-	"\x05-\xfe4\xc8i{\x03>z\x8d\x8bRJ%E" +
-	"\xf7\xcf\xba\x8bZm\xb7\x03wQV\xd2\xdb\xaa\xc8V" +
-	"1N\\J&\x9f\xd1\x98\xcbl\x91\xfe\x1f{#\xbc" +
-	"\x813w\xaa\x92ND6\xe5\x8b\xce$\xf585-" +
-	"=\xa8\x81\xd4\xc14M\xad\x8f\x11\xd0\xaa\xd8S\"a" +
-	"(\x8eX^\xe4\x9d\x0d\x84\xc5\xf2\xa2\xd2\xa2\x17\x9c\xf2" +
-	"x\xbf\xa9\x05\x15]\x1a\x10\xea\xf6r\x83\xbb\xbc\x1fK" +
-	"I\xe3\xa4a\x11\xe3A|wBI\xa8\x09EM\x8d" +
-	"\xb3I\xef\xdf\xb1R\xdalw\xd4\xd6\xd3\xe3e7\xec" +
-	"\x14\x1d\x882\xffnmd\xfeEm4\xb5\x15P\xa2" +
-	"\x88\x8bcPR\xf7r\xa3\xc7\xb2S\x15\xa2e\xf2\xa8" +
-	"\xc6\x1ay\xcd\x84\x90\x85\x19\x14\xb2\x08\xe6\xff\xc7\xc8\xd4" +
-	"\xee\xea\xf22\xeb\x1b\xc8j\xad\xac%\xab\x95dLz" +
-	"\x87\xff\xd3\xcfO\xe8\xe7\xfc\xe6\xe4\xff\x1e;p\xb5\xd9" +
-	"\x9c\xeeeE6\x99\x14\x02vdGX\x19\xb5#t" +
-	"\xc6\xde\x11\x06\xa4\x9a\"9y\x86\x18`\xe6\xb5b\x90" +
-	"\xb7\xa7`\xe1\xf2\xe56\xf5\x16{,\xd3\xd150\xfc" +
-	"\xe3\x94\x8a\x0cDU1w\x93X\x84\xf7\x13^\xea " +
-	"\xb1\x08o\x0f?\xd9A\xcaB\xbc*\xe6W:H\xbd" +
-	"\xb3W\xc5\xfcZ\xa7\xb4\xe6\"\xe5\xc2D\xa44\x98U" +
-	"=\x11TB\x09\x1a\xf3\xf5\x0e\xb7\xb9\xd2t\xa9\x10!" +
-	"\xa1R?]\xb1\xc7\x13\x8a\xfd\xb2+'\xe8\x1aV\xcb" +
-	">Y\xd4H#\xa1\xa2z\x16\xab\xb4\xa3\xb3~\xb2_" +
-	"Ha&g\x96\xa9\xe8rt\xca_c-\xa5\xe1\xb2" +
-	"]\x98b\x8e\xcak\x0bZ\xfaw\xa8w\xd0d\xcbD" +
-	"\x94\xa2e2B\xd1\xd2\xb1\x8a\xfc\x1a\x83QS9(" +
-	">\xe3\x09\xfeyOw\x9a\xd9z\xb8\xdc\xb0\xf1:\xa8" +
-	"O\x93\x0d\xa3\xd1/\x19'7\xed\xfd\x92=c$\xfb" +
-	"\xcd\xfb%s\xb6LEXp\xad\xcda%<\xc0\xf6" +
-	"\x94\xf5R\xc8\xc3\x0f\xa61\xb4U\x92\x84\xad\x01\xc1=" +
-	"e]\xc9\x96\"#\x13\x8b\xeanL=\xc8k\xd5\x94" +
-	"0\xea\x0d||\x10\xce\xb7\xd9\x11^\x9d\xdayQ\x84" +
-	"Z\xf6\x18\xcd\xecT\xa32;+\xe1\xd0\xc7\xda\xa5\x90" +
-	"\xa7o\xda=\xd1M\xd2=\x17%\xc4\xc2\xf2\xd4\xadr" +
-	"\x05K\xed\xe62\xe4\x8b\x11\xc2\xbc\x1b,\x8d\x9c`\xba" +
-	"\xc1\xb5.W\xc9\xb9\xbcXrI\xe4\xc9!A|/" +
-	"\xd8X\xed\"q\xa6\xac\x198\xfa\xaa\\$I\xd4s" +
-	"\xa3,j\xf4\xd8Dxa\x84\xe7\x1c_\xe9\x82\xecp" +
-	"\xe3\x11\xf9\x1a^Vv_\x02\xc5\xd1\xa7\xe1.\x94\x14" +
-	"\x15\x90\x14\xa8t`\xfd\xb7\xef\xa6\x1e_\x18\xff?_" +
-	"\x88\xbb\xd9\xe1\x9a\xd7]v\xd4\xb9\x7f\x93\xfcl)^" +
-	"\xdc_\x11\x99\xa3\xe2\x8d\xdd5\xc4\x1b;\xa8x\xa3\xea" +
-	"k7v\x04\xb5\x1b\x13\x9evcgP\xbbQ\xf5\xb4" +
-	"\x1b\xc7\x03\x1a\x8d^,\xe41<\xf4K\xf1(/\x16" +
-	"\xf2\x94j\x07D\xa2R\x09\xe1\x0cx\x16\xab1\xa4H" +
-	"\xd4\xe2E\"\x16\xf2<\xe2R$\xca\x13\x1e\x0b\x8bD" +
-	"}%%b!/\xe1}\x12\xed\xc6\xc5\x9evc[" +
-	"@$\xea\xec\xb3D,\xe4\x15\xc4\xa5H\xd49_\x11" +
-	"\xb1\x90\xd7\xb0\x1f)\x12\xf5\xb5\xaf\x8aX\xc8\x1bx\xff" +
-	"\xbe\x18\xd4<\x86\xbcI\xec\xcc,\x15\xc8\x95nns" +
-	"x\xf5`\xcf!\xf7\x8a\x13\xa8\x8d\xee\xf1\xa5_\xaa\xe7" +
-	"\x865\xbe\x8b\xbb\xfat`\xe9\xf5\xb1\xec\xf5\xb4\x86/" +
-	" T\x13\xacc*\xeaf\xd9\x95Yp\xb9R \xe5" +
-	"7\xca\xb3\x18\x12JGG\xbaV6'\x95,\xb7L" +
-	"]k\xaa\xe0\xf6/t\xc3\xf8B\xb5\x1b5,\xe0\x96" +
-	"z\xc28\xba9\xe9\x07\x8e\xa3u\x17\x1bX\xa0\x9d\x0d" +
-	",\xd0\x9caY.\x97\x897x)\x1d\xe6`\xad\xd0" +
-	"t\xd0\xb2k\xc1m5%\xd2\x16^\xf7\xa4\xcaM\x8d" +
-	"$\x0b\x076#\xb3\xc0\x9b\xfa\x16\xe9B\xec\xc1x\xaf" +
-	"\xc8\xd2%\xdf\xd0.\xdd\xb3M\x162x\xa5\xbe\xd9\x1e" +
-	"C/\xec\x8e#\xad>\x10e\xd1tGY4\xed\xb5" +
-	"\xa4\xd5\xa3\x0c\xe3p(;m7\xe52\xe87\x0b6" +
-	"g\x0eO\xe7]\x86\xcf\x7f\xa7\xbf\xc8\xde\x83\xa5\xa5\x07" +
-	"\x13I5\x7f\x98\x96\xc0\xde\x8b\xb8,!\xf5J`\xef" +
-	"C\xfc\x10\xe0Gi\x09\xec\xfd\x88\xcb\xd2R\xaf\x04\xf6" +
-	"\x01\xc4ei\xa9\x97\x93\xf2\x10\xe2\xb2\xb4\xd4KJy" +
-	"\x04\xf1c\x80?NK`\x1f\xc3\x12UYr\xeae" +
-	"\xa5<\x81\xf8\xa3\x80?MK`\x9f\xc2\xd2OY\x8a" +
-	"\xea\x95\xc0>\x83%\xa4\xb2\x14\xd5+\x81}\x16KT" +
-	"\x9f\x06\xfc\x04-\x81}\x1e\xfb\x97%\xaa^\x09\xec\x0b" +
-	"\x88\xcb\x12U\xaf\x04\xf6%\xc4e\x89\xaaW\x02{\x12" +
-	"qY\xa2\xea\x95\xc0\xbe\x82\xb8_\xa2\xea\x97\xc0\xbe\x86" +
-	"\xf8)\xc0O\xd3\x12\xd87\x10\xc7\xda\xd5\xb7h\x09\xec" +
-	"\x9b\xf8{_\x07\xfc\x1dZ\x02\xfb6\xe2\xa7\x01\x7f\x0f" +
-	"\xf0\xe5\x95\x1a\xd8w\xf19\xbc\x05\xf8\x07\x80\xafX!" +
-	"\"\xce\xef\xe3sx\xc7\xab\x8d]z\xee\xb9\"\xe2\xfc" +
-	"\x07,\xd5\xfd\x00\xf0O\x13\x89\xdaa=\x1a\xb0#a" +
-	"\xbd\x14\xe5F \x81<\x1a\x06LO\xe86-\x94%" +
-	"\xf4\xa7\x01\xcaJX\xfe\x1dB\xb6\xa6\xe9\x0e\x0cny" +
-	"\xd0r\xed\x0er\xc1\xe4\x05\x9b\xd4\xe5\x85\xc6g\xe5\x85" +
-	"n\xcaZ\xd5\xf4\x8c\xee\xc8\x8b\xc2\x94\xec \x0bwH" +
-	"kZ\x0dY\x11\x9c\xc3{\x94\xf4\x14\xe2&e\xc1p" +
-	"\xe5.;\xa2\xd6\xb6\x9a\xbbO\xba[7\xb5\xda\xb9\xcc" +
-	"R\xc7\xb3-J\xc7\xb3-J\xc7\xb3-J\xc7\xb3[" +
-	":[\xbf\x90\xb2W\xec\xfc\xf4\xe1\xb4Uq\xb87\xca" +
-	"\x8azPQ\xb6\xaeO\xaa[7%|W\xd7\xb0\x12" +
-	"\xaa\xf9)0\x9bs{\xd4V\xc5\x9f-=\x15U\xd6" +
-	"S\x87\x1b\xc0\xb5us\xb2NTO\x90\x0d\xc4\xde7" +
-	"z-\xcb\xce\x97\xd8LRTA4pcu\xd4r" +
-	"ce5\xcb\xa2)\xd8\xfaW\x9fh\xbbc\xcb\xe6\xcf" +
-	"b{\xb2F\x98\xae\x0d\xf1\xe2x\x8e\xdb\x9eyM\\" +
-	"A\xe3\x11\xfar\x01>;5\x8a\xcf\xaer`\x0b8" +
-	"}\xbc\xfc\xd5WvI\xff\x8e\x9f\xbf\xfa\x86M\xf9\xec" +
-	"*\xf9\xabo\x0f\xc8p\x9d(\xe9\xdaR.*)\xe2" +
-	"\x80X\x10\xe9\xff!\xdf{\x9cr\xca\x11\xac\xf2R\xd2" +
-	"\xc1j\xca\x1aU\x96E\xa6\x9b\xa3\xcc\xdc\xdd\x94\xc9q" +
-	"m\xa5\x06\xadR\x82\xd6\xa5v\x85\xf5g\xf7\x06tf" +
-	"\xbdM\xf5\x19\xf5'\x81#\x81\xb7\xa9\xbe\xa0\xfe\x97\x80" +
-	")\xefm\xaa\xaf\xa9\xff)\xa0\xdf\xeam\xaao\xe3\x11" +
-	"E\xea\xb4z\x9b\xea\xfb\x88\xfbz\xac\xfe\xa6\xfa\x91\xfa" +
-	"\xa0\xa2\xe4?V\x93\xea\x08\xddS\xff\x88\xcd?\x87\xe6" +
-	"\x8b\xe9\x9e\xba(\xf1;E\xc9/\x86\xb5>C\xf7\xd4" +
-	"\xa5\x09\xb8\xcd\xf3\x00_C\xf7\xd4\x0b\x13p\x9bk\x00" +
-	"_K\xf7\xd4\xcb\x13\xbfW\x94\xfcz\xc07\xd1=\xf5" +
-	"j\xec\xa7\x17\xf0a\xba\xa7\x0e%\xe06\x87\x01\xbf\x91" +
-	"\xee\xa9;\x12\xff\x87\xa2\xe4w\x02n\xd0=UG\x1e" +
-	"\x87)\xc0\xdd\x847\x8e\xba\xd8\x90\x92c\xf6n\xca\xcf" +
-	"T\xf9\x83\xa9\x96\x0a}\x9c\x19\xee\x14y\xd9\x95\xbf\xf5" +
-	"&\xb8\xc1'\x99\xcb\x87\x98nv9\x8e\xee\xa8nU" +
-	"\x1b\xd5kS\xe3\x0b\xd6+\xd5\x1do\x88\xc0\xfaU\xd3" +
-	")\xf1\x82\xdb]NML8\xe1\xbf~?\xe2\x13y" +
-	"\xb5\xc4\x0d\xa3k\x86\xd9Yn\xd2\x1a\xec\xca\xdf\xafK" +
-	"LLp\xd3\xe1\x9b\xcd)f\x16x\x91\x9b\xd5\xb7?" +
-	"\xa42\x93a\x83\x1c\xb6\xa8j\xd0\x97\xc0\x87#\xfbp" +
-	"\xabo\xa4\xd2f\x84O\x0a\xe5N\xdd\"T\xd1\x956" +
-	"\xd7\xa8\xb0k1wj4e\x0d\x17j\xf4p\xdd\xc4" +
-	"(:{\x9dQ\x96\xc6\xd2\xf6P\xb3u\x8a\xba\xa1I" +
-	"\xf2\x1b\xcc\xd6\xb9\x86M[\xb6\x9erEY\x15\xf1\x91" +
-	"\x8eEE\\\x88\xbf\xd6\xf3\x91n\xbd\xb5R\xacg\xc4" +
-	"\xacQ@Jd\xdd\xe5j\xa5\x98B\xa9\x15?\xadc" +
-	"\x9f;\xaen\x18\xbdi\xab<\xee\x9eq\\\xaf\x83\xc4" +
-	"\xf5\xf4~R\xc9\xdd%\xd3\xb1\xb2z \xea\x19\xabR" +
-	"m\x0b+\xf2\xdc0+\xec\xe6\xee\x97\xe1v\xd6]^" +
-	"$q\xd8\xb2\xb9\xdb\x9a9\xd3J\xf3\x1e\xcb\xb0l\xae" +
-	"\x8d\xa6\xf8l\x9c\x80\x11\xcd\x09\x12$\xff\x9e\x19Zt" +
-	"&\xe3\x7f+\xe6\x0a\xd8\xd6D\xea\xbf\xe7\xf4\xff\xe9s" +
-	"\xfao\xe0\xe3y\xee8z\xd22kOp\x7f~\x0f" +
-	"\xd0\x88j\xc5\x9e\xd96F\"\xaa6/Z.\xef\xd2" +
-	"\x94\xa4f\x13\xd5~\x00\xfbK\xc1\xaa\\\xc0\x86-%" +
-	"i7\x91x\x8f\x8e\x83Q\x9b\xa54\xc1>\xd1 -" +
-	"c@\xfa{\xd3\x85@\x04\xde\xe5\xc5\x82\xe5\xa0\xff\xa5" +
-	"\xd9\xd5e\x84\x15Dr\x99\x12\xf2\xd8\xaf\x8c\xf0\xd8\xb7" +
-	"\x13\x82\x06\xef\x81\x05\x08\x1a<\x03\xf0\xa11\xe2\xc6\xf7" +
-	"<\xf6\x8f\x8dP\x8f}%\xb7\x8a\x124\xd4\xca\xb8\xcd" +
-	"\x99V\x8f\xa5\x93X\x1d&.\x06\xf9\xdd\xa3\xca\xe9\xc2" +
-	"\xf5w1KU+\xa7\x90\xaeq\xddH\xe9\xee\\\x8c" +
-	"Z\xe9nR+\x1bbG\x9c\x17lFs\xea\x92\x85" +
-	"_]\xf7\xe8\xff\xb5\xeb\x8aK\x7f\x15;4P\x09\xc2" +
-	"XeSC\xd66\x117\xa3\xbc\x02#\x01\xca \xcf" +
-	"\x92\xbc\x1c3\xd0$5\x90gIn@\xcbs\xbd\xc7" +
-	"C\xe0[\x92a\"\x02\xcf\x92\xec\xc2\xfe7\x01>H" +
-	"\x97\x97~4\x0d%\xe1\x80\xb7\xbelE\xa79!\x16" +
-	"Hy\xc4\x02\x1dAb\x81\xc5\x1e\xb1\xc0@\x90X\xe0" +
-	",\x8fX`<H,\xf0\x95hb\x01\xe1\x0b\xeb\xd3" +
-	"\x15b\xce\x08\xac\x97)\xe9\x80r\x8a\x80{l%\xa7" +
-	"\xbbzA\xe6\xb5\xe4\xccR\xa1O\x97\x1f7K\x05\x0c" +
-	"\xef\x07\xb8\xf9\xc5\xd7\x94B\x84\xe4s\xdc\x1ebJ\x8a" +
-	"\xc4\x94\xb3\xd0Y\x89\xf65\xc4f\x83\x9f\xc3\x1a\xf8\xf0" +
-	"if\x1e\x13w\xb9\xd6\xe48\x15I\x91]\x85\x02/" +
-	"\xa9nm\xf6\x9a\xa5Q\xf45\xde\xe6K\x9d\xbe~f" +
-	"d\x19\x1a\xbaIu\xeb\xfe\x84:_IU\xf4\x97?" +
-	"\x93\xcf\xe0\xe1KQ\xe9\x92X\x9dK\x12Q\xb4\x1ds" +
-	"E$\x1c\x1ad\xdeuFy\xd5\x07d\xc8)D#" +
-	"QyC\xd15y\xb5-E\xe6\xec\xf6\xf2\xb2\xb1\xc8" +
-	"\xa7N,=\xda\xde\x0a3\x07lO\xa89\x979\xbb" +
-	"\xfbk\x14\x13\x14\xacb\xc9\xe0.W\xb2\xda\xa8\x1eA" +
-	"\xe9\xd0\x1a79v\xc4\x7f\x07g\x9cU7@\xc4\xce" +
-	"=\xab\xe3\xde\x01\xb2\x0fT\x8c\x8e\xfb\x07\xe4\x92\xdfL" +
-	"r)\x83\xa1\xea\x06\xb3\x87c\xa6\xb6\xc6sg\xf4r" +
-	"\x97\xa5t\xc3\xa9\x1d\x17\xf1\xe7\xc2X\x14\x95\xd3H\xd4" +
-	"d\x18\x90\x93!\x94\x15\x11\x9d\x01H\xe9\xeb\x96,|" +
-	"\x9cxn\xc7\x13'N\xbf\xeb-\xfa\xe2#M\x0eK" +
-	"?\x81\xa2\x12sP\xdd/\x9b\x15h\x0b\x9f\x81\xddF" +
-	"O\x9a<\x8e\xdf\xed'\xc4\xefV\xab\x1a\x11\x17\x00\xd7" +
-	"\xd6U\xd8LE\xe6n\xfc\xfb\xd9fj\xdc\x9e\xb1\xec" +
-	"\xa4\xa1\xc5\x89\xac\xb4E\x05\xb9\xda\xa2\x82\\m2\xdc" +
-	"\x92E\x96\xe3\x08\x0f\xe6l\x9d\x12\x84\xda\xde\xd8^\xcb" +
-	"B+n\xad\xbf?wa\x90\xd7'\xf2\xf1\xb6\xe7\xcd" +
-	"\x08\xcbm\xd5\xab\xd7\xebG\\\xf2\xfbx1\xea!\xc4" +
-	"\xe5v\xeb\xf1\xfel\xc5\xedP\xf2\xfbx\xbc?\xdb\x10" +
-	"\x97\xdb\xb0\xc7\xfb\xb3\x03\xfb\x91\xdb\xb0\xc7\xfbs\x13n" +
-	"\xff\xdb\x01\xd7(\xc3 \xc3\xedy'\xe0\x86JT\x0d" +
-	"t4/\xfcm\xd8W5\xd8\x83\xdbv\x09\xf0}*" +
-	"U5@3b\x16\xf0\xdb\xd4P\x98-=7l\xf9" +
-	"|\xc9\xe9Yz\xb1\x97\\\x84]\xc9\xf3\xbaY0t" +
-	"\x93G*)\xe54\xcb\"u\x9f\x0bV\x89W\x89\xde" +
-	"8.sy\x97\x9bW\xd2\xc86CK\x7fl7D" +
-	"\xba\xbd\x00\xfd\x0d3\x9b)j\xb1\xae\xdf\xd8\xe7\x10(" +
-	"\x94\x1d7g\xa1\x14W\x98G\xa0\xb5\xce\xd1\x05\xac\xda" +
-	"\xcd\xd3\xdc\xc6Hhp=\x8fN\x0e\xf5O2\xdd\x0d" +
-	"x\x85*\xf9!A\xa6\xb2\xda\x89-\x91\xd921(" +
-	"V\xd8\x8c\xd97L\x18\xc0\xc8]w\x9cI\x09o\xdd" +
-	"\xea\xb48LM\xd5n\xa0\xaeB!\xc5K\xe1\xf5s" +
-	"\x84\xb2\x9f\x9d)\x95`\x8f\xcdY\xb2\xea\x87GY\x0c" +
-	"cQ\xafk@\xbe\xaeX\xfbL\xbd\x9d\xa46\xfb\x1b" +
-	"w\xd1f\xc8m\xf3_\x12\xa9\xa7\x19!\x1ez\xbf\xa0" +
-	"\xa6\x83\xc8\xd0x/\xe9\xcdN\xe2\xb6\xf7\xd6V\xea\xb6" +
-	"\xf7L\x85\xf7G\x88\xcfc\x91*\xce|\x1f\x8d\x10\xf7" +
-	"\x86G\x15\xfe\xd9\x00\xf5dx\xf93*\xacA\xbe\xcb" +
-	"b\xa1\xc4]\xccD\x0ch\x09P_MU\x0a\x0a7" +
-	"\xabT\x1eY\x01\x03)h\x99z\x0fs\xb2\x88--" +
-	"E\xb5|lO\x99\xbb\x96\x1b\xa4\xdf\x8a\xe00o\xbc" +
-	"}\x8b\x02\xae<75\xb0j:\x94fS\x10jW" +
-	"wXv\x91\xb9.\xd7\x86\x04=P\xbd\xb5B\xba5" +
-	"\xdb#\xdc\x9a\xdd\xd2\\E}8s20\xb0B\x19" +
-	"75\xc8\x88\xea\xcc\x0eS\xb3\x8a\x92\xf3\x91l\xe7\x17" +
-	"Em\xe7\xed\x0drV\xbc\x9a\x8fb\xbb\xdc\xcdS\x86" +
-	"5\xe3\xdf\xee\x94.u\xedr6V\xbe\x9c1\xf3," +
-	"<\x8cA\xbd\x9a+\xfe\"\xf9\xda0\xe0\x1d\xdf/i" +
-	"X\x0e\xef\xb1\xcc\x1c\x9aMv\x9cW\xd6I\x17\x0c\xef" +
-	"\x9d\xb5\x93w\x16q\xda\x09\x1d\x88\xd2\xb0\x0d\xc6\xa6\xb5" +
-	"\x83\x81\xdaU*\xd9Vj\x9a\x19\xb5K\x9f\xa2\xdfX" +
-	"eE\xd3G\xa2\x98Q;\xa4\x8d\x1d\xb4\x04\x02Z\x11" +
-	"Q\xa1\xbc@\x09u\x8c\xec\xc1\x0aI]U\xc2v|" +
-	"6\x09\xf2\xd8\xc5v\xa3\x13~.4\x1e\xfd\x94\xed\xe0" +
-	"\x82\xdd\xa0\xe2$\xcf\x0d\xa3\xdf\xe5\xc5@\xb6'\xf1\xbd" +
-	"u\xcb3\x97\x7f\x87\xf4\xd0%\x89P\xe1\xb9\x1fM\xaa" +
-	"[\x1f%\xeb\xf0#\x9d\xd4\xf9VY\x88\x1f\xeb\x949" +
-	"\xb4\x1e\x11\xea\x13\x03\xd2\xf7\xe6[\x03^u\xea\x17-" +
-	"\xa2\xae\xc4\x06\x9a:\xc3\xf5\x1bF\xd9\xd1\xadJq!" +
-	"5\xa4\xbb\xa3\x0d\xe9\x81\x80\xc1\xec\x19\xd2a\x83\xd93" +
-	"\xa4\xb7\xa2\xa1+\x0df\xcf\x90\x0e\x1b\xcc\x9e!\xbd\x03" +
-	"\x0dZi\x18\xfb\xf2`\xd8\xbf\xf4[\xf9\xf2`h\xe8" +
-	"J\xbf\x95gH\x17\x117\x00\x9f\xa5\x86t\x19\xfdb" +
-	"\xd2`\xf6\x0c\xe99d\x14\xf3\x0df\xdf\x90\xbe\x05\xbf" +
-	"w\x1f\xe0\x07\xd4\xea!\x19\xb59\x05fU\x88v`" +
-	"\xde\x15\x0cDDy\xcf(\x02\xa6\xa4\x08\x1aTw\x8b" +
-	"\x12\xb3\x89\x12\xf8\x09\xe8\xd4D\xca\xdd\x04\xe4\x83\xfe\xdf" +
-	"\x00\x00\x00\xff\xff\x99A{\xb7"
+type ConsiderResponse capnp.Struct
+
+// ConsiderResponse_TypeID is the unique identifier for the type ConsiderResponse.
+const ConsiderResponse_TypeID = 0xf9f5042b9a64b7e6
+
+func NewConsiderResponse(s *capnp.Segment) (ConsiderResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5})
+	return ConsiderResponse(st), err
+}
+
+func NewRootConsiderResponse(s *capnp.Segment) (ConsiderResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5})
+	return ConsiderResponse(st), err
+}
+
+func ReadRootConsiderResponse(msg *capnp.Message) (ConsiderResponse, error) {
+	root, err := msg.Root()
+	return ConsiderResponse(root.Struct()), err
+}
+
+func (s ConsiderResponse) String() string {
+	str, _ := text.Marshal(0xf9f5042b9a64b7e6, capnp.Struct(s))
+	return str
+}
+
+func (s ConsiderResponse) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ConsiderResponse) DecodeFromPtr(p capnp.Ptr) ConsiderResponse {
+	return ConsiderResponse(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ConsiderResponse) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ConsiderResponse) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ConsiderResponse) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ConsiderResponse) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ConsiderResponse) Success() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s ConsiderResponse) SetSuccess(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s ConsiderResponse) Error() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s ConsiderResponse) HasError() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ConsiderResponse) ErrorBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s ConsiderResponse) SetError(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s ConsiderResponse) NpcId() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s ConsiderResponse) SetNpcId(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s ConsiderResponse) NpcName() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s ConsiderResponse) HasNpcName() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s ConsiderResponse) NpcNameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s ConsiderResponse) SetNpcName(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s ConsiderResponse) Level() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s ConsiderResponse) SetLevel(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s ConsiderResponse) ConColor() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s ConsiderResponse) HasConColor() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s ConsiderResponse) ConColorBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s ConsiderResponse) SetConColor(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
+func (s ConsiderResponse) FactionValue() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s ConsiderResponse) SetFactionValue(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s ConsiderResponse) FactionStanding() (string, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return p.Text(), err
+}
+
+func (s ConsiderResponse) HasFactionStanding() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s ConsiderResponse) FactionStandingBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s ConsiderResponse) SetFactionStanding(v string) error {
+	return capnp.Struct(s).SetText(3, v)
+}
+
+func (s ConsiderResponse) DangerRating() (string, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return p.Text(), err
+}
+
+func (s ConsiderResponse) HasDangerRating() bool {
+	return capnp.Struct(s).HasPtr(4)
+}
+
+func (s ConsiderResponse) DangerRatingBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(4)
+	return p.TextBytes(), err
+}
+
+func (s ConsiderResponse) SetDangerRating(v string) error {
+	return capnp.Struct(s).SetText(4, v)
+}
+
+func (s ConsiderResponse) PlayerRoundsToKill() float32 {
+	return math.Float32frombits(capnp.Struct(s).Uint32(16))
+}
+
+func (s ConsiderResponse) SetPlayerRoundsToKill(v float32) {
+	capnp.Struct(s).SetUint32(16, math.Float32bits(v))
+}
+
+func (s ConsiderResponse) NpcRoundsToKill() float32 {
+	return math.Float32frombits(capnp.Struct(s).Uint32(20))
+}
+
+func (s ConsiderResponse) SetNpcRoundsToKill(v float32) {
+	capnp.Struct(s).SetUint32(20, math.Float32bits(v))
+}
+
+// ConsiderResponse_List is a list of ConsiderResponse.
+type ConsiderResponse_List = capnp.StructList[ConsiderResponse]
+
+// NewConsiderResponse creates a new list of ConsiderResponse.
+func NewConsiderResponse_List(s *capnp.Segment, sz int32) (ConsiderResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5}, sz)
+	return capnp.StructList[ConsiderResponse](l), err
+}
+
+// ConsiderResponse_Future is a wrapper for a ConsiderResponse promised by a client call.
+type ConsiderResponse_Future struct{ *capnp.Future }
+
+func (f ConsiderResponse_Future) Struct() (ConsiderResponse, error) {
+	p, err := f.Future.Ptr()
+	return ConsiderResponse(p.Struct()), err
+}
+
+type GetCombatStatsRequest capnp.Struct
+
+// GetCombatStatsRequest_TypeID is the unique identifier for the type GetCombatStatsRequest.
+const GetCombatStatsRequest_TypeID = 0xea06c39fbc9cb2d5
+
+func NewGetCombatStatsRequest(s *capnp.Segment) (GetCombatStatsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return GetCombatStatsRequest(st), err
+}
+
+func NewRootGetCombatStatsRequest(s *capnp.Segment) (GetCombatStatsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return GetCombatStatsRequest(st), err
+}
+
+func ReadRootGetCombatStatsRequest(msg *capnp.Message) (GetCombatStatsRequest, error) {
+	root, err := msg.Root()
+	return GetCombatStatsRequest(root.Struct()), err
+}
+
+func (s GetCombatStatsRequest) String() string {
+	str, _ := text.Marshal(0xea06c39fbc9cb2d5, capnp.Struct(s))
+	return str
+}
+
+func (s GetCombatStatsRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (GetCombatStatsRequest) DecodeFromPtr(p capnp.Ptr) GetCombatStatsRequest {
+	return GetCombatStatsRequest(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s GetCombatStatsRequest) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s GetCombatStatsRequest) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s GetCombatStatsRequest) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s GetCombatStatsRequest) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s GetCombatStatsRequest) NpcLimit() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s GetCombatStatsRequest) SetNpcLimit(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+// GetCombatStatsRequest_List is a list of GetCombatStatsRequest.
+type GetCombatStatsRequest_List = capnp.StructList[GetCombatStatsRequest]
+
+// NewGetCombatStatsRequest creates a new list of GetCombatStatsRequest.
+func NewGetCombatStatsRequest_List(s *capnp.Segment, sz int32) (GetCombatStatsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[GetCombatStatsRequest](l), err
+}
+
+// GetCombatStatsRequest_Future is a wrapper for a GetCombatStatsRequest promised by a client call.
+type GetCombatStatsRequest_Future struct{ *capnp.Future }
+
+func (f GetCombatStatsRequest_Future) Struct() (GetCombatStatsRequest, error) {
+	p, err := f.Future.Ptr()
+	return GetCombatStatsRequest(p.Struct()), err
+}
+
+type NPCKillStats capnp.Struct
+
+// NPCKillStats_TypeID is the unique identifier for the type NPCKillStats.
+const NPCKillStats_TypeID = 0xd68f1dab8580fed6
+
+func NewNPCKillStats(s *capnp.Segment) (NPCKillStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 56, PointerCount: 1})
+	return NPCKillStats(st), err
+}
+
+func NewRootNPCKillStats(s *capnp.Segment) (NPCKillStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 56, PointerCount: 1})
+	return NPCKillStats(st), err
+}
+
+func ReadRootNPCKillStats(msg *capnp.Message) (NPCKillStats, error) {
+	root, err := msg.Root()
+	return NPCKillStats(root.Struct()), err
+}
+
+func (s NPCKillStats) String() string {
+	str, _ := text.Marshal(0xd68f1dab8580fed6, capnp.Struct(s))
+	return str
+}
+
+func (s NPCKillStats) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (NPCKillStats) DecodeFromPtr(p capnp.Ptr) NPCKillStats {
+	return NPCKillStats(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s NPCKillStats) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s NPCKillStats) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s NPCKillStats) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s NPCKillStats) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s NPCKillStats) NpcId() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s NPCKillStats) SetNpcId(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s NPCKillStats) NpcName() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s NPCKillStats) HasNpcName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s NPCKillStats) NpcNameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s NPCKillStats) SetNpcName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s NPCKillStats) Kills() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s NPCKillStats) SetKills(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s NPCKillStats) Deaths() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s NPCKillStats) SetDeaths(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s NPCKillStats) DamageDealt() int64 {
+	return int64(capnp.Struct(s).Uint64(16))
+}
+
+func (s NPCKillStats) SetDamageDealt(v int64) {
+	capnp.Struct(s).SetUint64(16, uint64(v))
+}
+
+func (s NPCKillStats) DamageTaken() int64 {
+	return int64(capnp.Struct(s).Uint64(24))
+}
+
+func (s NPCKillStats) SetDamageTaken(v int64) {
+	capnp.Struct(s).SetUint64(24, uint64(v))
+}
+
+func (s NPCKillStats) ExpEarned() int64 {
+	return int64(capnp.Struct(s).Uint64(32))
+}
+
+func (s NPCKillStats) SetExpEarned(v int64) {
+	capnp.Struct(s).SetUint64(32, uint64(v))
+}
+
+func (s NPCKillStats) CoinEarned() int64 {
+	return int64(capnp.Struct(s).Uint64(40))
+}
+
+func (s NPCKillStats) SetCoinEarned(v int64) {
+	capnp.Struct(s).SetUint64(40, uint64(v))
+}
+
+func (s NPCKillStats) LastKilledAt() int64 {
+	return int64(capnp.Struct(s).Uint64(48))
+}
+
+func (s NPCKillStats) SetLastKilledAt(v int64) {
+	capnp.Struct(s).SetUint64(48, uint64(v))
+}
+
+// NPCKillStats_List is a list of NPCKillStats.
+type NPCKillStats_List = capnp.StructList[NPCKillStats]
+
+// NewNPCKillStats creates a new list of NPCKillStats.
+func NewNPCKillStats_List(s *capnp.Segment, sz int32) (NPCKillStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 56, PointerCount: 1}, sz)
+	return capnp.StructList[NPCKillStats](l), err
+}
+
+// NPCKillStats_Future is a wrapper for a NPCKillStats promised by a client call.
+type NPCKillStats_Future struct{ *capnp.Future }
+
+func (f NPCKillStats_Future) Struct() (NPCKillStats, error) {
+	p, err := f.Future.Ptr()
+	return NPCKillStats(p.Struct()), err
+}
+
+type GetCombatStatsResponse capnp.Struct
+
+// GetCombatStatsResponse_TypeID is the unique identifier for the type GetCombatStatsResponse.
+const GetCombatStatsResponse_TypeID = 0xfa21bf17a15f69d1
+
+func NewGetCombatStatsResponse(s *capnp.Segment) (GetCombatStatsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 2})
+	return GetCombatStatsResponse(st), err
+}
+
+func NewRootGetCombatStatsResponse(s *capnp.Segment) (GetCombatStatsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 2})
+	return GetCombatStatsResponse(st), err
+}
+
+func ReadRootGetCombatStatsResponse(msg *capnp.Message) (GetCombatStatsResponse, error) {
+	root, err := msg.Root()
+	return GetCombatStatsResponse(root.Struct()), err
+}
+
+func (s GetCombatStatsResponse) String() string {
+	str, _ := text.Marshal(0xfa21bf17a15f69d1, capnp.Struct(s))
+	return str
+}
+
+func (s GetCombatStatsResponse) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (GetCombatStatsResponse) DecodeFromPtr(p capnp.Ptr) GetCombatStatsResponse {
+	return GetCombatStatsResponse(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s GetCombatStatsResponse) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s GetCombatStatsResponse) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s GetCombatStatsResponse) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s GetCombatStatsResponse) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s GetCombatStatsResponse) Success() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s GetCombatStatsResponse) SetSuccess(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s GetCombatStatsResponse) Error() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s GetCombatStatsResponse) HasError() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s GetCombatStatsResponse) ErrorBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s GetCombatStatsResponse) SetError(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s GetCombatStatsResponse) Kills() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s GetCombatStatsResponse) SetKills(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s GetCombatStatsResponse) Deaths() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s GetCombatStatsResponse) SetDeaths(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s GetCombatStatsResponse) DamageDealt() int64 {
+	return int64(capnp.Struct(s).Uint64(16))
+}
+
+func (s GetCombatStatsResponse) SetDamageDealt(v int64) {
+	capnp.Struct(s).SetUint64(16, uint64(v))
+}
+
+func (s GetCombatStatsResponse) DamageTaken() int64 {
+	return int64(capnp.Struct(s).Uint64(24))
+}
+
+func (s GetCombatStatsResponse) SetDamageTaken(v int64) {
+	capnp.Struct(s).SetUint64(24, uint64(v))
+}
+
+func (s GetCombatStatsResponse) HighestHit() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s GetCombatStatsResponse) SetHighestHit(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s GetCombatStatsResponse) ExpEarned() int64 {
+	return int64(capnp.Struct(s).Uint64(32))
+}
+
+func (s GetCombatStatsResponse) SetExpEarned(v int64) {
+	capnp.Struct(s).SetUint64(32, uint64(v))
+}
+
+func (s GetCombatStatsResponse) CoinEarned() int64 {
+	return int64(capnp.Struct(s).Uint64(40))
+}
+
+func (s GetCombatStatsResponse) SetCoinEarned(v int64) {
+	capnp.Struct(s).SetUint64(40, uint64(v))
+}
+
+func (s GetCombatStatsResponse) NpcKills() (NPCKillStats_List, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return NPCKillStats_List(p.List()), err
+}
+
+func (s GetCombatStatsResponse) HasNpcKills() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s GetCombatStatsResponse) SetNpcKills(v NPCKillStats_List) error {
+	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+}
+
+// NewNpcKills sets the npcKills field to a newly
+// allocated NPCKillStats_List, preferring placement in s's segment.
+func (s GetCombatStatsResponse) NewNpcKills(n int32) (NPCKillStats_List, error) {
+	l, err := NewNPCKillStats_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return NPCKillStats_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
+	return l, err
+}
+
+// GetCombatStatsResponse_List is a list of GetCombatStatsResponse.
+type GetCombatStatsResponse_List = capnp.StructList[GetCombatStatsResponse]
+
+// NewGetCombatStatsResponse creates a new list of GetCombatStatsResponse.
+func NewGetCombatStatsResponse_List(s *capnp.Segment, sz int32) (GetCombatStatsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 48, PointerCount: 2}, sz)
+	return capnp.StructList[GetCombatStatsResponse](l), err
+}
+
+// GetCombatStatsResponse_Future is a wrapper for a GetCombatStatsResponse promised by a client call.
+type GetCombatStatsResponse_Future struct{ *capnp.Future }
+
+func (f GetCombatStatsResponse_Future) Struct() (GetCombatStatsResponse, error) {
+	p, err := f.Future.Ptr()
+	return GetCombatStatsResponse(p.Struct()), err
+}
+
+type SetAutoHuntRequest capnp.Struct
+
+// SetAutoHuntRequest_TypeID is the unique identifier for the type SetAutoHuntRequest.
+const SetAutoHuntRequest_TypeID = 0xf880e264f3b349d0
+
+func NewSetAutoHuntRequest(s *capnp.Segment) (SetAutoHuntRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return SetAutoHuntRequest(st), err
+}
+
+func NewRootSetAutoHuntRequest(s *capnp.Segment) (SetAutoHuntRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return SetAutoHuntRequest(st), err
+}
+
+func ReadRootSetAutoHuntRequest(msg *capnp.Message) (SetAutoHuntRequest, error) {
+	root, err := msg.Root()
+	return SetAutoHuntRequest(root.Struct()), err
+}
+
+func (s SetAutoHuntRequest) String() string {
+	str, _ := text.Marshal(0xf880e264f3b349d0, capnp.Struct(s))
+	return str
+}
+
+func (s SetAutoHuntRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (SetAutoHuntRequest) DecodeFromPtr(p capnp.Ptr) SetAutoHuntRequest {
+	return SetAutoHuntRequest(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s SetAutoHuntRequest) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s SetAutoHuntRequest) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s SetAutoHuntRequest) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s SetAutoHuntRequest) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s SetAutoHuntRequest) Enabled() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s SetAutoHuntRequest) SetEnabled(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s SetAutoHuntRequest) RestHpPercent() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s SetAutoHuntRequest) SetRestHpPercent(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s SetAutoHuntRequest) RestManaPercent() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s SetAutoHuntRequest) SetRestManaPercent(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s SetAutoHuntRequest) StopAtLevel() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s SetAutoHuntRequest) SetStopAtLevel(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s SetAutoHuntRequest) MaxDeaths() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s SetAutoHuntRequest) SetMaxDeaths(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+func (s SetAutoHuntRequest) StopWhenInventoryFull() int32 {
+	return int32(capnp.Struct(s).Uint32(20))
+}
+
+func (s SetAutoHuntRequest) SetStopWhenInventoryFull(v int32) {
+	capnp.Struct(s).SetUint32(20, uint32(v))
+}
+
+// SetAutoHuntRequest_List is a list of SetAutoHuntRequest.
+type SetAutoHuntRequest_List = capnp.StructList[SetAutoHuntRequest]
+
+// NewSetAutoHuntRequest creates a new list of SetAutoHuntRequest.
+func NewSetAutoHuntRequest_List(s *capnp.Segment, sz int32) (SetAutoHuntRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return capnp.StructList[SetAutoHuntRequest](l), err
+}
+
+// SetAutoHuntRequest_Future is a wrapper for a SetAutoHuntRequest promised by a client call.
+type SetAutoHuntRequest_Future struct{ *capnp.Future }
+
+func (f SetAutoHuntRequest_Future) Struct() (SetAutoHuntRequest, error) {
+	p, err := f.Future.Ptr()
+	return SetAutoHuntRequest(p.Struct()), err
+}
+
+type AutoHuntStatus capnp.Struct
+
+// AutoHuntStatus_TypeID is the unique identifier for the type AutoHuntStatus.
+const AutoHuntStatus_TypeID = 0xf30b9ceadbcbce7a
+
+func NewAutoHuntStatus(s *capnp.Segment) (AutoHuntStatus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2})
+	return AutoHuntStatus(st), err
+}
+
+func NewRootAutoHuntStatus(s *capnp.Segment) (AutoHuntStatus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2})
+	return AutoHuntStatus(st), err
+}
+
+func ReadRootAutoHuntStatus(msg *capnp.Message) (AutoHuntStatus, error) {
+	root, err := msg.Root()
+	return AutoHuntStatus(root.Struct()), err
+}
+
+func (s AutoHuntStatus) String() string {
+	str, _ := text.Marshal(0xf30b9ceadbcbce7a, capnp.Struct(s))
+	return str
+}
+
+func (s AutoHuntStatus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (AutoHuntStatus) DecodeFromPtr(p capnp.Ptr) AutoHuntStatus {
+	return AutoHuntStatus(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s AutoHuntStatus) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s AutoHuntStatus) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s AutoHuntStatus) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s AutoHuntStatus) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s AutoHuntStatus) Active() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s AutoHuntStatus) SetActive(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s AutoHuntStatus) Resting() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s AutoHuntStatus) SetResting(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s AutoHuntStatus) Kills() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s AutoHuntStatus) SetKills(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s AutoHuntStatus) Deaths() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s AutoHuntStatus) SetDeaths(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s AutoHuntStatus) StopReason() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s AutoHuntStatus) HasStopReason() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s AutoHuntStatus) StopReasonBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s AutoHuntStatus) SetStopReason(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s AutoHuntStatus) Detail() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s AutoHuntStatus) HasDetail() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s AutoHuntStatus) DetailBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s AutoHuntStatus) SetDetail(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s AutoHuntStatus) Hp() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s AutoHuntStatus) SetHp(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+func (s AutoHuntStatus) MaxHp() int32 {
+	return int32(capnp.Struct(s).Uint32(20))
+}
+
+func (s AutoHuntStatus) SetMaxHp(v int32) {
+	capnp.Struct(s).SetUint32(20, uint32(v))
+}
+
+func (s AutoHuntStatus) Mana() int32 {
+	return int32(capnp.Struct(s).Uint32(24))
+}
+
+func (s AutoHuntStatus) SetMana(v int32) {
+	capnp.Struct(s).SetUint32(24, uint32(v))
+}
+
+func (s AutoHuntStatus) MaxMana() int32 {
+	return int32(capnp.Struct(s).Uint32(28))
+}
+
+func (s AutoHuntStatus) SetMaxMana(v int32) {
+	capnp.Struct(s).SetUint32(28, uint32(v))
+}
+
+// AutoHuntStatus_List is a list of AutoHuntStatus.
+type AutoHuntStatus_List = capnp.StructList[AutoHuntStatus]
+
+// NewAutoHuntStatus creates a new list of AutoHuntStatus.
+func NewAutoHuntStatus_List(s *capnp.Segment, sz int32) (AutoHuntStatus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2}, sz)
+	return capnp.StructList[AutoHuntStatus](l), err
+}
+
+// AutoHuntStatus_Future is a wrapper for a AutoHuntStatus promised by a client call.
+type AutoHuntStatus_Future struct{ *capnp.Future }
+
+func (f AutoHuntStatus_Future) Struct() (AutoHuntStatus, error) {
+	p, err := f.Future.Ptr()
+	return AutoHuntStatus(p.Struct()), err
+}
+
+type OfflineProgressSummary capnp.Struct
+
+// OfflineProgressSummary_TypeID is the unique identifier for the type OfflineProgressSummary.
+const OfflineProgressSummary_TypeID = 0xe344a4b7d9ec90b6
+
+func NewOfflineProgressSummary(s *capnp.Segment) (OfflineProgressSummary, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 56, PointerCount: 1})
+	return OfflineProgressSummary(st), err
+}
+
+func NewRootOfflineProgressSummary(s *capnp.Segment) (OfflineProgressSummary, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 56, PointerCount: 1})
+	return OfflineProgressSummary(st), err
+}
+
+func ReadRootOfflineProgressSummary(msg *capnp.Message) (OfflineProgressSummary, error) {
+	root, err := msg.Root()
+	return OfflineProgressSummary(root.Struct()), err
+}
+
+func (s OfflineProgressSummary) String() string {
+	str, _ := text.Marshal(0xe344a4b7d9ec90b6, capnp.Struct(s))
+	return str
+}
+
+func (s OfflineProgressSummary) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (OfflineProgressSummary) DecodeFromPtr(p capnp.Ptr) OfflineProgressSummary {
+	return OfflineProgressSummary(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s OfflineProgressSummary) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s OfflineProgressSummary) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s OfflineProgressSummary) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s OfflineProgressSummary) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s OfflineProgressSummary) AwaySeconds() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s OfflineProgressSummary) SetAwaySeconds(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s OfflineProgressSummary) HuntedSeconds() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s OfflineProgressSummary) SetHuntedSeconds(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+func (s OfflineProgressSummary) Fights() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s OfflineProgressSummary) SetFights(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+func (s OfflineProgressSummary) Kills() int32 {
+	return int32(capnp.Struct(s).Uint32(20))
+}
+
+func (s OfflineProgressSummary) SetKills(v int32) {
+	capnp.Struct(s).SetUint32(20, uint32(v))
+}
+
+func (s OfflineProgressSummary) Deaths() int32 {
+	return int32(capnp.Struct(s).Uint32(24))
+}
+
+func (s OfflineProgressSummary) SetDeaths(v int32) {
+	capnp.Struct(s).SetUint32(24, uint32(v))
+}
+
+func (s OfflineProgressSummary) ExpGained() int32 {
+	return int32(capnp.Struct(s).Uint32(28))
+}
+
+func (s OfflineProgressSummary) SetExpGained(v int32) {
+	capnp.Struct(s).SetUint32(28, uint32(v))
+}
+
+func (s OfflineProgressSummary) LevelsGained() int32 {
+	return int32(capnp.Struct(s).Uint32(32))
+}
+
+func (s OfflineProgressSummary) SetLevelsGained(v int32) {
+	capnp.Struct(s).SetUint32(32, uint32(v))
+}
+
+func (s OfflineProgressSummary) Platinum() int32 {
+	return int32(capnp.Struct(s).Uint32(36))
+}
+
+func (s OfflineProgressSummary) SetPlatinum(v int32) {
+	capnp.Struct(s).SetUint32(36, uint32(v))
+}
+
+func (s OfflineProgressSummary) Gold() int32 {
+	return int32(capnp.Struct(s).Uint32(40))
+}
+
+func (s OfflineProgressSummary) SetGold(v int32) {
+	capnp.Struct(s).SetUint32(40, uint32(v))
+}
+
+func (s OfflineProgressSummary) Silver() int32 {
+	return int32(capnp.Struct(s).Uint32(44))
+}
+
+func (s OfflineProgressSummary) SetSilver(v int32) {
+	capnp.Struct(s).SetUint32(44, uint32(v))
+}
+
+func (s OfflineProgressSummary) Copper() int32 {
+	return int32(capnp.Struct(s).Uint32(48))
+}
+
+func (s OfflineProgressSummary) SetCopper(v int32) {
+	capnp.Struct(s).SetUint32(48, uint32(v))
+}
+
+func (s OfflineProgressSummary) Items() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
+}
+
+func (s OfflineProgressSummary) HasItems() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s OfflineProgressSummary) SetItems(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewItems sets the items field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s OfflineProgressSummary) NewItems(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s OfflineProgressSummary) ItemsLost() int32 {
+	return int32(capnp.Struct(s).Uint32(52))
+}
+
+func (s OfflineProgressSummary) SetItemsLost(v int32) {
+	capnp.Struct(s).SetUint32(52, uint32(v))
+}
+
+// OfflineProgressSummary_List is a list of OfflineProgressSummary.
+type OfflineProgressSummary_List = capnp.StructList[OfflineProgressSummary]
+
+// NewOfflineProgressSummary creates a new list of OfflineProgressSummary.
+func NewOfflineProgressSummary_List(s *capnp.Segment, sz int32) (OfflineProgressSummary_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 56, PointerCount: 1}, sz)
+	return capnp.StructList[OfflineProgressSummary](l), err
+}
+
+// OfflineProgressSummary_Future is a wrapper for a OfflineProgressSummary promised by a client call.
+type OfflineProgressSummary_Future struct{ *capnp.Future }
+
+func (f OfflineProgressSummary_Future) Struct() (OfflineProgressSummary, error) {
+	p, err := f.Future.Ptr()
+	return OfflineProgressSummary(p.Struct()), err
+}
+
+type SpecialAttackHit capnp.Struct
+
+// SpecialAttackHit_TypeID is the unique identifier for the type SpecialAttackHit.
+const SpecialAttackHit_TypeID = 0xf4a38d4d317b81ba
+
+func NewSpecialAttackHit(s *capnp.Segment) (SpecialAttackHit, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return SpecialAttackHit(st), err
+}
+
+func NewRootSpecialAttackHit(s *capnp.Segment) (SpecialAttackHit, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return SpecialAttackHit(st), err
+}
+
+func ReadRootSpecialAttackHit(msg *capnp.Message) (SpecialAttackHit, error) {
+	root, err := msg.Root()
+	return SpecialAttackHit(root.Struct()), err
+}
+
+func (s SpecialAttackHit) String() string {
+	str, _ := text.Marshal(0xf4a38d4d317b81ba, capnp.Struct(s))
+	return str
+}
+
+func (s SpecialAttackHit) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (SpecialAttackHit) DecodeFromPtr(p capnp.Ptr) SpecialAttackHit {
+	return SpecialAttackHit(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s SpecialAttackHit) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s SpecialAttackHit) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s SpecialAttackHit) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s SpecialAttackHit) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s SpecialAttackHit) Skill() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s SpecialAttackHit) SetSkill(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s SpecialAttackHit) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s SpecialAttackHit) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s SpecialAttackHit) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s SpecialAttackHit) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s SpecialAttackHit) Hit() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s SpecialAttackHit) SetHit(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s SpecialAttackHit) Damage() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s SpecialAttackHit) SetDamage(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s SpecialAttackHit) Stunned() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s SpecialAttackHit) SetStunned(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s SpecialAttackHit) Behind() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s SpecialAttackHit) SetBehind(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+// SpecialAttackHit_List is a list of SpecialAttackHit.
+type SpecialAttackHit_List = capnp.StructList[SpecialAttackHit]
+
+// NewSpecialAttackHit creates a new list of SpecialAttackHit.
+func NewSpecialAttackHit_List(s *capnp.Segment, sz int32) (SpecialAttackHit_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[SpecialAttackHit](l), err
+}
+
+// SpecialAttackHit_Future is a wrapper for a SpecialAttackHit promised by a client call.
+type SpecialAttackHit_Future struct{ *capnp.Future }
+
+func (f SpecialAttackHit_Future) Struct() (SpecialAttackHit, error) {
+	p, err := f.Future.Ptr()
+	return SpecialAttackHit(p.Struct()), err
+}
+
+type RangedAttackHit capnp.Struct
+
+// RangedAttackHit_TypeID is the unique identifier for the type RangedAttackHit.
+const RangedAttackHit_TypeID = 0xb4b9900a1260c0a8
+
+func NewRangedAttackHit(s *capnp.Segment) (RangedAttackHit, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return RangedAttackHit(st), err
+}
+
+func NewRootRangedAttackHit(s *capnp.Segment) (RangedAttackHit, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return RangedAttackHit(st), err
+}
+
+func ReadRootRangedAttackHit(msg *capnp.Message) (RangedAttackHit, error) {
+	root, err := msg.Root()
+	return RangedAttackHit(root.Struct()), err
+}
+
+func (s RangedAttackHit) String() string {
+	str, _ := text.Marshal(0xb4b9900a1260c0a8, capnp.Struct(s))
+	return str
+}
+
+func (s RangedAttackHit) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (RangedAttackHit) DecodeFromPtr(p capnp.Ptr) RangedAttackHit {
+	return RangedAttackHit(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s RangedAttackHit) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s RangedAttackHit) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s RangedAttackHit) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s RangedAttackHit) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s RangedAttackHit) Skill() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s RangedAttackHit) SetSkill(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s RangedAttackHit) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s RangedAttackHit) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s RangedAttackHit) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s RangedAttackHit) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s RangedAttackHit) Hit() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s RangedAttackHit) SetHit(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s RangedAttackHit) Damage() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s RangedAttackHit) SetDamage(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s RangedAttackHit) AmmoBag() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s RangedAttackHit) SetAmmoBag(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s RangedAttackHit) AmmoSlot() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s RangedAttackHit) SetAmmoSlot(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+func (s RangedAttackHit) AmmoLeft() int32 {
+	return int32(capnp.Struct(s).Uint32(20))
+}
+
+func (s RangedAttackHit) SetAmmoLeft(v int32) {
+	capnp.Struct(s).SetUint32(20, uint32(v))
+}
+
+// RangedAttackHit_List is a list of RangedAttackHit.
+type RangedAttackHit_List = capnp.StructList[RangedAttackHit]
+
+// NewRangedAttackHit creates a new list of RangedAttackHit.
+func NewRangedAttackHit_List(s *capnp.Segment, sz int32) (RangedAttackHit_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[RangedAttackHit](l), err
+}
+
+// RangedAttackHit_Future is a wrapper for a RangedAttackHit promised by a client call.
+type RangedAttackHit_Future struct{ *capnp.Future }
+
+func (f RangedAttackHit_Future) Struct() (RangedAttackHit, error) {
+	p, err := f.Future.Ptr()
+	return RangedAttackHit(p.Struct()), err
+}
+
+type GroupMemberInfo capnp.Struct
+
+// GroupMemberInfo_TypeID is the unique identifier for the type GroupMemberInfo.
+const GroupMemberInfo_TypeID = 0xa160c0ca525d295c
+
+func NewGroupMemberInfo(s *capnp.Segment) (GroupMemberInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return GroupMemberInfo(st), err
+}
+
+func NewRootGroupMemberInfo(s *capnp.Segment) (GroupMemberInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return GroupMemberInfo(st), err
+}
+
+func ReadRootGroupMemberInfo(msg *capnp.Message) (GroupMemberInfo, error) {
+	root, err := msg.Root()
+	return GroupMemberInfo(root.Struct()), err
+}
+
+func (s GroupMemberInfo) String() string {
+	str, _ := text.Marshal(0xa160c0ca525d295c, capnp.Struct(s))
+	return str
+}
+
+func (s GroupMemberInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (GroupMemberInfo) DecodeFromPtr(p capnp.Ptr) GroupMemberInfo {
+	return GroupMemberInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s GroupMemberInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s GroupMemberInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s GroupMemberInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s GroupMemberInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s GroupMemberInfo) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s GroupMemberInfo) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s GroupMemberInfo) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s GroupMemberInfo) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s GroupMemberInfo) Level() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s GroupMemberInfo) SetLevel(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s GroupMemberInfo) Class() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s GroupMemberInfo) SetClass(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s GroupMemberInfo) Hp() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s GroupMemberInfo) SetHp(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s GroupMemberInfo) MaxHp() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s GroupMemberInfo) SetMaxHp(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s GroupMemberInfo) ZoneId() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s GroupMemberInfo) SetZoneId(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+// GroupMemberInfo_List is a list of GroupMemberInfo.
+type GroupMemberInfo_List = capnp.StructList[GroupMemberInfo]
+
+// NewGroupMemberInfo creates a new list of GroupMemberInfo.
+func NewGroupMemberInfo_List(s *capnp.Segment, sz int32) (GroupMemberInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[GroupMemberInfo](l), err
+}
+
+// GroupMemberInfo_Future is a wrapper for a GroupMemberInfo promised by a client call.
+type GroupMemberInfo_Future struct{ *capnp.Future }
+
+func (f GroupMemberInfo_Future) Struct() (GroupMemberInfo, error) {
+	p, err := f.Future.Ptr()
+	return GroupMemberInfo(p.Struct()), err
+}
+
+type GroupStatus capnp.Struct
+
+// GroupStatus_TypeID is the unique identifier for the type GroupStatus.
+const GroupStatus_TypeID = 0xe39f1f4235358285
+
+func NewGroupStatus(s *capnp.Segment) (GroupStatus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return GroupStatus(st), err
+}
+
+func NewRootGroupStatus(s *capnp.Segment) (GroupStatus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return GroupStatus(st), err
+}
+
+func ReadRootGroupStatus(msg *capnp.Message) (GroupStatus, error) {
+	root, err := msg.Root()
+	return GroupStatus(root.Struct()), err
+}
+
+func (s GroupStatus) String() string {
+	str, _ := text.Marshal(0xe39f1f4235358285, capnp.Struct(s))
+	return str
+}
+
+func (s GroupStatus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (GroupStatus) DecodeFromPtr(p capnp.Ptr) GroupStatus {
+	return GroupStatus(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s GroupStatus) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s GroupStatus) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s GroupStatus) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s GroupStatus) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s GroupStatus) GroupId() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s GroupStatus) SetGroupId(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s GroupStatus) Leader() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s GroupStatus) HasLeader() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s GroupStatus) LeaderBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s GroupStatus) SetLeader(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s GroupStatus) LootMode() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s GroupStatus) HasLootMode() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s GroupStatus) LootModeBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s GroupStatus) SetLootMode(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s GroupStatus) Members() (GroupMemberInfo_List, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return GroupMemberInfo_List(p.List()), err
+}
+
+func (s GroupStatus) HasMembers() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s GroupStatus) SetMembers(v GroupMemberInfo_List) error {
+	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+}
+
+// NewMembers sets the members field to a newly
+// allocated GroupMemberInfo_List, preferring placement in s's segment.
+func (s GroupStatus) NewMembers(n int32) (GroupMemberInfo_List, error) {
+	l, err := NewGroupMemberInfo_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return GroupMemberInfo_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
+	return l, err
+}
+
+// GroupStatus_List is a list of GroupStatus.
+type GroupStatus_List = capnp.StructList[GroupStatus]
+
+// NewGroupStatus creates a new list of GroupStatus.
+func NewGroupStatus_List(s *capnp.Segment, sz int32) (GroupStatus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return capnp.StructList[GroupStatus](l), err
+}
+
+// GroupStatus_Future is a wrapper for a GroupStatus promised by a client call.
+type GroupStatus_Future struct{ *capnp.Future }
+
+func (f GroupStatus_Future) Struct() (GroupStatus, error) {
+	p, err := f.Future.Ptr()
+	return GroupStatus(p.Struct()), err
+}
+
+type RaidMemberStatus capnp.Struct
+
+// RaidMemberStatus_TypeID is the unique identifier for the type RaidMemberStatus.
+const RaidMemberStatus_TypeID = 0xcc32c9cd75e06fc9
+
+func NewRaidMemberStatus(s *capnp.Segment) (RaidMemberStatus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
+	return RaidMemberStatus(st), err
+}
+
+func NewRootRaidMemberStatus(s *capnp.Segment) (RaidMemberStatus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
+	return RaidMemberStatus(st), err
+}
+
+func ReadRootRaidMemberStatus(msg *capnp.Message) (RaidMemberStatus, error) {
+	root, err := msg.Root()
+	return RaidMemberStatus(root.Struct()), err
+}
+
+func (s RaidMemberStatus) String() string {
+	str, _ := text.Marshal(0xcc32c9cd75e06fc9, capnp.Struct(s))
+	return str
+}
+
+func (s RaidMemberStatus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (RaidMemberStatus) DecodeFromPtr(p capnp.Ptr) RaidMemberStatus {
+	return RaidMemberStatus(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s RaidMemberStatus) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s RaidMemberStatus) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s RaidMemberStatus) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s RaidMemberStatus) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s RaidMemberStatus) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s RaidMemberStatus) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s RaidMemberStatus) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s RaidMemberStatus) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s RaidMemberStatus) Level() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s RaidMemberStatus) SetLevel(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s RaidMemberStatus) Class() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s RaidMemberStatus) SetClass(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s RaidMemberStatus) ZoneId() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s RaidMemberStatus) SetZoneId(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s RaidMemberStatus) GroupId() int64 {
+	return int64(capnp.Struct(s).Uint64(16))
+}
+
+func (s RaidMemberStatus) SetGroupId(v int64) {
+	capnp.Struct(s).SetUint64(16, uint64(v))
+}
+
+func (s RaidMemberStatus) Looter() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s RaidMemberStatus) SetLooter(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s RaidMemberStatus) Online() int32 {
+	return int32(capnp.Struct(s).Uint32(24))
+}
+
+func (s RaidMemberStatus) SetOnline(v int32) {
+	capnp.Struct(s).SetUint32(24, uint32(v))
+}
+
+// RaidMemberStatus_List is a list of RaidMemberStatus.
+type RaidMemberStatus_List = capnp.StructList[RaidMemberStatus]
+
+// NewRaidMemberStatus creates a new list of RaidMemberStatus.
+func NewRaidMemberStatus_List(s *capnp.Segment, sz int32) (RaidMemberStatus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1}, sz)
+	return capnp.StructList[RaidMemberStatus](l), err
+}
+
+// RaidMemberStatus_Future is a wrapper for a RaidMemberStatus promised by a client call.
+type RaidMemberStatus_Future struct{ *capnp.Future }
+
+func (f RaidMemberStatus_Future) Struct() (RaidMemberStatus, error) {
+	p, err := f.Future.Ptr()
+	return RaidMemberStatus(p.Struct()), err
+}
+
+type RaidStatus capnp.Struct
+
+// RaidStatus_TypeID is the unique identifier for the type RaidStatus.
+const RaidStatus_TypeID = 0xf5ea129a0bfa1035
+
+func NewRaidStatus(s *capnp.Segment) (RaidStatus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return RaidStatus(st), err
+}
+
+func NewRootRaidStatus(s *capnp.Segment) (RaidStatus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return RaidStatus(st), err
+}
+
+func ReadRootRaidStatus(msg *capnp.Message) (RaidStatus, error) {
+	root, err := msg.Root()
+	return RaidStatus(root.Struct()), err
+}
+
+func (s RaidStatus) String() string {
+	str, _ := text.Marshal(0xf5ea129a0bfa1035, capnp.Struct(s))
+	return str
+}
+
+func (s RaidStatus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (RaidStatus) DecodeFromPtr(p capnp.Ptr) RaidStatus {
+	return RaidStatus(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s RaidStatus) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s RaidStatus) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s RaidStatus) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s RaidStatus) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s RaidStatus) RaidId() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
+
+func (s RaidStatus) SetRaidId(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
+
+func (s RaidStatus) Leader() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s RaidStatus) HasLeader() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s RaidStatus) LeaderBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s RaidStatus) SetLeader(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s RaidStatus) LootMode() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s RaidStatus) HasLootMode() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s RaidStatus) LootModeBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s RaidStatus) SetLootMode(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s RaidStatus) MinMembers() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s RaidStatus) SetMinMembers(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s RaidStatus) Members() (RaidMemberStatus_List, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return RaidMemberStatus_List(p.List()), err
+}
+
+func (s RaidStatus) HasMembers() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s RaidStatus) SetMembers(v RaidMemberStatus_List) error {
+	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+}
+
+// NewMembers sets the members field to a newly
+// allocated RaidMemberStatus_List, preferring placement in s's segment.
+func (s RaidStatus) NewMembers(n int32) (RaidMemberStatus_List, error) {
+	l, err := NewRaidMemberStatus_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return RaidMemberStatus_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
+	return l, err
+}
+
+// RaidStatus_List is a list of RaidStatus.
+type RaidStatus_List = capnp.StructList[RaidStatus]
+
+// NewRaidStatus creates a new list of RaidStatus.
+func NewRaidStatus_List(s *capnp.Segment, sz int32) (RaidStatus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
+	return capnp.StructList[RaidStatus](l), err
+}
+
+// RaidStatus_Future is a wrapper for a RaidStatus promised by a client call.
+type RaidStatus_Future struct{ *capnp.Future }
+
+func (f RaidStatus_Future) Struct() (RaidStatus, error) {
+	p, err := f.Future.Ptr()
+	return RaidStatus(p.Struct()), err
+}
+
+type GuildCreateRequest capnp.Struct
+
+// GuildCreateRequest_TypeID is the unique identifier for the type GuildCreateRequest.
+const GuildCreateRequest_TypeID = 0xfd88ee75ec56953a
+
+func NewGuildCreateRequest(s *capnp.Segment) (GuildCreateRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return GuildCreateRequest(st), err
+}
+
+func NewRootGuildCreateRequest(s *capnp.Segment) (GuildCreateRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return GuildCreateRequest(st), err
+}
+
+func ReadRootGuildCreateRequest(msg *capnp.Message) (GuildCreateRequest, error) {
+	root, err := msg.Root()
+	return GuildCreateRequest(root.Struct()), err
+}
+
+func (s GuildCreateRequest) String() string {
+	str, _ := text.Marshal(0xfd88ee75ec56953a, capnp.Struct(s))
+	return str
+}
+
+func (s GuildCreateRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (GuildCreateRequest) DecodeFromPtr(p capnp.Ptr) GuildCreateRequest {
+	return GuildCreateRequest(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s GuildCreateRequest) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s GuildCreateRequest) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s GuildCreateRequest) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s GuildCreateRequest) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s GuildCreateRequest) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s GuildCreateRequest) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s GuildCreateRequest) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s GuildCreateRequest) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// GuildCreateRequest_List is a list of GuildCreateRequest.
+type GuildCreateRequest_List = capnp.StructList[GuildCreateRequest]
+
+// NewGuildCreateRequest creates a new list of GuildCreateRequest.
+func NewGuildCreateRequest_List(s *capnp.Segment, sz int32) (GuildCreateRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[GuildCreateRequest](l), err
+}
+
+// GuildCreateRequest_Future is a wrapper for a GuildCreateRequest promised by a client call.
+type GuildCreateRequest_Future struct{ *capnp.Future }
+
+func (f GuildCreateRequest_Future) Struct() (GuildCreateRequest, error) {
+	p, err := f.Future.Ptr()
+	return GuildCreateRequest(p.Struct()), err
+}
+
+type GuildRankInfo capnp.Struct
+
+// GuildRankInfo_TypeID is the unique identifier for the type GuildRankInfo.
+const GuildRankInfo_TypeID = 0x8dc15a5530df753d
+
+func NewGuildRankInfo(s *capnp.Segment) (GuildRankInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return GuildRankInfo(st), err
+}
+
+func NewRootGuildRankInfo(s *capnp.Segment) (GuildRankInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return GuildRankInfo(st), err
+}
+
+func ReadRootGuildRankInfo(msg *capnp.Message) (GuildRankInfo, error) {
+	root, err := msg.Root()
+	return GuildRankInfo(root.Struct()), err
+}
+
+func (s GuildRankInfo) String() string {
+	str, _ := text.Marshal(0x8dc15a5530df753d, capnp.Struct(s))
+	return str
+}
+
+func (s GuildRankInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (GuildRankInfo) DecodeFromPtr(p capnp.Ptr) GuildRankInfo {
+	return GuildRankInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s GuildRankInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s GuildRankInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s GuildRankInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s GuildRankInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s GuildRankInfo) Rank() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s GuildRankInfo) SetRank(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s GuildRankInfo) Title() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s GuildRankInfo) HasTitle() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s GuildRankInfo) TitleBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s GuildRankInfo) SetTitle(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s GuildRankInfo) Permissions() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s GuildRankInfo) SetPermissions(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+// GuildRankInfo_List is a list of GuildRankInfo.
+type GuildRankInfo_List = capnp.StructList[GuildRankInfo]
+
+// NewGuildRankInfo creates a new list of GuildRankInfo.
+func NewGuildRankInfo_List(s *capnp.Segment, sz int32) (GuildRankInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[GuildRankInfo](l), err
+}
+
+// GuildRankInfo_Future is a wrapper for a GuildRankInfo promised by a client call.
+type GuildRankInfo_Future struct{ *capnp.Future }
+
+func (f GuildRankInfo_Future) Struct() (GuildRankInfo, error) {
+	p, err := f.Future.Ptr()
+	return GuildRankInfo(p.Struct()), err
+}
+
+type GuildInfo capnp.Struct
+
+// GuildInfo_TypeID is the unique identifier for the type GuildInfo.
+const GuildInfo_TypeID = 0xf78f5141a05c8566
+
+func NewGuildInfo(s *capnp.Segment) (GuildInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return GuildInfo(st), err
+}
+
+func NewRootGuildInfo(s *capnp.Segment) (GuildInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return GuildInfo(st), err
+}
+
+func ReadRootGuildInfo(msg *capnp.Message) (GuildInfo, error) {
+	root, err := msg.Root()
+	return GuildInfo(root.Struct()), err
+}
+
+func (s GuildInfo) String() string {
+	str, _ := text.Marshal(0xf78f5141a05c8566, capnp.Struct(s))
+	return str
+}
+
+func (s GuildInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (GuildInfo) DecodeFromPtr(p capnp.Ptr) GuildInfo {
+	return GuildInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s GuildInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s GuildInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s GuildInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s GuildInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s GuildInfo) GuildId() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s GuildInfo) SetGuildId(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s GuildInfo) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s GuildInfo) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s GuildInfo) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s GuildInfo) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s GuildInfo) Rank() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s GuildInfo) SetRank(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s GuildInfo) Motd() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s GuildInfo) HasMotd() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s GuildInfo) MotdBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s GuildInfo) SetMotd(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s GuildInfo) MotdSetter() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s GuildInfo) HasMotdSetter() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s GuildInfo) MotdSetterBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s GuildInfo) SetMotdSetter(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
+func (s GuildInfo) Ranks() (GuildRankInfo_List, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return GuildRankInfo_List(p.List()), err
+}
+
+func (s GuildInfo) HasRanks() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s GuildInfo) SetRanks(v GuildRankInfo_List) error {
+	return capnp.Struct(s).SetPtr(3, v.ToPtr())
+}
+
+// NewRanks sets the ranks field to a newly
+// allocated GuildRankInfo_List, preferring placement in s's segment.
+func (s GuildInfo) NewRanks(n int32) (GuildRankInfo_List, error) {
+	l, err := NewGuildRankInfo_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return GuildRankInfo_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
+	return l, err
+}
+
+// GuildInfo_List is a list of GuildInfo.
+type GuildInfo_List = capnp.StructList[GuildInfo]
+
+// NewGuildInfo creates a new list of GuildInfo.
+func NewGuildInfo_List(s *capnp.Segment, sz int32) (GuildInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
+	return capnp.StructList[GuildInfo](l), err
+}
+
+// GuildInfo_Future is a wrapper for a GuildInfo promised by a client call.
+type GuildInfo_Future struct{ *capnp.Future }
+
+func (f GuildInfo_Future) Struct() (GuildInfo, error) {
+	p, err := f.Future.Ptr()
+	return GuildInfo(p.Struct()), err
+}
+
+type GuildPermissionUpdate capnp.Struct
+
+// GuildPermissionUpdate_TypeID is the unique identifier for the type GuildPermissionUpdate.
+const GuildPermissionUpdate_TypeID = 0xabedabc85e222f6e
+
+func NewGuildPermissionUpdate(s *capnp.Segment) (GuildPermissionUpdate, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return GuildPermissionUpdate(st), err
+}
+
+func NewRootGuildPermissionUpdate(s *capnp.Segment) (GuildPermissionUpdate, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return GuildPermissionUpdate(st), err
+}
+
+func ReadRootGuildPermissionUpdate(msg *capnp.Message) (GuildPermissionUpdate, error) {
+	root, err := msg.Root()
+	return GuildPermissionUpdate(root.Struct()), err
+}
+
+func (s GuildPermissionUpdate) String() string {
+	str, _ := text.Marshal(0xabedabc85e222f6e, capnp.Struct(s))
+	return str
+}
+
+func (s GuildPermissionUpdate) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (GuildPermissionUpdate) DecodeFromPtr(p capnp.Ptr) GuildPermissionUpdate {
+	return GuildPermissionUpdate(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s GuildPermissionUpdate) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s GuildPermissionUpdate) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s GuildPermissionUpdate) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s GuildPermissionUpdate) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s GuildPermissionUpdate) Permission() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s GuildPermissionUpdate) SetPermission(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s GuildPermissionUpdate) Rank() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s GuildPermissionUpdate) SetRank(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s GuildPermissionUpdate) Allowed() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s GuildPermissionUpdate) SetAllowed(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+// GuildPermissionUpdate_List is a list of GuildPermissionUpdate.
+type GuildPermissionUpdate_List = capnp.StructList[GuildPermissionUpdate]
+
+// NewGuildPermissionUpdate creates a new list of GuildPermissionUpdate.
+func NewGuildPermissionUpdate_List(s *capnp.Segment, sz int32) (GuildPermissionUpdate_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[GuildPermissionUpdate](l), err
+}
+
+// GuildPermissionUpdate_Future is a wrapper for a GuildPermissionUpdate promised by a client call.
+type GuildPermissionUpdate_Future struct{ *capnp.Future }
+
+func (f GuildPermissionUpdate_Future) Struct() (GuildPermissionUpdate, error) {
+	p, err := f.Future.Ptr()
+	return GuildPermissionUpdate(p.Struct()), err
+}
+
+type TradeItemOffer capnp.Struct
+
+// TradeItemOffer_TypeID is the unique identifier for the type TradeItemOffer.
+const TradeItemOffer_TypeID = 0xb09bd8a1ad1ed10f
+
+func NewTradeItemOffer(s *capnp.Segment) (TradeItemOffer, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return TradeItemOffer(st), err
+}
+
+func NewRootTradeItemOffer(s *capnp.Segment) (TradeItemOffer, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return TradeItemOffer(st), err
+}
+
+func ReadRootTradeItemOffer(msg *capnp.Message) (TradeItemOffer, error) {
+	root, err := msg.Root()
+	return TradeItemOffer(root.Struct()), err
+}
+
+func (s TradeItemOffer) String() string {
+	str, _ := text.Marshal(0xb09bd8a1ad1ed10f, capnp.Struct(s))
+	return str
+}
+
+func (s TradeItemOffer) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (TradeItemOffer) DecodeFromPtr(p capnp.Ptr) TradeItemOffer {
+	return TradeItemOffer(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s TradeItemOffer) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s TradeItemOffer) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s TradeItemOffer) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s TradeItemOffer) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s TradeItemOffer) Slot() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s TradeItemOffer) SetSlot(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s TradeItemOffer) BagSlot() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s TradeItemOffer) SetBagSlot(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s TradeItemOffer) Remove() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s TradeItemOffer) SetRemove(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+// TradeItemOffer_List is a list of TradeItemOffer.
+type TradeItemOffer_List = capnp.StructList[TradeItemOffer]
+
+// NewTradeItemOffer creates a new list of TradeItemOffer.
+func NewTradeItemOffer_List(s *capnp.Segment, sz int32) (TradeItemOffer_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[TradeItemOffer](l), err
+}
+
+// TradeItemOffer_Future is a wrapper for a TradeItemOffer promised by a client call.
+type TradeItemOffer_Future struct{ *capnp.Future }
+
+func (f TradeItemOffer_Future) Struct() (TradeItemOffer, error) {
+	p, err := f.Future.Ptr()
+	return TradeItemOffer(p.Struct()), err
+}
+
+type TradeItemInfo capnp.Struct
+
+// TradeItemInfo_TypeID is the unique identifier for the type TradeItemInfo.
+const TradeItemInfo_TypeID = 0x8b4a5b60a0196e49
+
+func NewTradeItemInfo(s *capnp.Segment) (TradeItemInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return TradeItemInfo(st), err
+}
+
+func NewRootTradeItemInfo(s *capnp.Segment) (TradeItemInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return TradeItemInfo(st), err
+}
+
+func ReadRootTradeItemInfo(msg *capnp.Message) (TradeItemInfo, error) {
+	root, err := msg.Root()
+	return TradeItemInfo(root.Struct()), err
+}
+
+func (s TradeItemInfo) String() string {
+	str, _ := text.Marshal(0x8b4a5b60a0196e49, capnp.Struct(s))
+	return str
+}
+
+func (s TradeItemInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (TradeItemInfo) DecodeFromPtr(p capnp.Ptr) TradeItemInfo {
+	return TradeItemInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s TradeItemInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s TradeItemInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s TradeItemInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s TradeItemInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s TradeItemInfo) Slot() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s TradeItemInfo) SetSlot(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s TradeItemInfo) BagSlot() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s TradeItemInfo) SetBagSlot(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s TradeItemInfo) ItemId() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s TradeItemInfo) SetItemId(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s TradeItemInfo) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s TradeItemInfo) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s TradeItemInfo) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s TradeItemInfo) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s TradeItemInfo) Icon() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s TradeItemInfo) SetIcon(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s TradeItemInfo) Charges() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s TradeItemInfo) SetCharges(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+// TradeItemInfo_List is a list of TradeItemInfo.
+type TradeItemInfo_List = capnp.StructList[TradeItemInfo]
+
+// NewTradeItemInfo creates a new list of TradeItemInfo.
+func NewTradeItemInfo_List(s *capnp.Segment, sz int32) (TradeItemInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[TradeItemInfo](l), err
+}
+
+// TradeItemInfo_Future is a wrapper for a TradeItemInfo promised by a client call.
+type TradeItemInfo_Future struct{ *capnp.Future }
+
+func (f TradeItemInfo_Future) Struct() (TradeItemInfo, error) {
+	p, err := f.Future.Ptr()
+	return TradeItemInfo(p.Struct()), err
+}
+
+type TradeOfferInfo capnp.Struct
+
+// TradeOfferInfo_TypeID is the unique identifier for the type TradeOfferInfo.
+const TradeOfferInfo_TypeID = 0xd228a5a668861786
+
+func NewTradeOfferInfo(s *capnp.Segment) (TradeOfferInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return TradeOfferInfo(st), err
+}
+
+func NewRootTradeOfferInfo(s *capnp.Segment) (TradeOfferInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return TradeOfferInfo(st), err
+}
+
+func ReadRootTradeOfferInfo(msg *capnp.Message) (TradeOfferInfo, error) {
+	root, err := msg.Root()
+	return TradeOfferInfo(root.Struct()), err
+}
+
+func (s TradeOfferInfo) String() string {
+	str, _ := text.Marshal(0xd228a5a668861786, capnp.Struct(s))
+	return str
+}
+
+func (s TradeOfferInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (TradeOfferInfo) DecodeFromPtr(p capnp.Ptr) TradeOfferInfo {
+	return TradeOfferInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s TradeOfferInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s TradeOfferInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s TradeOfferInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s TradeOfferInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s TradeOfferInfo) Items() (TradeItemInfo_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return TradeItemInfo_List(p.List()), err
+}
+
+func (s TradeOfferInfo) HasItems() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s TradeOfferInfo) SetItems(v TradeItemInfo_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewItems sets the items field to a newly
+// allocated TradeItemInfo_List, preferring placement in s's segment.
+func (s TradeOfferInfo) NewItems(n int32) (TradeItemInfo_List, error) {
+	l, err := NewTradeItemInfo_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return TradeItemInfo_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s TradeOfferInfo) Platinum() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s TradeOfferInfo) SetPlatinum(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s TradeOfferInfo) Gold() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s TradeOfferInfo) SetGold(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s TradeOfferInfo) Silver() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s TradeOfferInfo) SetSilver(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s TradeOfferInfo) Copper() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s TradeOfferInfo) SetCopper(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s TradeOfferInfo) Accepted() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s TradeOfferInfo) SetAccepted(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+// TradeOfferInfo_List is a list of TradeOfferInfo.
+type TradeOfferInfo_List = capnp.StructList[TradeOfferInfo]
+
+// NewTradeOfferInfo creates a new list of TradeOfferInfo.
+func NewTradeOfferInfo_List(s *capnp.Segment, sz int32) (TradeOfferInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[TradeOfferInfo](l), err
+}
+
+// TradeOfferInfo_Future is a wrapper for a TradeOfferInfo promised by a client call.
+type TradeOfferInfo_Future struct{ *capnp.Future }
+
+func (f TradeOfferInfo_Future) Struct() (TradeOfferInfo, error) {
+	p, err := f.Future.Ptr()
+	return TradeOfferInfo(p.Struct()), err
+}
+
+type TradeStatus capnp.Struct
+
+// TradeStatus_TypeID is the unique identifier for the type TradeStatus.
+const TradeStatus_TypeID = 0x912df186f42698b4
+
+func NewTradeStatus(s *capnp.Segment) (TradeStatus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return TradeStatus(st), err
+}
+
+func NewRootTradeStatus(s *capnp.Segment) (TradeStatus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return TradeStatus(st), err
+}
+
+func ReadRootTradeStatus(msg *capnp.Message) (TradeStatus, error) {
+	root, err := msg.Root()
+	return TradeStatus(root.Struct()), err
+}
+
+func (s TradeStatus) String() string {
+	str, _ := text.Marshal(0x912df186f42698b4, capnp.Struct(s))
+	return str
+}
+
+func (s TradeStatus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (TradeStatus) DecodeFromPtr(p capnp.Ptr) TradeStatus {
+	return TradeStatus(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s TradeStatus) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s TradeStatus) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s TradeStatus) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s TradeStatus) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s TradeStatus) State() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s TradeStatus) SetState(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s TradeStatus) PartnerName() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s TradeStatus) HasPartnerName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s TradeStatus) PartnerNameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s TradeStatus) SetPartnerName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s TradeStatus) Mine() (TradeOfferInfo, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return TradeOfferInfo(p.Struct()), err
+}
+
+func (s TradeStatus) HasMine() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s TradeStatus) SetMine(v TradeOfferInfo) error {
+	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+}
+
+// NewMine sets the mine field to a newly
+// allocated TradeOfferInfo struct, preferring placement in s's segment.
+func (s TradeStatus) NewMine() (TradeOfferInfo, error) {
+	ss, err := NewTradeOfferInfo(capnp.Struct(s).Segment())
+	if err != nil {
+		return TradeOfferInfo{}, err
+	}
+	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+func (s TradeStatus) Theirs() (TradeOfferInfo, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return TradeOfferInfo(p.Struct()), err
+}
+
+func (s TradeStatus) HasTheirs() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s TradeStatus) SetTheirs(v TradeOfferInfo) error {
+	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
+}
+
+// NewTheirs sets the theirs field to a newly
+// allocated TradeOfferInfo struct, preferring placement in s's segment.
+func (s TradeStatus) NewTheirs() (TradeOfferInfo, error) {
+	ss, err := NewTradeOfferInfo(capnp.Struct(s).Segment())
+	if err != nil {
+		return TradeOfferInfo{}, err
+	}
+	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
+	return ss, err
+}
+
+// TradeStatus_List is a list of TradeStatus.
+type TradeStatus_List = capnp.StructList[TradeStatus]
+
+// NewTradeStatus creates a new list of TradeStatus.
+func NewTradeStatus_List(s *capnp.Segment, sz int32) (TradeStatus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return capnp.StructList[TradeStatus](l), err
+}
+
+// TradeStatus_Future is a wrapper for a TradeStatus promised by a client call.
+type TradeStatus_Future struct{ *capnp.Future }
+
+func (f TradeStatus_Future) Struct() (TradeStatus, error) {
+	p, err := f.Future.Ptr()
+	return TradeStatus(p.Struct()), err
+}
+func (p TradeStatus_Future) Mine() TradeOfferInfo_Future {
+	return TradeOfferInfo_Future{Future: p.Future.Field(1, nil)}
+}
+func (p TradeStatus_Future) Theirs() TradeOfferInfo_Future {
+	return TradeOfferInfo_Future{Future: p.Future.Field(2, nil)}
+}
+
+type ChatChannelRequest capnp.Struct
+
+// ChatChannelRequest_TypeID is the unique identifier for the type ChatChannelRequest.
+const ChatChannelRequest_TypeID = 0xb646c486ebcbf5e6
+
+func NewChatChannelRequest(s *capnp.Segment) (ChatChannelRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return ChatChannelRequest(st), err
+}
+
+func NewRootChatChannelRequest(s *capnp.Segment) (ChatChannelRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return ChatChannelRequest(st), err
+}
+
+func ReadRootChatChannelRequest(msg *capnp.Message) (ChatChannelRequest, error) {
+	root, err := msg.Root()
+	return ChatChannelRequest(root.Struct()), err
+}
+
+func (s ChatChannelRequest) String() string {
+	str, _ := text.Marshal(0xb646c486ebcbf5e6, capnp.Struct(s))
+	return str
+}
+
+func (s ChatChannelRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChatChannelRequest) DecodeFromPtr(p capnp.Ptr) ChatChannelRequest {
+	return ChatChannelRequest(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChatChannelRequest) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChatChannelRequest) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChatChannelRequest) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChatChannelRequest) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ChatChannelRequest) Action() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s ChatChannelRequest) SetAction(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s ChatChannelRequest) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s ChatChannelRequest) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ChatChannelRequest) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s ChatChannelRequest) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s ChatChannelRequest) Password() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s ChatChannelRequest) HasPassword() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s ChatChannelRequest) PasswordBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s ChatChannelRequest) SetPassword(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+// ChatChannelRequest_List is a list of ChatChannelRequest.
+type ChatChannelRequest_List = capnp.StructList[ChatChannelRequest]
+
+// NewChatChannelRequest creates a new list of ChatChannelRequest.
+func NewChatChannelRequest_List(s *capnp.Segment, sz int32) (ChatChannelRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[ChatChannelRequest](l), err
+}
+
+// ChatChannelRequest_Future is a wrapper for a ChatChannelRequest promised by a client call.
+type ChatChannelRequest_Future struct{ *capnp.Future }
+
+func (f ChatChannelRequest_Future) Struct() (ChatChannelRequest, error) {
+	p, err := f.Future.Ptr()
+	return ChatChannelRequest(p.Struct()), err
+}
+
+type ChatChannelList capnp.Struct
+
+// ChatChannelList_TypeID is the unique identifier for the type ChatChannelList.
+const ChatChannelList_TypeID = 0xb332c883f556a2c0
+
+func NewChatChannelList(s *capnp.Segment) (ChatChannelList, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ChatChannelList(st), err
+}
+
+func NewRootChatChannelList(s *capnp.Segment) (ChatChannelList, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ChatChannelList(st), err
+}
+
+func ReadRootChatChannelList(msg *capnp.Message) (ChatChannelList, error) {
+	root, err := msg.Root()
+	return ChatChannelList(root.Struct()), err
+}
+
+func (s ChatChannelList) String() string {
+	str, _ := text.Marshal(0xb332c883f556a2c0, capnp.Struct(s))
+	return str
+}
+
+func (s ChatChannelList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChatChannelList) DecodeFromPtr(p capnp.Ptr) ChatChannelList {
+	return ChatChannelList(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChatChannelList) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChatChannelList) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChatChannelList) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChatChannelList) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ChatChannelList) Channels() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
+}
+
+func (s ChatChannelList) HasChannels() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ChatChannelList) SetChannels(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewChannels sets the channels field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s ChatChannelList) NewChannels(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// ChatChannelList_List is a list of ChatChannelList.
+type ChatChannelList_List = capnp.StructList[ChatChannelList]
+
+// NewChatChannelList creates a new list of ChatChannelList.
+func NewChatChannelList_List(s *capnp.Segment, sz int32) (ChatChannelList_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[ChatChannelList](l), err
+}
+
+// ChatChannelList_Future is a wrapper for a ChatChannelList promised by a client call.
+type ChatChannelList_Future struct{ *capnp.Future }
+
+func (f ChatChannelList_Future) Struct() (ChatChannelList, error) {
+	p, err := f.Future.Ptr()
+	return ChatChannelList(p.Struct()), err
+}
+
+type ChatModerationRequest capnp.Struct
+
+// ChatModerationRequest_TypeID is the unique identifier for the type ChatModerationRequest.
+const ChatModerationRequest_TypeID = 0xc61e43f35673e291
+
+func NewChatModerationRequest(s *capnp.Segment) (ChatModerationRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ChatModerationRequest(st), err
+}
+
+func NewRootChatModerationRequest(s *capnp.Segment) (ChatModerationRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ChatModerationRequest(st), err
+}
+
+func ReadRootChatModerationRequest(msg *capnp.Message) (ChatModerationRequest, error) {
+	root, err := msg.Root()
+	return ChatModerationRequest(root.Struct()), err
+}
+
+func (s ChatModerationRequest) String() string {
+	str, _ := text.Marshal(0xc61e43f35673e291, capnp.Struct(s))
+	return str
+}
+
+func (s ChatModerationRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChatModerationRequest) DecodeFromPtr(p capnp.Ptr) ChatModerationRequest {
+	return ChatModerationRequest(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChatModerationRequest) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChatModerationRequest) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChatModerationRequest) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChatModerationRequest) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ChatModerationRequest) Action() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s ChatModerationRequest) SetAction(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s ChatModerationRequest) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s ChatModerationRequest) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ChatModerationRequest) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s ChatModerationRequest) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s ChatModerationRequest) Minutes() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s ChatModerationRequest) SetMinutes(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+// ChatModerationRequest_List is a list of ChatModerationRequest.
+type ChatModerationRequest_List = capnp.StructList[ChatModerationRequest]
+
+// NewChatModerationRequest creates a new list of ChatModerationRequest.
+func NewChatModerationRequest_List(s *capnp.Segment, sz int32) (ChatModerationRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[ChatModerationRequest](l), err
+}
+
+// ChatModerationRequest_Future is a wrapper for a ChatModerationRequest promised by a client call.
+type ChatModerationRequest_Future struct{ *capnp.Future }
+
+func (f ChatModerationRequest_Future) Struct() (ChatModerationRequest, error) {
+	p, err := f.Future.Ptr()
+	return ChatModerationRequest(p.Struct()), err
+}
+
+type ChatIgnoreList capnp.Struct
+
+// ChatIgnoreList_TypeID is the unique identifier for the type ChatIgnoreList.
+const ChatIgnoreList_TypeID = 0x87b505340222092e
+
+func NewChatIgnoreList(s *capnp.Segment) (ChatIgnoreList, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ChatIgnoreList(st), err
+}
+
+func NewRootChatIgnoreList(s *capnp.Segment) (ChatIgnoreList, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ChatIgnoreList(st), err
+}
+
+func ReadRootChatIgnoreList(msg *capnp.Message) (ChatIgnoreList, error) {
+	root, err := msg.Root()
+	return ChatIgnoreList(root.Struct()), err
+}
+
+func (s ChatIgnoreList) String() string {
+	str, _ := text.Marshal(0x87b505340222092e, capnp.Struct(s))
+	return str
+}
+
+func (s ChatIgnoreList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChatIgnoreList) DecodeFromPtr(p capnp.Ptr) ChatIgnoreList {
+	return ChatIgnoreList(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChatIgnoreList) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChatIgnoreList) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChatIgnoreList) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChatIgnoreList) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ChatIgnoreList) Names() (capnp.TextList, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return capnp.TextList(p.List()), err
+}
+
+func (s ChatIgnoreList) HasNames() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ChatIgnoreList) SetNames(v capnp.TextList) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewNames sets the names field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s ChatIgnoreList) NewNames(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// ChatIgnoreList_List is a list of ChatIgnoreList.
+type ChatIgnoreList_List = capnp.StructList[ChatIgnoreList]
+
+// NewChatIgnoreList creates a new list of ChatIgnoreList.
+func NewChatIgnoreList_List(s *capnp.Segment, sz int32) (ChatIgnoreList_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[ChatIgnoreList](l), err
+}
+
+// ChatIgnoreList_Future is a wrapper for a ChatIgnoreList promised by a client call.
+type ChatIgnoreList_Future struct{ *capnp.Future }
+
+func (f ChatIgnoreList_Future) Struct() (ChatIgnoreList, error) {
+	p, err := f.Future.Ptr()
+	return ChatIgnoreList(p.Struct()), err
+}
+
+type ChatReport capnp.Struct
+
+// ChatReport_TypeID is the unique identifier for the type ChatReport.
+const ChatReport_TypeID = 0xe8f4085bcd9c6dcd
+
+func NewChatReport(s *capnp.Segment) (ChatReport, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return ChatReport(st), err
+}
+
+func NewRootChatReport(s *capnp.Segment) (ChatReport, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return ChatReport(st), err
+}
+
+func ReadRootChatReport(msg *capnp.Message) (ChatReport, error) {
+	root, err := msg.Root()
+	return ChatReport(root.Struct()), err
+}
+
+func (s ChatReport) String() string {
+	str, _ := text.Marshal(0xe8f4085bcd9c6dcd, capnp.Struct(s))
+	return str
+}
+
+func (s ChatReport) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ChatReport) DecodeFromPtr(p capnp.Ptr) ChatReport {
+	return ChatReport(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ChatReport) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ChatReport) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ChatReport) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ChatReport) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ChatReport) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s ChatReport) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ChatReport) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s ChatReport) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s ChatReport) Reason() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s ChatReport) HasReason() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s ChatReport) ReasonBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s ChatReport) SetReason(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+// ChatReport_List is a list of ChatReport.
+type ChatReport_List = capnp.StructList[ChatReport]
+
+// NewChatReport creates a new list of ChatReport.
+func NewChatReport_List(s *capnp.Segment, sz int32) (ChatReport_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[ChatReport](l), err
+}
+
+// ChatReport_Future is a wrapper for a ChatReport promised by a client call.
+type ChatReport_Future struct{ *capnp.Future }
+
+func (f ChatReport_Future) Struct() (ChatReport, error) {
+	p, err := f.Future.Ptr()
+	return ChatReport(p.Struct()), err
+}
+
+type FriendRequest capnp.Struct
+
+// FriendRequest_TypeID is the unique identifier for the type FriendRequest.
+const FriendRequest_TypeID = 0xc7de66ce8d7e08ad
+
+func NewFriendRequest(s *capnp.Segment) (FriendRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return FriendRequest(st), err
+}
+
+func NewRootFriendRequest(s *capnp.Segment) (FriendRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return FriendRequest(st), err
+}
+
+func ReadRootFriendRequest(msg *capnp.Message) (FriendRequest, error) {
+	root, err := msg.Root()
+	return FriendRequest(root.Struct()), err
+}
+
+func (s FriendRequest) String() string {
+	str, _ := text.Marshal(0xc7de66ce8d7e08ad, capnp.Struct(s))
+	return str
+}
+
+func (s FriendRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (FriendRequest) DecodeFromPtr(p capnp.Ptr) FriendRequest {
+	return FriendRequest(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s FriendRequest) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s FriendRequest) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s FriendRequest) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s FriendRequest) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s FriendRequest) Action() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s FriendRequest) SetAction(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s FriendRequest) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s FriendRequest) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s FriendRequest) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s FriendRequest) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// FriendRequest_List is a list of FriendRequest.
+type FriendRequest_List = capnp.StructList[FriendRequest]
+
+// NewFriendRequest creates a new list of FriendRequest.
+func NewFriendRequest_List(s *capnp.Segment, sz int32) (FriendRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[FriendRequest](l), err
+}
+
+// FriendRequest_Future is a wrapper for a FriendRequest promised by a client call.
+type FriendRequest_Future struct{ *capnp.Future }
+
+func (f FriendRequest_Future) Struct() (FriendRequest, error) {
+	p, err := f.Future.Ptr()
+	return FriendRequest(p.Struct()), err
+}
+
+type FriendInfo capnp.Struct
+
+// FriendInfo_TypeID is the unique identifier for the type FriendInfo.
+const FriendInfo_TypeID = 0xc683efbf7bffa2b4
+
+func NewFriendInfo(s *capnp.Segment) (FriendInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return FriendInfo(st), err
+}
+
+func NewRootFriendInfo(s *capnp.Segment) (FriendInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return FriendInfo(st), err
+}
+
+func ReadRootFriendInfo(msg *capnp.Message) (FriendInfo, error) {
+	root, err := msg.Root()
+	return FriendInfo(root.Struct()), err
+}
+
+func (s FriendInfo) String() string {
+	str, _ := text.Marshal(0xc683efbf7bffa2b4, capnp.Struct(s))
+	return str
+}
+
+func (s FriendInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (FriendInfo) DecodeFromPtr(p capnp.Ptr) FriendInfo {
+	return FriendInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s FriendInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s FriendInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s FriendInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s FriendInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s FriendInfo) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s FriendInfo) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s FriendInfo) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s FriendInfo) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s FriendInfo) Online() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s FriendInfo) SetOnline(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s FriendInfo) Zone() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s FriendInfo) SetZone(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s FriendInfo) Level() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s FriendInfo) SetLevel(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s FriendInfo) CharClass() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s FriendInfo) SetCharClass(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s FriendInfo) Anon() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s FriendInfo) SetAnon(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+// FriendInfo_List is a list of FriendInfo.
+type FriendInfo_List = capnp.StructList[FriendInfo]
+
+// NewFriendInfo creates a new list of FriendInfo.
+func NewFriendInfo_List(s *capnp.Segment, sz int32) (FriendInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[FriendInfo](l), err
+}
+
+// FriendInfo_Future is a wrapper for a FriendInfo promised by a client call.
+type FriendInfo_Future struct{ *capnp.Future }
+
+func (f FriendInfo_Future) Struct() (FriendInfo, error) {
+	p, err := f.Future.Ptr()
+	return FriendInfo(p.Struct()), err
+}
+
+type FriendList capnp.Struct
+
+// FriendList_TypeID is the unique identifier for the type FriendList.
+const FriendList_TypeID = 0xdea59dd927f499c3
+
+func NewFriendList(s *capnp.Segment) (FriendList, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return FriendList(st), err
+}
+
+func NewRootFriendList(s *capnp.Segment) (FriendList, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return FriendList(st), err
+}
+
+func ReadRootFriendList(msg *capnp.Message) (FriendList, error) {
+	root, err := msg.Root()
+	return FriendList(root.Struct()), err
+}
+
+func (s FriendList) String() string {
+	str, _ := text.Marshal(0xdea59dd927f499c3, capnp.Struct(s))
+	return str
+}
+
+func (s FriendList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (FriendList) DecodeFromPtr(p capnp.Ptr) FriendList {
+	return FriendList(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s FriendList) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s FriendList) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s FriendList) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s FriendList) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s FriendList) Friends() (FriendInfo_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return FriendInfo_List(p.List()), err
+}
+
+func (s FriendList) HasFriends() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s FriendList) SetFriends(v FriendInfo_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewFriends sets the friends field to a newly
+// allocated FriendInfo_List, preferring placement in s's segment.
+func (s FriendList) NewFriends(n int32) (FriendInfo_List, error) {
+	l, err := NewFriendInfo_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return FriendInfo_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+
+// FriendList_List is a list of FriendList.
+type FriendList_List = capnp.StructList[FriendList]
+
+// NewFriendList creates a new list of FriendList.
+func NewFriendList_List(s *capnp.Segment, sz int32) (FriendList_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[FriendList](l), err
+}
+
+// FriendList_Future is a wrapper for a FriendList promised by a client call.
+type FriendList_Future struct{ *capnp.Future }
+
+func (f FriendList_Future) Struct() (FriendList, error) {
+	p, err := f.Future.Ptr()
+	return FriendList(p.Struct()), err
+}
+
+type MailAttachmentSlot capnp.Struct
+
+// MailAttachmentSlot_TypeID is the unique identifier for the type MailAttachmentSlot.
+const MailAttachmentSlot_TypeID = 0x965581a04adbcfe6
+
+func NewMailAttachmentSlot(s *capnp.Segment) (MailAttachmentSlot, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return MailAttachmentSlot(st), err
+}
+
+func NewRootMailAttachmentSlot(s *capnp.Segment) (MailAttachmentSlot, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return MailAttachmentSlot(st), err
+}
+
+func ReadRootMailAttachmentSlot(msg *capnp.Message) (MailAttachmentSlot, error) {
+	root, err := msg.Root()
+	return MailAttachmentSlot(root.Struct()), err
+}
+
+func (s MailAttachmentSlot) String() string {
+	str, _ := text.Marshal(0x965581a04adbcfe6, capnp.Struct(s))
+	return str
+}
+
+func (s MailAttachmentSlot) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (MailAttachmentSlot) DecodeFromPtr(p capnp.Ptr) MailAttachmentSlot {
+	return MailAttachmentSlot(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s MailAttachmentSlot) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s MailAttachmentSlot) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s MailAttachmentSlot) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s MailAttachmentSlot) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s MailAttachmentSlot) Slot() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s MailAttachmentSlot) SetSlot(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s MailAttachmentSlot) BagSlot() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s MailAttachmentSlot) SetBagSlot(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+// MailAttachmentSlot_List is a list of MailAttachmentSlot.
+type MailAttachmentSlot_List = capnp.StructList[MailAttachmentSlot]
+
+// NewMailAttachmentSlot creates a new list of MailAttachmentSlot.
+func NewMailAttachmentSlot_List(s *capnp.Segment, sz int32) (MailAttachmentSlot_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[MailAttachmentSlot](l), err
+}
+
+// MailAttachmentSlot_Future is a wrapper for a MailAttachmentSlot promised by a client call.
+type MailAttachmentSlot_Future struct{ *capnp.Future }
+
+func (f MailAttachmentSlot_Future) Struct() (MailAttachmentSlot, error) {
+	p, err := f.Future.Ptr()
+	return MailAttachmentSlot(p.Struct()), err
+}
+
+type MailSendRequest capnp.Struct
+
+// MailSendRequest_TypeID is the unique identifier for the type MailSendRequest.
+const MailSendRequest_TypeID = 0xc61bb50b732910ae
+
+func NewMailSendRequest(s *capnp.Segment) (MailSendRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return MailSendRequest(st), err
+}
+
+func NewRootMailSendRequest(s *capnp.Segment) (MailSendRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return MailSendRequest(st), err
+}
+
+func ReadRootMailSendRequest(msg *capnp.Message) (MailSendRequest, error) {
+	root, err := msg.Root()
+	return MailSendRequest(root.Struct()), err
+}
+
+func (s MailSendRequest) String() string {
+	str, _ := text.Marshal(0xc61bb50b732910ae, capnp.Struct(s))
+	return str
+}
+
+func (s MailSendRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (MailSendRequest) DecodeFromPtr(p capnp.Ptr) MailSendRequest {
+	return MailSendRequest(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s MailSendRequest) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s MailSendRequest) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s MailSendRequest) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s MailSendRequest) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s MailSendRequest) To() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s MailSendRequest) HasTo() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s MailSendRequest) ToBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s MailSendRequest) SetTo(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s MailSendRequest) Subject() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s MailSendRequest) HasSubject() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s MailSendRequest) SubjectBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s MailSendRequest) SetSubject(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s MailSendRequest) Body() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s MailSendRequest) HasBody() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s MailSendRequest) BodyBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s MailSendRequest) SetBody(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
+func (s MailSendRequest) Items() (MailAttachmentSlot_List, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return MailAttachmentSlot_List(p.List()), err
+}
+
+func (s MailSendRequest) HasItems() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s MailSendRequest) SetItems(v MailAttachmentSlot_List) error {
+	return capnp.Struct(s).SetPtr(3, v.ToPtr())
+}
+
+// NewItems sets the items field to a newly
+// allocated MailAttachmentSlot_List, preferring placement in s's segment.
+func (s MailSendRequest) NewItems(n int32) (MailAttachmentSlot_List, error) {
+	l, err := NewMailAttachmentSlot_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return MailAttachmentSlot_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
+	return l, err
+}
+func (s MailSendRequest) Platinum() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s MailSendRequest) SetPlatinum(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s MailSendRequest) Gold() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s MailSendRequest) SetGold(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s MailSendRequest) Silver() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s MailSendRequest) SetSilver(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s MailSendRequest) Copper() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s MailSendRequest) SetCopper(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+// MailSendRequest_List is a list of MailSendRequest.
+type MailSendRequest_List = capnp.StructList[MailSendRequest]
+
+// NewMailSendRequest creates a new list of MailSendRequest.
+func NewMailSendRequest_List(s *capnp.Segment, sz int32) (MailSendRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4}, sz)
+	return capnp.StructList[MailSendRequest](l), err
+}
+
+// MailSendRequest_Future is a wrapper for a MailSendRequest promised by a client call.
+type MailSendRequest_Future struct{ *capnp.Future }
+
+func (f MailSendRequest_Future) Struct() (MailSendRequest, error) {
+	p, err := f.Future.Ptr()
+	return MailSendRequest(p.Struct()), err
+}
+
+type MailActionRequest capnp.Struct
+
+// MailActionRequest_TypeID is the unique identifier for the type MailActionRequest.
+const MailActionRequest_TypeID = 0xe8e34f0364644d08
+
+func NewMailActionRequest(s *capnp.Segment) (MailActionRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return MailActionRequest(st), err
+}
+
+func NewRootMailActionRequest(s *capnp.Segment) (MailActionRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return MailActionRequest(st), err
+}
+
+func ReadRootMailActionRequest(msg *capnp.Message) (MailActionRequest, error) {
+	root, err := msg.Root()
+	return MailActionRequest(root.Struct()), err
+}
+
+func (s MailActionRequest) String() string {
+	str, _ := text.Marshal(0xe8e34f0364644d08, capnp.Struct(s))
+	return str
+}
+
+func (s MailActionRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (MailActionRequest) DecodeFromPtr(p capnp.Ptr) MailActionRequest {
+	return MailActionRequest(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s MailActionRequest) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s MailActionRequest) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s MailActionRequest) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s MailActionRequest) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s MailActionRequest) Action() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s MailActionRequest) SetAction(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s MailActionRequest) Id() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s MailActionRequest) SetId(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+// MailActionRequest_List is a list of MailActionRequest.
+type MailActionRequest_List = capnp.StructList[MailActionRequest]
+
+// NewMailActionRequest creates a new list of MailActionRequest.
+func NewMailActionRequest_List(s *capnp.Segment, sz int32) (MailActionRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[MailActionRequest](l), err
+}
+
+// MailActionRequest_Future is a wrapper for a MailActionRequest promised by a client call.
+type MailActionRequest_Future struct{ *capnp.Future }
+
+func (f MailActionRequest_Future) Struct() (MailActionRequest, error) {
+	p, err := f.Future.Ptr()
+	return MailActionRequest(p.Struct()), err
+}
+
+type MailItemInfo capnp.Struct
+
+// MailItemInfo_TypeID is the unique identifier for the type MailItemInfo.
+const MailItemInfo_TypeID = 0xffd2adfdcf38c652
+
+func NewMailItemInfo(s *capnp.Segment) (MailItemInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return MailItemInfo(st), err
+}
+
+func NewRootMailItemInfo(s *capnp.Segment) (MailItemInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return MailItemInfo(st), err
+}
+
+func ReadRootMailItemInfo(msg *capnp.Message) (MailItemInfo, error) {
+	root, err := msg.Root()
+	return MailItemInfo(root.Struct()), err
+}
+
+func (s MailItemInfo) String() string {
+	str, _ := text.Marshal(0xffd2adfdcf38c652, capnp.Struct(s))
+	return str
+}
+
+func (s MailItemInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (MailItemInfo) DecodeFromPtr(p capnp.Ptr) MailItemInfo {
+	return MailItemInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s MailItemInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s MailItemInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s MailItemInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s MailItemInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s MailItemInfo) ItemId() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s MailItemInfo) SetItemId(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s MailItemInfo) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s MailItemInfo) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s MailItemInfo) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s MailItemInfo) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s MailItemInfo) Icon() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s MailItemInfo) SetIcon(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s MailItemInfo) Charges() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s MailItemInfo) SetCharges(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+// MailItemInfo_List is a list of MailItemInfo.
+type MailItemInfo_List = capnp.StructList[MailItemInfo]
+
+// NewMailItemInfo creates a new list of MailItemInfo.
+func NewMailItemInfo_List(s *capnp.Segment, sz int32) (MailItemInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[MailItemInfo](l), err
+}
+
+// MailItemInfo_Future is a wrapper for a MailItemInfo promised by a client call.
+type MailItemInfo_Future struct{ *capnp.Future }
+
+func (f MailItemInfo_Future) Struct() (MailItemInfo, error) {
+	p, err := f.Future.Ptr()
+	return MailItemInfo(p.Struct()), err
+}
+
+type MailLetter capnp.Struct
+
+// MailLetter_TypeID is the unique identifier for the type MailLetter.
+const MailLetter_TypeID = 0xc947f6cd1e07d9da
+
+func NewMailLetter(s *capnp.Segment) (MailLetter, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 4})
+	return MailLetter(st), err
+}
+
+func NewRootMailLetter(s *capnp.Segment) (MailLetter, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 4})
+	return MailLetter(st), err
+}
+
+func ReadRootMailLetter(msg *capnp.Message) (MailLetter, error) {
+	root, err := msg.Root()
+	return MailLetter(root.Struct()), err
+}
+
+func (s MailLetter) String() string {
+	str, _ := text.Marshal(0xc947f6cd1e07d9da, capnp.Struct(s))
+	return str
+}
+
+func (s MailLetter) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (MailLetter) DecodeFromPtr(p capnp.Ptr) MailLetter {
+	return MailLetter(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s MailLetter) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s MailLetter) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s MailLetter) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s MailLetter) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s MailLetter) Id() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s MailLetter) SetId(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s MailLetter) From() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s MailLetter) HasFrom() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s MailLetter) FromBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s MailLetter) SetFrom(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s MailLetter) Subject() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s MailLetter) HasSubject() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s MailLetter) SubjectBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s MailLetter) SetSubject(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s MailLetter) Body() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s MailLetter) HasBody() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s MailLetter) BodyBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s MailLetter) SetBody(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
+func (s MailLetter) Timestamp() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s MailLetter) SetTimestamp(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+func (s MailLetter) Read() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s MailLetter) SetRead(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s MailLetter) Items() (MailItemInfo_List, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return MailItemInfo_List(p.List()), err
+}
+
+func (s MailLetter) HasItems() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s MailLetter) SetItems(v MailItemInfo_List) error {
+	return capnp.Struct(s).SetPtr(3, v.ToPtr())
+}
+
+// NewItems sets the items field to a newly
+// allocated MailItemInfo_List, preferring placement in s's segment.
+func (s MailLetter) NewItems(n int32) (MailItemInfo_List, error) {
+	l, err := NewMailItemInfo_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return MailItemInfo_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
+	return l, err
+}
+func (s MailLetter) Platinum() int32 {
+	return int32(capnp.Struct(s).Uint32(16))
+}
+
+func (s MailLetter) SetPlatinum(v int32) {
+	capnp.Struct(s).SetUint32(16, uint32(v))
+}
+
+func (s MailLetter) Gold() int32 {
+	return int32(capnp.Struct(s).Uint32(20))
+}
+
+func (s MailLetter) SetGold(v int32) {
+	capnp.Struct(s).SetUint32(20, uint32(v))
+}
+
+func (s MailLetter) Silver() int32 {
+	return int32(capnp.Struct(s).Uint32(24))
+}
+
+func (s MailLetter) SetSilver(v int32) {
+	capnp.Struct(s).SetUint32(24, uint32(v))
+}
+
+func (s MailLetter) Copper() int32 {
+	return int32(capnp.Struct(s).Uint32(28))
+}
+
+func (s MailLetter) SetCopper(v int32) {
+	capnp.Struct(s).SetUint32(28, uint32(v))
+}
+
+// MailLetter_List is a list of MailLetter.
+type MailLetter_List = capnp.StructList[MailLetter]
+
+// NewMailLetter creates a new list of MailLetter.
+func NewMailLetter_List(s *capnp.Segment, sz int32) (MailLetter_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 4}, sz)
+	return capnp.StructList[MailLetter](l), err
+}
+
+// MailLetter_Future is a wrapper for a MailLetter promised by a client call.
+type MailLetter_Future struct{ *capnp.Future }
+
+func (f MailLetter_Future) Struct() (MailLetter, error) {
+	p, err := f.Future.Ptr()
+	return MailLetter(p.Struct()), err
+}
+
+type Mailbox capnp.Struct
+
+// Mailbox_TypeID is the unique identifier for the type Mailbox.
+const Mailbox_TypeID = 0xbb3d1850b12cd31b
+
+func NewMailbox(s *capnp.Segment) (Mailbox, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Mailbox(st), err
+}
+
+func NewRootMailbox(s *capnp.Segment) (Mailbox, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Mailbox(st), err
+}
+
+func ReadRootMailbox(msg *capnp.Message) (Mailbox, error) {
+	root, err := msg.Root()
+	return Mailbox(root.Struct()), err
+}
+
+func (s Mailbox) String() string {
+	str, _ := text.Marshal(0xbb3d1850b12cd31b, capnp.Struct(s))
+	return str
+}
+
+func (s Mailbox) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Mailbox) DecodeFromPtr(p capnp.Ptr) Mailbox {
+	return Mailbox(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Mailbox) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Mailbox) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Mailbox) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Mailbox) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Mailbox) Letters() (MailLetter_List, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return MailLetter_List(p.List()), err
+}
+
+func (s Mailbox) HasLetters() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Mailbox) SetLetters(v MailLetter_List) error {
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+}
+
+// NewLetters sets the letters field to a newly
+// allocated MailLetter_List, preferring placement in s's segment.
+func (s Mailbox) NewLetters(n int32) (MailLetter_List, error) {
+	l, err := NewMailLetter_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return MailLetter_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
+	return l, err
+}
+func (s Mailbox) Unread() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s Mailbox) SetUnread(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+// Mailbox_List is a list of Mailbox.
+type Mailbox_List = capnp.StructList[Mailbox]
+
+// NewMailbox creates a new list of Mailbox.
+func NewMailbox_List(s *capnp.Segment, sz int32) (Mailbox_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Mailbox](l), err
+}
+
+// Mailbox_Future is a wrapper for a Mailbox promised by a client call.
+type Mailbox_Future struct{ *capnp.Future }
+
+func (f Mailbox_Future) Struct() (Mailbox, error) {
+	p, err := f.Future.Ptr()
+	return Mailbox(p.Struct()), err
+}
+
+const schema_cdefabcdefabcdef = "x\xda\xec\xfd\x0dt\x14\xd5\xf9\x07\x8e\xcf\xddMX@" +
+	"0/\xbb\x18A0\x80\xa0\x10\xa1B\x00\x81\x08\x86\x84" +
+	"DHL \xd9\x04\x81(\x95\xc9\xee$\x19\xb2;\xb3" +
+	"\xcc\xce\x86\x04\xa1\x01\x04\x05+U\x14ZPPP\xb0" +
+	"\xa0\xd2\x8a\x85\x0aV\xacX\xb1\xe0\x17\xac\xb6`AA" +
+	"\xc1\x8a\x05\x85*-TQ1\xff\xf3<wg\xe7\xce" +
+	"\xe4&\xd9\xd8~\xbf\xbf\xf3;\xbf\xff9=\x95\xfd\xe4" +
+	"\xce\xcc}\xbf\xcf}^>\xcf\x90\x97\x9f\x19\x970\xb4" +
+	"\xebG+\x05G\xd9\x1b\x1d\x12;4\xfd\xf2\xcf\x8f'" +
+	"\xdd\xf8\xacs\x81P\xda\x9b\x90\xa6/\x0e>\x87\xff\x13" +
+	"\x12\x13\\\x820lJ\xe1\xcb\xc4\x1d,\x84\x7f\xca\x85" +
+	"\xe9D M\xb7\xa4m|idI\xb9\xbd8\x96^" +
+	"q\xbb\x97\xb87\xdd\xee\x12\x04\xf7\x86\xdb\xe7\x08\xa4\x89" +
+	"|W:\xe0\x95\xcaYX\xd8a+|\xf9\xf6\xf7\x89" +
+	"\xbb{\x11\x14\xeeV\xf4k\x814\x9d\x9b4o\xfaS" +
+	"\xbb^jV\x11\x02\xa5w\x14y\x89{?\x96\xde[" +
+	"\x04\xaf^\xe7<~\xf4\xe9\xcbw/\xb4\x97v@\xe9" +
+	"\xa1\xc5\x0f\x10wA1\x94\xce/\x86w\x7f\xfe\xb7G" +
+	"\xd4\xa6_\xfa\x16\xf2j}\xaax\x16q_\xc2\xc2\x17" +
+	"\x8b\xe1\xd5\x0dc\xb7%e\xee\x92\x17r+2}R" +
+	".q\xcb\x93\xa0\xb44\x09^\xbdg\xd9\xf7\x8b\x7f\xff" +
+	"\xd0\xfc\x85B\xca\xb5\xa4\xe9\xba=\x96\xc2\x9d&\xcf\"" +
+	"\xee^\x93\xa1p\xf7\xc9\xd9\x02ir\x0cZ\xa5\x94\xa7" +
+	"|\xb3PH\xe9\xdd\xac\xd2\x05\x93\xbd\xc4=\x03\x0bO" +
+	"\x9f\x0c\xf5X\x93s\xe7\xec\xd9/v]\x04\xf5\xe8`" +
+	"/\xbdw\xf2\x03\xc4}\x1cJ\x0f;:\xf9#\x87@" +
+	"\x9a\xae\x98\xf0\xed\xe7\xeb\xdf\xeb\xbb\xc8^\xedDl\xa4" +
+	"\xf7\x01\xe2\xbe\xec\x85\x7f^\xf2N%\x02i\x1a\xb0\xfe" +
+	"7;\x9e\xac\xff\xe5\"^\x97L)/$n\xb9\x1c" +
+	"\x1bY\x0e\xf5\xde_\xfaJh\xc9\xa7\xbf[\xc4\xed\xed" +
+	"%\xe5\x1f\x13\xf7\x06,\xbd\xae\xfc\xef\x02i\xfa\xdb\xff" +
+	"D\xfe\xb4\xf1\xde7\x16q;p\xc9\x94J\xe2^3" +
+	"\x05J\xaf\x9a\x02\x1d\xf8\x8fG\x0et\x9e\xff\xe6\xe9E" +
+	"\xb6>\xc1\xc2\x83\xef\xa8$\xee\x9c;\xa0\xf0\xd8;\xa0" +
+	"\"\xef\xbc9\xb1\xe4\xf8\xf8\xfa{\xe1\xd5\x09\xb6Z\xcf" +
+	"\xb8\xe3u\xe2\x8e@\xe1a\xb3\xefx\x08\xda\x98}i" +
+	"An\xb5\xf2S,\xed\xb4\xbf;q\x9a\x97\xb8\xbbO" +
+	"\x83\x7fv\x9b\x86\xc5\xc3\x9f\xff\xf3\xfe\xa9\x1fL^\xcc" +
+	"\xeb\x92\xad\xd3\x0b\x89{\xcft\xa8\xc9\xee\xe90:\xff" +
+	"\xfc\xe7\xee\xb3g\x87\xfd\xca^8\xd1\x09\xa5\xbbWd" +
+	"\x11\xf7\xc0\x0a(\xdd\xbf\x02\xba\xe4\xfb\x19\xf7\xbe\x7f9" +
+	"\xb3i1\xaf\x91\x97\xa0p\xa7;\xa1p\xe2\x9d\xd0\xc8" +
+	"~\x19\x0f\\):\x06-\xe1\xf6\xdf\x88;\xfb\x12w" +
+	">\x96\xce\xb9\x13*\xf2\xf2\x91\x92\x9a\x87\xaa\x86/\xb1" +
+	"5\x12k\xbd\xee\xce\xa7\x88{\x07\x16\xdev'\xd4c" +
+	"\xf1\xb0k\x1f\xb8\xe9\xab\xec%\xb6z\\\x01\x857\xdd" +
+	"\xf5\x00q\xef\xbe\x0b\xfe\xb9\xeb.\x15\xa6\x94\x7f\xc7\xd1" +
+	"\xeb\xceo\xf5.\xe1\xce\x91\xbb5\xe2\x96\xef\xc69r" +
+	"7\xd4\xe3\xab\xd3o\xfa_\xcay\x92[x\xef\xdd\x8b" +
+	"\x88\xfb(\x16>|74\xf1\xdac\x0d\x95\xd7$9" +
+	"\xee\x13J\xaf%\xae\xa6;\x86O\xfeq\xf0\xc1{\x1e" +
+	"\x13\x12\xaf\xc4\x0e\xb9;\x93\xb8;\xcd\xc4A\x9ayC" +
+	"\x07X\x0a7^X\xf9\xeb\x9f\xfe\xf6>^\x1b\xc5\xea" +
+	"\xc7\x88\xbb\xa1\x1a\xfe\x19\xa9\xc6=\xaaOvJ\xe1\x1b" +
+	"\xe7\xbf\xbc\x8f\xbbG\xd5<B\xdc[j\xa0&\x9bj" +
+	"\xa0&\x9b\x1e\xbf\xe9\x8eC7\x8c\xbe\x9f\xf7\xea\xbd5" +
+	"^\xe2>\x0a\x85\x87\x1d\xae\xc1W\xcf/\xf8j\xe4\xc6" +
+	"\xf4\x9a\xfb\xb9\x0b\xe1\x94\x0c;\x89\x8c;\x89\x0c\xbd\xfd" +
+	"\xa3N}\x1d\xc3\x13w\xdc\xcf\x1b\xf5wg\xcd%\xee" +
+	"S\xb3\xa0\xf0\xc9YP\x91\xe3\xbb\x1f\xf5_\xc8\xf8+" +
+	"\xbe:\xd1\xbe~Sj\x0b\x89\xbb\x7f-\xfc\xb3Om" +
+	"\x9aS \xbf_\xf9\xbb\xdc\xbf\x8d\xa8[\xcai\xe2l" +
+	"%\x83\xb8\x17*\xf0\xe6\xf9\x0a\xbc\xf9\xc44\xf7\xa9\xdd" +
+	"\x81\xa4\xa5\xdcJoP\xbc\xc4\xbd\x03KoS`=" +
+	"J\xd7>\xf5\xdb\xcd\xd9=\x96\xf2z\xafT\xad$n" +
+	"I\x85\xc2\xa2\x0a\xaf\x9eRs\xf9\x17\xbd~\xf2\x00\xb7" +
+	"\xf0\x12(\xbc\x06\x0b\xafRa\x86\xec\xee=\xf1\xcc{" +
+	"\xf7o[jo!\xf6\xc7E(\xdd)\x84\x83\x1e\x1a" +
+	"\x09\xb3\xafk\xde\xf1\xe9+\x7f\xf7&\xf7\xdd\x05\x9aF" +
+	"\xdc34\xdc,5x79\xb1\xff\xf4=\x8f\x0e\\" +
+	"\xc6?=\xb4B\xe2\xde\x8f\xa5\xf7b\xe9\xa9G\xa5W" +
+	"\x87\x0f{b\x19\xef\xd5C\xc3/\x12wA\x18\x0f\x8f" +
+	"0\x14\xee\xd0\x98\xd7\xf8X\xf9\xf9e\xbcM{S8" +
+	"\x97\xb8w`\xe1mX\xf8r\x1fm\xcf\x03_\xf6z" +
+	"\x807\x9dR\xf4\xa7\x88{\xa0\x0e\xff\xec\xaf\xe3&|" +
+	"\xecBV\xf7\xff\x91o~\x00\x96\x011\x97\x01\x96n" +
+	"\x88x\x89{y\x04^\xbd,\x02\xafN}\xb4\xa8\xf0" +
+	"\xc8\xf5%\x0f\x08\xa5\xe9\x844%g\xb2\x85\xcfD*" +
+	"\x88\xfb2\x16\xbe\x14\x81\x81Y\x90\xbe\xfb\xea\x85\x07\x0e" +
+	"<\xc0\x9bz\xdd\xeb\x16\x11\xf7\xe0:(<\xb0\x0e\x0a" +
+	"\x0f\xdf>\xb2\x87Xu\xfdOy\xdd1\xbd\xae\x90\xb8" +
+	"\x83Px\x98\\\x87k\xa0@\xe9\xbe~\xe6\x9d\x85?" +
+	"\xe5\xee\xaa\xcb\xe6h\xc4\xbdn\x0e\xfcs\xcd\x1cl\xe3" +
+	"\x0b=\xfc[\x9fn<\xf3S\xae\x80q\xaa\xfe-\xe2" +
+	"&\x0d(\x10\xd4\xe3\xdb\xd7|v`I\xc1\xd1\x0b\x0f" +
+	"\xf2*>tn!q\xe7\xcf\xc5\xbdo.T|l" +
+	"\xe4\xa3!S*\xf6,\xe7\x8e\xfa\xec\xb9\x1aq/\xc1" +
+	"\xd2\x0b\xe7\xc2\xcc\xeerk\xc6=\x07\xa7\x1e]n;" +
+	"<\xe8H\xf6\xba\xa7\x90\xb8\x87\xde\x83\xa7\xce=MP" +
+	"\x93\x7f\x15T\x96\x1c{;\xefg\xbc\xa1\xcc\x99\xff\"" +
+	"qO\x9f\x8f\x1b\xe1|l\xe6k\xfb^\xef\xb47\xe7" +
+	"'?\xe3\x1e\x1e?y\x9d\xb8\xf7\xfe\x04j\xb2\xe7'" +
+	"P\xefw\xbf\x12\x8fT\xa6\x8e|\x88W\xf8\xe4O2" +
+	"\x89\xfb<\x16>\xf7\x13\x18\xf7\x8b\xb9\x7f\xfct\xc7\x11" +
+	"\xf5![\x8f\xe0AS\xda\xf82q\xcb\x8d\xb8\x0b7" +
+	"B\x1b\xf7\xf7\xdd\xfa\xb3\xef\xf6/x\xc8.4`\x8f" +
+	"\x90\x05\x99\xc4\x9d\xb2\x00\xfe\xd9u\xc1/a\x8d\xf5\xdc" +
+	"\xfb\xb7\x8f\xd2\x87<\xfe\x10\xb7\x03\x0f/\xd2\x88\xfb\xcc" +
+	"\"x\xf9\xa9E\xf0\xf2\x89\x7f\x7f\xdc\xf5\xe1\x83I\x0f" +
+	"\xdbKw\x80\xd2\x0b\xef}\x99\xb8\xd7\xdc\x0b\xff\\u" +
+	"/\x9e\xa7/\xf8\xa6|\x7f\xf1\xa5\xbc\x87y\x0bg\xd5" +
+	"\x92\xb9\xc4\xbde\x09\xee\xc3K\xa0\x953W\xcd\xbf," +
+	"\x9f\xad\x7f\x98;MR\xee{\x96\xb8\x07\xde\x87+\xe7" +
+	">\x9c&\xdf'\xfeIz\xee\xfb5\xfc\xe2c\xef\x7f" +
+	"\x91\xb8\xa7\xdc\x0f\xff,\xbd\x1fGg\xfb\xea\xeb/\xdc" +
+	"w~\xf0\x0a\xeei\xbdai%q\xefX\x8a\x8bx" +
+	")\xec\xdb\xef<\xf7\xb8\xe3\xdf\x8e\xc6\x156\xb95:" +
+	"\xc3\x97=B\xdc\x1b\x96\xa1\xb8\xb3\x0cJ\xbb\x1ex\xf2" +
+	"\xce-;\x9fXa\xdb\xd6\xe8\"~ \x97\xb8\x97=" +
+	"\x80\xdb\xe1\x038\xab\xd4k\x06\xad=[\xf7\xdc\x0a\xde" +
+	"\xd0/{p\x11q\xaf{\x10^\xbd\xe6A\xe8\x94\x7f" +
+	"\xac\xed\xfaD\xd7\xbf\xff\xcf\x0a\x9e\x00}\x11\x0awZ" +
+	"\x0e\x85\x13\x97\xc3\xe8\xdc\xb0\xaf\xc7/\xd3\xbb\xa5=\xc2" +
+	"{\xf3\xa6\xe5\x85\xc4\xbd\x0b\x0b\xefX\x0eo\xfe\xd9\xf2" +
+	"\xc5K\x1cg\x1ey\xc4\xbe\x17\xe3\xe0t\xfb\xd9,\xe2" +
+	"\x1e\xf83\xec\xee\x9f\xe1<\xc9x\xe5\xec\x8f\x7f\xf7\xef" +
+	"\xdf?\xca[\x95\x17\x1f~\x96\xb8\xbb\xae\x80wwZ" +
+	"\x01\xb3\xfb\xe1a\xfe}\xbb\x93>|\x94\xbbA\x8c^" +
+	"1\x97\xb8\x8bW\xe0&\xbe\x02\xc6\xe6\xf7\xef\xfd\xe8\xa1" +
+	"\x8f\x0b\xd7\xac\xe4\xcc\xeeu\x8fx\x89{\xdb#\xf0\xe6" +
+	"\xad\x8f@\x13\xbbG\xaa\xd3\xb6\xbd\xd6y%w\xba\x16" +
+	"<\x0ag\xc2\xa3x&<\x0am,\xff\xd1?\x9e\x1b" +
+	"\xdf\xaf\x90_z\xc7\xa3\xaf\x13\xf7A,\xbd\xffQx" +
+	"w]\xef\x85\x9d?\x1d\xfd\x0e\xbftd\xe5\\\xe2^" +
+	"\xb6\x12J/Y\x09\xef\x1e5\xe6\xc8\xf9\xb7\x8f\xad^" +
+	"\xc5\x1b\x99\x93+\xbd\xc4}\x11\x0b\x9f_\x093d\xfc" +
+	"\x9d\xf3o\xec>\xe1\xadU\xb6E\x89\x85\x0f\xae\xca\"" +
+	"\xee\xe3\xabP\x90_\x85R\xd7\xa7\x7f\xfa\xa0p\xfd\xc2" +
+	")?\xe7\x8d\xa3\xb4\xfa1\xe2\x9e\xbf\x1a^\xdd\xb0\x1a" +
+	"\xea\xf1\xeck?\xfd\xf8\xc9c\xfe\x9f\xdb\xf74\xec\xbf" +
+	"\xc3\xab\x17\x11\xf7\x99\xd5\xb8\xd1\xae\xc6w\xebE\xee\xc7" +
+	";\x0dM\xfe\x05whf?\xfe,q/{\x1c'" +
+	"\xeb\xe3\xb8l27\xf5\xfc\xd7\xdd\x7f\xbb\xfe\x17\xbcV" +
+	"\x1e]\xfb\x18q\x9f_\x8b\xfb\xd4Z\xe8\xc0\x8b7\x0d" +
+	"\xbb\xc77\xf5\xf3_X\xe7\x08\x96]\xbe\xee\x11\xe2\xde" +
+	"\xb4\xce%8\x9b>=\xf8\xc9\xad?\x7f-\xb0\x9a\xbe" +
+	"r\xf8\xeb\x96\x85\xbbp]%q\xafZ\x87\x82\xda\xba" +
+	"?B\x0d~\xf3\x88\xfc\xfb;\xfb$\xaf\xe1\x8e\x8a\xfc" +
+	"\xe4\\\xe2\x9e\xff$\xf6\xc6\x93P\x85\xdc\xf4\xb3\xbf\xb9" +
+	"\xfe>q\x8d}\xe1\xd2\x1d~\xfd\"\xe2\x1e\xba\x1ew" +
+	"\xf8\xf5\xb8\x89\xd4\xf6\xfd\xd1\xa9\xe9\x9b\x1e\\\xc3\xed\x8d" +
+	"\xe2\x0d\x8f\x10\xb7\xb4\x01\xfe)n\xc0\xde\xd8\xf9\xf7\xbf" +
+	"&\xdc\xf2\xd4\xc3\x8fq\xe5\xa8\x1dO-\"\xee\xfdO" +
+	"\xa1\x8c\xf1\x14\xd4\xe5\xdb>g\xfb\xdf\xf1\x0f\xc7\xe3B" +
+	"J:sZ\xd3w\x8bO\xe7\x12\xf7\xec\xa7\xa1p\xf0" +
+	"iX2k\xc6\xa4\xfe\xe1\x91\xd2\xab\x1e\xe7\xee8\xcb" +
+	"\x9f\xf6\x12\xf7\x06,\xbd\xeei\x98O\xdd.\xfc!\xe1" +
+	"\xa5\xea\x09\x8fs+2{c%q/\xd9\x88\xc7\xde" +
+	"F\xa8H\xde\xcc\xd1\x89\x05\x19\x8f>\xce\xdb\x86\xbbo" +
+	"\xaa$\xee\xc1\x9bP\x14\xd8\x04\xf3\xe9G\x93\xf7?>" +
+	"j\xf8o\x1e\xe7\x0d\xe2\xa6\x17\x89{\xd3&\x18\xc4\xa1" +
+	"\x8d\xff\x9a=\xf7\x80c-o\x8a.\xdc\xf4:q\xaf" +
+	"\xc1W\xae\xc2W\xae\xd9\xf8\xc0{\x9f\xeeY\xb2\x96;" +
+	"\x84\x977i\xc4\x9d\xf2\x0c\x94\xee\xfa\x0c\xd4\xf6\x96\xb7" +
+	"V\xfen\x92\xeb\xc9\xb5\xbc\x1b\xde\x96g\xbc\xc4\xbd\xfb" +
+	"\x19\xbc\xa1<\x83G\xcc\x07?}p\xd3\x13\x8b7\xac" +
+	"\xe5\x8e\xe0\x94\xcdpE\xd9\x0c\xff\x946c\xf1\xe4\xdf" +
+	"\xd4\\\xfe\xd9\xbeSky]q~\xcb\\\xe2N|" +
+	"\x16jB\x9e\xc5\xae\xb8k@\xff\x7fv\xba\x7f\x1d\xaf" +
+	"\x913\x9e\xf5\x12\xf7l,\x1c\xc4\xc2\xfa\xe9\x15\x7f\xb8" +
+	"\xe6\xf7\x17\xb8\x85\xf7?;\x97\xb8\x8fc\xe1\xa3\xcff" +
+	"\x0b\xe4\xa3\xdfE>{\xfb\xab\x8c'x\xfdq\xe9\xd9" +
+	"\x0a\xe2\xee\xfa\x1cn\xa6\xcfA\x7f\xcc\xbf\xfe\xb1\xbbJ" +
+	"v\xdd\xf8\x04\x88\x88\x096\x11q\xd3s/\x12\xf7\xee" +
+	"\xe7\xb0?\x9e\xa37\xdeo\xfa\xfe\xf5\xfe\xa9\xda\x13P" +
+	"\x8dN\xf6\x16\xe6o]D\xdc\xd3\xb7b\xd7l\xadN" +
+	"\x80\xbb\xe3\x88\xd4\x0f\xbe\xf2\xcd{\x82W\xebK\xdbf" +
+	"\x11w\xd7\x17\xb1&/B\x13\x0b6]!M\xd8\xf5" +
+	"\xe7'\xb8\xe3(\xbd\xf8\"q\xcf\xc7\xd2\x0d/B\xbd" +
+	"\x9f\x9c\xfc\xfc\xbff\x0d\x1b\xf8$w\x8e\xf6\xff\xcd\\" +
+	"\xe2\x1e\xfd\x1b(=\xe270\xa3\xcb\xbc\xaak\xf8\xd4" +
+	"\xcc'\xb9\xe7s\x9f\xed\x8b\x88{\xc4v\x94\x00\xb7\xe3" +
+	"\xc2}|\xcd\x15\x7f\xff\xe9/\xa7=\xc9\xab\xf7\xec\x1d" +
+	"\xaf\x13\xf7\xb2\x1d\xb8U\xef\x80\xb5\xf5vC\xafN\x8b" +
+	"\xcb\xd6>\xc9\x9d#[v\xbcH\xdc{\xa0\xf4\xb0\xdd" +
+	";\xb0\x0boo\xf8\xfb\xb3\xcb\xdf9\xf2$o\xcf[" +
+	"\xf2R\x05q\xafy\x09\xe7\xf6KP\xef\x9b\xa4\xf2+" +
+	"\xef\xfd\xee{{aZ\xef\xd9;\xbd\xc4\xbdd'." +
+	"\x8a\x9d\xb8\x83\xd4L\x9b\xf8V\x8fO;\xaf\xe7V\xe5" +
+	"\xf0\xaeB\xe2>\xb3\x0bw\xeb]X\xfcRi\xce\xa0" +
+	"\x15\x17R\xd7\xf3\xaa2\xf4w^\xe2\xce\xff\x1d\xca\xc2" +
+	"\xbf\x83\xaa\xbcU\x7f\xeb\x9as+7\xad\xe7\x0eO\xaf" +
+	"W4\xe2\x1e\xfa\x0a\x94\x1e\xfc\x0a\x0c\xe6\xd6\x15\x13\xbe" +
+	"\xeb\xd5\xed\xecz\xdeJX\xf6J%q\xaf\xc3\xc2k" +
+	"\xb0\xf0]\x03gx\xdfzm\xe6\x06n\xb5/\xbe\x02" +
+	"r\xc8n\xf8g\xe2n\xacv\xde\xbck\x13:\x8e[" +
+	"\xb1\x81{\x83|u\x16qK\xaf\xe2\x0d\xf2U\x18\x9d" +
+	"\x17~\xda\xf0\xb7\x9a\x07\xc7<e\xefA\xda\xdf\xaf\xce" +
+	"%\xee5\xaf\xa2\xc0\xf8*\x8e\xce\xbd\x07\x96\xdd\xfe\xe6" +
+	"\x85\xef\x9f\xe2\x09\xc3\x03_{\x91\xb8s^Cm\xd1" +
+	"k0\x07\x93I\xf8\xa6\x99[\x13\x9e\xe6U\xe4\xf0k" +
+	"p6b\xe1S\xafA#\xdf{\xef\xd0=\xeb\x8f\xce" +
+	"y\x9a\xd7\xd99{2\x89\xbbt\x0f\x14.\xde\x03o" +
+	"\xee:\xf8\xd0\xf1Y\x8b.q\xdf|jO\x05q_" +
+	"\xc2\xc2\x17\xf7\xc0\x9b\xef\xad\xee~\xa5[O\xdeH\x0b" +
+	"\x0f\x7f\xdd\xd2}\xa5\xaf/\"n\xe9u\xec\x90\xd7Q" +
+	"\xb3\xf9\xda}#\xbf|\xf5\xdb\x8d\xbc\x0dp\xcf\xeb^" +
+	"\xe2>\xfc:\xea\x0f^\xc7\xf3\xf1\xd9\x9f}\xbe\xfe\xc0" +
+	"\xc5\xef\xb9\xa57\xbd1\x8b\xb8w\xbd\x81\xa7\xd3\x1bX" +
+	"\xfa\xad@\x8fo\x06\xaf\xed\xb9\x89\xbb\xcc\x96\xbf\xf9\x16" +
+	"qoy\x13\x9f|\x13\x97\xd9\x1f\xbfx\xab_\xf7\xed" +
+	"\xfa&\xa1\xb4gb\xc7\xa6\xa5\x81\xe3\xdf\x97\xf6\xccx" +
+	"W\x10\xc8\xb0=\x7ft\x10\xf7\xc1?\xba\x04\xa1l\xdf" +
+	"\x1f\x9dP\xb6\xcb\xb6\xcf\x93\x7f;`\xf1&\xee\xd1\xbb" +
+	"\xeb\x8f\xb3\xa2\xc5\x87\xed\xff#\xce\x91\xae\xfdN\x94L" +
+	"yq3\xbf&#\xf6W\x12w\xc1~\xbc\x82\xef\x87" +
+	"\xb9\x9d\xbd/\xfc\xdb\xe2_\xdf\xf8\x0c\x94N\xb5\xebF" +
+	"\x06\xbf\xf5\x00q\xe7\xbf\x85\xe3\xf4\xd6\x1f;\x0a\xa4\xe9" +
+	"\xbe\x95\xbb\x1a\xd3\x16\xfb\x9e\xe1I\xab)\x87r\x89\xbb" +
+	"\xcf!xw\xafC0\x01{\xb8\x1a&\xdd|\xcd\xaf" +
+	"\x9e\xe1nTc\x0f=@\xdcS\xb0t\xe9!\xa8\xc9" +
+	"\xd0?\x1dx\xfa\xcbs\xe5\xbf\xe46\xb3\xff\xe1\x0a\xe2" +
+	"\x1e}\x18\x9bp\x18\x9b\xf9W\xa5\xf8\xcd\x9b\x8f\xce\xfb" +
+	"\xa5}\xe5`3\x97\xbd\x07\xe2\xfe{xW~\x0f\xc7" +
+	"g\xfb\xc4\xefn8\xb2\xf1\xe7\xbf\xe4U\\>RH" +
+	"\xdc\xf3\x8f\xe0\x0e{\x04*~\xddu\xc3\xf7\xbdr\xe3" +
+	"s\xbf\xe4\xca\x0c[\x8eT\x10\xf7\xee#\xd8\xf9Gp" +
+	"0\xb7\xae\xae\xed+\x87>\xfe%o~\x1f?\xaa\x11" +
+	"\xf7\xf9\xa3(\xcb\x1d\x85f>\xbauO\xd3\x80\xce\xca" +
+	"f\xeeUy\xff\xfb\x1aq\x1f\x7f\x1f\x85\xc0\xf7QU" +
+	"3%\xfd\xe7\xe3\x0e\xce_\xbe\x99\xaf\xb1<\x9eE\xdc" +
+	"\xf9\xc7q\xa7:\x8er\xf9c\xdb\xd7~\xf1\xeb\xab\xb6" +
+	"p\xaf\xca\xc7\xbd\xc4\xbd\x07\x0b\xef>\x0e\xad\xf4L\xf6" +
+	"J\x8f~\xb2x\x0b\xf7\xd5'\x8f\xcf%\xee\x8bX\xfa" +
+	"\xfcqX\x97\x7f\x98x\xf7\x15i\xcf\xdf\xbf\x85\xd7\xc8" +
+	"e\x1fz\x89{\xdd\x87\xb8\xad}\x08\x8d\xec|\xfe\xc8" +
+	"\xcd_\xde;\xebY\xee\xc8G>z\x8b\xb8W|\x04" +
+	"\xa5\x97\x7f\x04\xaf\x9e\xf7\xe3^\x9f|Q\xdb\xeb9\x9e" +
+	"\xa2\x7f\xc4\x89Y\xc4]p\x02'\xec\x09\xa8\xf5\x961" +
+	"\x87\xee\xbf\xf5\x95k\x9f\xe3\x0dd\xe4\x84F\xdc\xcb\xb0" +
+	"\xf0\x12,\xbc\xed*q\xed\xa8S\x1b\x9f\xe3\xcb\x95'" +
+	"*\x89{?\x96\xde{\x02j\xad\xdc\xd4\xf7\xc7\xfb\x9e" +
+	";\xf7\x1c\xaf\x89\x9bN\x82@p\x12\x0a\xef:\x09\x95" +
+	"\x16\x07\xef\x9bv\xdf}[\x9e\xe7jp?\x9eK\xdc" +
+	"\xf2\xc7PX\xfa\x18\xc6\xe5\xfb\xc5\xbf\xe8t\xb0\xc3\x9f" +
+	"\xb7\xdaD\x0dZ\xeb\xbd\x1f\x17\x12\xf7\xd1\x8fq\xeb\xfc" +
+	"\x18'\xeb\xe6\x89}3;l\xbb\xffW\xdc\xfb\xe6'" +
+	"\xb0\xf5|\x82\xf7\xcdO\xe0\xdd\xbd\x06O\xe8\xb0\xea\xb9" +
+	"\xe5\xbf\xe2\xedS)\xa72\x89\xbb\xcf)<\xa7N\xe1" +
+	".\x7fM\xbf\xbb\xb5\x9b\xa4\xdf\xfe\x8a\xd7\xc6\x1d\x9fj" +
+	"\xc4\xbd\xffS\xec\x90O\xa1\x8d\xb57\x9d\x9a\xb8\xe7\x91" +
+	"\xe3\xbf\xe2v\xdf\x8c\xbf?F\xdc\x91\xbfC\xe9\xd9\x7f" +
+	"\xff\xb5@.\x0fX\xb7d\xf1\xdd\xe4\xd7\x9c:w?" +
+	"\x0d\xb2\xf0i\x94\x85O\xc3\xb8\xbc\x7f\xdd\x8fN\xae\xbe" +
+	"\x7f\xd9\xaf\xb9\x0b\xac\x00J\xcf\x80\xd2\xc3\xa6\x9f\xc6\x05" +
+	"\xb6t\xe7\x1d\xff\x98(\xea/\xf0\xc6<xf\x11q" +
+	"/<\x83:\xd93\xf0\xee\xa4w\xaf\xdd\xba\xe1\xc8\xe3" +
+	"/\xf0Z\xb8\xe1\xcc\\\xe2\xde\x81\x85\xb7\x9d\x81\x16\x0e" +
+	"\xecV\xec{\xe2\xda\xbfr\x0b\x17|\x06\xb7\xde\xcf\xf0" +
+	"\xd6\xfb\x19\x14~\xe6\xd7\x17\x1f\xdcu\xa8n\x1bw\xc1" +
+	"\\\xfc\xec1\xe2\xee\xfa9\x0au\x9fC\xe9\xc7\xb4\xaa" +
+	"I{\x8e\xd7\xbe\xc8{\xf5\x96\xcf+\x89{7\x16\xde" +
+	"\xf59\x8a\x18\x13\xb7'\xa4\xdd\xf3\xd9\x8b\xbc\x16\xae8" +
+	"\xfb\x18qo9\x8b\x0a\x9d\xb3\xd0\xc2\xba\xe5\xc3\x1es" +
+	"\xcc\x1b\xf2\x1b\xae\x86\xe1\xf0\xd9\xa7\x88\xfb\xdcYT]" +
+	"\x9e\xc5\x9b\xe9kO\xddq\xf1\xde}\x99\xbf\xe1\xae\x98" +
+	"/\x16\x11\xf7\xb2/p\xc5|\x01\xef\xfe\xe2\x9f\xbf\xbd" +
+	"u\xf5\x1f\xb4\xdfP\xbd\xe9u{\xd8Z\xef\xfa\"\x8b" +
+	"\xb8\xf7c\xe1\xbd_\xc0\xd4\xfb\xfa\xc0\xd3\x8fm\xbc\xb4" +
+	"\x99\xfb\xe6>_V\x10\xf7\x88/\xa1\xf0\xd0/\xe1\xcd" +
+	"\x8f\x94\xfb~w\xf6\xfb\xaf\x7f\xc3\x9b\xd4\xe2\x97\x0f\x10" +
+	"w\x03\x16\x8e|\x09o\x16S\x9c\x1d\xff\x91\x91\xbf\x9d" +
+	"W\xf8\xe8\x97^\xe2>\x87\x85\xcf`\xe1\xcd\xaf\xcdL" +
+	"\xed\xfc\xf0\xae\xed\\!\xaa\xe0\xfc\"\xe2\x9eq\x1eg" +
+	"\xd3y\\\x02\xc3\x9fN\x9dR\\\xe7\xdea/\x8e\xdd" +
+	"w\xe6\x9fY\xc4}\xe9\x9f8\xa2\xff\x84\xc5\xf8\xed\x87" +
+	"\x1fu\xbd\xf3\xd8\xa0\x1d\xbc\xd3t\xf7\x85E\xc4\xfd\xee" +
+	"\x05\xa8\xc9\xc1\x0b0\x8c\xbe/\x9fix\xfb\xb9gv" +
+	"\xf0d\xa8m\x17\xbd\xc4\xbd\xf7\"\xaa*/\xc2\x04\xe9" +
+	"\xb4\xeeH\xd9\xbd\xb7~\xb4\x83\xbb\x08f\xfc\xfbe\xe2" +
+	"\x8e\xfc\x1bW\xd7\xbf\xe1\xd5\x9f^\xfc\x9f\xcf\xef{\xe3" +
+	"\xb6\xdfr\xd7b\xc1W\x8f\x11\xb7\xf8\x15\x94\x9e\xf1\x15" +
+	"\xbc\xbb\xa0\xc3\x90??\xbab\xd6oy\x93\xef\xd2W" +
+	"p\xfd\xf8\x1ag\xea\xd7P\xf8\xe9?u\xbe\xc6\xb3s" +
+	"\xdfo\xb9\xf3z\xeb\xd7\x95\xc4\xbd\x07K\xef\xc6\xd2U" +
+	"\xf3g\xfc\xe5\xf6\xac\x0f\xb8\xaf\x9er\xa9\x92\xb8\xe5K" +
+	"\xb8\xf1]\x82Z\xff\xfd\xab\xb5\x0d??\xf8\xa3\x97x" +
+	"\xe3\x98\xffM\x05qO\xff\x06\x0aO\xf9\x06\xc6q\xc5" +
+	"\x8a?\x9c\x99\xffl>\xb7\xf0\x8eo2\x88{/\x16" +
+	"\xde\x83\x85\xc7]\xfew\xf7\xc8\xb5\x93_\xe2\xcd\xbd\xfe" +
+	"\xdfz\x89{\xf4\xb7x\x09\xfa\x16\xe6\xde\x87i?\xfa" +
+	"\xe3\xdd\xe1\xed/\xf1\x0d\xcf\xdf>F\xdc\xb3\xb1t\xf0" +
+	"[h\xe1\xc8\xe0\xb3?\xbb\xe5\xa1?\xbe\xc4\xbd\xd6\x7f" +
+	"\x07\xf6\xc7\xef\xd0\xfe\xf8\x1d\xd4\xe3\x8c\xd6\xf1\x89\xdc\xf7" +
+	"/q+\xbd\xf0;P\xba`\xe1\x15X\xb8\xf7g\xc1" +
+	"\xf3\xaf\xaeL\xda\xc9{\xf3\xb9\xef\x0a\x89\x9b\\\x86\xc2" +
+	"\x97\xb1\xf0\x98q7\xd46\xde=r'\xbc9\x93\x11" +
+	"n\xdd\xb8b.k\xc4\x1d\xb9\x8c\x97\xa1\xcb\xd5\xdd\xe1" +
+	"\xf4\xfaK\xfe\xe6\x92\x9d\xe5;ym\x1c\xb9\xa2\x07)" +
+	"$i\x9bz\xc0\x8f\xb4\x0d=\x084s\xf7\xfd\x7f\xf8" +
+	"\xc7\xa9W\xc4\x9d\x9c\x9a\x8f\x1c{\x0d\xa9$i\xa5\xd7" +
+	"`\xf9\xe2k\x08\xd4'\xad\xdf\xa9\x93o\xfev;\xff" +
+	"\x03\xdb\xf0\x81\xbd\xf4\x81=\xd7\xe0\x07>\"\xaboI" +
+	"\xbd\xe9\x8b\x9d\x9c\x992rzO(\x1f\xec\x89\xe5\xe5" +
+	"\x9eX\xde]\xfb\xaf\xc33\xfe\xdem\x17\xafB\x9dz" +
+	"\x11/I\xeb\xd5\x0b\xcbw\xef\x85\x15\x9a<\xf9\xd3\x1b" +
+	"\xa6\xae\xae\xe3\x96\x8f\xf4\"\x8f\x90\xb4\xe5\xb4\xfc2Z" +
+	"\xfe\xf0\xb2~\xef}\xb0}\xde\xcb\xbc\x057\xf2L/" +
+	"\xf2\x14I#\xd7\xe2\x8f\xcb\xbd\x08\x1e<7O\xdbv" +
+	"\xf8\xd0\xce\x87^\xe6\x8c\xd7\xc8\x94t2\x8b\xa4\xf5O" +
+	"\xc7/\xf4I\xc7/d%\xfe\xec\xaf=\xbf\xfe3\xb7" +
+	"\xfc\xfct\x18\x82\x15\xb4\xfcrZ~\xf6\xf7SO\x17" +
+	"\x7f\xff\xfe\xcb\xdc.=\x93\x0eM\xbeL\x1f\xb8\x94\x0e" +
+	"]\xf4\xed3\xa7\x1f\xf8\xd5\x1f\xfe\xf62\xaf\xf8\xa6\xde" +
+	"D#i\xbbzc\xf1\x1d\xbd\xb1G\xe7g|?\xf6" +
+	"\x9d\xed?\xfa\x1do\x04\xa6\xf4\x81\xfa\xc8}\xb0\xbc\xd4" +
+	"\x87\xc0r\xbd\xe6/\x83\xb6\x95\\=\xf6w\xdc\xfa\xe4" +
+	"\xf4%\xb9$\xad\xb4/\x9d\x13}\xb1\x01%\x9f\xec[" +
+	"\xb9'\xf9\xe5W8\xc2\xc7\xc8]}\xa1\x83\x0eb\xf9" +
+	"\x91\xfb\xfb\x12\x14m\xbe\xf5HO\xectN\xda\xcdY" +
+	"\xb6#W\xf5#\x8f\x91\xb4\xad\xfd\xf0\x03[\xfa\x11X" +
+	"\xb9\x1f\xbdu\xcdOoyw\xe5n\xde\x18\x1f\xecG" +
+	"\xfa\x92\xb4\xe3\xb4\xfcQZ~\xe4\x8f_\x1d\xf5\xc8c" +
+	"\x9fr\xcb_\xeaG\x1e i)\xfd\xb1|\xd7\xfe\xd8" +
+	"\x80\x1f\xbf\xf6\xa6\xe7x\xc2\xf9\xdd<-\xef\xc8`\x7f" +
+	"h\xc1B|`\xe4\xfc\xfe\x04%\xf2\xf7\xbf\xbd8\xec" +
+	"\xd0\xe7_\xed\x16R\xaeu\x98\xa2\x9c@F\x1e\xbe\x01" +
+	"J\x9f\xb9\x01_\x7f\xea\x062R M\x8b\\\x13\xae" +
+	"\xbe\xe2\xb3\xf2WyG\xd3\xc8\xf37@}:\x0d\xc0" +
+	"\x1f\x89\x03\x08\xdek&]\xc8u'N\x99\xf2*\xaf" +
+	"\x01S\x06\xc2\x10\xcb\x03\xe9\x90\x0d\xc4\x06\x9c{\xaek" +
+	"\xd35\xc9O\xbe\xca\x1d\xb2\xbd\x03\xc9\x8b$\xed8}" +
+	"\xe0\xe8@\x9c\x13'nl\xb8.\xb9q\xcb\xabp\xf5" +
+	"$\xec\xd5s\xe4\x92\x0c\xe8\xcfU\x19Pz\xea\xc3\x19" +
+	"\x04o\x9f\xb7\x04\xf7\xfaV?\xf7\xe7Wy\xd7\xb2\x91" +
+	"\x0b3\xc8\\\xe3\x89\x91+2\xe8\xa2)\x1dV\xf5\xe0" +
+	"\x9eA\x15\xbf\x87':\xdaZ\xb0\xe9Fh\xf2\xee\x1b" +
+	"\xf1\x81]7\x92\xfb\x9dp\xdd\xea\xf7\xf9\xb1\xa3?y" +
+	"\xf0\xf7\xbc\xa3m\xe4\xde\x9b`]\x1e\xbf\x896\xe1&" +
+	"l\xc2\x9d\x037?\xfc\x87\xbf\xfc\xf6\xf7\xbcI\xb4p" +
+	"\x08\x94_3\x04\xcb\xaf\x1a\x82\x93\xa2\xdb7\xcf_\xf3" +
+	"\xd7=\x1d_\xe3\xf5\xe9\x8e!d\x11I\xdbO\xcb\xef" +
+	"\x1dB\x97qx\xea_\xa6|z\xe65\xfb\xa4\xc0\x0f" +
+	"\xf4\x1f\x0a\xcbr\xf4P\xfc1b(A\xdbSy\xb7" +
+	"q\x9f\xde\xfe\xc4\xb8=\xbc/\x8c\x1eF*HZ\xf1" +
+	"0\xfcB\xc10\xac\xd1\xe5\x9b?:3\xf2\xd4\x9c=" +
+	"\xbc\x8dB\x1e\x06\x83\xb6\x90\x96\x9f?\x0ck4\xac\xe8" +
+	"\x17\xea\x1f\xb6\xdc\xb7\x87#X\x8c<\x87\xef'\xc3\xb1" +
+	"\xfc\xe5a\xd8Ck\xb4\xd5\xcbvz\xc9\xebv\x11\x11" +
+	"\x1fX3\x1c&\xeaV|`\xe4\x96\xe1\x04\xedP\xb5" +
+	"\xb7\x16\x7f\xdd\xc7\x99\xf9:\xafF\xb3GB\x8d\x96\x8d" +
+	"\xc4/,\x19\x895:\xbf\xfc\xa6\xb4\xd4\x99\xbb^\x17" +
+	"\xde\xed\x99\xf8}\x92e\x1e\x9d\x19I:\x93\xb4KX" +
+	"|\xea\x85\x91t\x1e}\xfe\xcb\xac[\xdfV\xf7\xbc\xce" +
+	"\x9d\xa7\xa7F\x92E\xc6\x13i\x17Gb\x13\xf6_?" +
+	"\xe1\xa3\xbfM\xbb\xf4:o\xef\xda4\x8ad\x92\xb4\x1d" +
+	"\xa3\xb0\xfc\xb6Q\xb8w=r\xea\xd0\xbe\xe7\xfd\x99\x7f" +
+	"\xe0~`\xd9hh\xc1\x86\xd1\xf8\xc0\xba\xd1\xd8\x82W" +
+	"\x1bw\xed\x7f\xac~\xed\x1b\xbc\x0f\x90,8>\xbag" +
+	"a\xf9nY\xf8\x81{_\xc9?\xf6\xc6\x97\xbfz\x83" +
+	"\xbb\x96/f\xc1 t\xba\x05\x7f$\xdeB\xd7\xf2_" +
+	"\x07\xd6i_\xff&u/oV\x88c`\xe9D\xc6" +
+	"\xe0\x17f\x8f\xc1\x1a\xdd\xb3\xcf\xa5N\x1f\x91\xb3\x97W" +
+	"\xa3\x83c\xa0F\xa7h\xf9\x93c\xb0Fi\xde\xfb\xd2" +
+	"|\xc3\xea\xb8\xe5\xdf\x1d\x0b\xd3\xf4\xd4XZ~,v" +
+	"\xe9\x8f\x1f}\xb2t\xf7{\x0f\xec\x85\xa5?\xdc2d" +
+	"\x0bo%\x0e\x92\xb6\xfcV\x1c\xb2\xa5\xb7\xd2!{\xe1" +
+	"\x93\xfd\x7f\xf8\xd7\x8fW\xed\xe5\xf6h\xc3\xad\xe4\xcf$" +
+	"m\x15>\x91\xb6\xe2V|\xff\xe6\xb2C\xdd\x93\xc7\xef" +
+	"\xe3?08\x1b\x1a\x90\x9f\x8d\x0f\xe4dc\x83{\xdf" +
+	"\xbci\xf8\xeb\x83\xfe\xc2\xed\xa0\x0d\xd9p\xdcl\xa3\xe5" +
+	"\xb7b\xf9\xafnr\xbd\xd0T\xf9\xf9^\xdbV\x94\x88" +
+	"\x02\xc28X\xf7}\xc6\xe1\xb7z\x8d\xa3\xcb\xf2W\xc9" +
+	"\x03\xc3W\xec\xb8\xe6M\x9e\x0at\xe4\x88\\\x98t\x05" +
+	"\xb9\xf8D~.=\x9fV|\x1c\xbe\xe3_\xe3\xaf}" +
+	"\x93\xdb\x84^y0\x8bF\xe4a\x95\x86\xe6a\x9b\xff" +
+	"\xa7\xd3\xf1c\x15{\xf2\xde\x84\x0bS\x07\xab\x15a\xe4" +
+	"\xfe<h\xc2Q,?\xf2p\x1e\xc1\xeb\xd8\xf6\xa7\x9a" +
+	"\xee\xf9\xfd\x17\xf7\xbe\xc9\x9dFS&\xc04\x92'\xe0" +
+	"\x0fi\x02N\xa3\xcbS\x96\xdcud\xe7\x9f\xde\xe4\xdc" +
+	"@F\xee\x98\x08b\xd4\xfe\x89t\xf7\x9a\x88\xb3\xa2\xf3" +
+	"?B\xc1\x97\x9e\xb8\xf5\x8f\xdc\xdd\xae\x00N\x90\xfd\x05" +
+	"\xb4|\x01\x0e\x82\xf6\xdd\x86\xab\x9e\x9c7\xed\x8f\xbcY" +
+	"\xd4\xbf\x10Z0\xa2\x90\xb6\xb8\x10[\\>\xc9\xa1\xac" +
+	"\xf9z\x16\xf7\xfd\x07\x0ba\xd6\x9d\xa4\xe5\x8f\x17\xe2\xfb" +
+	"\xb7v\xfc\xc9\xf2\xb7\xab>\xfc#\xb7KG\xdf\x0e\x15" +
+	"*\xbe\x9dn\x8e\xb7\xe3\x03\x03]\xd3\xef\x8dd\x9c\xe4" +
+	"?\xb0\xe9v\xf2,I\xdbM\x1f\xd8u;\xd6h\xc7" +
+	"\xd0\xeb_]5\xbe\xf3\xbe\xe6\xa6\xb5\x91\xd3\x8b`\xd6" +
+	"\xcd.\"`\\{\xff\xe6\xbf\xc8\xa5\x1b\xba\xee\xe3\xd5" +
+	"\xbc\x18\x0bJE\xf8^\xb1\x08w\xe9\xc7\x9f\xae\x1f\xfd" +
+	"\xce\xef\xd3\xf6\xf1zfI\x11\xcc\x9e5\xb4\xfc\xaa\"" +
+	"\xac\xc7\xb2\xea\xcf\x06\xdf\xf0T\xf9>\xde\xb94\xb4\x18" +
+	"6\xdd\xfcb:\xfd\x8b\xf1\xfd\xa9'\xbcg\x1b\xee\xab" +
+	"\xdb\xd7L\x0b<rv1I%i\x0b\xb1\xf4\xd4y" +
+	"\xc5t=6N\xfc\xe7\xda\xdf\xcb\xefp\xab\x13,\x86" +
+	"\x83u\x09}\xfd\xc2b\x9c\x08\xef\x1fu]{\xf0\xdf" +
+	"\x13\xf6\xdb\x8f1|`\xc6$\x98i\xb3'a\xe5\x82" +
+	"\x93\xe8\xdc\xecQ\xea\x88\xcc\x19R\xb7\x9f{\x12_." +
+	"\x81\x16\xa4\x94R\xf1\xa9\x14?\xb1f\xfa\x81\x1b'\xdf" +
+	"\xbfz?\xafJ\xe7J\xa1J\x89^,O\xbcX~" +
+	"\xf7\xed\x07k?|\xa8\xe6-(\xdf\xd9\xdeE\xc7\xbd" +
+	"P\xa5\xf3\xf8\xc0\xc8s^\xf2d\x02\xd8\x0a7\xdc\\" +
+	"\xfb\xf0\xa0\xc7\xde\xe2V\xe9\xf8T\xa8\xd2\xf9\xa9\xf8\x89" +
+	"sSq\x10\xa6\xbd5\xf6\xfb\xc2\x1eO\xe0\x03W2" +
+	"\xd7&z\xf4M\x83\xd9\xb3m\x1a~b\xeb4\xf2'" +
+	"\xf0\x81\xec\x99\xbb\xe6\xea%\xca\x8e\xb7x\x8d\xb8|7" +
+	"6z&m\xf4L\xfc\xc2\xb8\xc5\x95\xbf\xf8\xc3\xf87" +
+	"\xde\xe2M\xa3-3aA\xee\xa6\xe5w\xcd\xc4\xf9\xbc" +
+	"v\xe0?\xae\xdb]\xfc\xed[\xbci\xd1K\x84\xf2C" +
+	"E,?X\xc4i\xb1_=\x119\xb8?\xf3\x00W" +
+	"\xfc(\x15\xa1We|`\xa4$\x12Ti\xf4{r" +
+	"\xeb\x9d\xb3#\xf9\x07x_8WI2H\xda\xe5J" +
+	"z\x8d\xa8\xc4/\xbc\xb6\xe2\xc7\x07v\xf6\xf0\x1e\xe0\x1d" +
+	"\xf6\xdd}p/\x18\xec\xc3\xf2\x03}\xd8\x82\xfb\x0e\xff" +
+	"\xfc\x99\x1b\x9eY}\x80\xd7\xe2%>\\\x08\xb4\xfc*" +
+	"\x1f\xbe_\xea\xf7\xb3\xa9\x89}\x92\x0f\xf2T,#w" +
+	"\xf8`\xa7>\xe8\xa3\xf7\x02\x1fm\xc1?\xbamx\xf8" +
+	"\x91\xd5\xa3\x0f\xf2|\xacG\xca\x12\\\x0c\x16JT\x0e" +
+	"\x97\xe8\xe1z\xeb\xe7\xc1\x9b_\x09~t\x907j\x9d" +
+	"\xaa\xa1N\xbd\xaa\xe9m\xb1\x1aG\xed\x85\xe7=w\xe7" +
+	"<4\xeam\xee\xbe\xbb\xad\x1a\xf6\xad\xbd\xf8\xc0\xc8=" +
+	"\xd5\xf4\x0b\xc5\xb2z\xe2\xfd\xa6)o\xf3z5E\x86" +
+	"\xb9\xda_\xa6\xb7?\x99\xdeUVu\xe9\xb5\xe7\xc77" +
+	"\xff\x89{\xbf,\x95a\x7f\x91\xe9\x03\x92LE\x96u" +
+	")\xb3\xbf_\x1c\xfa\x13O\xaa\x1b=\x0b&F\xf1," +
+	"\xba1\xce\xc2&\xd4\xde\xaa\xed\xfaG\x8f\xbf\xfd\x89\xd7" +
+	"\xe4S\xb3`#\xbdD\xcb_\x9c\x85\xef\x9f\xb9\xfah" +
+	"\xbf\x83\xdb\xbf\xfc\x13\xaf\x01\x07kA\x84:^K\xe5" +
+	"\xeaZl\xc0\xac\xf7f-\x7f=x\xf5;\x9c}\xf4" +
+	"R-\x0cAJ\x00\xf7\xd1G\x07~XAz?\xfc" +
+	"\x0ew\xba\xd5\xc2\x89q\x99\xbe\xf7\x12}\xef\xe4\xee\x17" +
+	"\x13>H\xb9\xf8\x0e\xb7c\x06\x06`\xac\xc6\x06\xf0\x81" +
+	"\xd1\x01\xacx\xd7\x1f\xff\xd3\x9f88\xf1]\x9e\x0f\xda" +
+	"\xc8nAX\x92\x03\x83T\x1a\x0f\xd2K\xc7}i\xf7" +
+	"\xd5<\xb3i\xc0\x9f\xf9\xa7\xaa\x02\xb2\x96\xac\xe0\x0fI" +
+	"\xa1\xa3\xbb\xe5\xd6\xb5\xab\\\xf7\xd7\xfd\x99;G\xf7\xab" +
+	"\xb0\x08\x8e\xab\xf8\xc4Q\x95\xce\xd1\x9f\xcf\x9e\x7f\xe6\xd6" +
+	"\x0b\xa5\x7f\xe1nE\xd2l\x92E\xd2\"\xb3\xa9<7" +
+	"\x1b\xc7\xeb\xd1K\xef?V\xbd\xb7\xe2/|yH\x83" +
+	"\x01\xce\xd1\xf0\x81\xb1\x1a\xae\xb3\xed\xf3\x7f\xf9\xafy7" +
+	"\xfd\xee/\x1c\xc7\xc8\x91[4h\xf6n\x8d^\x9d4" +
+	"\xda\xec\xbd\x9d\x1f\xcd:s\xe3\xa7\x87xw\xad\xc3a" +
+	"\x90\xd0\xce\x87\xe9\xb0\x84\xc9d'(\xe4\xae:P\xbd" +
+	"`\xe1\xd6\xc3\xbc)4{\x0e|a\xc9\x1cz\x86\xcc" +
+	"\xc1\x91\xf8\xc7\xa8\x09\xb5o\xa7~p\x98\xdb\xe6)\xf5" +
+	"\xd0\x04\xb9\x9e\xce\xe9z|\xe0\x0f\xfb\x87\xebU#<" +
+	"\xefqe\xde\x06\xd8\xbb\x1a\x1a\xb0|\xa4\x01\x9b<\xe3" +
+	"\x83\x91\xfb\x94\x19w\xbe\xc7\xed\xa3\xc3\x0d\xe4e\x92v" +
+	"\x8e>p\x86>P\xf0z\xd77\xf6vZ\xf4\x1e\xf7" +
+	"\xc6^0\x17\xd6\xf1\x8c\xb9\xf8\xf4\xf4\xb9\xf4\xc6\xfe\xde" +
+	"\xf7\x0b\x96<\xd7\xeb!|\xc2\xd5L\x9e\x98\x07\x8d\xde" +
+	"5\x0f\x7f\xec\x98G\x9a\x88@\xbe:\xbc\xb4\xfc\xces" +
+	"[\xdf\xe3\x8c\xc2\xfe\x9f@\x13N\xfe\x84\x1eX?\xa1" +
+	"\xa3\xb0qQ\xdf)\x1f_S\xf8W\xde(\x9co\x84" +
+	"\xe9\x9d\xb8\x00\x1f \x0b\xe8\x8d7\xd4\xf3\x96>\x8fm" +
+	"\xac\xf8+o\xff\xddt/L\xd6]\xf7R=\xce\xbd" +
+	"\xd8\xe6\x1f\x0d:8z\xf3\x0b\xf2_\xb9\xa3\x90\xb2\x18" +
+	"F\xa1\xffb\xba\x15-\xc6\x99\xf7\xf5\xc6\xba\xac\xabo" +
+	"\xf8\xdb_\xb9\xbd\xba{1l\x15\xef\xd2\x07\x0e\xd2\x07" +
+	"nI\xdb{\xfc\xc9\x85\xe4\x08\xf7\xcc\x91\x96\x90\xb7H" +
+	"\xda\xc2%t\xff]\x12\x95\x94\x0f\xf7\xf8\xfa\x09\xddu" +
+	"\x84\xbb\xa8\x8b\xef\x07IY\xba\x1f\x7f\x88\xf7c7}" +
+	"\xdfP\x9b\x7f\xddG\xa1#\xbc\x15\xbad)Ti\xcd" +
+	"R\xaa\x07ZJ\xd7\xdb\x81\xa9\xd7,\xfe\xbca\xf5\x11" +
+	"\xdej\xe8\xf3\x00<0\xe2\x01*[=\x80\x1f\xf8\xd6" +
+	"Y\xf3\xd0\xa6E\xbf<Rz-)g\xec\x88\x1dp" +
+	"b\xfc\x14\xf6\xeb\x19?\xa5\x13\xe3\xa7\xe4\xa3\xfe\x02i" +
+	"zj\xc0\xf7\xd5\xbd~\xba\xeb\x08O{\xb5a\x07<" +
+	"\xb0c\x07=\x1ev\xd0\x1a\xfd\xf1\xb6Uo\xdf\xf9\xa7" +
+	"o\xf0\x81k\xec\xa7T\xf1K\xb0\xa3\xcex\x89~\xe2" +
+	"%\xf2R\x17\x814}\xd7q\xe8\xc8i\x9b}G\xb9" +
+	"\xbb\xcc\xe0\xbd\xb0g\x8c\xddK%\xe1\xbd\xf4\x1b\x1e\xf9" +
+	"\xba\xa9\xf9\xd7\xad;\xca[@{\xdf\x84]\xf8\xf8\x9b" +
+	"t\xd3~\x137\xd7\xc1o\xbb^w\x7ft\xf0(W" +
+	"zy\x13\xa5\x97?R\xe9\xe5\x8f8\xd2I\xca\x9b\xe3" +
+	"N\xa7\xf6x\x9f{\xaf\xfe#\xaagh\xf9]\xb4\xfc" +
+	"G\xdb\xdf\xff\xd9\xf4\xf2\xe7\xdf\xe7\xd5\xa7t\x1fL=" +
+	"i\x1f\x96\x17\xf7\xe1\\}\xf9\x17o\xc8]\x9f=\x88" +
+	"\xe5\xafh6\xf5\xf6A\x8b\x0f\xee\xa3g\xff>\xf2\xd7" +
+	"\x04\xd8\x02\x92}C\xb2w\xde\xf1\x01\xcf$>2\xe5" +
+	"mh\xf2\xc0\xb7\xe9n\xff6]\xd1\xcb'~y>" +
+	"!]\xfa\x80W'\xf9\x1d8\x80\x1a\xde\xa1\x9b\xcc;" +
+	"X\xa7\x1b~\xab}\xeaO]\xf8\x01\xef\xc0z\xf7\x1d" +
+	"h\xc3)Z\xfe\xe4;\xd8\xa7+\xaf~2\xf2\xcd\xdf" +
+	"\x1f\xf9\x80\xab\xf7}\x17\x16t\xffw\xe9r{\x17\xdf" +
+	"\x7f\xf0\x89\x9f\x15\xfb~\xfc+n}\xe6\xbf\x0b\xef_" +
+	"A\xcb/\xa7\xe5\xcf\xd5\xceH\xb8\xb6\xf3\x9e\x0f\xb8\xcb" +
+	"\xf3\xdc\xbb0h\xe4\xcfT\x9f\xf3.\x0e\xc2\xcfj\x8f" +
+	"\xf4\xe9sd\xed1\xeeb[\xf7gX\x0b\xdb\xe8\x03" +
+	"[\xff\x8c\xdb\xf0\x1d\xeb\x87\x17\xdd\xf7\xdcw\xc7\xf8\xda" +
+	"\x90\xbf\xc0\x16\xb3\xee/\xf8\xc0\x9a\xbf\xe0\x17^\xfa\xc3" +
+	"]\xafO-K?\xce=@\x87\x1e\x826\xe4\x1f\xa2" +
+	"z\xe0C\xf4\x00\xdd\xb0\xeb\xb3\x91\x87\xb4\x87\x8f\xf3\x1a" +
+	"\xbd\xee0\x9c\x9f\xdb\x0e\xd3*\x1d\xc6N]\xf4\xec\x82" +
+	"\xbd\x0b^-\xfd\x90;\x08\x87a\xd0N\xd2\xf2\xc7i" +
+	"\xf9_.x\xaa\xaa\xe3\xdb\x1b?\xe4MT\xf2\x1e4" +
+	"\xb9\xdb{X>\xe5=z\xf2\xac\xb9p\xc3\xd1u\x9b" +
+	"\xb8\xefO\xfc+\xac\xe6\xee\x7f\xa5\xfa\x9c\xbf\xc2\xfb\xbf" +
+	"\xbd\xf2\xc9\x9f\x0e\x0a\x1c\xfe\x90cD\x1d\x99\xffWh" +
+	"\xef\xf4\xbfR\xe9\xe1\xafx9\xba\xbc\xf5\xca\xfd\xd7\xa7" +
+	"v\xf9\xc8\x1a#\x12\x1d\xb2\xa3p\xec\x90\xf7\xe9\x90\x1d" +
+	"\xc5\x0e]\xb5\xf0\xd9?~\x1b\x11?\xe2\x8e\xc0\xba\xf7" +
+	"a\x12m\xa3\x0fl}\x1f'\xc5/6\xe4\x7f\xf5\xe6" +
+	"\xc0\xda\x8fx\xf5\xef\xfa\x01\xcc\x89>\x1f`\xf9^\x1f" +
+	"\xd0\xdbi\xe0\x17+\xc9\xf7s?\xe2\x1e\x0a\xc5\x1f\x80" +
+	"\x90-\xd1\x07\xc4\x0f\xb0F\xcf\x9d_\xf4\xdd};\x93" +
+	"N\xf0\x06\xac\xeb1\x98\x12}\x8e\xd1\x0f\x1c\xc3\x0f\xbc" +
+	"\xf2\xcfC\xd7}\xa1\xf7\xe3\x96\x1f{\x0c\x06\xac\x98\x96" +
+	"/8\x86\x0d\xc8\xff\xe5\x91\xd5\x17\x17l9\xc1\xbf\xb7" +
+	"\x1f\x03\xf9w7}`\x17}\xe0\xc1\xed\xddS{\xfd" +
+	"\xf9\x85\x13<W\xa5\x91\xdd\x8f\xc3\x18\x0c>N\x85\xc4" +
+	"\xe3\xf4\xa4-x\xf4\xe7\xbf\x9b\xfb\xf0\xdb'x\x0e_" +
+	"#\xc7~\x08\x9dZ\xfa!5P|H\xc5\x8b/z" +
+	"\xef\xca\xef\xf1\xf1\x09\x81\xa73\xda\xf3!\x0c\xdb\xe1\x0f" +
+	"\xe9\x14\xfc\x90\xea\x8c|\xcb\xd7.}\xf5\x8e\xce'\xf9" +
+	"V\xa2\x130\xef.\x9f\xc0\x1f\x97N\xd0J\xed\xc8\xbb" +
+	"\xf2z\xf2\xdb!'\x9bk\xd4\xbb}Lz\x90\xb4\xfe" +
+	"\x1f\xe35\xbe\xf7\xc7\xf4\x1a\xff\xe9\x9f\x0f\x8c\xd57>" +
+	"\xf51\xcfsid\xca\xc7\xd0\xaf}>\xa6\x97\xc3\x8f" +
+	"i\x95\x16U\x0d\xda9\xe1\xd5\xeb\xfe\xc6\x1d\xe9\xfe\x9f" +
+	"\xc0H\x8f\xfd\x84\xca\xcf\x9f\xe0H\xff\xf6\xe1\xb3G_" +
+	"\xda\x98\xf77\xae\xc4s\xf2\x13\x10\xaa.}B\xf5\x96" +
+	"\x9f\x10\x8cq\xfd\x9d\xaf\xf1}\xcf\xb2\xfa\xbfq'\xdf" +
+	"i\x9c\x1b\xa7\xe9\xdc8\x8dsc\xc9\xa2\x11#r\xd3" +
+	"\x9f\xfc\x1bw\xe8\xc6\x9eF\xeb!}\xa0\xf84\xae\xce" +
+	"\x92\xb5+\x92\xb5\x15\xbf\xfa\x1bo2\x8d8C^'" +
+	"i\xc5g\xe8d:\x83\x1f\x18B\x96\xcc\xbf\xe7\x9b!" +
+	"\x9fpb?F\x06\xcf@\x85\x16\x9e\xa1\xf2\xc8\x19\xda" +
+	"I_o\xfb\xfc\x96\x17\xff\xf1\xf7Ox\x1fX\xf89" +
+	"\x0c\xf4\xaa\xcf\xa9\xb2\xf2s\x9c\x19\x1ff\xf9\xdf\x9e2" +
+	"\xbd\xf7)^\xf9\xf3\x9fC\x03\x12\xcfbyr\x16\xcb" +
+	"_p\xbc6}\xdb\x1b\xc7N\xf1\xce\x04\xe9,,\xcf" +
+	"\x06Z>B\xcb\x9f\xdf\xf5}}\xb9\xef\xc6O\xb9\xca" +
+	"\xd9\xb3 *\x9c\xa4\xe5\x8f\x9f\xc51\xbb\xac}=\xe3" +
+	"\x9bGr?\xe5\x8d@\xe4\x1c\xcc\xece\xe7\xa8\x02\xfe" +
+	"\x1cvP\xffu\xbe\xed\xef\x7f1\x03\xdf\x9fbs\xf7" +
+	"\x1f\xb9\xe9\x1c\x0a\x91\xe7\xa8T{\x8e\xa4\x83\xf3\xde[" +
+	"}\xf6\xdd\xb6\xf1\xd7\xf3?\xe5]7\x17\xfe\x1b\x14#" +
+	"k\xfeMo\xf1\xff\xc6\x1a\xcd9pB\\\xff\xbb\x8e" +
+	"\x7f\xe7\xb5`\xf4W0O\x0b\xbe\xc2\xf2\xf9_Q\xf5" +
+	"\xef\x83OV\xf4\xbd\xfe\x86\xbf\xf3z\xf4\xf8W\xd0\xa3" +
+	"\xe7i\xf9s_a\x0f\xcd?\xd5\xf0\xae\xaf\xf7\x8f\xfe" +
+	"\xce\x09\xbb\x1bY\xf05\xd4G\xfc\x1a\xcb\xcf\xf8\x1a[" +
+	"\xfc\xe4\xf1\xc9i\xcf>q\xdb\xdf\xb9\xa6\xa8\xf9_C" +
+	"\x17\xad\xc0\x07F.\xff\x1aW\xe6\x17\xbd\x86\x9dW\xba" +
+	">\xfew\x9e\xf6w\xeb%\x10u\xf6^\xa27\xfeK" +
+	"T\xa6\xed\xdb\xed\x85c\xaf\xfc\xe3\xec\xdfy\x12\xe7\xb6" +
+	"o\xe1H\xdb\xfb-}\xe0[\xba\xf4\x17\x8b\x9f\xe7\xbe" +
+	"\xf5\x8f[O\xdbb.h\x93\xbf\x03\xc5\xcb\xb9\xef\xe8" +
+	"\xe5\xe5;\\\x05/\xfd\xec\xf4\xbfF\xf4\x7f\xfc4_" +
+	".\xbf\x0cU:|\x19\x1fx\xf72\x95\x14\xea\xbfx" +
+	"\xf1\xban/\x9d\xe6\xb6y\xec\xf7\xe4}\x926\xfd{" +
+	"zL}O\xab4\xaa\xfc\x83\x9e\xc7f\x1c;\xcd\xb5" +
+	"\x8a7\xc1(,k\xa2\xf3\xa8\x09{\xb5c\xb1\xdf\xef" +
+	"\x9c\xfc7n\xf9-M\xb0i\xef\xa1\xe5w7QY" +
+	"'\xb8\xf6\xe0\x9d\x1d/\x9c\xe6\xac\x83\x11\xdd\x1d\x15\xa4" +
+	"\xdb`\xf8g\xb7\x81\x0e(\x9cW\x1fN:\xfd\xcb!" +
+	"g8Sh\xc4\x12G.\xe9\xb6\x0a\x0b\xafp\xc0\xfc" +
+	"\xb9\xda[?\xba\xeb\xa4\xf5\xbc\xc2#\x07\x12\x87F\xd2" +
+	"\xc6\x12\x07\xeer\x04\xcb\x1f~q\xed+O\xfe\xa1\xc3" +
+	"g\xbc\x9a\x1f&\x8e\x17I\xda9Z\xfe\x0cq@K" +
+	"S\x06\xba\x7f\xfb\xfb\xd3y\x9fq|\x9bFvu8" +
+	"\x0aIZ\x1f\x07\x96\xef\xe5\xc0\xca_\xb5\xa5~\xe1\xe0" +
+	"1\xaf~\xc6\xabO\x83\xc31\x8b\xa4-\xa7\xe5\x979" +
+	"\xb0>;v\xbcql\xef\x85\xef?\xe3\xed\x10\x83\x9d" +
+	"\x0e\xb8\xed;\xb1\xfcX'\xbe\xff\x93\xa7\xbe;\xf4\xca" +
+	"3\x15g\xf9\x12\x82\xd3\x91E\xd2\xb6\xd2\x07\xb6\xd0\x07" +
+	"\x16\xcf\x9f\xfd\xae\xeb\x9e\xbb\xce\xf2*\xd4-\xc1QA" +
+	"\xd2\x06&`\xf9\xfe\x09\x0e\x98m\x03f\x05_:\xb8" +
+	"b\xf7Y\xde\xfd\xe6r\x0248\x85\xd6\xaek\xa2\x03" +
+	"\xef\x1e\xbf\xd1\xff\xf9\xee=\x03\xe7\x9f\xe3}`]\x07" +
+	"\x87\x97\xa4m\xeb\x80\x1f\xd8\xda\x01[\x1c|\xfa\x8a\x85" +
+	"\xf3\x9e\xd9z\x8e\xdb\x82|\x97c\x11I\x9b\xee\xc2\x07" +
+	"\xa6\xb8\xf0\x81\xbai\x8b\x16g\xad\xfb\x15\xf7\x03\xe7\\" +
+	"\x8eGHZbG,O:b\x0b\x9e\xfc\xa2\xd7\xe7" +
+	"_|t\xe1\x1f\xbc\xf2\xc7;B\x0b\xce\xd3\xf2\xe7h" +
+	"\xf9s\x9fM\x7f\xa4t\x88\xeb\x0b\xde\x94x\xb7\x93\xe3" +
+	"1\x92v\xa6\x13\x96?\xd5\x09{\xb4\xe8\xf9\x9fl\xde" +
+	"\xfa\xe6\xec/xQ\x06#\xf3;\xc3\x9c\x9b\xde\x99\xea" +
+	"::;@\xea\xfd\xe8\xfe\xff\xa9\x984\xf8\xd4\x17\xbc" +
+	"\x06o\xbd\xc2\xf12I\xdb{\x05~`\xcf\x15\xf8\x81" +
+	"5\x9f\xcc\x7fjm\xaa\xe7K^\x85\x86v\x81\x1e\xcd" +
+	"\xef\x82\xe5s\xba`\xf9+\xf6m\x18\xbat\xc3\xd8/" +
+	"\xedQ\xa6X\xa1\x0d]\xa0B;\xf0\x81\x91\xdb\xba8" +
+	"P\xa3\xd3K\x7f{|\x9f\xfa\x09_\xf2f\xdd\xc0d" +
+	"\x98D\xa3\x93\xf1\x0b#\x92\xf1\x0b\xdb\xc5\x81\x97?x" +
+	"\xf2\x8e/y]\xba\"\x19f\xe9&Z~C2v" +
+	"\xa9X\xf2'\xf5\xa5?\xfd\x94\xdb\x82\x86\x14Xe+" +
+	"R\xb0\xfc\xf2\x14\\e\xd5/.\\r2\xeb\xf3/" +
+	"y\xe7\xd8\xb6\x14\x18\xe2\xfd\xb4\xfc^Z\xfe\x99\x7fM" +
+	"\x7f\xa8\xe8\xcbu\xe7\xb9J\xcd\x14h\xf0%Z\xfeb" +
+	"\x0a\xd6\xe7H\xd7_\xff\xdd\xf1?\x07\xb0|\x17[\xf9" +
+	"\x83\xa9\xb0*O\xa6R\xe3@\xaacB\"(\xc7\x9f" +
+	"~\xe8\xc3\x9e\xb3\xffv\x9e\xb7\xa9KW;2HZ" +
+	"\xe4jj\xe6\xbe\xda\x81;\xe8_\xbbT<\xfa\xd4\xcb" +
+	"_\x9d\xe7J\x12\xdd\xe1\x0b\xab\xbac\x8dVt\xc7\x1e" +
+	"]~\xc5\xdb'_u\x0d\xfb'\xaf\xc5\x97\xba\xc3\x08" +
+	"t\xea\x81\xe5\x13{`\x8b\x1b\xaf\xff\xf5\x1f\x92\x16$" +
+	"\xfc\x8b\xbbj\xfa\xf7\x80u<\x9a>0\xa2\x07~`" +
+	"\xee\xdb\xff\xf3\xc1gk\xaf\xf8\x17\xf7\x82\xbc\xa1\x87c" +
+	".I\xdb\xd1\x83N\x8a\x1e\x0e\xbc \xf7;\xd5\xf4\xde" +
+	"\xd7\xa3\xaf\xfb\x17\xdf\xef\xa4'<\x91\xd8\x0b\x9f \xbd" +
+	"\xe8\xd2\x1fsK\xf9f\xf1\xe0\xd5\x17x:\xac\x0d\xd7" +
+	"\xc2\xb0\xed\xba\x96\xaa\xef\xafu\xa0\x0ekA\xda\xafo" +
+	"\xffs^\xc6\x05n+\x8e\xf6u<E\xd2\xce\xf7\xa5" +
+	"k\xb3/\xae\xfd\xc6\x86\xa2\x9bo>>\xff\x02ws" +
+	"\xb9\x0e\xbau\xdbuts\xb9\x0e\xcb\xbf\xbc\xf0\x9e\xa1" +
+	"\xc5\xcb\x9f\xbe\xc0mC~?\xc7\x03$mF?\xac" +
+	"\xd2\xf4~\x0e\xca\xbc\xf2\x8b\xcc?-\x1f\xfa\x07~\x95" +
+	"6\xf5\x87V\xef\xea\x8f\x9f\xd8\xd1\x1f?\xa1u\x19\xf4" +
+	"\xe4_\xae\x9ev\x91\xfb@\xf1\xf5\xb0\x18\xc4\xeb\xf1\x81" +
+	"\x19\xd7\xe3Hlx\xe7\x91\xf1\xeb\x1b7_\xe4I\xa5" +
+	"\xbb\xb0\xfc\xc1\xeb\xa9\x02\xf9z\x07J\xa5#\x92\xbf\xb9" +
+	"\xe2\xb1\xd4\xcf.r7\x98\x83\x03`\xacO\x0e\xa0\xd3" +
+	"u\x00\x9d}\x9f\xcfZ\xef\x7f\xdc\xe1\xfe7\xb7N\x89" +
+	"\x19\xf0D\xf7\x0c\xacS\xb7\x0cl\xc4\xf0\x9f\xa8\x9f/" +
+	"89\x8e\xff\xc0\xae\x0c\xac\x14}`\x7f\x066\xa2p" +
+	"{\xc2M\xdf\x1d>\xf6o\xaeL1\xfaF\xd8U\x8b" +
+	"o\xc4\x1f\x057\xd2\x8e\x9d\xdc0\xf6\xcf;\xbf\xfe\x9c" +
+	"\xff\x89u\x83`\xacw\x0c\xc2Ol\x1b\x84\x9f8\xfe" +
+	"\xf7Q/\x1fy\xfd\xca\xaf\xecJ lv\xd7\xc10" +
+	"\x9d\xfa\xa3\x9c0\xb2\xcf`\xbaJ\xffz\xec\xf1??" +
+	"\xa2\xd5\x7f\xc5\xbd\xe3\\\xca\x84'R\x86\xe1'\xba\x0e" +
+	"\xc3}\xa0j\xc9]\xebsJ\x1f\xfa\x8ak#87" +
+	"\x0c\xb6V2\x9c\x1a.\x87\xd1V\xec\x15\xdf\xdaro" +
+	"\xa7_\x7f\xc5[\xd73F@?\xcd\x1e\x81_\x08\x8e" +
+	"\xc0F$L\xbb\xb3\xf3}w\xbc\xf4\x15\xb7\xd5\x07G" +
+	"\xc0\x04<E\x1f89\x02G\xe27_\xf4\x9bt\xe7" +
+	"\xec\x8f\xbe\xe2^\x1d\xe7\xdf\xecx\x8b\xa4\xad\xb9\x19\xab" +
+	"\xb4\xeaf:\xd8\x1b?X{\xe9\xc3\xc8O\xbe\xe6\xb6" +
+	"z\xd7H\x1c\xbb\x91t\xecFb\xab\xdf)\xf8\xcd\xbf" +
+	"\xfc\x1f/\xf8\x9ak!\x18\x05\x07\xdc\x9eQ\xf8\xf0\xee" +
+	"Q\xb4\xd1\xd3\xdev\xff\xa2\xcb{k\xbf\xe65\xbaO" +
+	"\x16t\xeb\xe8,\xba\xd7d\xe1\xe6t\xf1\xee\x9f\xf7=" +
+	"\xb8\xf7\x18\xb7\xfc\xf4,\x98}AZ^\xce\xc2Nz" +
+	"}\xc3\x0d\x81\xec\xb5].q\xaf9X\xfe\x14-\x7f" +
+	"2\x0b\x1b _\xb1-\xf5\xbeI\xf9\x97x4\x0a#" +
+	"w\xdc\x02\xdb\xf1\xde[\xb0\x05{nq\xa0g\xd2\xa7" +
+	"/\xf9\x1f\xbb1\xe1\xe2%\xfb>\x80W\xf8%\xb7\xc2" +
+	"0\xac\xbb\x15\x9fXs\xab\x03\xcd\xd8\x8f\xf6\xbe\xe9\xc4" +
+	"cR\xf27p!\xefm\xb9\x90\x0f\xce\x81\xedxl" +
+	"\x0e\x14\x9f:*\xc7\x81\x17\xf2w\xe5\xbb7\xa4\xfd\xbe" +
+	"\xcf7<:\xa8\x91\x03s\xe0P\xcf\xc9\xa1\x02w\x0e" +
+	"\xdd^\x8f\xfe\xe8\x86\xfb'\xbe3\xe2\x1b\xee\xcc\xd80" +
+	"\x1e\x06z\xf7xl\xf5\xae\xf183R\xde\xbc\xe1\xf9" +
+	"\x07g\x15\x7f\xc3u\x85\xcd\x83C.\x98G{5\x0f" +
+	"\xcb\xdf~\xe8\xd8\xae\x97\xae\xbf\xe3\x1b\xde(\x90|\x98" +
+	"\x16\xdd\xf2\xb1|J>\x8e\x9a\xf8\xe1\xcc\xbf\x8d\x99\xb6" +
+	"\x80_\xa1\x11\xf90\x0c\x05\xf4\x81\xfc|\xfc@\xa4q" +
+	"X\xaf\xa4\xa7v|\xc3UB\x9c\xca\x87\xad\xf2\x12>" +
+	"0\xf2b\xbe\x03\xefF/,\xcd\xb9\xfc\x97\x81\x1d\xbe" +
+	"\xe5\xaa\xef&\x80\xa8wj\x02\x1d\xe8\x09X\xa5\x8f~" +
+	"\xd4\xe1\xc7\xff\x0eN\xff\x96[%2\x11\x86\xad\xfbD" +
+	"\xba\x8fM\xc4*}\x95\xb4ry^ \xef;\xee\xea" +
+	"\xd96\x11\x1a\xbd\x97>\xb0g\"N\xa5\x8b\x9f\xbc\xf7" +
+	"\xe5\x1fg\xd5}\xc7\xf5%-\x806o)\xc0\xf2\x9b" +
+	"\x0a\xb0F\x1f\xff\xdcQ\xb8\xe2\xa9i\x97y\x83\xb0\xb7" +
+	"\x00\x9a|\x94\x96?\\\x80\x15\xcaZu\xc7\xd9\xc8?" +
+	"\x96^\xe6\xbd\x7fv!\xac\xb5e\x85X~I!\xbe" +
+	"\xff=w\xf2\x9c\xc1\xd7-\xfd\x1e\xee\xb3\x0e\xbb\x8ap" +
+	"S!4`\x17}`G!6`\xf3\xe9\xcd\x89i" +
+	"\xd7<\xfa=\xafB\xcbo\x87\x1dl\xc3\xedX~\xdd" +
+	"\xedX\xa1\xf7\x1f8\xd2\xb8*\xf3\xc7Mp\x81q\xda" +
+	"\"\xa5F\x8e(\x82\x16\x14\x14Q\x05f\x11]\xfd\xde" +
+	"7G\xfd\xe9\xf2\xd6?7q\xfbtM1\x1c\xba[" +
+	"\x8b\xe9\x15\xa3\x18\xab\xd4q\xc3\x93\xf7\xef\x08|\xd7\xc4" +
+	"]\x9e\xf3'\xc1\xd9\xb0b\x12~b\xf9$X\x9e\xe3" +
+	"\x9bdE\x974E\x0c$\xde$\x86\xe4\x9b|bH" +
+	"\x09\xdd\xe4S\x83AU\xf9\x11\xfe\xc8\x9a \xe9\x93J" +
+	"\xc6\xe7\xc9b@\xad\x8eH^);\x1cR\x95\xb0T" +
+	"BH\xa9\xc7\x99 \x08\x09D\x10R\xe6\xe7\x0aBi" +
+	"\xbd\x93\x94.v\x10B<\x04\xb0\x85\x99\x82P:\xcf" +
+	"IJ\x97:H\x8a\x83x\x88C\x10R\x96\x14\x0aB" +
+	"\xe9b')}\xd8AR\x9c\x0e\x0fq\x0aB\xcar" +
+	"\xaf \x94>\xe8$\xa5\x9b\x1d$%\xc1\xe9!\x09\x82" +
+	"\x90\xb2\x09^\xb9\xdeIJ\x9fw\x90\xc6p\xc4\xe7\x93" +
+	"\xc2a\x92 8H\x82@\xd2%MS5\xd2Ep" +
+	"\x10\xb0\xd2\xf8\xa3\x95\x13\x04!\x86i\x12\xad\xa7@\xc2" +
+	"\xe4J\x81\x948\x09\xfe\xe9J\x814*!\xdf$1" +
+	"(\xc5\x8a\x1a]\x90\xd0R\x17\xe4\xd7\x87\xa6\x84\xfc\xa2" +
+	"N\xb0\xd5\x1dc\xad\x1e\xd8W\x10J\xfb9I\xe9\x10" +
+	"\x07I1\x9a=8C\x10J\x078I\xe9p\x07q" +
+	"I\xf5!\xa3\xceI\xa2h\xfe\x88}\xb3CK\xdf\xcc" +
+	"kP\xc4\xa0\xec\xabP\x15i|\x8d\xaa\x86%\xf8\x97" +
+	"W\x0a9\x03\x0dP\x89.\xb1J\xe4C\x8f\xe69I" +
+	"i\x09S\x89\xe2Y\x82PZ\xe4$\xa5\xd3\xa0\xef\x1d" +
+	"\xb4\xef\xa7d\x09Bi\x89\x93\x94\xde\xe5 M\xfe\xb9" +
+	"\xf0\xc2\x02\xbf \x08\xb1J\xf9\xe7\x16(a]T\x84" +
+	"$\x9fT\xe07\xe0l\xff\xdc\xf2\x86\x90\xd4\xac\xea-" +
+	"vW\xb9\xac\x07\xa4\"9Lt[we\x9a\xdd\x15" +
+	"\xeb\xad\xacho\xe59H\xbaO\x8d(z\xec\xb3:" +
+	"\xbc&6x\xc9&G\x81@\xc8\x95L=Z\x9c\xb9" +
+	"\xc5\x91\x80.\x17\xabuR\x81.\x05\xcb\"\x95\x82\xd0" +
+	"r\xcf\xc5:Nc;\x8ep:\xaeJS\x83e\x01" +
+	"U\x17\x04\x81$\x9bJ\x0f\x81\x90d\x814)\x91`" +
+	"\xa5\xa4\x15(Bz\x99.\xfaj\xcd\xc6\xa8\xf0\x0c\xe7" +
+	"\x816{3/\"\x05\xbcR8\x94d\xac:\xa6C" +
+	"\x0b\xcd\xa9\x16\x1b\xfa\xa1\x00\x0eq\x92\xd21\x0e\xd2\xa4" +
+	"\x8bZ\xb5\xa4[GYRtYo\xb0bm\xd6\xa2" +
+	"@\x09\x87$\x9f.\xc43\xf7\x0a\xcd.$\xc6\xd4\xab" +
+	"\x10\x84\xd2r')\x9d\xd9B\xa5B\x01\xb1A\xd2\xac" +
+	"\x18-7I\x14\x9cm,VY\x97\x82\xd1z\xe6F" +
+	"\x02\xb50\xdc%b\x92\xafV\xc2\x09\x98\x10\xabnW" +
+	"\x98\x80\x1d\x9d\xa4\xb4\x9f\x83\xa4\xc3C\xe6\xec2\x8c\xcf" +
+	"\xb6\xb9\xd5b\x7f\xe4\x8a\x8a_\x0d\xc8\x12\xd1lC\x92" +
+	"\xc1\xdb\x122\xcdI\x9e\xa40[\x8f\xad\x12\xa6\xdd?" +
+	"\xde)>!\"\x07\xfc\xc5\x12\xcc\xb9|E\xd7\x1a\xe8" +
+	"\x14\x1fnT\xc7=\x83d\x08B\xd94\xe2$e~" +
+	"\x12\x9b\xe5n\x91d\x0aB\xd9]\x00\xd7\x10s\xa2\xbb" +
+	"%\x92%\x08e3\x01\x0f\x10s\x83v\xcb\xc4+\x08" +
+	"e5\x80\xeb\xc4\xdc\xa3\xdd\xb3\xf1\xf5\x01\xc0\xeb\x01O" +
+	"L\xf0\x90D\x88\x02$\x15\x82P\xa6\x03\xbe\x00\xf0\x0e" +
+	"\x89\x1e\xd2\x01B<\x89&\x08e\xf3\x00_\x0a\xb8\xab" +
+	"\x83\x87`\xf0\"\x99%\x08e\x8b\x01\x7f\x18\xf0\x8e." +
+	"\x0f\xe9\x08\x01\xc6\xa4R\x10\xca\x1e\x04|5\xe0\x9d\x88" +
+	"\x87t\x02\x8e\x09|\xffJ\xc0\xd7\x03\xde\xb9\xa3\x87t" +
+	"\x06\x16\x18|\xcfZ\xc07\x03~E'\x0f\xb9\x02\x02" +
+	"/\xb1]\xeb\x01\x7f\x1e\xf0.\x9d=\xa4\x8b \xb8\xb7" +
+	" \xbe\x11\xf0\x17\x88}l\x02R\x9d\x14\x88-\xe0J" +
+	"Q\xa9\x954\xe3g\x93\xafF\xd4\xc6\x07\xc40\x1c/" +
+	"\xc6\x06\xaf\x89J\xad9y\xe5\xa0T$\x86u\xc19" +
+	"Y1AM\xae\x8c\xe8R\xbe\x90\xae\x88\x95\x01sK" +
+	"\xd5U]\x0c\x94k\xb2\x90\x04\x7f\x8e\xc1\x011\xac\x97" +
+	"kr\xa5\xe0b\xd1P\xa42 \xfb&\xa9\x82S7" +
+	"\x97\xc5\\U\x91d%\xac\x0bI\xa2\xe2\x8b\x95\xcd\x9e" +
+	"\x8b{|\xec\xa7\xaa\x04d\xa5\xf9N\xde\xe2\xf4\x1a_" +
+	"#\xea\xc5R8,VK\xe3\x01\xa0\xd3\xeb\xea\xd8l" +
+	"_\x03\xb3}\xa5\x93\x94\xaegf\xfb\xbaJA(]" +
+	"\x1b=\xcd\x8d\xb3g\x13l\x00\x1b\x9d\xa4\xf4\x05\x07!" +
+	"Nz\xeco\x85c\xffy')\xdd\xc9\x1c\xfb;\x00" +
+	"\xdc\xee$\xa5\xaf\x99\xf3)e7\xc8\x02;\x9d\xa4\xf4" +
+	"\x0d\x07I\xd2\xa5z=\xd6\xee \xad]\xb9\xe0\x82#" +
+	"\xca@\xc3\x92\xe2\x974\xdb\xce\x01#\x12\xd6\xc5\xa0@" +
+	"B$Qp\x90D\xd0v\xc1\xfa\x99\x04X\xac\\\xa3" +
+	"\xafFT\x14)\x10\xbfx0\xa1XW\xab\xab\x03\x92" +
+	" \xd8\xb6\x9b\xac\xe8v\xe3q\x90lZ$\xfe\xf3?" +
+	"\xbf>$\xf9e]V\x95\"\xd5W\xabF\xf4r9" +
+	"\x08\x8b\xdc\xa5k(\x00$\xc7>#\xce\x15\x84\xd2\x99" +
+	"NR\x1a0\x8f1\xf9\x01A(\x0d8Ii=s" +
+	"\x8cE\xa0ku')]\x00K\x9b\xd0A\x98\xef5" +
+	"\xa5\xb4&)\xfaQ![U,\"RX\xf2\xa9\x8a" +
+	"?\xec%RP\x94\x15Y\xa9f\xcf\x93:I\xd1\xcb" +
+	"\x1bB\xd0\x87\x16\xcc\xda\xafq\xec\xa9\xaaZ\xeb\x95f" +
+	"G\\RX\xb7\x9d3\xd0\x93\xe3\x9c\xa4\xb4\x88\x99g" +
+	"\x05\x19\xcc\xf1\x1d\x9df\xc50Q&:Ii\xb9\x83" +
+	"d\xcf\x91\x15\xbf:'\xb6<uF\x88i\xd4\xeb\xf5" +
+	"*9 \xb5g\x90\xf3\x83\xaa.U\xb8TE\xb2\x0d" +
+	"s\x869\xcc\xd6\xc9\xd9.!O\x0d\x86\xc4p\x98\xee" +
+	"\xe2\xf0\x81\x9e\xb1\x0f\xec(d\x96\x84\xd1\xfa\xdd \xe1" +
+	"\xbd\xe2$\xa5\xfb\x98U\xb6\x17\xfa\xe95')=\x00" +
+	"#\x1c]f\xfba\xe9\xeds\x92\xd2C\xb0\xcc\x12\xe8" +
+	"2{7U\x10J\x0f8I\xe9\x11Xf\x89t\x99" +
+	"\x1d\x06\xf0\x1d')=\x06{v\x07\xdc\xb3S\x8e\x02" +
+	"x\xc8IJO\xfcGR\xa3\x7fn\xd9\x1cY\xf7\xd5" +
+	"\x08N\xb3\x0ci \x9d\x05\x07\xe9,\x10R\x1f\xfb\xd7" +
+	"\\\xe3_q\x08\x9a\x9a\xe8\x97\xb4\xdc\x08i\xb0u\x18" +
+	"t\xc3\x0bNR\xfa\x0a\xd3a\xbb2\x99^4:l" +
+	"w\xa1\xd9\x8b\xc6\xb6\xb4\x17\xb07\x9c\xa4\xf4\x1df[" +
+	":\x98\xc5t\xa2\xb1-\xbd[\xc9\xf6Wb\xb4\xbf\xe0" +
+	"\xf1#NR\xfa\x89\x83d\x8b>X\xc1\xb1kKH" +
+	"\x93}\xcc\xae\x8f\xd5\xb7\x09b\xba\x14\x84\x95\xc7\\e" +
+	"\xb2\x013;\xadI\x0ch\x92\xe8o(\x13\\j\xc0" +
+	"DgGD\x94\xeb\xda%\xd5\x81p\x9c\xa7\xaa\x9a " +
+	"\xd8\xa4\x98,\x9e\x14\x93eJ\x9b\xd9~U\xd5ds" +
+	"\xb0\xad\xed\x8c}\xd8\xd9\xd2\x87\xb3'\x14C+m\x1b" +
+	"\x19,\xde\xbb\x9c\xa4\xb4\x86\xf9\xaa\x94e\xeen\xb1a" +
+	"\x93\xa1\xa4\xdfIJC\xe6\xb0\x05\x01\xabq\x92R\xdd" +
+	"A\x1a\xd5\x80\x9f=\xcb\xb3\xab\x83\xec\xcfFE\x9ac" +
+	"\xf9])\xd2\xe2\xf1\xd7\xbf,$\xceQ\xc2-\xec\xf7" +
+	"\xfd\x1c$;\x8c\x05L\xd1.\xe6{h\x13\xedZ\xfc" +
+	"\x84\xab\xe8\xb6\x09q\xdc\x9f\x98\xdbfz\x9d\x18\x88\xc4" +
+	"\x1aa\x11f\xda>\xea\x8b$\x98\x8c\xe1\x1a9Do" +
+	"\xbb.Q\xb7\x0f\xcfcf\xa7\xa7\xc0\x86\x0b`\xf0E" +
+	"A(\x0d9I\xe9<fx\x1a\x1ean\xfe\xc66" +
+	"\xb4\xe4YA(]\xea$\xa5+\x1d\xa4\xa9ZS#" +
+	"\xa1\"I$\xc67\x9d\xf5!r\x85\xe0 W\x08\xcd" +
+	"\xffX\x92\xae\xca\x8a\x1e\x93\xb3\x9a4Q\xf6Cu\x89" +
+	"Q_b>k\xfb[\x89\x9ady\xb4\xcdn(\x13" +
+	"\x1b\x8ad\xa56W\xf57\xdc\xa6\xe1:\x84N\x18\x14" +
+	"\x13\xa8\xfb\x93BA(\xeb\x07\x12\xe3\x10bNS\xf7" +
+	"`\x94$\x07\x00>\x9c\x98]\xe1\x1e\x8a\xe5\x87\x00>" +
+	"\x86\x98\xbd\xe1\x1e\x8d\xf8(\xc0\xf3\x88\xb9/\xbbs\x10" +
+	"\x1f\x07x\x111\xb7fw\x01\xe2\x13\x01/'\xe6\xee" +
+	"\xec.E\xbc\x04\xf0\xbb\x00w\xb9\xa8D=\x9dT\xb0" +
+	"\x82\x7fJ\xc7\x8eT\xa2\x16I\xa5E\xc2\xef\xd4\x89J" +
+	"\xd42\xa9\xb4H\xf8\x9d;S\x89\xda&\xe17\xd1\x85" +
+	"N\xf7,\xdb\xfe\x14\xfd\xd9$F\xaa\x83\x92\xa2\x0fe" +
+	"\x8a\x18X&\x07\x1b\xc6\xc1\x86s\xb0\x11,&\x87\xf3" +
+	"\xeb\xd4@\x9d,8\x95\xea\x18(\x01$M\xd0\x04\x97" +
+	"\x1a\x09\xd9\xd0\"Ip\xd5\x99B]R\x8d\x18\xae\x89" +
+	"\xff\xf0/\x93\x83\xa1\x80T,\xa5\xa3\xb0\x19\xcf%\x1c" +
+	"\x96\xea ')\x1d\xe5 Ma]\x93\x95j\xcb>" +
+	"\x9f\xeeS\x03\xaa\x16\xff\xb4\x9c \xe9\xf9\xb3\xc3\xba\x06" +
+	"\xe2\x91\x14\xd6\xed\x82&T\xa1\x8b\x93\x94^\xcd\xff\x1a" +
+	"w\xb3\x81\xabA\xf4\xed\x93\xa4\xf49\x15Q\xb9&\x14" +
+	"\x9b\xea\xc4Y(\x08^\xa7\x93\x94uq23\xbd\x93" +
+	"\x13\xee\x82\x1d\x01\xf78\x99\x99\x9e\x02\xc5\xcb\x92\x01\xef" +
+	"\xe9\x8cm\xcb\xee\xeeN\xb8jz\x00\xee\xedd\xae\x8e" +
+	"\xbd\x9c\xb0`\xae\x06|\xb8\x93\xb9:\x0e\xc5\xd7\x0c\x01" +
+	"\xbc\xc8\xc9\\\x1d\x0b\x9c\xb9\x82P\x96\x07\xf8L's" +
+	"u\x9c\xe1\x84\x89~\x17\xe0\xf5N\xe6\xea\x18A\\\x07" +
+	"\xfca'su\\\x8e\xefY\x0a\xf8J\xc0;;\xe8" +
+	"D_\x81\xdf}\x18\xf0\xb5\x80_\xd1\x91^\x1d\xd7\xe0" +
+	"{V\x03\xbe\x1d\xf0.\x9d\xe8\xd5q\x9b\x13\xae\x9a/" +
+	"\x00~\x00\xf0\xae\x9d=\xa4+p\xe6b\xf9}\x80\x7f" +
+	"\x02\xf8\x95Wx\xc8\x95\xc0e\x8f\xe5O\x00\xfe\x1d\xe0" +
+	"IN\x0fI\x02Vrg_A(\xbb\xe0t\x12o" +
+	"\x82\x83\xa4$'xH2p\x888\x1f\x11\x04o\x02" +
+	"t>\xc0)\x89\x1e\x92\x02\x9d\x9f\x00\xbd\x99\x00x2" +
+	"\xe0\xa9\x1d<$\x15(B\x11\xef\x08\xb8\x07p\xb7\xcb" +
+	"C\xdc0(\x88w\x01\xfcj\xc0=\x1d=\xc4\x03\x99" +
+	"E\x12`Y'\x03\xde\x13\xf0n\x9d<\xa4\x1b\x8cV" +
+	"\x02\xd4\xfej\xc0\xfb\x01~Ug\x0f\xb9J\x10\xdc}" +
+	"\x12\xa0\xd7z\x02>\x00\xf0\xb4+<$\x0d\xb6C\xc4" +
+	"{\x03>\x08\xf0\xab\xbbx\xc8\xd5\xc0\x13\x950\x17\xb6" +
+	"C\xc0\x87\x03\xde\xbd\x8b\x87t\x87\xd1M\x80^\x18\x02" +
+	"\xf8\x18\xc0{t\xf5\x90\x1e\xb0\x1d\">\x0a\xf0<\xc0" +
+	"\xaf\xe9\xea!\xd7\xc0v\x88\xf5\x19\x07\xf8]\x09\x0ez" +
+	"\xed\xb6\x89GM\xe1\x1aU\xb3\xdf5\x02\xaaRm+" +
+	"\x97>\x97\xbd\x00dW\xa9\xd5^\xc9o\x9c\xcf\x09T" +
+	"5\xdcT\xa5VO\xd0$I\x81\xe7\xac\x7fj\xacR" +
+	"\xabs\xe1xm\xfeD\xb1\xac\xf8\x02\x82S\x0e\x19\x7f" +
+	"\xeb\xcc\xfcM\xac\xe7\xfe\xad\xb1Z\x13\xebd=&\x00" +
+	"\xe3\xed\x14\x04fv\xd9j\xa2\xac\x8c\xaf\x11\x15\xc1\xe9" +
+	"k\xf6]\xf8[^D\x13\x85$\x94\xbcl\x7f\x0d+" +
+	"\xea\x9c\x96\x9e\x84\xbf\xb5\xf8\xa4+\\\xdb\x10\xfb>l" +
+	"\x11\xf9\xf5\xa1b\x02\x8a\xd4\x10j\xbb\x8c\xfa\xa6\x87\xc5" +
+	"*i\xba\xe5\xd74\xcb\xaf\x0a\xe3WRP\xac\x8f\xfd" +
+	"h\x8a\xc0\xc5|\x8e\xaa\x09\xce\x80\xdf\x00\x1b\x83\xd0\x81" +
+	"r\xc8\xfc-\xd6\xb3\xbf\xb1\x1ee5\xaa&d\xc38" +
+	"K\x99\x16\xadG\x81\x7fRDH\x02\x0d\x98\xa5\xde\x05" +
+	"\xcd\x94!\x08\x97\xa8\xb2\"8uF0\x8bE\x80\xc7" +
+	"\xabs+\xba\x0d\xb5ne\x92\xa8\xf9j&Ddg" +
+	"\xc0\xdf\xb21$\x85o\x0dq\xf0\xac!QAiy" +
+	"a\xd4\x1a\xb2\x9a\xb9\xaf\xad\xf2\x9a\x9a\x96F\xa8\x8e\xa8" +
+	"\xc4\xa4o\xab\xa6\xaaI\x14KP^\xb2\xa8R\xe5 " +
+	"\x1a\x10X\x8c\xa3\xc2j\xbb\xf1\xb9\xe2\\Q\xd4\xa6\xe2" +
+	"\xcd\xbaL\x175\xa2\xb7\xa2\xf3h\xe1V\xd0\x8a\xe1 " +
+	"(M\xae\xca\x13I\x83\xadC3x\x1d\x9a\xc5\xebP" +
+	"\xb0\xc8,p\x92\xd2\x07\x99\x0e]\x96\xc9\xf4\xb2\xd1\xa1" +
+	"\xcb3Lq4\xa9F\x8d\xc4fOvPV\x18u" +
+	"\x9b\xcb/\xc6\x16DzPU\xf4\x9a\x98\x80\xdd \x89" +
+	"Z\xfc\x8d\xc3I\xe3S\x83I0t6\xc9\xda\xcb\xdc" +
+	"qb*\x9c,\xe6\x8e\x13m^\xd0\xcb\xa8u\x0c\x0d" +
+	"N$\xd7\x14\xc1\x9bT\xbd\x06\xaa\xc1n\x86\xd9\xc1\x06" +
+	"\xcbU\x00u^\xd2lY \xb1\x19\xd4\xa8VU\xc9" +
+	">\xa9\x1d\xad\x01\xcd`A\xb5\xa2fk`\xe9iM" +
+	"\xcf\x0e\xdf\xb6\x9b\xe0\xda~\x7f\x89\xa4\xa3\xf2\x8b\xca\xdf" +
+	"\xa3bBI\x03j\xa2\xebA\x1e]\xcc\xca\xdf\x0bI" +
+	".\xabY\x8ev\x98{\x09\xf1Z\x14\xcb\xd1>s/" +
+	"Gq})\xe0+\x01OpP\xa9d\x05\xc9\xb0(" +
+	"\x9c\x13\x1dT*YE\x0a-\x0a\xe7\x0eN*\x95\xac" +
+	"#^\x8b\xc2\xd9\x95@\xa5\x92M\xc4\xcb*\x96S:" +
+	"&R\xa9d+\xbe\xe7y\xc0w\xa2\xf8\xdd\x81J%" +
+	";\xb0\xfcv\xc0_C\xf1\xdbE\xa5\x92\xdd\xd8\xac\x9d" +
+	"\x80\xbfA\x18\xa9d\x0f\xbe\xe75\xc0\x0f\x00\xde\xc5I" +
+	"\xa5\x92\xfd\xa8\x00\xdf\x07\xf8!\xc0\xbb&P\xa9\xe4]" +
+	"l\xef\x01\xc0\x8f\x10\x07i\x0aI:Z\xaa\x04\x12\x1b" +
+	"\xf3\xc6\x88V-)>s\xf7\x17}h\x8f\xc3yb" +
+	"L%\xd0HW\x07cR4\xec\xa8\x96\xfdD\xb1\x9d" +
+	"\xcd\x80\xc1\xee$\x90\x80\xa5\x9c\xcf\xb6\xef\x00\xa6\x89>" +
+	"\xdb\xfe$\xa1\xe2\x93)\xd7\x18Q\xc4:Q6\xdf\x15" +
+	"\x96\x14\x1d\xf66\xf6\xb9\x901u,\xca\xb8\xec\xea " +
+	"W7\xd7\xe2\xfd;\xa9L\x8f(\xad\xc8\xd9\xfe\x88&" +
+	"\xe2g\xda\xa3p\x99\x00W\xdbBU&J\xcb\x0a\xce" +
+	"\x98~\xb3\xd0\xd4e\xc6t\xb8\xa5\x15\xa6)\xd2\xbe\xbf" +
+	"6\xa8\x91f\x03\x10D\xdb\x90\xd2\xb6\x0d\xcd\xaeX\xf3" +
+	"\xd5\x96\x8b\x9a\xab\xba\x99\x09\x8d\xed\x02jI\x94\xdbg" +
+	"I\xcc\x93\x02\x92.\x95\x85\\R `\xbbIyy" +
+	"7\xa9\\\xf6&\x15\x92\x02\x81\xb2\x80*\x90\x98\x99\xd8" +
+	"\xee\x1a\x10\xdf\x18(\xfe\xb2\x90K\x9c\x83\xa30 \xb6" +
+	"\xb9t\xc2\xd5\x9f\x00\xab$\x99\xdd\\\xba\"\xde\x11p" +
+	"\x0f{\xb9OA\xbc\x0b\xe0W\xb3\x97\xfbn\x88'\x03" +
+	"\xde\x93\xbd\xdcwG\xdc\x03xo\xf6r\xdf\x0bW\xf9" +
+	"\xd5\x80\xf7#\x0eB\xa2w\xfb>X\xbc'\xc0\x03X" +
+	"kY\x7f\xc4{\x03>\x88\xb5\x96\x0d$\x15\x16\x1dD" +
+	"\xa7\x8ets\x19Jf\xb1:\x08\x10\xc8\xa6\xb1\xd2\xd9" +
+	"t\xf3\x87\xacLc\x7fL\xe7\x0aq\x8d5\x92\xe8\x97" +
+	"\x95\xea\xd8\x1f\xd9\xe3%\x09\xb4\x01\xb1\xc1\x08\x8a\xf59" +
+	"\x81\x80:GpJ\xa6\xaa\x12\\3\xc49\x8a.$" +
+	"\x81I#\xfe\x81+\xbamBN($\x89\xe9\x1a\x88" +
+	"u\xb6\xb9\x93\xcb\xd3X\xf65'T#~\xd3T\xa3" +
+	"\xba\x02U\xd5\xed\x90L`A\xa0\xd9#\x0e\x95\\n" +
+	"K.\x0d\x8d\x92\xa2k2\xeb\xd3\x10\xcbw\x14\xaf\xf0" +
+	"\x09W\x84\x80\x14\x0e\x17(`aQ\xb5\xf4\x06\xf0+" +
+	"\x88\xc7\x06]h\xae\xa3\xa4p@\x8dU\xaa)\x1c\xa9" +
+	",P\xfcR}\xbbVqYt\xabi\xef\x97-\x92" +
+	"\x08\x9c&\xf6\x0d\xab}\xe2wI@t5H\x9a\xcd" +
+	"*\x99\xcb\xb3JzyVI\xc6\xc7(&.n\xc9" +
+	"4M\x951qqk!c\xab4\xec%;rM" +
+	";\x83](G?\x91\"\xeb\xd1\xd7\xa8\xabE\xac\xa8" +
+	"\x9e\x1e\x94\x95\x1c\xb15!\xbd\x11\x0e\xca\xb0\xd4\xfa\xee" +
+	"\xc6hr\xe0\xf12)\x1c\x96U\xa2\xd8\x86\x86\xab\xd2" +
+	"\xaf0\x87\xc6f+n\x92\xd1\xa8\xe3\x93X\x83\x0d\xf7" +
+	"\xf3sT-\xe0\x8f~\xbfpj\xb9\x17\x1d\xaf\x9ca" +
+	"\xa9\x95\x8bAX\x17\xf5H{\xb4\xb2\x92^&iu" +
+	"\x92v\x9b\x1c\xd0%\xcd\xae\xfe\xca5\xc5\xcd\xc6*," +
+	"\x11\xb6_z\xe32\x85\x80\xe3\x88 \xd8\xee\x1e\x85\xcc" +
+	"5\xc3\xe8\xb7%Y\xcc5\xc38\x9a\x97i\xe6\x8d\"" +
+	"\xe6\xda\xb6\x02LD\x0f;I\xe9Z\xc6\x98\xb4\x06\xe6" +
+	"\xe2j')\xddh\xf3'r\x08\x0e\xe20\xbd\x85\xa2" +
+	"?[r*\xc2Gs\xc5\xea2\xc1\xc5\x96\xd6U\xc0" +
+	"\xe8!i`\xf1\x99\xd2\x0at)=X\xa0T\xa9\xf1" +
+	"X\xf9s\xcd6\xc4\xd6\xd3\x86,s\x91\x19v\x99M" +
+	"\x19\xcc\x1a3z`K\x06\xb3\xc6\x0cs\xdaVx\xe5" +
+	"f')\xddn\xdb\xa2\x1a+\xb1A\xb1\xdf6\x8b\x98" +
+	"\xed\xfc\xf1\x99\"\x11\xd8\xf2\xb5j\xce\xf2\xe9\xd0\x8a\xa2" +
+	"\xd5+\xf9\xe4\x90\x94'\xe9\xa2\x1c\x08{\x0d7B\xe1" +
+	"\x87:<\xb2S\xc5\x98\x15\xcb*\xa2Se#\xd3'" +
+	"\x1br\xa3\x9d\xb7\xb3-\x87\xc7l\x0d\xabH\x92\xcd\xac" +
+	"\x0bQo2\x9f\x1a\x0c\xa9\x8adSq\xc4\xb2?\xd1" +
+	"S\xa6Q\x8d\xe8\xa1H+\x05\xe2\xb8\x9e\xa1\xbbVN" +
+	"\x8e \xb4b\x02\x13E\xb8\x1a\x9a_\x89\xd1\x05\xc6\xfb" +
+	"\x15\xdc\xe8\xbd\xa2\x92^kLJFb\xce\xe0H\xcc" +
+	"\x99\x8c;\x9a1\x00\xc5\x95\xa6;\x9a\xc5E'\x1d\x1d" +
+	"\x0cc\x07OH\xd2\x82r8,\x0b.Ui\x874" +
+	"\x09;n\x9e\xa8\x8b\xb4'\xfa\xc5\xeaw\xae\x87 \x94" +
+	"\x9ev\x92\xd2\x0bf\xfd\xce\xc3\xba\xff\xd2IJ\xbfc" +
+	"\xeaw\x096\x98\xaf\x9d\xa4,\x81\xbd\xa0\x12\x90\xd9\xbc" +
+	" \xb2ua\xef\xa7\x9dH\xa6EBMtR\x09\xb2" +
+	"+\xc9\xb4H\xa8\x1d\x12\xa8\x08\x99B2-\x12\xaa+" +
+	"\x91\x8a\x90\xddH\xa1E\x12\xed\xd8\x81\x8a\x90\xbdH!" +
+	"+r:M\x13o\xbcjV\xea\x89\xe4Wl:9" +
+	"\x8e\x86p:__\xd8\x14\x94\x15<%Yy$(" +
+	"\xd67\xc3\xe2\xf1^\xd2\xc6k\x92\xa8\x83sE\xa5\xac" +
+	"\xa4\xe3}\xcd\xb6\xb7\xcdb\xb61c\x9c6d\xf0d" +
+	"\x85\xcc\xb8e\x05/OV\xd0L\xf7\x83&1\x10P" +
+	"}\xa2.\x0bI*#\x92&\xc1\x0d\xd8\xb4\x10\xc1\xc9" +
+	"\x1f\xfb\xe5\x97@Y\x1c\x1b\x0b]\xd4\xf4\x0aU\xb1\xb8" +
+	"\xdc\xd4\x87D%,\xabB\xba\x12\xf6J\xb3\xdb\xb5\xe5" +
+	"\xe5\xf8g\x89>I\x81WJ\xe1\x16lL\xec\xf9\xdd" +
+	"LTh\xe3.\x9d\x8e\xf2s{\x9dWy\xd7K\xbf" +
+	"\x1c\x95J\x04\xa1\x99\x9fH+\xe2\x83\xe2g\\\xd9\xbc" +
+	"\xd9\xb4\x85-o(\xa6\x8fQ%{\x07\x8f\xce\x84\xd2" +
+	",fGi\xdb\x1b-\x9b\xfa\xb1\xc6\xafyH\xcf\xd1" +
+	"4u\x0e\xd4nH\xecj\x9a\x83w\xbe1\xb00'" +
+	"\xb2W\xd3|\x92a\xb1\x17\x1bW\xd3\x02\xc4\xf3\x00/" +
+	"a\xaf\xa6\xc5$\xc3bG6\xae\xa6v;\xb2q5" +
+	"\x9dN*-vd\xc3\xee,\x92\x0c\x8b\x07\xa9aw" +
+	"\x96H_\x8b}\xd9\xb0;\xcb\xa4\xd0b_6\xec\xce" +
+	"\xb3\x11\x0f\x01>\x8f\xb5;7\x90,\xd6\x83\x94\\A" +
+	"\xf5^\xf3\x89\x97U\xf3Y|\xba\x92\xc2\x9a\xcf\xbc\xb0" +
+	"\x865\xdf4\xf6\x87\xb9\xbf\xd4I\x01\xd5\x17\xf5\x941" +
+	"\xb0\x80\x18Q|59\x8a\xe0\x02'=\xe31]\x0e" +
+	"\xe8\xc6\x0f\x97\xa8\xf9b\xc5\xc3jD\xf3I<oe" +
+	"\x0bf\xf7\xdc\x09\xaa~)\xd0^\xaf\xb8\x02(\xa0E" +
+	"B\xe9\xfax\xb1\xd9\x9c\xcd\xe5\xcdY\xaf9g\x0d\xc5" +
+	"qi\xae9e\xe9M\x98\xd9\xd9\xa3\xb3\xd6\xa2\x10\x8e" +
+	"b\xf1_\xcb\xcc\xcd\x16NCK\x98\x08\xe3\x98\xc5\\" +
+	"\x98\xf8~Y\xd1Cq\xf7\xcbQG\xb6c\x8c\xd4t" +
+	"\xf4}A(=\x11=>\x0d\xa9\xe9\xd2\xeb\x82P\xfa" +
+	"\x9dq\xbe\x19V\xe4n\xa4\xc28\xdf\x86\xb0\x0e\xc8\x83" +
+	"\xc9\x03\x86*\xa4\x88\xb4%fQ\x0b\x89&\x89\xc48" +
+	"A\xb2\xf1\x04a\x04\xa7X*\xe4\xa8Hc<\xe1\xd0" +
+	"\xd1\xd2\xa4\xe7D7z\xd5\xc9>\x15\xa3\xfd3\x9e\xc2" +
+	"\x97\x8b\xbaCV\x95<)\xec\xd3\xe4\x10~G0\x1f" +
+	"\x89e\x1b\x89>\x12;\x01\x9c\xac:!\x96\"-Z" +
+	"\x0a\xf6ix!\x89\xbd\x91ye,kg\xbc\xe2\xd8" +
+	"xz\xc3-\xce\x96xn\x10\xb9\xad\xc5\xc2\xe41\x17" +
+	"dCP\x17\xb5\xea\x16\xed\x02-N3o\xd4\xbf\x87" +
+	"\xba\xf7L\x09%\xf9\xa3~J\x8ch\x9e\xc5\x11\xcd+" +
+	"x\x17\xb6\x0a\xde\x85-\x93\xb5\xbeE'\xd9\xaa\x0c\xf3" +
+	"\x16g\xd7\xba\xd2\xc8\x05\x9b\xafq\x00\xabh\x05\xd3\xd1" +
+	"\xaf\x89$\x9b\xb1\xdaThO\x02\x9f%\x92l\x12\xef" +
+	"\xdb\"C\x12[\x19\x8fJQ\x07+\x9c.\xf9\xbdR" +
+	"8=\xb6\xe2\xf8\xba\x90\xd8\xd5-\x93\xbd\xbaE;c" +
+	"C_F\xe61:cS_\xde\xdd-\x8b\xbd\xbb\x91" +
+	"\xe8\xddm\xae)\xf3\xb4\xbe\xaa\\J\xc8G\x92M\x92" +
+	"~\xdaZWH\x82\xe8\x98X\xbaW\x8afk\x92\x18" +
+	"V\x15KP\x978G)\x13\xb2\xa9?r\xbb\x14x" +
+	"~\xa9L\x17]z$l3\xbee\x9a^\x871\xa7" +
+	"\xc3J\xc6\xd5\xcd\xe8\xa1`\x86\xe9`\x18\xeb\xa1\xd9Y" +
+	"\xa6E.\x1d\xd4\x1a\x8c\x9f\xbe\xa8\xe9\x8a\xa4M\x12\\" +
+	"\xec\xf54\x08\x0e\xf8\xc9&\xe1i\xb4\xa1z\x8d$k" +
+	"\xe1\xe6\x7fhW\x08\x08\x13\xa4\xc6\xf7\xaa\x8c\xb5\xaf\x82" +
+	"\xd7\xbe,^\xfb*L\xe3b#Z\x0e\xd9\xf3\x0c\xbf" +
+	"j\x9d\xe5?X\x8dd\xd5\xc6\x88\xb5R\x89\xa4\xdb\xef" +
+	"T\x99\xe6\x9d*\x85\x7f\xa9\x8a\x9ew\x97`\xac.8" +
+	"\x89\x97\x91}R.\xe7\xb27-C\xf2!$7z" +
+	"\x82td\x05\x9fD\x92\xc1^\xc0\x0c\xb9\xc7n\"0" +
+	"\xe4\x9e\xae$\xcbr\x013\xe4\x9e\x14\x92\xc5^\xc0l" +
+	"\xa6z~\x14\x89\x8fqS\x97\xea\xf5\x88f\xfe\x0eI" +
+	"\xbaU\xe4\x91\xe72\xe2\x0a\xf3\x17\xb0b\xe7\x05\xab\xcd" +
+	"\x9fb=\xf3\xb3\xedY\x95\xe3\x07\xfdrD\x93n\x93" +
+	"\x159\\#\xc4\xb3\xd93\xfe\xc1\x8dsd\xa5H\x0d" +
+	"\x9b\xd5\x09\xa9\xed\xf3\xc3,\x964\x88\xc3\xd0\xf3\xa4\x00" +
+	"j\xe5lrO&O\xee)\xe4\xc9\xea\x85\xa6\xbd," +
+	"]\x09\xf9d\xbfm\xe3\x96\x9b\xb9_\x87\xa3\xca\xb8\xf8" +
+	"u\xe1\x92\x8e!\x8f\xcd\x8c\x03\x85m\xd9\xb3\xe4pY" +
+	"\xa4\xaaJfU\xef\x8d\xa8\x95h\xcfZ\xa1.\x19e" +
+	"R\x12*\xc4m\xb7\x06/{;0.\x0dvgR" +
+	"#\xfc\xab\x94x-\x97\x00#\xfck:N\xfar\xc0" +
+	"g\xb2\xe1_\xb6\xe8\xb2\x98\xf4e\xbf\x1c\x18\xd2\x97D" +
+	"2,\x97\x03\x17\xa1\x8bGF\xdc\x0fx\x88\xd5F\x04" +
+	"I!\xeb|\x9a\xd2\xc9E/\x0d\x11R\xc8^\x0eb" +
+	"\xe1_\xf3I!{;\x88\x85\x7f-!\x85\xac\x17@" +
+	"S\xa5T-+\x8a\xac\x08\xa4\x9a$7\xf5\xc9N)" +
+	"|\xe3\xfc\x97\xf7\x19;.u\xcb\xb7\xce\x8b\xb6\x16j" +
+	"\x12l\xfd\xe6\x0fFWi\xbd\xa2X\xcc\x1fAY\xc1" +
+	"\x80\x00\x9b\xa2\xa39&+\x01\x8eB$\xd0n\x85H" +
+	">^\xa8K\xd40\xda\xc7\x19\xe1\xa9\x05]z\x04\xce" +
+	"\x13V\xbe\x8c\xa5\xc9\x8d[d\x0c\xc8\xbe\xda\xc9\x95\xb3" +
+	"\xb2%\x9f\x9e\xe3\xab\xb5\xc9&\x85<\xddK\x16O\xf7" +
+	"\x92\xc1\xd3\xbdd\xf0t/\x19\xa6\x0e\x99DU/\xdb" +
+	"*\xcc[\x07/\xd04\xdb\xaf\xa9!F\x0d\xa3\x86$" +
+	"\x85?|\xacn\xb9I\xad\x9c%\xf9\xe2\x09O\xe5\x1c" +
+	"\xd7\x93\xcbI^<\xea\x07/oK\xcb`\xd4\x0f\x8a" +
+	"5JK\xafl\xb0\xde/\x93\x82\xaa\xeeoG\x08\x16" +
+	"\xee%\x05J:F\xfb\xb6lA\xe2\x85\x1f\xb4\xa6\x95" +
+	"\xff\xa1\xf1n\xe1\xb8\x02\x8e39\x17^CGS\x03" +
+	"^\xa3\x01YRt\xbb\x03\xb65\xd6<\xc8\x18\x8d\x92" +
+	"\x9b\xf6\x97\xbe\x12Z\xf2\xe9\xef\x16\xc5=\xd1\xa9\xe1M" +
+	"\xab\xcb\x964CW\xcd\xf4\\\x8f\xd6{\x0e\xdcC\x8d" +
+	"\xf1\x0a\xa9\x9a\x1e\xff\xde\x9f\x93\x93S)\x07d\x9d4" +
+	"\xc4\x13J\xe3e\xa5>\xc3\xa5\xac\x82u)\x8b.\xad" +
+	"H\x06#\xf5\x85k\xe5@\x809\x90*\xc5\xb0t\x87" +
+	"\x18\x88\xb0\xca\xc7\x80\x1c\x94\xf5;\xc4\x80\xe0\x8cH\xdc" +
+	"m0\x8e\x90\x9a\x1c\x9f\xa1\x9ee\xc3-\xb2,\xae\x0e" +
+	"\xc6\x096\x90dY\xc20\x0c\xb5\xd7`\x92iq\x81" +
+	"0\xd4^C\x89f\xdc\xfb\xc7\xb0\xc2\xdfh,?\x1c" +
+	"\xf0q\xac\xf47\x96TX\xd4m\x86\xf8\x97O\x0a-" +
+	"j5C\xfc\xb3\xab\xd5\x0c\xf1\xaf\x14\xdf_\x04\xf84" +
+	"V\xed5\x85TXNTC\xed5\x83T\xb0'\xa7" +
+	"\xa1:4\xa6)\xd5;\xb5\xe0\xf7\x09\x92\xb6\x16\x09J" +
+	"B\xba\xa2\x17\xab\xa6wh\x95\xaa\xf9bBbS\x8d" +
+	"\xacO\x04\xf7\x0d\x8c\x9c`\xc0\x12\x0c\xce3Ub\x96" +
+	"\x8d/\x1d\x1doL\xf53\xfc*\x92\xea\x04'\xf3y" +
+	"\xa9\xaaJ\xf2\xe9\xb7\x05\x04\xa7\xd8\x0e9\xb3X\x94\x03" +
+	"9\xba.\xfaj \xc6\xa3,\xe0\x8c\xcf\x99!\xd7\\" +
+	"<\xadZ\x0a\xe3\x8a\xac\xa0\xcc\x1aQ;\x9f}\xfe\xe5" +
+	"\xb2\xf3\xcf\x9c~\x99\xd6\xe9G\x8c\xe9\xd7\xc3:\xfd\x88" +
+	"1\xfd\xbc\xd6\xe9\xe70\xa6\x9f5\x0a\xc8p7\xcc!" +
+	"\xb3X\xb9-\xe6nX@2\xad\xd3/\xc1\x98~\x99" +
+	"\xd6\xe9\x97\xd8\xc2\xf4\xeb`L\xbfB\xeb\xf4s\x19\xd3" +
+	"\xaf\x90\x9d~\xad_\xe4\xff_n\x1c\xc23\x81#\x0b" +
+	"\xb5%\x9f\xa4\xf2\xe4\x93T\x9e|\x92\xca\x93Or9" +
+	"\xf2\x89\x97\x91O8g\x15\x13\x1c\xdb\xd0<L\xd6\xee" +
+	"\x8d\xd5$*r\x10T\x8e\x02Q\xe2W\xdf\xa2\xea@" +
+	"25k\xce\x9c\x1c\xdb\xc9\xeb\xe5\x9d\xbc\xb9<\xf9\x84" +
+	"qQl\x12\xf1xj(`u\xcb\x8a4\xc7\xcbR" +
+	"%\xd0\xbba@\x12\x9cU\xedX\xb8e\xba\xa8\xcb>" +
+	"\xaal\x06s\x0ez\xa2sOL*\xf2Y\x89L\x0a" +
+	"\\\xd1S\xbaw\xac\x85\xeff\x98!\xd1F\x03\x0fg" +
+	"2\x11\xbe\x86\xa2\xe4\xa8\xd7\x8c\xf0\x8d)JN\xc2\xd3" +
+	"\xc7\x9c\xa4\xf44\x8c6\xa1\xa3}*3\xaa\xb1>k" +
+	"\xdajS\xce@\xc9O\x9c\xa4\xf4KX\xd9\x0e\x1a5" +
+	"|.\x83Qm\xb8\x9c\xb8\xacS\xce\xc3\xe3g\xa3\x9a" +
+	"\x89V\xc8)\xda\xbc\x9e\xa4\xa3\xe6\xc6\xd4\xbc**#" +
+	"\xe02\x06\xac\xff\x90\x14\xa5\\\x0c\xd7\x82&*[\xd2" +
+	"\x0c\xa7\xf0\xb6D\xb7J\x0eWL%\xc3\x15S\xad\x86" +
+	"j\xec1\xd2T\xed4^\x15\\\x8c\x08\xd7\x14\x80/" +
+	"Bt\x87K\x97\xb4\xf8'>\xf5\xf6\x18\x1f\xf5\x9b\xd0" +
+	"\x858t\xc9\x85<]r!\xabK\xee\x18\xd5%\x17" +
+	"\xb2\xce?\xd19\xc1\xee*v\x03\x11'\xfa\x1b\xb1\x02" +
+	"\x9f\xd5\xcf\x98\x0d\xf6\x8e\xb9\xf8\x84'\xa3[\x07\xe5%" +
+	"\x8a\xa5\x14\x8b[\x99\x1c\xbd\xa9\xe1EM7\xfc\xc7\xfe" +
+	"\xbfqYKl\xd5\xb9\xc34\xc8\x08q8mW\xf2" +
+	"\x9c\xb6\x19\xeb\x9b]?\xea\x8f\xbe^p\xc9\xa6\xa2\xbb" +
+	"q\x8e\x14\xf0\xa9m\\+m~wEj\xb5\xac\x08" +
+	"-\x04bx\x1c$]Wk%%\xfe\xab \xb5." +
+	"\x94HD\xb7]-28\xfa\xe4L6H?\xdaj" +
+	"\xb9\x07s\xdd0\xb6\xc9`\xa6\xa9dneGs\xd6" +
+	"\x84L'I\xb1~b\xa8\x9d.\xf6\xe3E\xc5\xe5\x93" +
+	"\x02\xf1h\x0d39,\"Y\xe6 b\xfc\xca\xd0X" +
+	"-\x156\xfe\xac%R\x97\xd6\xebV\xa0\xd4\xb9d\xdd" +
+	"n>\xab\xe4\xe9\x0a+\x19o\x08Y\xa9\x93uI\xb2" +
+	"\x9a\x13\xa2\xa8\xd6\x0cm\xa7\xb56\xddp\x88h\xcfE" +
+	"=\xbf>$k\xd2TQCB\x98xt\x9f\xc0J" +
+	"3\xdcIJ\xc7\xf1\x05\x9d&\x1az\xc5\xa7\x99\x89S" +
+	"w1UJ\xc7\xa5\xd3\xb2\xf4\xc2\x17^\x8c\xa5\x9ai" +
+	".\xd5\xd6\xf5\x84\x8dTOh\x0a\xc7\xf4\xec\x8c_k" +
+	"\x0c\x97\xa8\xdcH\x15\xa9\xb2\xd9\xc8+xl/\x99&" +
+	"\x01Rl\xa3\xdd3\x8b\xc7\xf6\x92\xcb\xb2\x97D7\xda" +
+	"\x83\x85<\xb6\x97B\x93\xd8%\xc6\xf6r\xbc\xd0\x14c" +
+	"\xa2W\xba\xf2\x06\xc1\x19j\xe9\xd2Y)j\xfeb\xd5" +
+	"/\x0bIU\xb2)\xd07\xe2\x0d\x91\xb9\x1f\xf0\x82e" +
+	"P\x03\x03*\x9e6h\xe0\xdavF\xbc\xa3\x04\x84\xc1" +
+	"p:\x92\xe7\xd8z3#^\x8f\x83\x0c\xa6\x87\x8d\xdd" +
+	"j\x8f\x97\xe9a\xc3\xfe\xb9?\x83\xe9a\xc3w\xf5`" +
+	"\x06\xc3\x0fcP\xc1\xbc\x9bev{+\x9b\x9dET" +
+	"\xe3\xcarl\x9cU\x12\xe8\xaa\xda\xb2\xab\xb4\xc9\xcd\x98" +
+	"\xad\xc7:\xab-\x1fq\xe6\xae\x9d\x1d\xd2\xa4*\xb9>" +
+	"vL\x85\xd4\xb0\xce\xfc\x8ec\x8d\xca\x8a\x7f\xaa\x1aQ" +
+	"\x88\xbfe\x0d\x19\x97I\xd3\xa9\xab\xdcS>\x8e=W" +
+	"\xd2\xc1\x82\xc4:}\xb5\xe0\xd5f\x17\xc6\xe2\xea\xcb|" +
+	"Ewj\x0d\xf1\xb8\xe5Tp\xb4\x94\x99\xac[\x8eM" +
+	"\xcf\x86\xbfm:5\x9b\xcf*o\x9fg\xa2\x01\xa2\x9e" +
+	"|\xf4\xaa\x8b$S\xe95\xa2R-\xc5\xc3\x96T\xc1" +
+	"\xdbpR\x19\xce)c\xc3\xd9\x9b\xca\xae\x91\xe8\x86\xb3" +
+	"?\x95]#\xd1\x0d\xe7`.\xbbF\xbaD\xd7\x08s" +
+	"\xeb\x8a\xc7v\xdc:gT\xb3\xd0$\x9c+IM\xef" +
+	"\x7f{q\xd8\xa1\xcf\xbf\xda-\x08\x84$\xc5\xa9\x16\xc2" +
+	"Y\xc3\xaa\x85Jbj\xa1>\x0e\xa4Cp\x80\x9b\xac" +
+	"\xc3T\x0b\xf5w\x80\xba\xa57\xc0\x83\x1c\x8cZh\xa0" +
+	"\x03\xb5\x95\x80\x0fq0j\xa1\xc1\x0e\xd0\x1a\x0e\x00|" +
+	"\xb8\x83Q\x0b\x0dE|\x10\xe0\xa3\x1c\x8c\x97\xef\x08\x07" +
+	"\xa8\x91\x86\x03>\xce\xc1x\xf9\x8e\xc5\xf7\x8f\x02<\xcf" +
+	"\xc1x\xf9\xe6`}\xc6\x00>\xd1\xc1\xd8\xd5\xf2\x11\x1f" +
+	"\x07x\x91\x83\xb1\xab\x158@}\x95\x07x\x89\x83\xb1" +
+	"\xab\x15\xe3\xfb'\x02^\xee`\xecj\xa5\xf8\x9e\"\xc0" +
+	"\xa79\x18Z\xc5)\xf8\x9e\x12\xc0\xef\x02\xbc\xeb\x154" +
+	"\x0au:\xb6\xab\x1c\xf0\x99\x80_\xd9\x85rc\xcc\xc0" +
+	"\xee\x9c\x06\xb8\x1f\xf0\xa4\xae\x94\x1bC\xc4\xf7\xdf\x05x" +
+	"\x0d\xe0\xc9WRr\x0c\xc9\x01j*?\xe0!\xc0S" +
+	"\x92(;F\x10\xdfS\x03\xb8\x0exj2e\xc7\x98" +
+	"\x8d\xe5C\x80\xcf\x03\xdc\x9dB\xd91\x1a\x1c\xa0f\xab" +
+	"\x07|\xb1\xa3M\xa7\xfbx\x83\x0e\xf0\xc6f\x0bp\xcd" +
+	"\x9e#\xc9\xd55\xba\xa9\xf0\x0a\xa8z\x98O\x0b\xe6\x14" +
+	"}\xe6]G\x0c\x82\x13\x9e\xe9\x04\x1c\x10\x1b8\"2" +
+	"\x84\x94\x8b-\x85\x0c\xa5\xc3\xe9\x12f\x0e\xecj\xfc8" +
+	"kr\x06\x0c\x1c\x09\xd8\x06\xe86.\x8a\xb0OS\x03" +
+	"\x01\xa9JH\x02\x99\xa0\x1dR\x99\x04\xd2X\xb9\x96\x04" +
+	"\xc2R{7}S\x82H\xf2\xa9\xfev\x10W2\xc4" +
+	"z\xe8\x1a\x93\x1e\xb7B\"\xb3\x15\xe7\xc9\xb8lI\x8d" +
+	"T=\xc1\x18\x93b9r\xe3\xd6\xa3P\x9a\xd0\x9cl" +
+	"\xaa<\x8b\x87,\xc0k^\xab\x8cj\xcf\xce\x8cZv" +
+	"\x962d\x01K2L\xe5\x85AG\xca*\xe7\x9at" +
+	"\x19T+\x11\x85\x09\xb1M\xd7eK{b\x09\xd7i" +
+	"{\xdaI4\x16U\xc0Yv\xd6\xb6\xc2\xad\x18\x9a\x0c" +
+	"\xa3u\x16\xcep\xa3u\x9b\xbcQ-\xc3k\xe6nj" +
+	"\x91\xecZ\xe1\x1en\xee\x08\x02\xedb\xe8\xc3\x93M\xc6" +
+	"zc u)\x08+\x9f)\x14\x8d7k'\x0f\xe4" +
+	"T\xa9\x12\"j\xf2\xeb$\x0dO\xecxh\x9e0\x1e" +
+	"\x8e/)\xb7\xed\xd5C\x19B\xa6\xa4\x87\x0c\xad7#" +
+	"\x0e<ev\x971\x0019\xd8\xa2\x06\xd5xj\xd0" +
+	"G\x18\xe5\xa6!1\x9f{\x8aq\xd12$\xe6KO" +
+	"\xb1nW\x86\x8bH\"y\x80\xf5\xa3j\x0a\xf8U%" +
+	"\xa7N\x94I\x00\x08sKT\xd9\xc5\x8a\xbb\x11\xa5V" +
+	"\x9d\xa3\xe4\x84\x04\x12\xb1\x0d\x00>8!R[\"\xd8" +
+	"\xc8\xe3\x00/\x96\xb5\x08\xa9\x16\x03\xd8\x0b\xc4\xfe\xb7\xb0" +
+	"N\x82\xaa\xaa5\xfb\x18\xfc\xd5\x1b\x99%\x12\xadV\x16" +
+	"\x15\xde_\xcb\xc5Z\x99\x84kh\xe7\xb6\xe7\x063U" +
+	"\x125\xa0\xebqV\xc7E\x89X\xc8\xb3\xe3f\xb2\xde" +
+	"{N\x8e\xf7\x9e-\x00\xba)(\xea\x92&\x8b\x81\x96" +
+	"i\xc9\xe6H\xa2\x86\xa1\x89\xed\xf2\xdd\xcb\x83P\x95\x02" +
+	"\xa5\x8a\xd8\xc3\x08{p\\Q3x\xae\xa8\xb9\xac+" +
+	"\xaa\xb1\xb4+Y\x05\xa2\xa3\xb95\xc50\x9e\xa5l+" +
+	"4\xe5Y\xf6\x04\xb12>\xcazP\x0c\xd7\xb6\xa1\x86" +
+	"\x13\x03\xba\x95\x06\x17\xae\xb5\xe1v^O\x8bTU7" +
+	"#K\x99\xfe\xc8\x8a\xb7?2x\xae\xb9\xb9<\xd7\xdc" +
+	"\\^X%\xa3emU\x88i\xcd\x90j\x8f\xa4\xb4" +
+	"I<q\xf9\x01\xe5\xa9*\xd1l\xf3;\x8b7\xbf5" +
+	"\xde\xfc\xce\xe2\xcd\xefBs~\xdb(I\x9bB\xb2\xaf" +
+	"6\xa0\xfaj\x85t\xbcH\xb5\x14\x93\xf1\x834\x0ew" +
+	"H\xba\xa4\x89\x8a7]\x9a#j\xfe\x96}#yB" +
+	"\x0d\xc8g2;\x06P!\x92\xdc\xb4\xb9\xecP\xf7\xe4" +
+	"\xf1\xfb\xf6\xc6\x9d\x18\x00=\x89\xf2\xa4\xa0Km\xa6L" +
+	"\xcch\xc3=\xd32\xee-\xc5'%\xb6\xaa\xc5\xa4\x1e" +
+	"\xc7\xe0\xeb\xd2\x0a'x\xeb\x1e\xe7\x99\xbci\xdd\x837" +
+	"\xad3y\xd3:\x8b\x89\x16nY\xb9b\x8d\xa3kQ" +
+	"\xaf\xdcRH[B\xeb\xf6\xe3r1Ik\xce\x91\xe2" +
+	"e\xceiE\x9aS\x0e\xfd\xcb\xc8Sq\xb1+\x01\x05" +
+	"9\x1bi\xd1\x96\x820\x8b9\xb5\x8d\xebz!\xab\x1f" +
+	"$<\xfd`t\x1f=\xb8\x88\xd5\x0fF\xf7\xd1\xc3Y" +
+	",\xbb\xb13\xcan\x9c\xc1\xb0A\xd3\x89c%\x97\xc9" +
+	"\xa6\xdc\xeb\x0c\xc7\x83R\x1d\x11\xab\xad\xa4\x065\xa22" +
+	")\x12\xb4j:\x0a\x94\"b\x945/@\x18\x85\x1d" +
+	"\xdb\xa1\xb8RU\xeb\x9e\xf1\xd4\xb0]\x02\x9c\xf9\xe9\xbe" +
+	"I\xd1\xa5\xd2\x96\xdb]\x16\x1bGl\x18\x052\xcc\x9b" +
+	"Ak\xeb'IQ\xf5v\x88\xc3F\"\x09\x8d\xf2\xe2" +
+	"\xb4\x9b\x88\xd9\x1a\x8d\x92M\xa9\x02\xda\x11\x999%," +
+	"53\xf5s\xed$\xb9\xbc>\xe9\xcb\x18JP[\x1e" +
+	"\x1b\xe3\xa8\xe1\xdf\xf8\xed\x92\x94v,\xae\x12I\xc7`" +
+	"#\xa7\xe2o\xaf\xe3\xb9\x8d\x86\xc3\xe6A\xc5\x1d\x0f\x8b" +
+	"\x7f\x00XF\xca\xa4\x80\xe4\xd3\xf3gG\xe4P+f" +
+	"\x0d.\xd7k\xcbBU\xb2\x99m8\xde\x1d\xbe,\x1a" +
+	"\xe4E$\x9b\xa7\x02K\xde\x1esU`\xc9\xdb\x8d\x01" +
+	"b\xc9\xdbc\xa7\xe6\xf1\\Fp74v'g\xb1" +
+	"\xae\x0aQ\x8d\xdd\x99J\xc6+\xc10\x11\x9c\xafd\xa4" +
+	"\xf9\xa8\x03\\\xca\xa5\x0a&\xb6\xa2\x9d\x0e+-\xb08" +
+	"\xd2a\x19\x1f\x10\\\xcc\x06\x1eE\xf3$\xc1\xc5\x06D" +
+	"S\xd4+\"\xdfe;,F\x90\xd3c|M\x92\xa1" +
+	"\x14e\xba\xb8\x90\xe9M\xa3\x8b\xd9\xcd/\xd6\xc5\xc7\xb3" +
+	"\xd8kP\xb4\x8bOf\xb1\xde \xd1.>5\x8b\xb9" +
+	"\x1b\x19]|\xae\x90\xfax\x94~\xcdt\xf1\xc5\x0a&" +
+	"|\xc5\xe8\xe2\xcb\x15\xcc\x85\x09Z\xac\xcbJ$\xc8L" +
+	"\xb4\xa4j\x86\x10>;,\x07\xea\xcc\xde\xcc\xf6\xa9\xa1" +
+	"\x90\xb5s\xe9\xe3I\xb9\xac+\x0e\xbc\x01\x00\x8b\xe6\x07" +
+	"_\x94+\x0aN\xa6 }\x9d\x0dl\xdbvhs@" +
+	"\x87\xad9W\x8c\x93]\xa3m\xb7\x8b\xe8\x11\xb7\"\x97" +
+	"\x0d\xe1sp\x084\xedW\xa1P\xb4F\xd4w\"\x96" +
+	"8=\xbaN\xd9\x80\xe1f\x7fm\x97\xfb\x15\xbb\x0dW" +
+	"\xab\xd1N\xd1E4\x9eB\xe18n\x8a5jN " +
+	"\xe0\x95\x92\xf4\x88\xa6\xb4|\xbf2iZ\x1e0\xe5)" +
+	"C.\xb0\xf8b\x18\x82\xd7\xd6\xa7\x18+\x80!xY" +
+	",eF\xa8_L\xd48d%\x88\x88\xaaQ\x14\"" +
+	"\xcd\xa6\x1c\xd4\xcc\xcc\xc4\xb43Fo\xd0\x82a\x99(" +
+	"\xb0\xf2\xc3\xba\xe6\x82\xfe\xe3;f\xd3\xb2\xa6\x0af\x8d" +
+	"\xb6z\xd9N/y=n]\x1a\x04\x8c\x82\xac\xea\x92" +
+	"\xb4p\x1c\x81q\xdc\xc0?#\xb3\xc0b\xa6\xbfX\xb2" +
+	"\x97F*\xa6@\xf8^,#ht\xeep}\x95\x9a" +
+	"\xab\x08c\x09\x7fi\xb3\xd2\xa5`Ho\xb0\xe1\xc9q" +
+	"\xda-\xd0\xb0l\xe5\xadY\x1f\xb3\\\x94\"as\x11" +
+	"\x10*OK0-\x17S\x90'\xba\xc4\xe0Y\x8eY" +
+	".\xa6'\x80&\xbf\x1c\xf0\x99\x09\x8c\xe5b\x06\xf2G" +
+	"O\x03\xdc\x9f\xc0X.\xc4\x04\xd0\xb4\xcf\x04<\x90\xc0" +
+	"X.d\xe4w\xae\x01\\O`,\x17\xb3\x91\xdf9" +
+	"\x04\xf8\xbc\x04\xc6r\xd1\x80\xe5\xeb\x01_\x9c\xc0X." +
+	"\x16\xe2w\xe7\x01\xbe4\x81\xb1\\,A|\x01\xe0\x0f" +
+	"&0\x96\x8be\xd8\xdc\xc5\x80?\x9c\xc0X.\x96'" +
+	"\x80\x9a\xe9a\xc0\xd7&0\x96\x8b5\x88\xaf\x05|s" +
+	"\x02c\xb9\xd8\x84\xf8f\xc0\xb7'0\x96\x8bm\x09\x8f" +
+	"\x00o'\xe0\xaf%0\x96\x8b\xdd\x88\xbf\x06\xf8\x81\x04" +
+	"\xc6r\xb1\x1f\xf1\x03\x80\x1fI`,\x17\x87\xb1\xfe\xef" +
+	"\x00~,\x81\xb1\\\x1cE\xfc\x10\xe0'\x12\x18\xcb\xc5" +
+	"q\xc4\x8f\x00\xfe\x09\xe0\x9eT\xca\xeb}\x12\xfb\xff\x04" +
+	"\xe0g\x01\xef\xe6\xa6\xbc\xdeg\x10?\x0d\xf8\x05\xe4\xf5" +
+	"\xf6P^\xef\xf3\x88\x7f\x09\xf8w\x80\xa7u\xa3\xbc\xde" +
+	"\x97\x10\xff\x1a\xf0\x84D\xe0\xf5\xbe\x8a\xf2z\x93D\xe0" +
+	"\x84O\x84\xa8H\x80\xbb\xa7QZ\xefN\x00\x97u\x04" +
+	"\xdc\x03x\x8f\xab)\xadw\x0a\xe2\xc9\x80\xf7\x04\xfc\x9a" +
+	"\xee\x94\xd6\xbb;\xe2W\x03\xde\x0f\xf0\x9e=<\xa4'" +
+	"\xd8\xd5\x10\xef\x0d\xf8 \xc0{]\xe3!\xbd\xc0\x80\x86" +
+	"\xf8\x00\xc0\x87\x03~mO\x0f\xb9\x16\x0ce\x88\x0f\x01" +
+	"|\x0c\xe0\xe9\xbd<$\x1d\xfc\xaa\x11\x1f\x05x\x1e\xe0" +
+	"\xbd\xaf\xf5\x90\xde`\x10K\xc4x8\xc0\x8b\x00\xef\x93" +
+	"\xee!}\xc0\xf0\x85\xf8D\xc0\xcb\x01\xef\xdb\xdbC\xfa" +
+	"\xc2jA\xbc\x04\xf0\xbb\x00\xbf\xae\x8f\x87\\\x07\xcb\x02" +
+	"\xf1i\x80\xfb\x01\xef\xd7\xd7C\xfa\xc1\xf4G|&\xe0" +
+	"\x01\xc0\xfb;<\xa4?L\x7f\xc4k\x00\xd7\x01\xbf\xde" +
+	"\xe9!\xd7\xc3\xf4O\x84\xe9\x19\x00\xbc\x1e\xf0\x1b\x12<" +
+	"\xe4\x06\x08pKD\xf2z\xc0\x17$\xb6\xc3\x0f\xdb\xaa" +
+	"\x8a\xf7A\xfa0\x1b9\xaa&\xf9\xd4:Ik\x10\x92" +
+	"\xca\x197\x02\x80\xb1\xb0\xe0d\xc0\xcaHU\x95\xdf$" +
+	"\x0a\xe7\x19\x9b\xacl[\xa0DS\x98{\x1d\xf5\x1f\xc9" +
+	"\x15\x09\x0d\x18\x91\x86\x0aB\x8b\x7f\xcbl\xe5o\xc3\x9a" +
+	"\xfd\xadH&4\xe0$\"\x91\xa1\xad\xfc-\xb3\x95\xbf" +
+	"\x0dcZT?\x94\xfd\x91\xc9\xfe\x18f\xd2\xad\xa9Z" +
+	"0\x12\x10\x87\xb2\xfd\x19\xc529\xd80\x16\x8b\x1a\xe5" +
+	"\x86r\xb0L\x0e\xc6{v8\x07\x1b\xc1\xc1n\xe6`" +
+	"#9\xd8(\x0e6\x9a[g2\x84\x83\x0d\xe5`\x99" +
+	"\x1cl\x18\x07\x1bnbbX\x9f\xacLW\x05\x12\x89" +
+	"i]\x1b\xd4\x08P\x9dX\xb5\xae\xb7\x89~\xe4\xbah" +
+	"\x87\xff\xa2\x126\x1cz[H\xd6\xd5>\xc3\x95\x99\x83" +
+	"\x83=Z\xdb\x90'\xdav\x8c\x8c\x0a\xad,\xbb@\xfc" +
+	"\xeb\x9d\xab\x10Ih\xdd\xd79Ot\xeab{U\xf3" +
+	"\x06\xc1_\x05\xcf\xb7\xb7\x92\xd1\xc2\x1b\x97\x9dm\xb9," +
+	"\x09VTtd]MZ\xdc\xb6\xd0\xaf.\\+\x0b" +
+	"\xce@\x808\x05\x07q\x1a*\"E\x92\x04\x97_\xf2" +
+	"\x1bh\xa3\xae\xc9u\xb2\x18+\x95\xad\xa8U\xa0.\xfa" +
+	"\x01\xee\xcf\xe0\xban\xf7\xf4en\x83\x1a\xef6\x98\xc5" +
+	"\xbb\x0d\xf6\xe5\xdd\x06{\x98\xb7Ab\\\x063\xd8\xfb" +
+	"6\xe1\x85\x06$DC\x03*\x99\x1b\xa2\xcbA/\x83" +
+	"\xec\x0d\x11\x93\xf2@2\x09!}\xbc5\xaaQ\x0c\xd7" +
+	"\x9a\xbd\xec\x8a\x98W\xb5\x96:?\x09\xb6p\x86n\x03" +
+	"\xd4\xdbvG{\x8a\x16\xc9x\xf5\x8b?\xee\x95:\xd4" +
+	"\x84\x05!\xde\x9c\xac\xf1Sw\x8a\x8a\xc8X\xcf\xdaJ" +
+	"\xc4\x90\xcb\xcb\x1b\x90\xcb\xcb\x1bPi^.\x8da\x8b" +
+	"\xdd-7;0\x90\xa5\x98\xf5\xbc\x80\xec\x922\xfb\x1b" +
+	"6.\xe6\x9aY+I!\xd8\xf0\x04\xf6\xc2\xd3\x18\x12" +
+	"\xfdx\x81lw\xb3\xa5\xa0\xaa\xc9s\xa5t\x14\xf8[" +
+	"v\xd26\xed*\xb9\xbcTj\x85<\xbb\x8a\xd7\xb4\xab" +
+	"X\xcdB\xf6&\xc1\x8a\xa9\xc4\xbb\x1e+c\xf8#\xbe" +
+	"\xe8\x0d8\xfeH\xd6:1 \x8b\x95\x81t\x09\x96\xa2" +
+	"\x9d\x97\xdbk!\xd70n-)d\x96\x85g;\xda" +
+	"&ww\x92e\xb0?\xf5f\xa3@{\x11\x8de7" +
+	"\x8c\x8ei3>m\x83\xc6` \x99k\x89\xe63l" +
+	"\xd4CI\x85%\x9a\xcf\xe0\xe5\x1eMfY\xa2\xf9\x0c" +
+	"^n{4\x9f\x91\x8a\xc8F\xc3\xd0\x04\xcb\x15\x98\x98" +
+	"\x05R\xcf\xb0\x8a\x85k\x8ba\xca$\x81\x91\xca\xb2\xb2" +
+	"\xcd\x9f-m\x00\xbc\xd5\\\x12\x10\xb2\xa9\xea\xc7\xb6\xa0" +
+	"'\xa8\x98\x93\xc5\x0a\x96\xc9B\x12\xabF2\xb7\x84$" +
+	"\x8b:)\xac\x06\xa5I-09\xb7\x1c\xf1\x8b\x0e\x94" +
+	"\xe1\xfff\x92\xf0\x8f~\x17\xf9\xec\xed\xaf2\x9e\x88W" +
+	";PV#K\x01\xbf\xac\x90\xeaV\\-x>#" +
+	"m\xbe\x19zc\x82\xa4H\xd9Z\x8cu\xb2\x1dV\x07" +
+	"\xc23:\xb0n\xc2\xd9\xd5\xd4\xcc\xd2j\xb0}\xcb\xb2" +
+	"\x91\x18F5\x01\xf9\xe1\xdb\x86\xd6\x869\xb6\xf5mC" +
+	"\x8e\xd2\x97\x0b\xe9\x0dl\xb9V\xbb\xba\x1d\x04\x96\xac\x80" +
+	"\xc6w\xcbm\x9d2\xd6p\x02\xf3\xb7%\x8b\xa5\xa3\x06" +
+	"\xcb\xd4\xe3\xf4(uD\xe6\x0c\xa9\xdb\xdf\x9az\xca\x96" +
+	"\xad\x9c\xda}\x920iy\xbc\xd9\xca\x9bR\x06\xba\x7f" +
+	"\xfb\xfb\xd3y\x9f\xc5\xad\x063r\xe1\xa7Km|(" +
+	"\xa8\xd6Y\x18\xe5\x9c\xc7\x8f>}\xf9\xee\x85\xed\xd2\xb7" +
+	"\xc1\xbcwib\xa0e[\x7f\xebDT\x15\xbc,7" +
+	"L\xf2\xe2\x1fL\xc1\x06\x9c\\bP\xd2\xd9\xbc'q" +
+	"\xda\x11K\xa2\xf4\xc0\xaa\xc2xM1s\xab\x82\xe7L" +
+	"\x98\xc1\x0b\x8fe\x82\xc1L\xd2a's\xc1fI\x8a" +
+	"\x1b\x81*v\x8e\xe4o\x97\xbf;\x06\xb43\xfe\xeem" +
+	"\xd9\x13g\xb5\xcc\x02\xcf\x0f\xd8\x8e\x87\x86~<\xba\x9a" +
+	"\x0bB\xcbv\x15\xbe \x1d\x9d\x04\xc7+\x18\x0b\x8a\xa1" +
+	"t=\x95\xca\x08\xcd\x86\x92\xfaL*kV\x89z\x07" +
+	"\x9cKe-W\xd1x\x8c\xf3\x15\xac\xe5\xaaC\xd4r" +
+	"\x95\xcbH\xd2\x9c\xfcr\xff\xb9S:v\xa2W\x12\xc3" +
+	"\xec(\xb7?o\x89a)N\xd2\xca$\xfd\xff\xdaL" +
+	"\x9c'\x89zM\xcbd\x9ef\xdc@!/n\xa0\x90" +
+	"\x17\xa8T\xc9KK\x9d\xcb\x0bT\xaa\xe0\x05*1f" +
+	"\xb7f\xf6\x1c\xb8,\xda\x9dz|\xaa\x16\x0aKV/" +
+	"O\x11\x98'j\xcbj\x05\x17\xe3!\xd4\xec\xb0\xaa\x94" +
+	"\x15?\x0c\xa1\xc0\xdc\x9al\xae\xd9\xf1\x86S\xe6T\xa6" +
+	"\xc7\\z\xdb\x8a\x17\xc9\xe0\x19\xdc\x99\xc8\xc4\xc6`\xb9" +
+	"\xc5\xb6\x9d\x14\xcc\xd1c\xfbFv\xb0\x8c\xf5z\x8aK" +
+	"\xbbA\xd5\x03aK\x02\x87\x1frz\xe6\xc6{z6" +
+	"R\x1e{\xe6\xb8a\xf9\xec\xaflW6\xa1h2\x1f" +
+	"\x0e\x93\x95\x873A\xda~/u \x9f\xea\x12\x03\x01" +
+	"\xdb\xa52\x83c\x9bL\xe51\xff\xa7\xf2\x98\xffS\xd9" +
+	"\\t\xd1\x1dly\x85i\xb0\xb4Hrm\xec+\xaa" +
+	"\"M\x8c(~\xcd\x92]'N\xe6\x0b\xd8\xa2\xcdd" +
+	"\xf3-H\xbe\xbct\x9bq\xa7\x92\xc8\x0eN\xae\xaa\x92" +
+	"4\xdb,\xca\x88\xdb\xb7\x84\x99\xea\xadg\x83\xd0$\x10" +
+	"Z\xdaqB\x16\xe3\xda`\x82\xa1\xda\xf2}\xf1\xb6q" +
+	"\x8e\xdb\x98\x05QYU)*\x021\xcfq{tW" +
+	"\x1c\xa4\xe5\xe0\x84\x12\x0d\x05\xf0J!g\xa0!\x9e\xf8" +
+	"\x05/\x87P!\x83!\xe4\xe0\x85\xddr\xe2\x00\xacW" +
+	"\xc78\xb2\xa7(R\x03?kx!/\\!#n" +
+	"\x0fO\x86_\xf5?\xf5yho\x9a\x99\x1c\xa7\xaf\xd6" +
+	"\xb68XI\xb92\x00rZ\x0bZ\x9b6H{\xf3" +
+	"\x15?R\xf6\x86TW\x94\xb27\x0e\xce\xa1\xdc\x168" +
+	"\x87@92\x08\xf0Q,i\xe3\x08Rh\xa1\xb62" +
+	"H\x1b\xc7\x92J\x0b\xb5\x95\xa1\xed\xc8'\x15\x16n!" +
+	"C\xdba\xe7\x162\xb4\x1dvn!C\xdb1\x85d" +
+	"ZH$\x8d,dv&\xf9\xce\x84\x9ahE\x92k" +
+	"\xe1\x1c\xaa\x93}\xba\xaa5\x98l1!\x9f\xc5\x07[" +
+	"\xaa\x0fM\x10eEb\x83V\xe8\x05`b\xa8yT" +
+	"G\xb1(\xb8X\xfa\x008\xcb+\xf0,7\xd7b:" +
+	"\x80&\xfd\x10\xfc\x9an\xf9e\xd2\x0f\xc1/\xa0\xcf\xa2" +
+	"\x1a\xbc(\x0a\xf9T\xb8l\x0d\xad\x85\xdf\xebQ\x1fO" +
+	"\x88Kji\x0b\x1e@\xb7`(\x16f2\x12\xdb\xc9" +
+	"\xb2\x9d\xfc\xcbe\x99\x94\x1d\x08\x18\xb7\xbd\xb6\x9c\x83\xfb" +
+	"\xda\x09\xb5\xa2\xb4\"\xaeJ\xb1:\xfe,BxS\x0a" +
+	"\x17\xc9\xce\xb0\xdeJ^\x18$\xa3i-\xac\xa6\x9d[" +
+	"\xe3\xec\xf8\x88W\xbd\x0c\x99B\x9c\xbb`\xbc\xe1m\x93" +
+	"J\xc8\xf8xb\xdb\x98Lh\xac\xe7\xcc\x0f\"\xf3\xf4" +
+	"\xc2\xe1\xe5G\xee\xb4\xda\x89\xb2.\xd8\x84\xf3LN\xdc" +
+	"{\x06/\xee\xbd//\xee=\x8b\x09\xf45\xa4\x94\xbd" +
+	"\xb9\x8c\x14o\xdc\xb3\xf6\x17\xf2\xe2\xde\x99K\x9e\xd5\xfb" +
+	"\xde\"\xdd\xb8jd\xbd\x05y\xbaQ\x0c\x06!\x8d\x95" +
+	")\xa9\x07\x83j\x99\x8d\x98\x17\xb0\"\xa9Jo+\xd3" +
+	"\xbdU\xe9H=\xa8~(\xf5\x13{U5z\xcb\xe2" +
+	"\x01h\xf4\xd6\xc9\\\xe6\xfej\xf4\xd6\xa9B\xd6\xbe\x93" +
+	"\xc8\xa3~\"V\xea'0\xfa$\xcd\xa9Qc9W" +
+	"\xd3\xe7X\xd4\x80s,\xee\xed\xd9\x81:P\x17\xc4z" +
+	"1P\x17\xa8\x91\xabkL\xf7\xbe`@Uk#\xec" +
+	">i\xe1\x16\xb0\xf2D\xc5s\x98\xa1\x8d\xd5\x12\xcb\xf7" +
+	"C\"\x15\x990\xa5X\xaf6\x14\x9arvS\xb5&" +
+	"*\xba5\xadq\x93:G\xb1\xa7:\xe6\xeaS\xf0\xfa" +
+	"\xad\xb4W\x8a-V+\xbd\xf0\x14\x91\xe2\xb9\xab\xcdj" +
+	"C@lT\x03~\xcb)\x16\xfd\x9d#$U\x8b\xb2" +
+	"\x19p\xa4Hs&\xb5\x8f\xc8%\x9a:\xc2\xc8\x1c\x91" +
+	"M\xed\x97\xf1D\xd9p\xd5\xba\xb9\xa6`f$p\x0b" +
+	"V\x9a\"X6L>\xf3\xe4\xa4\xb1\xc6\xccoLS" +
+	"\xd4\x16\xbbQ\xbb\xceG\x0c\x85t6\x8b\xdd\xe5Q/" +
+	"e\xf0.\xa3\x85\x0c\xf5\x92U\x09b\xd5\x99\x87\xc4p" +
+	"x\x8e\xaa\xf9\xdby\xd5\xc1#\xa38\x09\xc4\xdfx\xa4" +
+	"\xf3Efmb}>Ec\xf8\xce8\"\xaeq0" +
+	"\x15\x131\xac\xa3V\xc3\xfe\x97fA\x8em[\xdbB" +
+	"!M\xad\x93*\\\x90\x12\xbf\xed\xbcjY\xad]\xf3" +
+	"\x9b\xc5CP\x9d\xa1\xb9\x95\xd3o\xb5'W\x03=\x88" +
+	"]Q\xd9\xa5-\xfb\x047\x1cr\x11\x8f\xd6V3u" +
+	"\xc8VvZ\x96P\xed\x7f\xb1\xdb'\x14\x97k\xa2\xac" +
+	"\xe47\x8fk\xc8lC\\\x88\x87\xbb\xbe\xcd\x8c\xd6y" +
+	"\x11\xc9\x9erY\xe3IJ\x15\x8c\xa4\xe4\x8fH\x81\x02" +
+	"E\xd6\x85tY\xd4\x99\xd8P\xc0A\xff$8%\xbd" +
+	"=\xb1\x90\xd5x\xc3\"\x9aM>\x9c\xcbh\x1d\x90\xd8" +
+	",\\\xa3\x0a\xd9\x9a\xde>\x17\x1d&\xec\x1f\x18\xfdD" +
+	"g8\xfc\x7f\x13\xf3\xdf,\xb1\xef;oN,9>" +
+	"\xbe\xfe^\xbbL\xdb\xb2\\2\xa1\xf8v\xb9Y\x8e\xb4" +
+	"\xf6\x05\xf2U\x07\xdbk\x06T|R\xa0\\s\x89~" +
+	"\xa9\xbd\x0ae\xc8=\xca\xa8Cm\x16\x998\"\x9e+" +
+	")a\x9d\xd0\xde\xacp\x91\xb0\xd4,\xcby\xbc\x9b\xb7" +
+	"%\xd2\x87\xe6\xb0,\xd1\xd2\xd5*9\x00\xed/{\x87" +
+	"\xc4.\xe1\x1b\xba\x80\x0b\xc1\xda.\xe0\x08\xdc\xc5\xac\x8a" +
+	"{S\x17\xf0\x15X\x0f\xf8\xf3]\x18\x97\x83-]\xe0" +
+	"\x16\xbe\x19\xf0\xed]\x18\x97\x83m]\xe06\xfc<\xe0" +
+	";\xbb\x98.\x07;\x10~\x01\xe0\x03]\x98K\xf8~" +
+	"\xc4\xdf\x00\xfc\x9d.\xcc%\xfc`\x17p!8\x00\xf8" +
+	"\x91.L\xe6\x84\xc3]\xc0/\xf9\x08\xe0\x17\xba0~" +
+	"\xd2\xe7\xbb<&\x08e\x17\xba8\x89\xb7+\xdc\xc1\x1d" +
+	"\xf4\x0e~\x19_\xf3\x1d\x14\xef\xd8\x95\xe1\xfdM\x84+" +
+	"gYBW\xc8j\x02\xf8\x15N\xea&\xdd\x15\x94\xa8" +
+	"e\x1d\x01\xef\x07x\x97\x8e\xd4M\xba\x0f\xdc\xe7\xcaz" +
+	"\x02>\x00\xf0\xae\x9d\xa8\x9bt\xff\xae\xe8I\x01\xf8 " +
+	"\xc0\xaf\xecL\xdd\xa4\x07vE\xbed\xc0\x87\x00\x9et" +
+	"\x05u\x93\x1e\xdc\x15\xb2\xc5\x0d\x00|8\xe0\xc9]\xa8" +
+	"\x9b\xf4P\xc4\x07\x01>\x0a\xf0\x94\xae\xd4Mz\x04\xe2" +
+	"C\x00\x1f\x03x\xea\x95\xd4Mz4\xe2\xc3\x01\x1f\x07" +
+	"\xb8;\x89\xbaI\x8f\xc5\xef\x8e\x02<\x0fpO2u" +
+	"\x93\xce\xc1\xf2c\x00\x9f\x08x\xb7\x14\xea&\x9d\x8f\xf8" +
+	"8\xc0\x8b\x00\xbf*\x95\xbaI\x17`\xbb\xf2\x00/\x01" +
+	"<-\x81\xbaI\x17C\xa8gY\x11\xe05\x80_\x9d" +
+	"H\xdd\xa4%\xc4\xfd\x80/\x00\xbc\xbb\x9b\xfaI\xcf\xc7" +
+	"~\x9e\x07\xf8R\xc0{x\xa8\x9f\xf4\x12|\xff\x02\xc0" +
+	"\x1f\x04\xfc\x9an\xd4Oz\x19\xf6\xf3b\xc0\x1f\x06\xbc" +
+	"\xe7U\xd4Oz9\xe2K\x01_\x09x\xaf4\xea'" +
+	"\xbd\x02\xf6\xd1\xb2\x87\x01_\x0b\xf8\xb5WS?\xe95" +
+	"]A\xb7\xb3\x1a\xf0\x8d\x80\xa7w\xa7~\xd2\x1b\xba\xc2" +
+	"|X\x0f\xf8\xf3\x80\xf7\xeeA\xfd\xa4\xb7 \xbe\x19\xf0" +
+	"\xed\x80\xf7\xe9@\xfd\xa4\xb7\xe1w\x9f\x07|\x1f\xe0}" +
+	"]\xd4Oz/\x96\x7f\x03\xf0\x13\x80_w\x0d\xf5\x93" +
+	">\x8e\xed=\x06\xf8i\xc0\xfb\xf5\xa4~\xd2\xa7\xba\x82" +
+	".\xe8\x13\xc0\xbf\x04\xbc\x7f/\xea'}\x0e\xf1\xb3\x80" +
+	"\x7f\x0d\xf8\xf5\x1d\xa9\x9f\xf4E\x1c\xc7/\x01\xefr%" +
+	"\xf8Iw\xa2~\xd2\x9d\xae\x84\xf2\x1d\xaf\x04\xc7 \xc0" +
+	"\x07t\xf6\x90\x01\x82\xe0N\xb9\x12\x1d\x83\x00\x1f\x04\xf8" +
+	"\xc0k=d \xcc\xc3+a\\\x06\x00>\x1c\xf0\x8c" +
+	"t\x0f\xc9\x80\xf9v%\xd2\xc3\x03>\x06\xf0\x1b{{" +
+	"\xc8\x8d0\xaf\xaeD?r\xc0\xf3\x00\x1ft\x85\x87\x0c" +
+	"\x82\xf9s%\xf4\xe78\xc0\xef\x02|p\x17\x0f\x19\x0c" +
+	":.\xc4\xa7\x01\xee\x07\xfcG}<\xe4G\x82\xe0\x16" +
+	"\xaf\x84q\x99\x09x\x00\xf0\x9b\xbaz\xc8M\x82\xe0\x96" +
+	"\xaf\xc4\xc4'\x80\x87\x00\x1fr\xa5\x87\x0c\x11\x04w\x10" +
+	"\xbf\x1b\x00\xbc\x1e\xf0\xa1}=d(\xf8\x85_\x09\xeb" +
+	"1\x04\xf8<\xc03\xaf\xf3\x90L\x08\x97\xc0\xf2\xf5\x80" +
+	"/\x06|X?\x0f\x19\x06\xe1\x12\xd8\xde\x05\x80?\x08" +
+	"\xf8\xf0\xfe\x1e2\x1c\xe6\x15\xb6w)\xe0+\x01\x1fq" +
+	"\xbd\x87\x8c\x80\xf9s%\xcc\xff\x07\x01_\x0d\xf8\xcd7" +
+	"x\xc8\xcd\x82\xe0^\x85\xf5|\x18\xf0\xb5\x80\x8f\x1c\xe0" +
+	"!#a^]\x09\xe1!+\x01_\x0f\xf8\xa8\x81\x1e" +
+	"2J\x10\xdc\xeb\xf0\xbbk\x01\xdf\x0c\xf8\xe8\x0c\x0f\x19" +
+	"\x0d\xbb'\x8e\xd7F\xc0_\x00<\xebF\x0f\xc9\x12\x04" +
+	"\xf7V\xfc\xeef\xc0\xb7\x03~\xcb \x0f\xb9\x05\xe6\xdb" +
+	"\x95/Bx\x05\xe0\xaf\x01>&\xc9C\xc6@x\x05" +
+	"\xbe\xff\x15\xc0\x8f\x00>v\xb0\x87\x8c\x85\xed\xf0\xcaT" +
+	"\x08\xa3\x00\xfc\x18\xe0\xb7\xfe\xc8Cn\x850\x0a\xc4\x0f" +
+	"\x01~\x02\xf0\xec\x9b<$\x1b\xe6'\xe2G\x00\xff\x04" +
+	"\xf0qC<d\x1c\x84Q`?\x1f\x03\xfc4\xe09" +
+	"C=$\x07\xe6-\xce\xabO\x00\xff\x12\xf0\xdcL\x0f" +
+	"\xc9\x85y\x8b\xfd\x7f\x16\xf0\xaf\x01\x1f?\xccC\xc6\xc3" +
+	"\xbc\xc5\xf9p\xe1J'\xf1&9HJ\xdep\x0f\xc9" +
+	"\x83m\x18\xe1\xef\xa0xG\xc0\xf3GxH>l\xc3" +
+	"I0M\x12\x92`\x1b\x06\xfc\xb6\x9b=\xe46\xd8\x86" +
+	"\x93\xa0|\x17\xc0\xaf\x06|\xc2H\x0f\x99\x00Y\x0e\x93" +
+	"\xa0\x1b<\x80\xf7\x06|\xe2(\x0f\x99\x08~nI\xb0" +
+	"L\xaf\x06\xbc\x1f\xe0\x05\xa3=\xa4\x00\xb6\xed$\xa8~" +
+	"o\xc0\x07\x01^\x98\xec!\x85\xb0,\x10\x1f\x00x\x1e" +
+	"\xe0\xb7\xa7x\xc8\xed0\xcd\x93`\xb8\xc6\x01^\x04x" +
+	"Q\x96\x87\x14\xc1v\x98\x84\x8ek\x80\x97\x03^|\x8b" +
+	"\x87\x14\x0b\x82\xbb\x14\xdfS\x02\xf8]\x80O\x1a\xe3!" +
+	"\x93`Y >\x0dp?\xe0\x93\xc7z\xc8dX\x16" +
+	"I\xc8\xef\x05x\x0d\xe0%\xb7zH\x09l\x9fIp" +
+	"\xaa\xd5\x00\xae\x03^\x9a\xed!\xa5\x10.\x91\xb4\x08\xa6" +
+	"?\xe0\xf3\x00\xf7\x8e\xf3\x10/L\x7f,?\x0f\xf0\xa5" +
+	"\x80\x97\xe5xH\x19l\xabX\xcf\xc5\x80?\x0cxy" +
+	"\xaa\x87\x94\xc3\xf6\x89\xfd\xfc \xe0\x9b\x01\x9f\x92\xeb!" +
+	"S`z&\xc1\xb0\xaf\x07\xfcy\xc0\xef\x18\xef!w" +
+	"\xc0v\x88\xfd\xb9\x11\xf0\x17\x00\x9f\x9a\xe7!Sa\xda" +
+	"\xe2\xfb\x9f\x07|'\xe0\xd3\xf2=d\x1a\x9c\xeeI\xb0" +
+	",^\x00\xfc\x15\xc0\xa7\xdf\xe6!\xd3\x05\xc1\xbd\x0b\xfb" +
+	"s'\xe0o\x00^1\xc1C*\x04\xc1\xbd\x07\xc7\xf7" +
+	"5\xc0\x0f\x00~\xe7D\x0f\xb9\x13\xa4\x01\xc4\xf7\x01~" +
+	"\x08\xf0\xbb\x0a<\xe4.Ap\xbf\x8b\xfd\xf9\x0e\xe0\xc7" +
+	"\x00\x9fQ\xe8!3`\x9a'\xc1\xb2>\x02\xf8'\x80" +
+	"\xff\xf8v\x0f\xf91Lg\xec\xe7c\x80\x9f\x06\xfc\xee" +
+	"\"\x0f\xb9\x1b\xa63\xd6\xff\x13\xc0\xbf\x04|f\xb1\x87" +
+	"\xcc\x84\xe9\x8c\xed=\x0d\xf8\x05\xc0\xc5I\x1e\"\x82T" +
+	"\x81\xf3\xedK\xc0\xbf\x03\xbcr\xb2\x87TB\xb4\x10\xd6" +
+	"\xe7k\xc0\x13\x92\x1d$\xc5W\xe2!>Ap\x93d" +
+	"M\x10\xbc\xc9\xb0k\x03\xec/\xf5\x10?\xec\xda\xc9/" +
+	"\xc3t\x06\xfcj\xc0%\xaf\x87H0\x9d\x93\xe15\x1e" +
+	"\xc0{\x03^U\xe6!U0\x9d\x11\xef\x09\xf8\x00\xc0" +
+	"\xab\xcb=\xa4\x1a\xa4\x8dd4\x94\x00>\x08\xf0\x9a)" +
+	"\x1eR\x03\xd39\x19wy\xc0\x87\x03.\xdf\xe1!2" +
+	"\xec\xf2\xc90\xecC\x00\x1f\x03\xf8\xac\xa9\x1e2\x0bv" +
+	"y,?\x0a\xf0<\xc0k\xa7yH-L\xffd\x9c" +
+	"\xfe\x80\x17\x01\x1e\x98\xee!\x01\x98\xfe\xf8\x9e\x89\x80\x97" +
+	"\x03\x1e\xac\xf0\x90 L\x7fhnY\x09\xe0w\x01\xae" +
+	"\xdc\xe9!\x0aL\xffd<\x15\x00\xf7\x03\xae\xde\xe5!" +
+	"*L\xffd\x0c\x96\x03<\x00xh\x86\x87\x84\xe0T" +
+	"\xc0v\xf9\x01\x0f\x01>\xfb&\x0f\x99\x0d\xa7B2\x0c" +
+	"c\x0d\xe0:\xe0\xda\x10\x0f\xd1`Y`\xf9\x00\xe0\xf5" +
+	"\x80\x87\x87zH\x18N\x0b\xec7\x1d\xf0\x05\xc9\x8ef" +
+	".\x83m\xb1\x8e\xdax4\xc0\xf4\xc1F,>\xfd\xd0" +
+	"\x87=g\xff\xed\xbc\x11\xb1hMP-+\xbaZ/" +
+	"\xfbl\xa1C4\x92\xa3,\xa0\x12\xdd+UiR\xb8" +
+	"\xc64j\x18\x06\xb4(o@\x19\x09\xa8\xb4\x903\\" +
+	"ca\xe5*\x16uIH\x82\x18{\x92\xdc4\x84," +
+	"\x99\x7f\xcf7C>\x89\xf9\xf9\xebR\xb0\\VX\x95" +
+	"p\xa3(\xe6h\x9a\xd8\xd0b\x1ez\x1b\x87\xaa%\xbe" +
+	")\xdd\x17\xd1L\xeb\x91+\xack\xcc\xbfc\xa5\\\xbe" +
+	"\x1a\xf3\xdf~)\xe6\xbd\x9b\x0ew\x90X\x0f\xba\xc4j" +
+	"9\xf6\xef9\xb2\xf9\xc1*v \xb0\x8brU\x15l" +
+	"\xc7\xb6\xae\x09JAt\xf5nN7\xf6_\x8b\x06\xcf" +
+	"\x1e\x1f\xd1\xc2\xcc-\x1f\xde\x01\x90\xe0d@\xfa\xae\xf1" +
+	"\x11!\xc9R\x96\xbe\xd3\x0eg\xa3\xfe\x9f\xe3\xd4\xaf\x88" +
+	"\xbaTV+$\xf1\xfe\x8a\x93\xc7\xae\x8e\xa9\x91\xb5\xb0" +
+	"^$\x09.fV6\xd5D\x94jI\xb3\xa1\xe9\x10" +
+	"\xb9\xc6\xcc\xd5[\xdeZ\xf9\xbbI\xae'\xd7\x1a\x97q" +
+	"\xbf\x1c\xf6\xc9\xa1\x80,\xb8\xc0y3\xb9\xe9\x86\xdfj" +
+	"\x9f\xfaS\x17~`\xcc\xa2X4\\\x12\x9b\x15\xca\xa8" +
+	"\x9c\xa4@P\x9c\xe2c\xf3\x1f\x89\"\x9dD\x028\xe3" +
+	"+zs\x9ciHe\xd4\x8fKp\xb2\x11\xc0\x8eA" +
+	"\xab\x94\xf2\x94ob\x8e\x96!\x15VN\xa5$8\x03" +
+	"\x90\x18\xb5XVO\xbc\xdf4\xe5m\xa3\x8ab\x9d(" +
+	"\x03%\x9a\x90-\x95\xb1\\\x92V\xa5f@\x0c\xeb6" +
+	"\xff\x8dfI<+eM\xaf\xf1\x8b\x96\xbe\x86\xe7\x02" +
+	"j\xb5\xccz\xf0\x03\xed0\\\x99\x85t\xc9_l\x12" +
+	"}\xb8Bu&\x0f%\x9bX\xc2Ymz\x98\xe37" +
+	"5\x8bC\x04\xc5\xc0I\xc2Uk\xceD\x97T\x1f\xb2" +
+	"|\x11r\xc0\x13= \xf9UE\xf4Y\x95.&\xb5" +
+	"L\xb3\xf5\xd0N\x02\x8a\xff\x152\x04sM\x95\xd5\x88" +
+	"\x9a\xe4o\x9e\xa8\x1frB\xc7&JDW\xc30%" +
+	"M\x9a \x9e+f\x81\x02\xa1\x00\xa0\xb61k\x19e" +
+	"c\x12\x92\xac!\xe5v\xab*\x96+\x92D\x88\xa02" +
+	"\xe9yx9\xf6\xc1s6G\xcc\xf1\xc1\xe6\xcd\xe8m" +
+	"EY\xf3\x02/\xbb\x90\xa4\xb0\xf1\xfaR}(G\xb4" +
+	"\xd6&'\xa2\x13\xd5\x17\x8d\xbf3\xdf\x0by\x8es\"" +
+	"\xbaJ\x8c?Y\xe7B\x0bO\x85k\xd495R " +
+	"hUuF\xdd\xc7\xb3\x1b\x0aZ\xc9Xbw\xfb\xca" +
+	"\x86,\x8d\x11\xa6\xd3\xc5\x12\xfb\xead\xd8W\x9b\x82b" +
+	"\xb5\xec\xf3Ja\xc1\x05\x9c\x80\xb1\xe8NY\x83lQ" +
+	"\xb2\xe0d@\x9f\x1a\xf07\x03C\xaa\x1cV\x15\xaf$" +
+	"$\x85\xd9\x17\xf8\xe5\xb0$\x86%\xaf\x90.\xb1xz" +
+	"\x0d\xe8\x99\xcd\xba\xf9|\x11M\xf4\xb1\xcb2\x9b\xba+" +
+	"\x9aE\xeaT\xd9o\xdb\x88\x80\\L\xabS\x1bl\x93" +
+	"\xc4G\xbdN\xaa\x84t\x88\x825{\x80Z]\xcbj" +
+	"\x08\x84A\x14\xcb\xba\x9c]-ZNl\xa3\x80\x90\x04" +
+	"%LX\xd51tBH\xf2\xb3\xa1L5!\xafT" +
+	"m\xe6\xe3h\x82\xa3\x14\x10v\x1f\x89m\x9f\xd9\x92\xb5" +
+	"p8\x1a\x8b!\x90j\xebi\x98'\x06\x05\x17\xebl" +
+	"\x89hY\x8d,d\xe3\x03Lq]\x93k%\xbdF" +
+	"H\xd7\xd4\x08c\xf7\x0c\xeb\x11\xa5\xd9\xf0T\x8a\xbe\xda" +
+	"\xb0.VZd\x85\x80O\xadQ\x036\x8e\xb0D\xc1" +
+	"A\x12\x05\xd2\x18\x14\xeb1\xde+\xfa\x1b\xec\xf6\xf9\xd0" +
+	"\x9ahg\x1bp\xdbN\x03\xaaZ[.\xd5\xebB+" +
+	"\x09E\xb8~\xa0\xb1\xb0\x10\xd6\xaa5GV\xfc\xa6\xcd" +
+	"\xd7\xca\x8e^\xa9\xaa\xb5:~\xa9\x1dV\xad\x9c\x9c\xfc" +
+	"\xfa\x10\xe3\xcc\xc5hg\x17\xb5\x95\xa5\xc28\xf0\xc8\x14" +
+	"\xa0ye\xd7\xb8(\xd6\x87J$\xcd'\xb8\xd8\x032" +
+	"\xae8\x1a\xb0V\xb9\xd4\xbax,U\\\x1b`V\x0b" +
+	"\x96\xaa\xd6\"j\xe2\xe8\xa6\x80\x9e\xe3\xaf+\xd3]\xa2" +
+	"\x1e\x8e'\x18\xa0\xcd\\YR}H\xd2dIaI" +
+	"\x8c\x1a#\xb4\x1f\xcdME\xd2 \xd0E\x14\x9c\xed\xf1" +
+	">.V+'Jb@'5\xf1\xb8\xa9\xf40G" +
+	"\x99!\xccc=V\xda\xb4\xc4\xdc&+\x102\x81\xfb" +
+	"_,;W\x9b\x06/\xaf\xd5\xe0\xc5\x1c~\xbeh\xba" +
+	"6\xe6~\xd2v%\x8an\xa3\xa1\x1b\xa8\xe8\xd7\xd5j" +
+	"Wu@\x8a\x83h(\x97\xe7\xcc\xcbP\x10\xc7\\\x14" +
+	"\x96g1\xecC\x09N[\x84\xe8\xea\xe6ld\xf8\x1b" +
+	"F3\x16\xeb,\x07%tGcvykR\x99F" +
+	"\x09\xb3\xac\x84\xdb\x99df\x82\xa4$I\x9a\xec\x8b\xa7" +
+	"\xd73\xcd\xe1n-\xdbM\x9c\\;\x93\xcb\xf3\x84\xb8" +
+	"\xd2\x85\xb3\x9c\x98\xd5\x92\"ix\xa3\xdbv\x95\xb8v" +
+	"\xd4\xa9\x8d\xcfE\x05\xddv\xe6\xaf\x8d\x1aX%\"\xb5" +
+	"3a8/ol\xdb6\xd7\x16/7qf\x0cO" +
+	"\xf7J\xa1\xd6\xdczy\x16\xca\xd8\x94dS\x9b\xf32" +
+	"\x89\xdbrEPI\xaf\x9dQ\x8c994+k3" +
+	"\xae\x83\xac\xff,\xbe\x98\xe5m\xb5\x9a\x0e\xed\xc4\x81\xdc" +
+	"\x90@\xa9>\x84\x1c\x1e\xedj\x0b\xa4\x1a\xadT\xeb[" +
+	"\x9a\x9b\xe3\xcc\xee\x1e\x0b\xad\x1bE\xe3M\x1b\x03\x92\xae" +
+	"[\x04\xeb\xf7\x8f\xba\xae=\xf8\xef\x09F\x8c_vD" +
+	"\xd1$\xd1\xdf\xdeH\x90<1)(6c\x9d\xcb\xe2" +
+	"\x11\xfbe\xf1\x88\xfd2\xe2&\xf6c\xa9\xe8\x8c\x90\x1a" +
+	"KbB#\xa4\xe6L\x05\xeb\x88\x16e\x9d;_h" +
+	"\xc6L\xb5\x9e\x8e\xd6\"y\xd83\x00\xd9S\x19\xfc\xb0" +
+	"\xa4\xb4q\xa7s\x9dT2>\xccz\x0e\xb5\x10\xdf\x00" +
+	"W\xaav\x87-\xbb\x0a\x14\xbd\x954ku01\xe3" +
+	"?\xa3h\xd6*\xaf\x04|nyx\x12\xc4\xe3\x02\xc0" +
+	"x\x86X\x05>^\xf2\xfa\xb8\xf26\xc2:O\xaa\x8b" +
+	"\x86&\xb1\xa1\xf7Z\x0b\xa1\xf7\xa0\xe2\xee\x02\xf8\xd5l" +
+	"\xe8}7\x92e\x09\xc97\xec\xe0\xdd\xd1\xbb\xfcj\xc0" +
+	"\xfb\xb1\x09\x98\xfb\x90Y\x16g\xf7D#\xf4\xde\xea\xec" +
+	"\xde\x81P;\xf8`Rhqv7\xf8\xc2F\x10\xaf" +
+	"\xc5\xd9\xbd\xa3\x83\xda\xc1\xc7\x92Y\x16gw#\x01n" +
+	">\xf1\xb2\xce\xee-\x05\xd0;ek\xc8\xbd\xcc\x09\xb9" +
+	"w\xf2\xe2\xf0\x93\xca\xd9\xb5\x10T+\xad^}\x9c4" +
+	"\x8f\xd5\xaa\x18\xb0\xe5]`^gy\xdc\x8f\xee-\xd6" +
+	"\xa2q\x07db\xcdp\x8a\xe1>\x92\xe2\x15\x04Bp" +
+	"U4\x81K\xc7TU\x0b\x08\xc4\x8f\xff\x8e\x0a&q" +
+	"\xa4\x15\xa0\x99\xbb\xc1}E\xf5\xd5Jz3\xa6s." +
+	"3!\x97_\xc6B\xfd\x1e\xdd\xd6,\x09%\x8dm\x8d" +
+	"%1\x8c\xce\x9a\x94\xad\x85&\xe7\x0c\x9b0\x0a\x1ac" +
+	"\x0eF\x83\xd5#\xd9\x923\xd2\xa7\xcaJ\xf3\x1c\x10\xed" +
+	"\xba<\xf9|RH\x9f$\xa5\xcf1x,\xda\x13}" +
+	"k\xe3u\xe0\xb3?\xb4\xcf\x0f\xaaFU\xc3\xe9(d" +
+	"\xfe\xdf\xb8B\xf9jT\xd9\xc7\xbaB\x9d\xab\xff\xe2\xc5" +
+	"\xeb\xba\xbdt\xba5W\xa8\x18\x19fH\xf4\xd5\xba\xe8" +
+	"\xb9\x18\x1f!\xe6d\x9a\x0f\x9a\xa5\xcaf\xc4\xfbL\x1e" +
+	"\x01L\x86)\xdf\x13.\xffK4\xe4\xdcB.\x9a\xe0" +
+	"h.\xde[%,\x0b\x19SceD\xd7UF\xc1" +
+	"\xc7\xc9\x80\xd7\x18RC\x91P{\xc6\x15\x04l3G" +
+	"sN\x0e]h\xe3b\xbb\xf56\xdc\xad_ `x" +
+	"dw\xeb]\x04\x0c\xa7;\x01\x7f\x83\xdd\xad\xf7\x90\xb7" +
+	"\xc0\xc0\x08\xf8!v\xb7~\x97\x80\xdd\xfd\x10\xe0'\xd8" +
+	"\xdd\xfa8n\xcbG\x00\xff\x84M\x97\x7f\x12\xf1c\x80" +
+	"\x9ff\xd3\xe5\x9fB\xfc\x04\xe0g\xd9t\xf9g\xc8\xb3" +
+	"`/\x07\xfck6]\xfeE\xac\xcfw\x80wt0" +
+	"\xe9\xf2\x13\x1dP\x9f\x8e\x0e8\x85\x1cL\xba\xfc\x14\xc7" +
+	"\xeb\x82P\xe6\x01\xbc7\xe0W\\A\xfd\x96z9\xde" +
+	"\xb2$\xdc\xea\xd2\x85\xfa-\x0dv\x80[\xd4\x10\xc0\xc7" +
+	"\x00\xde\xb5+\xf5[\x1a\xed\xf8\xd8\x92\x10\xeb\xca+\xa9" +
+	"\xdfR\x81\xa3\xd0\x92\xf8*)\x89\xfa-\x95:\x0a\xd9" +
+	"\x04WT\xb3)\x16\x8bB\xbaV;)\xe4\xb3j<" +
+	"E%D|x\xfb\xae\xb1\xe9B\xc5<\xc9\x11\x90\xaa" +
+	"E]*\x16e%'\x0c\xea@\xa1y\x19b\x94\xb1" +
+	"\xbe\xbd\x91\xfe}\xb8\xed\xf7\x08\xdb\xef\x9bm\xef+\x0b" +
+	"\x11)\x10\xc8\x99#jR\x92\xc2\x06\xa0\xd3?O\xae" +
+	"rTIJX\xcaWj@\xa9\x15\xe4\xa8p\xc5b" +
+	"\x91(\"-\x90\x8e%l\x7f\x9f(9\xa0\xb5\xec+" +
+	"\xf8%P\x01(\x19\x0b\xc3V\xe66\x99(\xfe\x12Q" +
+	"\xaf)W\x9d%>\xfe\x0b&W\xd1\x18\xe8p\xb9\xa8" +
+	"\xb9X\x9eoZj\xa8\x853/\x8a\x8dhW\x98\x0d" +
+	"\x90\x8b\x04\x02&\xaf\x88\x11\xaf\xf7\x7fJ+\xd2\xe5\xd6" +
+	"\x8c{\x0eN=\xba<\xee\x08\xa9;\xc4\x80\x0c$\x15" +
+	" 7\x80\x1c\xecj\x9e\xf9\xf0\x87\x13\xe1a\x80\xafV" +
+	"V\xa3\xce\xa1\x8c^\xed\x15X-\xa9\xbc\xa8\xc0\xda\xce" +
+	"k)\xbdB\x19QW\xfdb\xdf>\xd7\xc3\xbc\xc1\x18" +
+	"\x9f>\x9f\xc1\xd0\xa7\x19Cq1\x93a\x820\xd49" +
+	"\x97z0\xac\xdb\x86:\xe7r&Cl\x1e\xf3\xd3$" +
+	"\xa4\x07C\xc6\xcdd/\xca\x12\x04/\x01\x87\x076T" +
+	"\xb2\x13\xc0e\x09\x80'\xb3\xa1\x92]I%+D\xb7" +
+	"D\xca\x16gjd6q\\PV\xf2\x82\xd5\xe6O" +
+	"\xb1\x9e\xf9\x19\xa5=\x00\x0eu&\x9f\\\\Z\x0bo" +
+	"D)V\x9d~\xa9\x95\xb9\x14lW\xa2\xb6\x09\x92>" +
+	"\xa9d|\x9e,\x06\xd4\xea\x88\xc4$\"nS}\xb4" +
+	"(:\xa3&:\x9a\xc7\x88\xfa\xa3\xef#\x13\xe50\x04" +
+	"\x93\x0a\xe6Rz\xab\xcf\xbe\xdb6\xfez\xfe\xa7q3" +
+	"\x0cL(.B+\xaa3\xf8\xc3SG0\xea\xfaV" +
+	"=\xb6\xc1\xee\xdan\x893\x1a\xd0\x16HBwfB" +
+	"J\x87\xc4\xe4\x01;\xf3\x98!\x0f\x14\x10/\x1b\xd3\x1b" +
+	"\x95\x82\xdc\xa5$\xc3\x12\xd2k\x84\x12O\xc1\xcb[9" +
+	"\xe03\x89\x99\xca\xde=\x83dZBz\x0d\xb6g\x11" +
+	"\xcb\xcf\x04<@\x18\xb6g\x19\xdf\xef\x07<\xc4\xde\xde" +
+	"\x82X\x9d\x00\xe0\xf5\x84\xf1b\x8e\xe0\xfbC\x80\xcf#" +
+	"\x0c\xdbs\x03\xbeG\x07|\x01\xe0\x9d\x1dT\x1c\x98\x8f" +
+	"\xe2F=\xe0\x8b\x01\xbf\xa2#\x15\x07\x16b\xf9y\x80" +
+	"/%\x0eJ\xd0*\xea Vk\xac\xf5($\xfb\x91" +
+	"\xb8\x9c1@YwF \xe6\x81\x12\xa8\xa6\xe0\x87\xcc" +
+	"E\x19\xcd-E,!vm{\xdaX\xac\x12\x8d\xa2" +
+	"\x8f\x8a\xd7\x86\x90\xc9\x9a\xd8\xdb^^f\xb2n\xcc\xa7" +
+	"\xa1\xa4\xfb\xcb\xe6\x88\xa1xb@4\xd6\xa8\xe3\xa7:" +
+	"t!]\xb3,3M\x0ar\xf1V%|:\xbf\xed" +
+	"\xf2}\xcb\xe9\x02u)x\x87,\xcdaS\xe2\xb5\x11" +
+	"\x07Vh\x1e\xb3\xb1\x0d\x9f\xa5!\xb0\xa7\x80\x12#\xd5" +
+	"A)j\x06\xb6g\x91\x93\x95\xda\x89b\xb8\xa6]q" +
+	"6\xe9e\xa1\x80\xac\xff\xbf\x97\x06\x81\xb9=\xe2e\x83" +
+	"\x8en\x1c\x89P*\xda\x88\xb0\xe6Rr\xb5}\xe9A" +
+	"\x92\x18p\x1c\xa0\xc1\x8e\xc4\xce0Q\xc1\xeb\xda,^" +
+	"\xd7\x16\xf2\x82\xc2\x18b\xb1&\xb5rV\xb3\x8c\xe7\xd9" +
+	"@nX\xc02#B}\xac-\xd3@\x8f?^\xf5" +
+	"\xb3\xa6\xf8\xb6\xb3\xdcD\x15&NI\xff\x7fH_b" +
+	"\xa4\xcf{\xe3\xbf\xa1/i\x84\xd3+,\xd7\xb7\xdf\x1a" +
+	"\x02^\x1a\x13K\xa6d#\xafZf<\x87?c\xa1" +
+	"\xb4;y0\xf2Q<L\x02@\xf7Q&\xfb%J" +
+	"\xf9A\xec9\"\x9eb\xa6\x91\xf1\xf5\xe0\x03L\xb8\xb2" +
+	"1\x12\x0d\x8f0VCc$\x96\xcc5\xad\x86\xd1\x15" +
+	"!\xea\x04\x84\xaf*9\xa0CJ\x8aXU\x95\x90\x0f" +
+	"\xc52\x82\x7f\xc2\xb4\xf86\xe3\xa7O$a\x9d\xfe\x91" +
+	"X\x9e\x03g\xb5*!\x9b>\xd6\x1e\xea\x82jY\x19" +
+	"/FM\xb3m)\x86\xb8\xd6k\xd6\x02\xe53\xc2$" +
+	"\x19\x05\x87\x9dI\x8b\xc7H\xdf\xeaa\xe1W}\xcd\x8e" +
+	"\x8a\x0e\xf1&\xfd\xc5\x9c\xbf\x938!\xdcm50\xa6" +
+	"\xf9\xca`\xa8\xfa8\x1bZ\xa3\xe8\xa7Q\xdd\xad\xb2p" +
+	"&\xb6\x95E\x10)U\x0bt\x89\x04[V\x17\xf2." +
+	"R\xcd\x12\xd6s\xd4\xca\xf1X\xf8\xc0;V\x88\xc7/" +
+	"\x801\x14;E\xd3G\xb6\x9d\x16\x0f#\xf4\x0fT\xcd" +
+	"\xfe2Is\xd5I\x9a\xed\x1a\x97\xc5\x18\xa2b\xf7\xb8" +
+	"\x1e\xec=.:B\x17\x0b\x99+\x9b\xb1\xea.W\xb2" +
+	"w3Cw\x95Hf\xb1\x970\xc3\xd2\xd0\x15eU" +
+	"\xd3\x90aX\x1a\xba\x91B\x0b\x87p\x94x\xdb\xdd\x8b" +
+	"dY\x0c\x19\x1d\x9dTV\xedC4\xd6\x90\x91\x0d." +
+	"\xa8\x12\xc3\xd9\x1e2\x85|U\xa9\xb6\x0f\x13\xcaxZ" +
+	"\xc3x\xc1\x05\xb7\xa7f\xb9\xf3\x92\xc6\xb30M\xfdk" +
+	"=\xa4x\xf9\x80\xed\xeen\xd1\xbc;\x93\x85t\x05\xf3" +
+	"\xf1\xc4=b`9-\x93\x14?+\x831F\xcb\x1e" +
+	"<\xa3en\xdcF\xcb\xcc\xa8\xd1\xf2\x82\xc9\xb2\xcd\x1a" +
+	"\x1dc\xe4\xe8\x972\x98\xb1\xee\xe0\xa06\xcb\xcbY\xec" +
+	"\xf5\xdc\xe5\xa4\x83DH\x163\x05\xe0h3\xd4\xb2\xe1" +
+	"\x08\x9e\xf01q\xbaR\xf57\x18?\xec$\xab\x9f\xfe" +
+	"\xe9\x83\xc2\xf5\x0b\xa7\xfc<\xe6\x92\xfb\xbf,w\x01\xeb" +
+	"A\xb1\xea\x8f\xea\xc3\xd8\xbb\xf0\x0f!>`\xf6\xb3\xd6" +
+	"\x88\x0f\x1a\x83\xb2\x12\xd1\xdb\xf0/\xb1Y\x92\xd0kR" +
+	"\xb0\xb3V\x15Z,v\xc6Us0I\xb5\x90h\x1b" +
+	"\xaa\xe7\xa1$\xd5b\xc93T\xcf#H\xaa\x85\\\xdb" +
+	"X\xbe\xa3I\xae\xc5\xc2g\xa8\x9e\xc7\x92,\x0b\xe9\xb6" +
+	"\xa1z\xb6_}\x0d\xd5s\x01\xc9b-\x7f1\xd5s" +
+	"1\xc9e\xaf\xc41\xd5s)\xc9\xb5\xdc\x89\x0d\xd5\xf3" +
+	"\x14\x92\xcb\xd2\\\xc1\xe6\xabA\x80<39\xda\xe7\x85" +
+	"\x1c\x9f\xbb\xaf\x8d\xb9\xb3\x91Z\xe4c\xe4U\xd1\xdf\xd3" +
+	"m\xbf+\x9a\x19\xd0[<\x0fn\x03\x0f4\x7f\x81\xe2" +
+	"\xacR\xe3\xc8\xeb\x9a\x15w\xba\xe2L^^W/\x9b" +
+	"\xbe9\xca\x91\xb3-\xc34\xe2Y\x95%\xaae\xdb\xb2" +
+	"\x12\xd7Xn\xd0\xbc;6\xff\xe2\xdc\xba\x13\x15\x9f\xd0" +
+	"\x8eGc\\\xd8\x1a\x8d\xf1R\xa6\x1f\x96T\x9a\x12\xa1" +
+	"=h\xbeA\x8d4\x8bl\xa7\x99\xc2\xa2)V[\xf4" +
+	"\xed\xa6\x17\xab\xb0\"\xb8\xda\x15\xffO\x15\xb9yR:" +
+	"\x8f&,\xab\x0d5n6\x10h\xc8\xfeV)4\xe2" +
+	"J\xd2\x12\x09JB<\x9a5.\xc7\x0e#\xa1Y\x19" +
+	"=D\xbc/*a!)\x12\x94\xfc|\xef\xd88\x1c" +
+	"\xda\x14\xbf\x1a\xf4Jd\xb6\xads\xfa\xb6\xe1\xd0\xe6b" +
+	"X\x98\x92,\x14Lq.\xc0\x164\xa1Y\xad\xe7\x96" +
+	"n\x95\xe1\xa6\x9dF\x83p(Imn\xebh\x8b\x1e" +
+	"3&:\xcf2\xaf\x06  \xb2\x9e\x13F\xe8\x0as" +
+	"WF\xebG\xb1\x14\x16\x92\xc0\x88\x1b\x7f\x95\xcbt5" +
+	"D\x8d\x01\x8c\x9bi\xfb\xc8nc\x8f\xb1\x0a\xedY," +
+	"\xfd\xbf\x91\x8f&\x09\xef2FF\x9a\xb6\xeb\x06\xf7\xd2" +
+	"\x9cPH\x12\xc1\xdd]\x12~0\xe7/C\xe9i\xbf" +
+	"\xecZ\xe7sk\xec\xe6\xadP\xb2\x88~\x08+K\x0a" +
+	"\x94Iz+t\x97x\x97k\xe9n\xcf\xd7\xf4\xa9\xba" +
+	"\x88\x89\x15\x04g\x1c2\xa6\xa4\x01\xd5\xa1^\x12\x81\xff" +
+	"r\xb2+e\xc6M\xfd\xc3\xd5\xf2d\x9awv\x9b_" +
+	"'J}6j9\xcef\x96\x1e\xd2\xe4\xf6$\x97\x05" +
+	"\xa1\xb9H\xd2u\xa7\xa4\xd9%\xa5\x1e|~\xcf\x8c\x16" +
+	"\xf8=s-\x02\x94\xa1\x94\x1fJ2,\x02\x94\xa1\x94" +
+	"\xb7\xbbBE\xc5g\xf7X\x92a\x15\x94\x9c\x86\xa0\x94" +
+	"i\xb8H\xa1\x80\xe3J\xa0\x82\xd2\x14RhQ\xfaw" +
+	"L\xa4\x82\xd2\x0c\x92aQ\xfa\x1b.U\"\xc9by" +
+	"<c\xdc\"\x12\xc9b\x8d\x01\xac\x91\x0b\xf5L\xf1H" +
+	"\xe6\xe8'\x0d\xd9u\x04\x12\x8b\xc6Hb\xbd.\xed\xa2" +
+	"\xbb\xf7\xcdQ\x7f\xba\xbc\xf5\xcfM\xffe\xd1\xbd\xe5[" +
+	"t4\xffD\x92\xe1g\xc3L\xdc\x1e\x1cy\xc1\xcb\x93" +
+	"\x17\x0ayi\x0ff\x99\xdaI\xd6\x01?\\\xa3j\xa0" +
+	"\xdb`\xc9\xefx\x17\xcb6\xf8\xfb\xdb\xb0\xf1\x82X@" +
+	"\xb3\xf46[\x8e\\}6\xeb\xebk,\xc7\xd9Y\xbc" +
+	"\xe5\x98k.G\xae\xcf\"L\x0d\xdb\xa2\xcc\xd6U\x0b" +
+	"\x0d4\x08y\xd5\xedq\x8c\x87\xd4\xde\xe35ItR" +
+	"\x91\xae<\xb6\"\xc7:`\xc9\x8cq\x80\x97\xa0\x83\xb9" +
+	"\xbb\xe4#\x9e\x07x\x89\x83\xb9\xbb\x14;@gP\x04" +
+	"\xf84\x07sw\x99\xe2\xc8d\xddBbw\x97\xe9\x0e" +
+	"X\x02\xe5\x80\xcft0w\x97\x19\x0e\\J\x80\xfb\x1d" +
+	"\xcc\xddE\xc4\xef\xce\x04<\xe0`\xee.2\xe25\x80" +
+	"\xeb\x0e\xe6\xee2\x1b\xbf\x1b\x00\xbc\x9eu\x9b\x898\x80" +
+	"V#\x04\xf8<\xd6m\xa6\x01q\x1d\xf0\x05\xac\xdb\xcc" +
+	"|\xc4\xeb\x01_\xcc\xba\xcd,D|\x1e\xe0KY\xb7" +
+	"\x99%\x88/\x00\xfcA\xd6mf\x19\xd6g1\xe0\x0f" +
+	"\xb3n3\xcb\xb1\xfcR\xc0W\x02\x9e\x9cL\xe9~V" +
+	"`?<\x08\xf8j\xc0SR(\xdd\xcf*l\xefJ" +
+	"\xc0\xd7\x03\x9e\x9aJ\xe9~\xd6!\xbe\x16\xf0\xcd\x80\xbb" +
+	"\xdd\x94\xeeg\x13\xba\xe5l\x04\xfc\x05\x87\x83\x10\x0fe" +
+	"\xfb\xd9\x8a\xaf\xdf\x0c\xf0v\x87\x83{;h\xaa\x11e" +
+	"\x0d\xf2\xb4\xb3Gg\xa5$j~\x9f\x1a`\x83\x9f\xd3" +
+	"\x11l)\x0b\x8f%\xa4>\x1c\xcd\xde\xce\xca9\xf0\x99" +
+	"\xb0\xde\xc0\xca>\xd6\xc8\xf9\x16C\xcc\xd9\xe0q&\xc4" +
+	"\x9c\x0d$\xb7\x85\x9b3A\xe9\xd6\x10s\xa9A\x82\xa6" +
+	"jl\x0eG\x133s8\xea\x11]\xb5\xe5\xb2og" +
+	"\x9e!\x0c\xbf)\x96\x82\x18\x9a\xdar\x8cT\xeba\x0e" +
+	"LD\x067\xd3\x8b-#\x14\xbd11\x19\xa3s\xee" +
+	"\x9c=\xfb\xc5\xae\x8bZ\xf3\x9d\xb1\xf0\x82\xc16!\xfa" +
+	"tI\xa34\xc3\xf9I\xa0\x99\x83\xca\xcf\x8bm\x18S" +
+	"\x9c0\xa3J\x9c\xb0\xd0\x9d\xe6\x11>\xdd\x89ia\x01" +
+	"\xf6;\x99#\\\xc4\xe2w\x01^\xe3d\x8ep\xc9\x09" +
+	"\xebd&\xe0\x01'C\xd1-cy?\xe0!'\xe3" +
+	"u\x12t\"-\x10\xe0\xf5N\xc6\xed$\xe2\x84\xfd%" +
+	"\x04\xf8<'\xe3w\xd2\x80\xef\xd1\x01_\x00xGB" +
+	"\xf7\x8b\xf9\xf8\xddz\xc0W:\x19\xbb\xfa\x0a\xc4\x1f\x04" +
+	"|\xb5\x93\xc9\xa2\xbc\xca\xa9\xc1:\x04|\xbd\x93\xc9\xa2" +
+	"\xbc\xce\x09n\x85k\x01\xdf\xecd\xb2(o\xc2\xfa\xac" +
+	"\x07\xfcy'\x93Ey\x8b\x13D\x99\x8d\x80\xbf\xe0d" +
+	"\xb2(o\xc5~{\x1e\xf0\x9dN&\x8b\xf2\x0e\xac\xcf" +
+	"\x0b\x80\xbf\xe2d\xb2(\xefr\xc2>\xb2\x1d\xf0\xd7\x9c" +
+	"L\x16\xe5\xddN\x90\xacv\x02\xfe\x86\x93\xc9\xa2\xbc\x07" +
+	"\xdf\xf3\x0a\xe0\xfb\x9cL\x16\xe5\xbdX\x9f\xd7\x00?\xe0" +
+	"d\xb2(\xefG\xfc\x0d\xc0\xdfq2Y\x94\x0f\xe2\xfb" +
+	"\xf7\x01~\xc8\xc9dQ~\x17\xebs\x00\xf0#N&" +
+	"\x8b\xf2a\xc4\xdf\x01\xfc\x98\x93\xc9\xa2|\x14\xf1C\x80" +
+	"\x9fp2i\x94\x8f#~\x04\xf0O\x9cL\x1a\xe5\x93" +
+	"\x88\x1f\x03\xfc\xb4\x93I\xa3|\x0a\xdbu\x02\xf0\xb3N" +
+	"&\x8d\xf2\x19,\xff\x09\xe0_:\x994\xca\xe7\x10?" +
+	"\x0d\xf8\x05\xc0\xafuPz\xb0\xf3\xd8\xff_\x02\xfe\x9d" +
+	"\xd3\xee\x0e\xc6\xd3\xa5\xb0\xfb\x9c\xcdy\xc1\xe2\xeb\x10\xcb" +
+	"\x95#\x08-m\x99\xec\xce\x94.\xb7\x12\xccmc\x18" +
+	"\x09irP\xd4\x1a\x0a\x84t\xffmr\x80\xd5\x83\xfb" +
+	"T\xc5/j\xa4\xa1\x00\xff\xc0|X\x9d\xa8\x06\x99\xb8" +
+	":E\xac\x0c0a\xf9`\xbd,\xb2r\x1e\xd8\xd8?" +
+	"\x18n\x02F\x14\xb3:a\xc5b\x84\x8d\xdf\xbe\x88\xc6" +
+	"\xfef\x9c\xb4\\\xa2^\xdb\xe6\xb6\xff\x1f1\x8b\xd0\xb8" +
+	"z\x9b\x94\x18/\xfdjQ\x12\x8ck<\xd7\x7f\xad\x0d" +
+	"\xc5\x8cM9\x17\xe3YO\xb7\x98\xaf\x92|jXo" +
+	"\x0fI,\x08\x8d\xe3\x03\xae\xe6\xa4\x99mRH\xf2\x04" +
+	"N\xcaR+\x06\xda\xa5\xbe\x02i\x7f\x8a\"\xd6\xb9D" +
+	"9\xd0F\x98QKn]\xad\xfazS3f\x19\x9a" +
+	"q\x04;\xc3{\x06\x87\xe1=\x93\xc7\xf0\x9e\xd9&\xc3" +
+	"\xbb\x83\xc7\xf0\xee\x8c2\xbcC\xc97\x9c\xa4\xf4\x1d&" +
+	"T\xec`\x96I\xfb\xde\x8a\xafb\xba\x95\x9d\xdc\xaa\xe1" +
+	"nD\xae\x88\x02\xbfq\xa1\xcb\x0e\xa8\xaa\xce\xdc\xc1T" +
+	"\xbe\x95\xaaez\xdb\\U\x0d\xb4\x19\x98\xd5rn\xe0" +
+	"V\xc3Z'\xa9\xba\xf4\xdf\x0ckUT\xbd}\xa9\xa7" +
+	"\x91\xf9\x875\xc0\xb5\x98\xe9Hj_\x9e\x19\x1a\x80V" +
+	"&\x89\x9a\xaf\xc6+\x85#\xae\x80\x1e\xb6\xcd4\xaf9" +
+	"\xab\x8cf\xee.d\xe6\x8f1\xd3\xf6\x162S\xc5\x98" +
+	"i\x07g1\xe6\xc1\xa8\x92\"\xe5p!c\x094f" +
+	"\xda\xf1\x0c&|\xd1\xc8~|\xb2\xd0\x8cTl\xaa\x04" +
+	"\x97\x05\x85z\xe8%7\xf5\xc9N)|\xe3\xfc\x97\xf7" +
+	"\x19\x03\x19\x96\x9ag\xfdR\"\xc1h\xdaa\x815\x95" +
+	"\xcab`R\xb3;qs\xf3\xb9mO\xd2\xa5 \xac" +
+	"\xc5v\xf9\x8f\xc3\x03\xb2/O\xd4EK^-\xc6\xae" +
+	"\x92\xcb\xb1\xabd\xf2\xec*\x99Q\xbb\xcaN\xa6wc" +
+	"y\x1e\x0e0v\x95\xfd\xb9\xd1q8\xc1\xd8Ub\xc1" +
+	"\xa1\x17\xfeC\xef\xf3t8\xfd\x99?\x17nO\xb8\xe9" +
+	"\xbb\xc3\xc7\xfe\x1d\xfdsc4\xcd\xbbY`\xc6\x17\xbd" +
+	"w\xe5\xf7\xf8\xf8\x84Q\x00\x0er\x0bY\xf2MR\xf9" +
+	"\x95\xf7~\xf7\xfd\x93q\xbb\xb7\x9b\xec\x02\xa6)\x929" +
+	"\xa5Ry\xa7T*O\xb5\x9aj\x1e]m\xe4\xda\x8a" +
+	"\xef\xaeS\xa8\xcaDiy|S\xf8\x03l8sy" +
+	"y\xc9\xd5\x19\xbf\xad\x983\xd7\xd6,\xd3pF\x12\x9b" +
+	"\xdb\xcd(\xc9\x13#\xa3\xb4m\x1ec\x93N\xda\x18\xdc" +
+	"\xdby\xe9+A\xe6\xaa\\\xc9\x19\xd0\xe3Ohz\xc7" +
+	"\xfa\xe1E\xf7=\xf7\xdd\xb1\xb8\xa7\x00\xbdZ\x8a\x0a\xb0" +
+	"\xd3\xc0\xf9\xd8\xccs+\xf7?\xcay\xc0f\xe3\xb7u" +
+	"&\xe4r\xb0\xa4\xe8T\xa49\xec\xef\xf6\xe6\xe2E\xbf" +
+	"\xa2|\xcd\xa5\xa9q\xe5J\xd3\xdaH5AM\x02\xac" +
+	"\xf4\x0e\x97Y\x9b\x8bnc\x90\x86\xcb\xb5\xc3O\xc8\x0f" +
+	"\x94K\x11-]*k\x9em\x9c\xab\x9b\xcfh\xa3\xaf" +
+	"cS<\xe855\x84V\xdd|R\xab\x0a?\xd8\xef" +
+	"K4\xd9'\x90vH\x09\xe9\xf9AU\x97Z9@" +
+	"[\xea\x99\xf8\"\x84b!\xe7\xf1x^\x95\xc3\x0dD" +
+	"h%S\x92(\x82\x0b[\x8b\xcc\x85\xf1\xd8\xa0\x80M" +
+	"0RUU\"b`n\xcbJ\\\xbeO2\xe1\xf9" +
+	"$;\x9a\x938\xf0x\xc4\x92\xc0\x05\xca\xc6\xbe\x97," +
+	"4\xb3\x1bC\xa9*\xd1\xdf>.\x07\xc3<\x9fd8" +
+	"\x8c21\x9fY\x1cJ\x97B&\xbc\xd3h\xd4\xb2\x8a" +
+	"hx\xe7F\xa6Q\x1b*\xdb\xf0S\xf8\xdf6\xdaS" +
+	"4G\x14\x080\x13\x8e\xb9\xa5|\xb3x\xf0\xea\x0bq" +
+	"\xcb\xaax-\x9a\\U\x95-i\x05J3\x17\x8e\xcc" +
+	"\xe8Q\xf4\x82\xd95l\xcct\xack\xd8\xbbE\xack" +
+	"ve1\x97\x0b\xa3kvg1\x97\x0bC\xd4`\xf3" +
+	"\xbd\xda7\xfa\x02\xa5\xfb\xfa\x99w\x16\xfe\xf4\xbfd\x99" +
+	"\x111\xdcZj_\xf8\xd8\x84\xe2\xb2\x08\xfclv\xa5" +
+	"*dZ\x18\x93t\x99\x16\x1a\xd6\xed=\xb9\x8c\xf4k" +
+	"\x98j\xf6f\x99\xad\x8e]\xa9\xf6\xa72\"\xb1!\xe8" +
+	"\x1eLe\x93fE\x05\xddwSM9\x19\xb7m\xdb" +
+	"\xac\xb2\xc5\x0a\xd9E8\xdb\xe5\x8a40^H\xc6\xbf" +
+	"\xe6\xb6#M\x16\xbd_\xb7#7\xccLf\xfe\xcc(" +
+	"\x8c\x1e\x08\xf5\xb6p?tY\x95\xfd\xcd\xcc\xc8\xba\x14" +
+	"\x04Q\x9b\x13\x81\x11\x17y\x05\x84y\xb9T\xad\xc1v" +
+	"?\xcb\xe4xK\xe4F\xafgy\x8e6sx$L" +
+	"\xbb\xb3\xf3}w\xbc\xf4U\xfcI\xd6\xa36\xec$\xe3" +
+	"\x88l+\x12\xdd\xb2-E\xe7\x96%\xea\xdc8\"W" +
+	"\x00\xf8\xb0\x93\x94\xaee\xa4\xc0\xd8r^\x1f_~\x98" +
+	"\xff\xc8\xce\xdd\xa1-\xab\x9aN\xdd\x02s\x02\x01\xd5\x07" +
+	"\x0e\x8cT\x0e\x1e\x13\xd3\x99\xafB\xb3\xf7\xc3\x048\xe4" +
+	"Y\x07\xc15h\xdf^\x09\xf8z\xd6Ap\x1d\xe2\xab" +
+	"\x01\xdf\xc8:\x08n@|-\xe0\x9bY\x07\xc1M\x88" +
+	"\xaf\x07\xfcy\xd6Ap\x0b\xe2\x1b\x01\x7f\x81u\x10\xdc" +
+	"\x8a\xf8f\xc0\xb7\xb3\x0e\x82\xdb\x10\x7f\x1e\xf0\x9d\xac\x83" +
+	"\xe0\x0e\xb4\x87o\x07\xfc5\xd6Ap7\xe2\xaf\x00\xbe" +
+	"\x8fu\x10\xdc\x8b\xf8\x1b\x80\xbfC\x18#\xdbA\xc4\x0f" +
+	"\x00~\x840F\xb6\xc3\xa4\xd0\x12so\x18\xd9\x8e\x93" +
+	"BKl\xbdad;\x85\xf8'\x80\x7fi\xb5\xab7" +
+	"V\x8aa\xa9L\xd7l\xbfE\xcb\xef<\xa9\xde\xf2;" +
+	"\xa7Z\xb6\xfc.Pt\xcb\xef\xa9r\xd8\xf2{\xbc\xa9" +
+	"\x98l\x82\x1c\xf5\xbe2]\xb3\xe8\xd3(&6\xc3\xf2" +
+	"\xa4\xfafXN\xb5\xdc\x0c+P\xf4f\xd8T9\xdc" +
+	"\x0c\x1b_#\xb6k\xf3\xa7\x19\x1b\xc7\xabI\x90\x0e<" +
+	"\x9e;C*O\x8eM\xe5\xc9\xb1\xa9\xcc\xa5\xc1\xe6\xa8" +
+	"\xf3\x9f\xde.c9\xb1\xa2\x87z\x9c\xfe\x88\x0b\x98M" +
+	"9\xb6\x05\xadd\x0e\xad\x15\x8b\xcc\x8d\x05E\x9b:\xc9" +
+	"\xae\xa8\xe5\xc4\xc5\xa5\xeb2\x87\x18\xb9\xe5\x84Ym3" +
+	"\xc8Do\x17\x92\xa1\xe1jwP\xb9&\x87k\x19\x8e" +
+	"\xd1\x96\xb9\xce\x12\xe3\x0d\x1f\xc9\xd6\xf2\x0d\xcb\x1f\xbf\"" +
+	"<\x06\x17\xeb\xe5\x8f\xaa0\xcb\xc0\x87\x97u\xc4o[" +
+	"\xe3(\x85#\x9a&\xf9\x88ngc\xb2\x06\x92\x1b{" +
+	"\xa8=\x88\xc1dcJm\x81\x8d)\xd5\x12\xdc`\xec" +
+	"\xa1\xbdH*\x1b\xdc`\xc4H\xf4!\x85\xac\xc7R," +
+	"Fb \xa9\xb0\xb8&\x19\xf1\xbcCI\xae\xc55\xc9" +
+	"`c\x1aA*,\xaeI\x86\xeb\x90\xcd\x87\xdb\xee\x09" +
+	"\x1d\xb3\"9\xe3^O \x95\xdb]a4i\xee\\" +
+	"\xbb\xed\xd8NS\xd6\xe4Sa[\xb0\x16jwr\xab" +
+	"I%\xe3o\x97\x03\x81\xb2$=J\x0d\xca\x84\xb6d" +
+	"r\x18\x0ar\xe3f(\xc8b\x19\x0a\xa2\"\xa65\xdc" +
+	"\xc5\x88\xc1N$\x95\x96\xa9b\xc4`w%^\xcbT" +
+	"1\xc6\xac\x1b\xa9\xb0L\x09#\x06\xbb\x17\xfa\xd1\xf7\x04" +
+	"|\x00\xb1\xd3r\xda\x03\xef\xd3)\x1db\x8c\xf3M\x12" +
+	"\xf5\x1a;\xd90\xd0\x0e\x88\x01=\xc6\x96K\xd1r\x11" +
+	"x\xc0\x95\x18*\xd5\x87\xf2E\x8dfy60\x086" +
+	"\x04\x10\xd3\xdd\x1b \x98\xed\xa0\xb3\x85$H\x86\xdb\x8c" +
+	"\x83\xb7e\x07')\x182\xe4\xb5\"\xd9\xd8q\xdaA" +
+	"\x1d\x14;\x0b\x96d\xb0\xdcA\xd1\xb3`Y.\x9b\xe7" +
+	"?*\xb0-/d\xb8\x83ZVt$\x81\xa4\xd6\xa2" +
+	"\xd6C\xd5\xe4j\xbb\x18\xd76\x13*\xe8\xffl\xbe\xa8" +
+	",m\x90U\xe41\xb6\x95\x1d$\xd3B'dl+" +
+	"\xbbH\xaeE\x142\xb6\x95\xdd$\xd7B3dl+" +
+	"{H\xa6ED2D\xb3\xbdX\xfe5\xc0\x0f\xb0\xa2" +
+	"\xd9~\x92k\x11\x9d\x0c\xd1\xec \xbe\xc7\xa4+2D" +
+	"\xb3wI\xaeE\xa42D\xb3\xc3\x88\xbf\x03\xf81V" +
+	"4;J2-\xa2\x96!\x9a\xd9\xe9\x8d\x0c\xd1\xccN" +
+	"od\x88f\xa7H\xa6\x85\xde\xc8\x10\xcd\xce\x90\\V" +
+	"4\x8b\xf9?\x9dC\xfc4\xe0\x17Hs\x19!=$" +
+	"j\xc1\x98KN#\xda\xab\x86\x8a\xb6\xdf\x95\x96\xd2\x99" +
+	"\xd6\xbff\xdaJgZK\x0f\xb3\xfeu\x98\xad\xf40" +
+	"k\xe9\xe1\xd6\xbf\x0e\xb7\x95\x1en-=\xc2\xfa\xd7\x11" +
+	"\xb6\xd2#*\xdbgU-P\xca\xa4l\xc5\xcf\x09<" +
+	"\xcfh#2\xd1j\x8el'\x83-j\x95\xbdR\x90" +
+	"\xc36\x1d77\xf7,\x86\x9b;J\x98i\x98\x93\x94" +
+	"\xe6\x9a\x9ep\xbb\x85\x83\xdch\xfe\x88t\xcd\x88\xda`" +
+	"j\xd9#\xdeZf\x98jd\xd6%W\xf6\xb5\x15>" +
+	"\xd0!^\x19.\x96\x15Z\x88#\xd9v%c\x0f4" +
+	"\x8e\xc0\xe3\x85\x0cs\xa9q\x04\x9e\xca`\x98K\x0d-" +
+	"\xd4\x99T&\xaf\xb6\xa1\x85:\x97\xcaD\x91\x1a\xc9\xb6" +
+	"\xcf\xa72gm\xd4O*\xe5b\xa5y\xacZX\xdc" +
+	"\xd0\xefXW%Ep1\x96iD\x03\xd6\xc4\xe3\x16" +
+	"\xf9\xb3\xf5\xc0/\xcch\xe0S\x83!\xc1\xc5\x18\xc7\xe3" +
+	"\xd8\xc3UU\x9f@\xe9\xb2$H6\x90\x1e2\xe23" +
+	"8\xa7\xd6J\xb3o-\xba\x03\xa3o\xd9h\xaeX\xdf" +
+	"Z\xc2\xb9\x8c\xbe\xdd\x90e\x86s\xd9\x95y5\xd3&" +
+	"\xbe\xd5\xe3\xd3\xce\xeb\xff\xaf\xdc\xac'\x14\xc3\xde\xc0\xc6" +
+	"\xc6\xf0\x95w<\xdd]\xac\xe9{RY\xe5]\xb4\xe9" +
+	"{SY\xe5\x9d\x93\xa7\xbc\x8bN\xab\x83\xb9\xbc\x8c\xf7" +
+	"\xb9m(\xefl\xda\xb9vE\x06\xdaU}\xf1\xd0\xbd" +
+	"+R\xc3de|\xba\xaa\x85\x9aM\x91BF\x861" +
+	":\xcaBon\x086\xcb3x\x9a\xa8,S\x86\x89" +
+	"\x096\xab\xb2\xcc)\xd6\xa4\xc5\xd6\xbd\xd0<\x19\xca\x7f" +
+	"ob\xc0Td8b\x0a\xf0\x92\xe0\xf4Ak\xcb\xb6" +
+	";b\xb2\xcd\xf1\xd4\x0c8\xd3S\xe1LO5]5" +
+	"O\xa6\xe2\x91\x0e\xf0\xe9T\xc6U\xf3T*jU\x00" +
+	"\xff2\x95q\xd5<\x87\xaf9\x0d\xf8\x85T\xc6U\xf3" +
+	"|*h\xb5\xce\x02\xfeu*\xe3\xaay\x11\xdfs!" +
+	"\xd5I\xbcn\xc6S\xf32\xbe\xe6k(\x9e\xe0f<" +
+	"5\x89\x1b\xf0\xef\x00\xef\xe8f\x18\xc2\x12\x01\xf7\xba\x9d" +
+	"\xa4\xac\x0b\xc0\x9d:R\xc1\xa6\x93\x1b\x84\xf2\x8e\x80{" +
+	"\x00\xef\xdc)\xca\x87\x88\xaf\xe9\x02\xf8\xd5\x80_\xd1\x99" +
+	"\x0a6\xdd\x10O\x06\xbc'\xe0]\xae\xa0\x82Mw7" +
+	"\\\xbc<\x80\xf7\x06\xbck\x17*\xd8\xf4r\xc3\xe5\xa1" +
+	"'\xe0\x03\x00\xbf\xb2+\x15l\xfa\xe3w\xfb\x01>\x04" +
+	"\xf0\xa4+\xa3y\\\xdd\xe0\x08:\x08\xf0Q\x80''" +
+	"QG\xcd\x11X~8\xe0\xe3\x00OI\xa6\x8e\x9ac" +
+	"\xb1\xfc\x18\xc0'\x02\x9e\x9aB\x1d5\xf3\xb1|\x1e\xe0" +
+	"%\x80\xbbS\xa9\xa3f1\x96/\x02|\x1a\xe0\x1e7" +
+	"u\xd4\x9c\x82\xe5\xcb\x01\x9f\x09x7\x0fu\xd4\x9c\x81" +
+	"\xe5\xef\x02\xbc\x06\xf0\xab\xbaQGM\x09\xcb\xfb\x01\x0f" +
+	"\x01\x9ev\x15u\xd4\x0cb\xf9\x00\xe0\xf5\x80_\x9dF" +
+	"\x1d5#X^\x07|\x01\xe0\xdd\xaf\x8e\xe6q\xc5\xf2" +
+	"\xf3\x00_\x0ax\x8f\xee\xd1<\xaen\x98U\x0b\x00\x7f" +
+	"\x10\xf0kzD\xf3\xb8\xba\xe1\xd2\xb5\x14\xf0\x95\x80\xf7" +
+	"\xbc\x86:j\xae\xc0qy\x10\xf0\xd5\x80\xf7\xeaI\x1d" +
+	"5W\xe1{\x1e\x06|-\xe0\xd7\xf6\x8a\xe6qu\xc3" +
+	"\xacZ\x0d\xf8F\xc0\xd3\xaf\x8d\xe6q\xc5\xf2k\x01\xdf" +
+	"\x0cx\xeft\x9a\xc7u\x93\x1b\x04\xd4\xf5\x80?\x0fx" +
+	"\x9f\xde4\x8f\xeb\x167\\\xf66\x03\xbe\x1d\xf0\xbe}" +
+	"h\x1e\xd7mn\xc8l\xf7\x02\xe0\xaf\x00~]_\x9a" +
+	"\xc7u\x17\xce\x87\x9d\x80\xbf\x01x\xbf\xebh\x1e\xd7=" +
+	"\x88\xbf\x06\xf8\x01\xc0\xfb\xf7\xa3y\\\xf7c=\xf7\x01" +
+	"~\x08\xf0\xeb\xfb\xd3<\xae\xefb?\xbc\x03\xf81\xc0" +
+	"o\xb8\x9e\xe6q=\x8a\xfdp\x08\xf0\x13\x80\x0f\xb8\x81" +
+	"\xe6q=\x8e\xef9\x06\xf8i\xc0\x07\x0e\xa0y\\O" +
+	"\xe1{>\x01\xfcK\xc03\x06\xd2<\xae\xe7\xb0\x1fN" +
+	"\x03~\x01\xf0\x1b3h\x1e\xd7\xf3\xd8\x0fg\x01\xff\x1a" +
+	"\xf0A\x84\xe6q\xbd\xe8\xd6`\x95\xba\x9d\xc4\xeb\x814" +
+	"\xae7\xd24\xae\x97\x11\xfe\x0e\x8aw\x04\xfcG\x83h" +
+	"\x1a\xd7D\x0f\xde\xa9=p\xa7\x06\xfc\xa6\xc14\x8dk" +
+	"W\x0f\xbc\xbe#\xe0\x1e\xc0\x87\xfc\x88\xa6qM\xf1 " +
+	"I6\xe0W\x03>\xf4&\x9a\xc6\xb5\x9b\x07I\xb2\x01" +
+	"\xef\x09x\xe6\x10\x9a\xc6\xb5\xbb\x07\xa6\xdb\xd5\x80\xf7\x03" +
+	"|\xd8P\x9a\xc6\xb5\x0f\xbe\xbf'\xe0\x03\x00\x1f\x9eI" +
+	"\xd3\xb8\xf6G\xbc7\xe0\x83\x00\x1f1\x8c\xa6q\x1d\x88" +
+	"\xef\x19\x00\xf8p\xc0o\x1eN\xd3\xb8\x0eE|\x08\xe0" +
+	"c\x00\x1f9\x82\xa6q\x1d\x8d\xf5\x1c\x0e\xf88\xc0G" +
+	"\xddL\xd3\xb8\x8e\xf5`\\\x0c\xe0\x13\x01\x1f=\x92\xa6" +
+	"q\xcd\xc7~\xc8\x03\xbc\x04\xf0\xacQ4\x8dk1\xe2" +
+	"E\x80O\x03\xfc\x96\xd14\x8d\xeb\x14\x0f\xf2\xc7\x01>" +
+	"\x13\xf01Y4\x8d\xeb\x0c\xac\xff4\xc0\xfd\x80\x8f\xbd" +
+	"\x85\xa6q\x15\xf1=3\x01\x0f\x00~\xeb\x18\x9a\xc6U" +
+	"F\xbc\x06p\x1d\xf0\xec\xb14\x8d\xebl\xc4C\x80\xcf" +
+	"\x03|\xdc\xad4\x8dk\x03\xe2\xf5\x80/\x06<'\x9b" +
+	"\xa6q]\x88\xf8\x02\xc0\x1f\x04<w\x1cM\xe3\xba\x0c" +
+	"\xf1\xa5\x80\xaf\x04||\x0eM\xe3\xba\x02\xf1\x87\x01_" +
+	"\x0bx^.\xcd\xe3\xba\x06\xf1\xd5\x80o\x04<\x7f<" +
+	"\xcd\xe3\xba\x01\xf1\xf5\x80?\x0f\xf8my4\x8f\xeb\x16" +
+	"\xec\xe7\x8d\x80\xbf\x00\xf8\x84|\x9a\xc7u\xab\x07\xf3\x88" +
+	"\x02\xbe\x13\xf0\x89\xb7\xd1<\xae;\xb0\x7f^\x00\xfc\x15" +
+	"\xc0\x0b&\xd0<\xae\xbb\xb0?w\x02\xfe\x06\xe0\x85\x13" +
+	"i\x1e\xd7=\xf8\xfeW\x00\xdf\x07\xf8\xed\x054\x8f\xeb" +
+	"^|\xcfk\x80\x1f\x00\xbc\xa8\x90\xe6q\xdd\xef\x81e" +
+	"\xf7\x06\xe0\xef\x00^\xec\xa0y\\\x0f\xe2\xfc\xdc\x07\xf8" +
+	"!\xc0'\xddN\xf3\xb8\xbe\x8b\xf3\xe1\x1d\xc0\x8f\x01>" +
+	"\xb9\x88\xe6q=\x8a\xf5?\x02\xf8'\x80\x97\x14\xd3<" +
+	"\xae'\xf1\xbb\xc7\x00?\x0dx\xa9\x93\xe6q=\x85\xdf" +
+	"=\x01\xf8Y\xc0\xbd\x93h\x1e\xd73\xf8\xfe\xd3\x80_" +
+	"\x00\xbcl2\xcd\xe3z\x1e\xdfs\x16\xf0\xaf\x01//" +
+	"\xa1y\\/\xe2{\xbe\x04\xfc;\xc0\xa7\x94\xd2<\xae" +
+	"\x97\xf0=_\x03\x9e\xd0\x0d\xf2\xb8zi\x1eW\xd2m" +
+	"\xae x\xbb\xc1i\x0a\xf0\xd42\x9a\xc6\xb5S7\xa8" +
+	"~G\xc0=\x80O+\xa7i\\S\xba\xc1.\x9f\x0c" +
+	"xO\xc0\xa7O\xa1i\\\xbbwC.{\xc0\xfb\x01" +
+	"^q\x07M\xe3\xda\xa7\x1bt\x7fO\xc0\x07\x00~\xe7" +
+	"T\x9a\xc6\xb5\x7f7\xe8\xce\xde\x80\x0f\x02\xfc\xaei4" +
+	"\x8d\xeb@\xc4\xfb\x01>\x04\xf0\x19\xd3i\x1a\xd7\xc1\xf8" +
+	"\xddA\x80\x8f\x02\xfc\xc7\x154\x8d\xeb\x08|\xff\x10\xc0" +
+	"\xc7\x00~\xf7\x9d4\x8d\xebh\xac\xff(\xc0\xf3\x00\x9f" +
+	"y\x17M\xe3\x9a\xd3\x0d#;\x01\x9f\x08\xb88\x83\xa6" +
+	"q\xcdG|\x1c\xe0E\x80W\xfe\x98\xa6q-\xc0\xf7" +
+	"L\x04\xbc\x1cp\xdf\xdd4\x8dk)\xe2%\x80\xdf\x05" +
+	"\xb8\x7f&\xcd\xe3:\x1d\xf1i\x80\xfb\x01\x97\x86\xd2<" +
+	"\xae\"\xe23\x01\x0f\x00^%\xd2<\xaer7\x0c_" +
+	"\x03\\\x07\xbc\xdaO\xf3\xb8\xce\xee\x06\xc3\x18\x00\xbc\x1e" +
+	"\xf0\x1a\x89\xe6q\x8dt\xc3\xd3\x17\xf0\x05\x80\xcbU4" +
+	"\x8f\xeb\xfcn\xb0=\xcf\x03|)\xe0\xb3\xaai\x1e\xd7" +
+	"%\xd8\xae\x05\x80?\x08xm\x0d\xcd\xe3\xba\xac\x1b." +
+	"k\xc0W\x02\x1e\x90i\x1e\xd7\x15\x88?\x0c\xf8Z\xc0" +
+	"\x83\xb3h\x1e\xd75\xf8\xfe\xd5\x80o\x04\\\xa9\xa5y" +
+	"\\7\xe0\xb8\xaf\x07\xfcy\xc0\xd5\x00\xcd\xe3\xba\x05\xeb" +
+	"\xb9\x19\xf0\xed\x80\x87\x824\x8f\xeb6|\xff\x0b\x80\xbf" +
+	"\x02\xf8l\x85\xe6q\xdd\x85\xf5\xdc\x0e\xf8k\x80k*" +
+	"\xcd\xe3\xba\x1b\xe7\xc3N\xc0\xdf\x00<\x1c\xa2y\\\xf7" +
+	"\xe0{^\x03\xfc\x00\xe0\xfal\x0f\xd1a\xf9b?\xef" +
+	"\x03\xfc\x10\xe0\x11\xcdC\"\xb0L\xb1\xfc;\x80\x1f\x03" +
+	"\xbc.\xec!u\xb0L\xb1\xfeG\x00\xff\x04\xf09\xba" +
+	"\x87\xcc\x81e\x8a\xf8\x09\xc0\xcf\x02^\x1f\xf1\x90zX" +
+	"\x8e\x88\x9f\x06\xfc\x02\xe0\x0du\x1e\xd2\x00\xcb\x11\xeb\x7f" +
+	"\x16\xf0\xaf\x01\x9f\x9b\xe0!sa9v\x83\xd3\xf7K" +
+	"\xc0\xbf\x03\xfc\x9e9\x1er\x0f,G\x1c\xf7\xaf\x01O" +
+	"\xb8\xcaAR\xe6\xd5{\xc8<Ap\x93\xab*\x04\xc1" +
+	"{\x15,G\x80\xe77x\xc8|X\x8eW\xc1\xf4\xef" +
+	"\x08\xb8\x07\xf0\x9f\xcc\xf5\x90\x9f\xc0r\xbc\x0a^\x93\x0c" +
+	"xO\xc0\x1b\xef\xf1\x90FX\x8eW\xe1r\x04\xbc\x1f" +
+	"\xe0\x0b\xe6y\xc8\x02X\x8eX\xbe7\xe0\x83\x00_8" +
+	"\xdfC\x16\xc2\xb2\xc3\xf2\x03\x00\x1f\x0e\xf8\xa2\x9fx\xc8" +
+	"\"8\x1d\xaf\x82\xee\x1c\x02\xf8\x18\xc0\xefm\xf4\x90{" +
+	"aya\xf9Q\x80\xe7\x01\xbex\x81\x87,\x86\xe5\x85" +
+	"\xef\x1f\x07x\x11\xe0K\x16z\xc8\x12XFX~\"" +
+	"\xe0\xe5\x80\xdf\xb7\xc8C\xee\x83e\x84\xef/\x01\xfc." +
+	"\xc0\xef\xbf\xd7C\xee\x87e\x84\xe5\xa7\x01\xee\x07|\xe9" +
+	"b\x0fY\x0a\xcb\x08\xdf?\x13\xf0\x00\xe0\xcb\x96x\xc8" +
+	"2XF\x88\xd7\x00\xae\x03\xfe\xc0}\x1e\xf2\x00,\xa3" +
+	"\xab\xf0\x14\x04|\x1e\xe0?\xbd\xdfC~\x0a\xa7 \xbe" +
+	"\xbf\x1e\xf0\xc5\x80?\xb8\xd4C\x1e\x84S\xf0*\x98\xb6" +
+	"\x0b\x00\x7f\x10\xf0\xe5\xcb<d9,\x17,\xbf\x14\xf0" +
+	"\x95\x80\xff\xec\x01\x0f\xf9\x19,\x17,\xff0\xe0k\x01" +
+	"\x7f\xe8\xa7\x1e\xf2\x10,\x17\xfc\xeej\xc07\x02\xfe\xf0" +
+	"\x83\x1e\xf20,\x17,\xbf\x1e\xf0\xe7\x01_\xb1\xdcC" +
+	"V\xc0r\xc1\xf2\x9b\x01\xdf\x0e\xf8#?\xf3\x90G`" +
+	"\xb9\\\x85\xe4\xf5\x80\xbf\x02\xf8\xa3\x0fy\xc8\xa3\xb0\\" +
+	"\xae\x82e\xb1\x1d\xf0\xd7\x00_\xf9\xb0\x87\xac\x84\xe5r" +
+	"\x15j\x9b\x01\x7f\x03\xf0U+<d\x15,\x97\xabP" +
+	"\xdb\x0c\xf8>\xc0\x7f\xfe\x88\x87\xfc\x1cNA|\xff\x1b" +
+	"\x80\xbf\x03\xf8/\x1e\xf5\x90_\xc0i\x87\xef\xdf\x07\xf8" +
+	"!\xc0W\xaf\xf4\x90\xd5\xb0\x8c\xb0\xfe\xef\x00~\x0c\xf0" +
+	"5\xab<d\x0d,#\xfc\xee!\xc0O\x00\xfe\xd8\xcf" +
+	"=\xe41\x10Vq|\x8f\x01~\x1a\xf0\xc7\x7f\xe1!" +
+	"\x8f\xc3i\x87\xef\xf9\x04\xf0/\x01_\xbb\xdaC\xd6\x82" +
+	"\xb0\x8a\xfdp\x16\xf0\xaf\x01_\xb7\xc6C\xd6\xc12\xc2" +
+	"z^\xb8\xcaI\xbci\x0e\x92\xf2\xc4c\x1e\xf2\x04\x08" +
+	"\xa5\xf8\x9a\xef\xa0xG\xc0\x9f|\xdcC\x9e\x04\xa14" +
+	"\x0d\x96KB\x1a\x08\xa5\x80\xaf_\xeb!\xebA(M" +
+	"{\x0a\x96\x0b\xe0=\x01\xdf\xb0\xceC6\xc0rI\xc3" +
+	"\xe5\x02x?\xc0\x9fz\xc2C\x9e\x82\xe5\x92\x06\xb2\x7f" +
+	"o\xc0\x07\x01\xfe\xf4\x93\x1e\xf24,\x17,?\x00\xf0" +
+	"\xe1\x80o\\\xef!\x1ba\xb9\xa4\xc14\x1c\x02\xf8\x18" +
+	"\xc07m\xf0\x90M\xb0\\\xd2\x90\xa8\x09\xf0q\x80?" +
+	"\xf3\x94\x87<\x03\xc2d\x1at\xcf\x18\xc0'\x02\xfe\xcb" +
+	"\xa7=\xe4\x97p\x1a\xe1{\xf2\x00/\x01|\xf3F\x0f" +
+	"\xd9\x0c\xc2$\x96/\x02|\x1a\xe0[6y\xc8\x16\x10" +
+	"&\xb1|9\xe03\x01\x7f6\xd1\x03\xd9\x04\xdc3\xd2" +
+	"0\x98\x1ap?\xe0\xcf=\xe3!\xcf\xc12B\xfc." +
+	"\xc0k\x00\x7f\xfe\x97\x1e\xf2\xbc \xb8%\xac\xe7L\xc0" +
+	"\x03i\x94\xb7:\x1c3\x0b\x1aF\x97\x8e\x82\x83t\xb4" +
+	"\xf9\xc9D1\x8b\xc9\x86M\x1b\xca\xc9\xdc\x99$\xb2\xc1" +
+	"\xb8\"\x13\xc8\x95$\xcal\xba`M\x97\xabD\x9f." +
+	"$U\x05\xc4\xea\xd8\x97D&\"\x0c~h-f\x04" +
+	"\x8dTk@?,\xb8d\x9f\xce\xa2P\xd7\xa1\x82\x95" +
+	"-\xc4\x80I\x9d\x1c\x96+\x99H9\xe3/\x99\xfc\x07" +
+	"2[|`\x18\xff\x81a->0\x9c\xff\xc0\xf0\x16" +
+	"\x1f\x18\xc1\x7f`D\x8b\x0f\xdc\xcc\x7f\xe0\xe6f\x0f4" +
+	"\x8a\x91jk\xc9\xe6\xd9U\x93\xc49\x16/\x98\xea\xb0" +
+	"<Wb\xf2\x89\xe2\xabY\x0f\x15(\xc3\xbe4\xbdR" +
+	"\xac\x9e\xc3\x04\x98\x8b\x8a\xe4\x0fV\x83\xb1Y\xb7\x83\x9a" +
+	"\x90-\xfa$\xb1\xf9\x1f*\x81#\xb0\xa1yq\x17\x1b" +
+	"\xe2])j~\xf8\xb05\xef\xb4\xe6\x07[\x0c\xdb " +
+	"\xc8\x0fja\xe7\xd4m\xec\x9c1\xcc\x8c\x0b\x8f\x05x" +
+	"\x98\xf9_\x02\xaa\x16[*4\xd5\xac\x14K5\x1b\xcb" +
+	"?W\x0faw\xc1j!\xdd\xc2'k\xe2.\xa6\xb1" +
+	"V'4\xa7Ok!\x8fX\xd4z\x1c\xb6\xe5\xa7\xb5" +
+	"\xc6\x8a\xa6\xfb%\x96\xe7^\x8cT\xfb\xe5\xb0\x8e|>" +
+	"\x92Yo\xbf\xaa\x87\x9b\xa7\xb4u\xfa5\x96\x86\xd5W" +
+	"\xab7\x84\xac\xf9ve_m@\xaa\x13\\R\xc0\x8c" +
+	"\x9c\x97\x02R\xd0\x1f\xac\xd6\x05\x17;\xa3\xa2\xa8e\xb8" +
+	"\xd31\x1dn\xacH\x15u5\x10\x05WP\x1f\xcaE" +
+	"3\xb9\xe80.:\xdc\x8e\x06a\xee\x0c\xe5\xa2\x99\\" +
+	"t\x18\x17e\xde\xab\xfa\"a\xa9\xaaJpI\xe6\x86" +
+	"\xe3\xacb\x1aT\xa7\xa8~M\x0d\xb1\x0e\x86\xd6D\xc7" +
+	"\xb1\x1et\xf2s\x1chl\x92`\x8b}*[\xf6W" +
+	"\xc9\x01kZ-\x9f\x8d\xd7\x010\xdbBH\x0f\xc8\xd5" +
+	"5\xa6\xb1=\xa0j,\x85\x8a&AX\xa2@\xd8$" +
+	"\x0b\xd5rlwO\x0a2\xc1\xbc\x98\xdfXk9\xbf" +
+	"\xb1fK\x86\xacK6:\x85\xa6\x1aIS\xc3U\xaa" +
+	"F\xaa%\xc8\x9b\x10\x10\x98\xd2\xf5x\x08\x09Ns\xa5" +
+	"9\x83\xe6:\xa0\xdd\xca\xfc\xd4\xacYb\x15\x98\xc5\x01" +
+	"\xa2j\x12\x1c%\xb1S\xcb\x192\xc7&\xa4\xa9>\x0d" +
+	"\xb9]\xcc\xbe\xa1\xb1]\xe6/\x85Yk\x9a\xe4\xc38" +
+	"\"+\xf1\xb5\x0f\x97\xb3\x15\x9b\xdd\xac\x1c\xc4j\x18\xdf" +
+	"\xbaBp\x90+\xf8\x09\x9f\x93,;*\xbe9\xa8\xfa" +
+	"m{x\x0c\xb62\xcf\xa6\x87\xd9D\xf8t^\xd9\xa7" +
+	"&M\x1d\x1d\xae\x91\x05\x17\xb3]\xb4\x9a7Z\xb3\xe7" +
+	"\x8d\x0e\xa3\xef\xb8\xe4\xb7\x1e\xd2&M\x98\x8bq\x81I" +
+	"\xaf\x12\xebL\xf2\x90\xec9\x12;\xf5\x9a*%E\xaa" +
+	"\x92\xf5*\xc8\xc3Qm\xcd\xd9l\xdb\xbb5\x096b" +
+	"\xbf-c\x07Eu+\x11,F+U\x89u,k" +
+	"\x09d\xfc\x88(\x92X)8\xcdP\xf9tE\x0d\x99" +
+	"\x19s\x1a#\xc8\xf3cf2\xc0<\xce\xb6\xdd.\x84" +
+	"\xa1]\x95\x92\xe0\x0c\xe8\xcd@\x12\xd0\xe9\xf9\xc7t\x1f" +
+	"\xa4\xd8\x96\xe7\xb2\xafPT]\x13\x95p\x95\xe0\x944" +
+	"kA\x1b%\x1c\xccN\xa9\xaaJ\x12\x9c>\xdd\x02\xda" +
+	"\xfb\x060\x98o\x823\x90\xd9\x1c$\xe6I3G\xd5" +
+	"\x94fo\x04\xd0\xfeF\xc0\x9a\xbd1\x06\x92\x80u\xfb" +
+	"\xb3\xf5\x11b\xcdN\x84\x18\xca\xecrMa\x9f\xa6\x06" +
+	"\x02R\x95\x90Te\x99\xa3\x08\xdb\x86\x95\x82\x01IH" +
+	"\xaac\xdf\x1b\x83]l\x80_\xb8\xce\xa7jZ$\x04" +
+	"\x19\xfcU\xa5\xf9\xc2q\x05\xc5z\xc6\x07\\\x0a\xeb2" +
+	"\x84\x88\x05\xab\x98y\x98\x1d\x8ah\xcc!\xda(\xd5\xa9" +
+	"\xac_R:\xfc6W\x90T\xa7\x06\xead\xa5Z\xb0" +
+	"\x86\x1afKu*\xfb-\x7f8(\xebr\xb5h\xad" +
+	"\x18\x18<\x03b\xd0\xb6JA&\x10\x84\xb62\xdb\xc7" +
+	"\xb2\xb8\xbb\xfcL\x86\x1b)\x00u\x15u!\x1d\xb7\\" +
+	"\xb6\xbb\xe4\x90^\x05g\xbf\xc4\xd6\xbe\x1e6L\xb1\x92" +
+	"\x04$Q\xd3\xd49\xcc\xe4R\xe7@\x9ci\x84h>" +
+	"\xc9'\x86D\x9f\x8b\xe9\x13\x14\xa9\x9a\xcd\xa8\x18\x98\xed" +
+	"\xd3\xad\xc9\xd8E\xcd\xdflZ\xc5@sZ\x01\x15\x9a" +
+	"\xe5\xc1\x1aI\xd6\x02\xaa\x1a\xb4YT}\x92m\xc9H" +
+	"!\xd9\x07\xedf\xcb\x05\xd1{R\x8f\xb0\xb1\x9e\x96\x1c" +
+	"\xe8\xa2h\xf1\xfc\xae.cY4\xdbf\xab\x14}\x98" +
+	"X\xd2Y-\xd9\xcc\xf1^^,M\x05\x1b5\x1e5" +
+	"3\xef\xf5\xb2Q\xe3Q3\xf3A/\x1b5\x1e53" +
+	"\x1f\xf6\xb2Q\xe3\xd1\xb8\xd7\xe3\x99l\xd4x\x94\x9f\xe0" +
+	"d\x86\xe9:\x127\xb7S\xdc\xc4H<\x16'\x0b/" +
+	"\x94\x95o)\x9e\x84!\xe2\x1c\x0c\x978\x1d\xb3[\x17" +
+	"t\x00eo^\x07\xb8\x16w`|\xf2\x8a;\x80\xb6" +
+	"b\"\xe0\xe5\x1d\x18\x9f\xbc\xd2\x0e\x98\xbb\x07\xf0i\x1d" +
+	"XN2\xc4K\x00\xbf\xabC\x8ca\xdb=\x1d\xe1r" +
+	"\x80gv`\xec\xd63:\xa0I\x06p\x7f\x07\xc6p" +
+	"-b\xf9\xbb\x00\xaf\xe9\xc0\x18\xae\xa5\x0e@)3\x13" +
+	"\xf0@\x07\xc6p-\xe3{\xfc\x80\x87:0\x96\xeb`" +
+	"\x07L\xf5\x03x}\x07\xc6r\x1d\xc1\xf2!\xc0\xe7u" +
+	"`,\xd7\x0d\x88\xeb\x80/\xe8\xc0X\xae\xe7w\x00\xed" +
+	"\xc0<\xc0\x97v`,\xd7K:\x80\x96d1\xe0\x0f" +
+	"w`,\xd7\xcb;\x80g\xf3R\xc0Wv`,\xd7" +
+	"+\x10\x7f\x10\xf0\xd5\x1d\x18\xcb\xf5*\xac\xe7J\xc0\xd7" +
+	"w`,\xd7\xeb\xb0\xfcj\xc07v`,\xd7\x1bp" +
+	"X\xd6\x02\xbe\xb9\x03c\xb9\xde\x84\xf8z\xc0\x9f\xef\xc0" +
+	"X\xae\xb7t\xc0\xa8\x14\xc0_\xe8\xc0X\xae\xb7b\xf9" +
+	"\xcd\x80o\xef\xc0X\xae\xb7a}^\x00\xfc\x95\x0e\x8c" +
+	"\xe5z\x17\xf6\xc3N\xc0\xdf\xe8\xc0X\xae\xf7`\xbf\xbd" +
+	"\x02\xf8\xbe\x0e\x8c\xe5z/\xbe\xe7\x0d\xc0\xdf\xe9\xc0X" +
+	"\xae\x0fv\x00-\xcf\x01\xc0\x8ft`,\xd7\x87\xf1=" +
+	"\xef\x00~\xac\x03c\xb9>\xda\x01\x94WG\x00\xff\xa4" +
+	"\x03c\xb9>\x89\xe5\x8f\x01~\x1a\xf0k\x09\xb5\\\x9f" +
+	"\xc2v\x9d\x00\xfc,\xe0\xe9\xbd\xa8\xe5\xfa\x0c~\xf74" +
+	"\xe0\x17\x00\xef}-\xb5\\\x9f\xc7z~\x09\xf8w\x80" +
+	"\xf7qP\xcb\xf5%\xc4\xbf\x06<\xc1\x05\x96\xebtj" +
+	"\xb9&\xaeBA\xf0\xba@\x97\x0c\xf0u\xbd\xa9\xe1\xba" +
+	"\x93\x0b\xa6g\x02\xe0\xc9\x80\xf7\xebC\x0d\xd7]]\xd0" +
+	"\xfd\x1d\x01\xf7\x00\xde\xdfI\x0d\xd7).\xa8~\x17\xc0" +
+	"\xaf\x06\xfc\xfa\xbe\xd4p\xdd\xcd\x85\x0e\x14\x80\xf7\x04\xfc" +
+	"\x86\xeb\xa8\xe1\xba;\xe2\x1e\xc0{\x03> \x81\x1a\xae" +
+	"{Au\xcaz\x02>\x00\xf0\x81\xfd\xa8\xe1\xba\xbf\x0b" +
+	"\xaa\xdf\x0f\xf0!\x80g\xf4\xa7\x86\xeb\xc1\xf8\xdd\x01\x80" +
+	"\x0f\x07\xfc\xc6\xeb\xa9\xe1z(\xe2\x83\x00\x1f\x05\xf8\xa0" +
+	"\x1b\xa8\xe1z\x04\xbeg8\xe0\xe3\x00\x1f<\x80Z\xae" +
+	"\xc7\">\x06\xf0\x89\x80\xffh \xb5\\\xe7c{\xc7" +
+	"\x01^\x04\xf8M\x19\xd4r]\xe0B6t\xc0K\x00" +
+	"\x1fr#\xb5\\\x17c\xfd\x8b\x00\x9f\x06\xf8\xd0A\xd4" +
+	"r=\xc5\x85\x96\\\xc0g\x02\x9e9\x98Z\xaeg " +
+	"~\x17\xe05\x80\x0f\xfb\x11\xb5\\K\xf8\xdd\x99\x80\x07" +
+	"\x00\x1f~\x13\xb5\\\xcb.P6\xd6\x00\xae\x03>b" +
+	"\x08\xb5\\\xcfvi\xb0\x0d\x00>\x0f\xf0\x9b\x87R\xcb" +
+	"u\x03\x8e\xa3\x0e\xf8\x02\xc0GfR\xcb\xf5|\xec\x9f" +
+	"z\xc0\x17\x03>j\x18\xb5\\/D|\x1e\xe0K\x01" +
+	"\x1f=\x9cZ\xae\x97 \xbe\x00\xf0\x07]\x0e\xe2\xac\x0e" +
+	"\x9a\xaa4\x11\x93\xb9\xf2\xe8\xc2-\xdb\xb9\xd5\xbf\xd4\xa2" +
+	"h\xa0\xb7\x97\xa8\xd7\x96K1Su\xa6\xcbJ\x9d\xdc" +
+	"*\xd9\xa0\x95j\xca\xca)\xd5T%\x83p\x12\xb0\xc8" +
+	"\xa8~)\xa0\x8b\x13%\xd1\xaa\xae`\x82=\x19\x85\x87" +
+	"\"\x07!\"\x8f\xb9\xa5\x9a\xa1\xa0\xd9\xf8\xa2\xe9\xd6\x9f" +
+	"\xd3X\xc9\x8c}?\xfdsE\xab'&\xb87\xd6H" +
+	"\x01V\x0aI\x97\xc3lZT\xce\xf9\xc9'[\xb4\xd2" +
+	"V\xd0\xa0\xc62]\x00R\xf6\x16\x08\x19\xc3\x91\xaa*" +
+	"\xb9\xde\xbc\xc4H\xfa\xe49\x8a\xa4Y\xb2t\xe3\x0dI" +
+	"\x13\x15\x810\x11C\xb3#r(()\x02\xd1Ir" +
+	"\xd3\x10\xb2d\xfe=\xdf\x0c\xf9\xc4\x08\xbb\xd6\"J8" +
+	"$\xd1(ccx\xc5\xaaZ\x93\xc9\x08\xdeh~\xc1" +
+	"\x96\x17\x18:\x83O\x11\x19\xc0\xcc\x82\xd60\x959b" +
+	"\xa0\x16>&\x10\xbf\xf1\xadt9\\\"\xe9|\xfd\x05" +
+	"\x97\xcd\x92#\xb44V\x05\x1a\xd8\xd4\x8c6\x1e\xb6&" +
+	"\xa0\xdb\xb5\xdf\x83\xb0S\xc6\xd7H\x96\xebo\x10\x82f" +
+	"\xc7[\x07\xca\xee\xc9\xdeT\xa9FP\x01!d{E" +
+	"\xbf\x1c\x09\x1b-1\xfb9]/g4\xdf\xae@\x95" +
+	"\x99Q\xcf'\x05\x02\xd3,\xbf\xa6[~U\xb4#\x8c" +
+	"yB\xf1\x04UW\xff\xbf\x1e\xe6\xddr\xca\x11M\xac" +
+	"\x8aR\xa13\xdc\x15-\xf1\x98\xf12\x9f\xc5\x1f\x14]" +
+	"$\xd3\x97\xb7\xc3[\xdd$\xeai\xc1]\xbd\xc5\xb0\x99" +
+	"8\x19\x8e\xd1Q\x96e8n\x87\xc3\xbfY\xb9,\xb3" +
+	"r\xd9\x94[\x8e\xcb\xcf\x9e-\x06\xd9\x80\xf3\xb6\xbb\xae" +
+	"\x0c\xee\xebL\x12\x8a\xb6\xa8\xc72\xd9\xach\xf0,\xb3" +
+	"%\xf1\x934\xb5\xbcp \xdfON\x0e|uZ\xec" +
+	"N2\xdd\xd1\xc3\xc2cl\xdcIf \x1f\xef]\x80" +
+	"\xd7\xb0<\xc9\x12\xf2$\xfb\x01\x0f\xb1<\xc9AG\xa1" +
+	"\x85\xaf\xd8\x88\x13\x8a8r-|\xc5F\x9cP\x83\xc3" +
+	"k\xe1%6\xe2\x84\x16:2,\xbc\xc4F\x9c\x90\x9d" +
+	"\x97\xd8\x88\x13Z\xe6\x98e\xe1\x1f6\xe2\x84V8\xd0" +
+	"\x11\x04\xf0\xb5,O\xf2\x1a\x07\x98VW\x03\xbe\x91\xe5" +
+	"I\xde\xe0\xc8\xb0\xf0\x0f\x1bqB\x9b\xb0\xfe\xeb\x01\x7f" +
+	"\x9e\xe5I\xde\xe2\xf0\xb2\x04\xc4\xb18\xa1mX\x9f\x17" +
+	"\x00\x7f\x85\xe5I\xde\x85\xef\xd9\x0e\xf8k,O\xf2n" +
+	"\xec\xb7W\x00\xdf\xc7\xf2$\xefE~\xe9\xd7\x00?\xc0" +
+	"\xf2$\xefG\xfc\x0d\xc0\xdfay\x92\x0f\"?\xf3>" +
+	"\xc0\x0f\x01\xee\x89\x12%\xbf\x8b\xf8\x01\xc0\x8f8\x1c\x84" +
+	"t\xa3w\x92\xc3X\xfdC\x00\x7f\xe9\xb0\xd2\x90\xd7\xa8" +
+	"z\xad\xd4P&\x0b\x84\x839\x19\xa3\x02\x9e\x88e\x16" +
+	"B\x82F\xbf\x14\xf6\x951/C\xbbR\xb9E\x9bf" +
+	"!`s\x85\xa5\xd9fa\x08\x90U\xf4\"!\xc9*" +
+	"#h\x92&\xcd.\xab\x15\\r\xc0\x8e\x16\xcbDA" +
+	"\xadfX\xe0\xae\xcff\xc1\xa0\xf8\xdbZ!\x8ayA" +
+	"g\xa7I\xe1\x9a\x96lb\xa0\xbe/\xb2\xe9\xc0\xb3\xe1" +
+	"\xb47Wd\xb6\"\xd53?\xd3\xa1\xa5\x99\xcc/v" +
+	"\xa7\x10+\xe5\x00p\xa6\x09\x84\x09\xbe\x185\xe6\xc8\xf9" +
+	"\xb7\x8f\xad^\x157\x19P\xd1m\x18\xde\x84\xff\xa7\xab" +
+	"\xd5\xd5\xce\x80d\x0f/\xce\xb5\xa4H\x8bE\x17\xe3e" +
+	"\x09`\x0f\x9b\x99 \x85x\xad\xd1\xc5\x0e#\xba8\xd7" +
+	"\x1a]\xec4\xa2\x8bs-\xa9\xd3\x0c\x9dC\x1f\x92\xcb" +
+	"\x86\x98\xc6t\x0e\xf6\x94R\x86\xcea0\xc9\xb2\x84\x1d" +
+	"\x1b:\x07{\xd8q'B\x97\xf7\x08\x92\xc1\xa6\x94j" +
+	"\xf4a*\\3\x88\x15~Kf\x8a]\xe4\x9e/\xb2" +
+	"\xa9\xe4t\xb5\x88\x9dg\xf6\xf1n\x14\xc5\xf1\x96\x11\x03" +
+	"\x9b)\xc4\xaaX\xf9\xeb\xab\xab\xcd\x1bF\xa3T\x1f\x92" +
+	"5)\xfc\x03i\xc3\xcat1(+\xa2\xd0\xde\xe8\xb8" +
+	"*U5\xe7\xdc\x1cQoO\xf8M\x9e\x1c\xf6\xc9\xa1" +
+	"\x80\xecR\xa4p+\xacUx\xd4\x84[\"ri\x8b" +
+	"\xd1\xad\x96&\x9d\xd5\xe2jYV\x0bq\x7f\xb6\xc0\xbb" +
+	"8\xc8\xb8B\xa1@C\x89*\xbb\x80S0\x8e\xf4\xc8" +
+	"\xb9f\xc8\x7f\x93\xac@\xe0\x9b\xaa\x09\xe9\x0d\xac\xf2\xb4" +
+	"\xfda;(\x92x\xa5\xd9I\x9c\xbco\xb9\xf1&\xde" +
+	"\xa90\x13\xef4\xeaj\xb1Z\xc9\x88\xe50\xb3\x01a" +
+	"\xb7k\x1e\xa5z|\x9c\xa89~\x7fq:R]\xb5" +
+	"L\xa3\xd1z\xc6\x8bL\x1e\xad\x98f\xd2\x8a5j\xa2" +
+	"\xec\x9f )\xcd\x09T\xdbN\xa3\xdd$\x87\x91%\xac" +
+	"HH\x97X\x91,~\x02\xc3\xec\x80\xfe\xffH\x84e" +
+	"B\xeb\x99\x90\xbd\x92\x0b\xe6\xaa\x95\xd9\xab\x82\x89\xdc3" +
+	"j\xc5F\xee\xc5\x84\xd5Ms\x19>IC\xdbn!" +
+	"\x013\xb4\xed;r\x19\x82\xe1\xa8\xb2}W\x85yK" +
+	"\x8a+Y2\xc49\x02\xfb\xb1\x90\xad[7H\xce]" +
+	"\xa2Q\xd7\xe4:\xd6ZC\xcb\xb4wj\xe6\xc7\xc8?" +
+	"Z\xb9\xca\xb4\xc6\x10\xd2\xf2\xc4\x08\x88\x0d\xc5%\xc3Z" +
+	"{/8$\xb4;\x83?lx\xf9\xf5\xa1)\xe9x" +
+	"\x11\xb1-\xa6\xc7x\x19\x88_\x8c;\x03\xf1\xb3f\x0c" +
+	"_\x13\x12.\x17I\"\x81\xb8\xe3\x1a9\x94\xef4\x19" +
+	"\xcd\x9b\xfd\xb1$\x1dE%s0D\xd9\x0f\x15%\xd1" +
+	"g\xebI\xa8\xa5\xbf\x95\xa8I\x96G\xe3\xccyV$" +
+	"7\xbf~\xe6\x9a\xe7Jc\x15\x96b$\xa0\xedO5" +
+	"\xdd\xf3\xfb/\xee}3n\x82.L9;\xb9r\x16" +
+	"\xb8\x04\xd8\x932\xcd5d\x8dq\x8c\xe8c\xcf.i" +
+	"\x88>9$\xcbH\x9a4\x91\x15}\xf2\xc9,Kv" +
+	"IC\xf4\xb1g\x974D\x9fR\x92j\xc9.i\x88" +
+	">SH*\x9b]2&\xfaL'\xa9\xd6\xa4L\xc4" +
+	"H\xcaTaI\xbeddt\x90\x10\xf7\x03\x1e\"L" +
+	"F\x87 \xd6\xa7\x06p\x9d8h\x9e{\xc9_$\x0b" +
+	"\xd9a=\xc7\xef\xd7l\x87w6\xf8\x9a\x14\xf8[P" +
+	"B\xb4\x90\xaa\xd2\x1e\xc6j\x06\xb8\xf2\xc2_\xa3\xbb\x89" +
+	"m\xb1\xf3\xb6\x98f\xca&\xde\xb0\xcf\x81\x9167\x04" +
+	"I\x83\xa1'\xfe8\xf8\xec\x0ay9H\x98C\xd5\xba" +
+	"gsr\xa44i\x92\x1e\xd1\x94\x89*\xb6$n}" +
+	"\x04\xdd\xb6r\xa2\xbaZ\xa5\x15\xae\xf1\x98\xf4\xe35\x85" +
+	"\x90f\x9d\xc2*}\xe3\xa7?\xd0@oW\x16J\x12" +
+	"\xe7(\xe1Vs\xc1\x89s\x14s\x1d\xee\xee=\xf1\xcc" +
+	"{\xf7o[\x1a\xf7M\xc4\xa2v\x0a\x87TW\xf3D" +
+	"\x83\xb9\x9c\xa1\xc9\xe4\xe5\xb9\xd5\xcc\xf40\xad\xd3Z\x83" +
+	"O\x88?\xe2\x93\xfcBz\x815\x8c=7\xfd\xeco" +
+	"\xae\xbfO\\\x13\xf7>\x82b\x81\xa6\x86\xb2%\x0d\x98" +
+	"\xac[a\x93mw\x92\xaa(\xd1W\xbb\xa9\xe6\xa3\xe4" +
+	"[\xe6\xc5VnO\xba/\x86M\xd9+\x05\xd5:b" +
+	"\xd77y\xcdO\xc5d\xe0,F\x06F\x0d\xb84\x9b" +
+	"U\x04\x18\x99x\xe2\xbf\xd2\x84$\x9f,\x06\x8a]R" +
+	"\xb8:\x0eN\xd7\\\xe6\xb83f\xc3\x12\x8d\x89c7" +
+	"4\xb6\xcb3\x998vCc\xbb\"\xd7\x8cc\xcf\xae" +
+	"\xa1\x12b\x8c\x1c9\\]\xce\xfa_\xd0\xcbDYH" +
+	"H\xb7\xf2\xb5\x84\xc5\x06\xf6\xa98\x88\x83[K4d" +
+	"\x1f\xf2\xb9\x9c.\xaf\x88f\xb1(r0\xd4\xce\xd9\x9a" +
+	"U\xb4\x8a\xfd\xc1\xa91\x93|\xda[c\xbf/\xec\xf1" +
+	"\xc4[qOr\x90\xae\xc3\x05J\x15Qm\x14V=" +
+	"x\xd9\xd9\x19\x0e+C\x02\xbe\x98\xc1RXE\x07\xe3" +
+	"\x92\x97M\xd8\x1d\x1d\x0d7\x81s\xd3\x0b\xc7Q\x17\x96" +
+	"\xc4\xaa\x13\xa9\xb4h\x1e\xa2Y\xbf\xdd)\xa4\xc2\xa2y" +
+	"0\xf2~w'\x95\x16\x0dC\xc7\x04#9{\x05K" +
+	"`f\xe1J\x94\xf5\xa0\x18n\x81\xbc\x9b\x97e\xcf\x17" +
+	"\xe5\xba\x14\xac2\x0e\xea\x85\x8a\xe4\xb0\xe0\xd2\xd9\x0b\x17" +
+	"\x18\xba\xcae\x1d\xfd\xeebo\xc5\x145\xe5\xb2\xe0b" +
+	"\xacDMz\x8d\xac\xf9\xedE\xe3\xbc\x12\x84\xcb\xd21" +
+	"\x7f\x83m\xd5T\xf0\x98\x1e\xb2x\x9c\xa3\x15<\xa6\x87" +
+	"\x0a\x93\xd4\x81p(G\xe3\x92\xff\x83\xb2\x82R\xbd\xe0" +
+	"d\xe4\xfa\xa0X\xdf\x0c\x04J\x0f\xad\xa1Y\xc3\xb9\x89" +
+	"E\xe5`Huj \xc1A\xf9\xb8\xf8\xd8 \x01\x83" +
+	"\xd0\xf2<\x8eM\xe3\x8c\xb8\xa9\xd8\xd8l\xf4\x06a\xc8" +
+	"\xe5Lvr\x1bB\x1e!=X\x866C\xc6K$" +
+	"Y\xec\x9cw\x11:\x87;\x91B\xcb\x9c7\xc8\xf3R" +
+	"\xc8,v\xce;[\xe0\xe7\xb7\xa5c\xb2dj\xb2d" +
+	"ca|\xac\xed\xb6@\x9f\xaa\x8cG\xf3!s\x89\xf1" +
+	"\x83+\xb0\xe6E\xcf=\xa5\xba]\xec\xe8\xa0\x07\x9bT" +
+	"2>\xfc\x1f\x1f\xf5\xc6\x8d\xda\xdf\xd6Q\x9f\xa4\x84|" +
+	"l\"\xff?\x1f\x18\xabo|\xea\xe3\xb8\xe5\x93\xc9U" +
+	"U@\xdcX\xa2\xa9\x10O\x13.\xcb\x8e\x04!\xd9\x14" +
+	"\xd4{x\xec\xca0\x83TZdn\xe3\xce \x11\xcd" +
+	"\"s;\x12\xe8\x9d!H\xb2X\x99;\xc5\x99H\xef" +
+	"\x0c\xb3\x91E,\x00x=\xe0\x09\x1d\xa2\xd6\x10,\x1f" +
+	"\x02|\x1eN'W\xd4\x1a\x82j\xd7z\xc0\x17\xe3|" +
+	"\xea\x18\xb5\x86\xe0\x04Y\x00\xf8\x838\xa1:\xd1\x09\xb5" +
+	"\x0c'\xd4R\xc0W\xe2\x84\xeaL'\xd4\x0aT\x8b>" +
+	"\x08\xf8j\xbc3\\A\xef\x0c\xabH\x96\x85\xa8\xb7s" +
+	"\x97\xa85\x84d\xb1D\xbd$j\x0cYG2\x0d\x9e" +
+	"^\xe4\xd1\xed\xd2\x95\x1aC\xb6\x11/K\x1e\xd7$\xce" +
+	"\x11\x1b\xca$\x9f*\xb8\xe0\x0eg\xf0\xf4\xd5D\x14]" +
+	"\xf2\x97IB\xbaOe\xf0\xec*\xb0q\x9b\xa3\xdb*" +
+	"\xaf\xa0T\x1f\x9a \xca\x94+\xd0\xc0p\x15\x84'\x88" +
+	"\xb0KK\xfe\xff\x12\x0f\x8d\x95\xfe\xa8\x0bC^\x1d." +
+	"R\xc3\x02i\x87i\xaf\x00\x1c\x0a|zq6\x15\x1a" +
+	"l\x12d\x86)AZ\xf9\xa8\xe2#\xc6/\xd3E\x97" +
+	"\x1e\x09\xc7\xa1\x95\xcbb\xb9m\xa3\xcb\xcc\x92\xc55\xa6" +
+	"\x95\xcb5\x93\xd97O\xdcd\x11\x9f\x9a \x8fS\xb1" +
+	"\xeagu \x8dT\x16d\xd6\xe4]\x03gx\xdfz" +
+	"m\xe6\x06\xfb\x9a\xec\xd0f\xce\xee<I\x17\xe5@\xb8" +
+	"\x85\\H\xff\xb1\xbd\xba\\\xaa\x07\x12\xb3\x92lM\x05" +
+	"e\x8e\xed\xcc\xc8\xe0\xc9>\x99\xec\xa1\xc1\x15~\xa2G" +
+	"\xea\xa5L\xf6\xd0H\xe0\x1e\x1a\x89\xc6\xa1\x91a94" +
+	":\x18\x87F\x86\xe5\xd0p\x19\x87\x86\xc5r\x13\xb3x" +
+	"\xda\xd8>\x93j\xd8\x9c\xc9\xbe\x1a\xc94\xae%\x89Z" +
+	"\xd0\\os4\xd9\xfcSz\x8d\x08K\xd3(\x18\x90" +
+	"\xaa\xcd\x1fU\x12\x13B\x10\xcd\xc9\xd7,\x17\x9f@\x1a" +
+	"\xe2\xef\xff\xb29b\x08\xd2V\x90@<i\xdd\xd8;" +
+	"\x08h\xb7\xcb\x9a\xe5\x0cn\x9f?sN\xa4\x1alA" +
+	"\x05\xae\xa8\xe2\xb7-;@%\x93V\xce\xa7*:\xee" +
+	"C\xe9\x9a\xe5\xa3\"}g\x99\xe0jWU\xa8\x19$" +
+	"\\\x93$\x87rrlu\xc9l\xe3:\x98\x8eK\xb4" +
+	"y\x02\x89$\xd0\xd4\x91\xe4\xa6\xd2aU\x0f\xee\x19T" +
+	"\xf1{{^\x89\x96]\x94\xe1\xb2\x12\x8c\xc7&\x91\xc9" +
+	"\xf3\x90\xc85\x95*\x8d*8d\xb1\xac\x97\x92^`" +
+	"5\xc61\xc6\xb98R\x9cHZ\x9d\xa4\x81\x8c\x81t" +
+	"\xcd-\xa5S\xf18H6\xbd}\x91\xe4\xa6?\xde\xb6" +
+	"\xea\xed;\xff\xf4\xcd\x91\xd6\xd2jp\xafj\xd9\x98\x8c" +
+	"\x0f7\x85\x951A\xe02\xa6\x05\xfd\xda\x09K8\xc1" +
+	"\xd4\x1d\x92\x04\xe4\xf4\x02\xb8c\x02\xa3;LL\x80\xf3" +
+	"8!\x01\xd6j\x82y3rwM\xc0\xb5\x0a\xf8\xd5" +
+	"\x09\xcc\xdd\xa8\x1b\xbe'\x19\xf0\x9e\x09\xcc\xe5\xa8{\x02" +
+	"\x1c\xbc\x1e\xc0{'0\x0c\xbf\xbd\x12\xe0\x9c\xbe\x1a\xf0" +
+	"~\x09\x0c\xc3o\x1f|OO\xc0\x07$0\x0c\xbf\xfd" +
+	"\xf1=\xbd\x01\x1f\x94\xc0\xe8\x0e\x07&\x80\x17E?\xc0" +
+	"\x87$0\xba\xc3\xc1\xf8\xfe\x01\x80\x0fO`\xb2\xc1\x0e" +
+	"M@v!\xc0\xc7$0\xd9`G'\x80\xcer8" +
+	"\xe0\xe3\x12\x98l\xb0c\x11\x1f\x05x^\x02\x93\x0d6" +
+	"\x07\xf11\x80OL`\xb2\xc1\xe6'\xa0\x0f+\xe0E" +
+	"\x09L6\xd8\x02\xac\x7f\x1e\xe0%\x09L6\xd8b\xc4" +
+	"'\x02^\x9e\xc0d\x83-\xc5\xf7\x14\x01>-\x81\xc9" +
+	"\x06;\x05\xf1\x12\xc0\xefJ`\xb2\xc1N\xc7q\x99\x06" +
+	"\xb8?\x81\xc9\x06+b{g\x02\x1eH`\xb2\xc1\xca" +
+	"\x090\x1d\xfc\x80\x87\x12\x98l\xb0A\xec\xcf\x1a\xc0\xf5" +
+	"\x04&\x1b\xecl\xc4\x03\x80\xd7'0\xd9`#\x88\x87" +
+	"\x00\x9f\x97\xc0d\x83m@\\\x07|A\x02\x93\x0dv" +
+	">\xe2\xf5\x80/N`\xb2\xc1.\xc4~\x98\x07\xf8\xd2" +
+	"\x04&\x1b\xec\x12,\xbf\x00\xf0\x07\x01\xbf\xb6'u\xd5" +
+	"^\x86\xf8b\xc0\x1fN`\\\xb5\x97\xe3\xbc}\x10\xf0" +
+	"\xd5\x09\x8c\xab\xf6*\x9cW\x0f\x03\xbe\x16\xf0>\xe9\xd4" +
+	"U{\x0d\xce\x93\x95\x80\xaf\x07\xbcoo\xea\xaa\xbd\x0e" +
+	"\xf1\xd5\x80o\x04\xfc:\x07\xf5\xd5\xde\x90\x00*\xf8\xf5" +
+	"\x80\xbf\x02x?'\xf5\xd5\xde\x85\xe5\xb7\x03\xfeNB" +
+	"\xcb\x17\x1f\xae3e\x9b\xc9k-\xfe\xbb\xad\xe6\xa6\xb5" +
+	"\xdc\xab\xd8\\\xb0\xf1i\xc3\xdbG\xf9\xd8\x9a;\xb0=" +
+	"\xa5\xac=\xe5\xac\x19\x81\xca\xb8\xad\x04\xc5\xfa|\x80\xad" +
+	"\x95\xfa\xbf\xcdF\xfb\x1f\x93@F\xcd\xec\xd9\x0d\x05\xad" +
+	"\xe4\x09\xce\x0e\xd3\xcbB{\xdd\x0f\xf2d1\xa0VG" +
+	"$\xc8%\xa3j\x0df\xdao\xe6\xc0\xabd\xce\xb6X" +
+	"\xd2\xf2\xb9&\xdfo\xec\xc0\x9b\x02\xc2J9\xcd\x8a\xd3" +
+	"\xa4\x84|\xf8r\xc1U\x1d1\xa7+=_J#B" +
+	"6\x84\xdf\xa9\x8c\x96>\\\x82\x7f\x12\x04\xe1\x87d+" +
+	"\xbdC\xf2\xe9\xaa6\xec\xbf\x93\x13\xb1u\"\xdb8D" +
+	"\x98:\xa9\x05\x07F\xae\x04\xd3\x97\x91`Z\\o\xdc" +
+	"\xa1d\xed=S\xa5\xca\x02E\xd6eQ\x97\xc6\xab\x8a" +
+	"\"\xf90\xd8\xb0\x95\xcc\xb0\x01\xb5ZV\x08\x11\x1c\x84" +
+	"\xb43Q\x04\xe4\xd8\x12~0\xbfj\x85\xa9\x826\x12" +
+	"\x0f\xb2L\xaa\xb1l\xa9\xacu\x1f\xe2\x9aA@\xb5&" +
+	"/\x0e\x87\xe5j\xc5\x9a\x05+(\xd6\xe3<B-p" +
+	"\xec\xe6<wRs\x0a\xea\x16\x0c\xec-[\xaa\xeaC" +
+	"\x92_\x86>-P\xead\xbd9\xc134\xf6\x1d'" +
+	")=f\xde4\x8fV2Qz\xc6M\xf3\xe4\\\x86" +
+	"\xcb\xd9P\x10\x9f)d\xaeX\x86\xb6\xfe|!s\x9b" +
+	"2\xfc\xab/\xe5\xd2\xdbTY2\xab\x1b\xeeJ\x0a\xd9" +
+	"+OL7\xdc\x8d\xccb\xbd\xd2\xb8\xfd(c{\xb4" +
+	"I\xd6\x94lR\xb4\xbdB\xb6\xaaX\xba/<G\x0c" +
+	"\x85\xc0\xcb\x9dy\x05`\xb6S\xa81$\xfaqs\xb7" +
+	"mI\xfe\xb98\x85,5\xf0\xcf\xa5D\xb6B\x92O" +
+	"j\xc3\xc4ic\xfc\xad\x93\xc6\xab\xb2\"\xc45\x1b\xb9" +
+	":`/O\x07\xec\xe5\xe5\x9d\xcab\x94\xc0q\\\xba" +
+	" o\x03\x86\x16\x90\xa1\x1c,\xb3-\xf7hgKB" +
+	"y\x12D\x10\xd8\x94\x1c\x19\xbc\x0c>\x99qg\xa2\xcc" +
+	"5U\x1fI\x95,\xb7@\xb5&\x99T\x0e\x8d\x9a\xe4" +
+	"\xf7\x8b\x0d\xa6\xf7_$,\x95\xcb\x9c\xaa\xc7\x91\xc0\x16" +
+	"R\xbd\x04Z\xcdw\xd3\xec~\x97\xe7 I~\xf6\xea" +
+	"\x1e\x12\xf5\x9a\x96\xce\xbb\x0eq\xecb\xe3kT5," +
+	"E\xafPN\xad!\x9e)4\x8b\xb7\xa1e\x99S\xc8" +
+	"\xd8\xd0VT\xb6\xb5\xa1\xc5\xbf\x0c\xb2\xfds-v9" +
+	"\xbfD\xe3\xb9\x05\x17{~\xb6\xba\xa9\xb5\xacv\x90t" +
+	"L\xd2l\x1cU\xccQQ\xc9\xa8\x94\x14i\x8e_\xd4" +
+	"E\x8d\xc6 \xd9\x0f\xc2\x16\xc7\xbbX\x94\x039H\xa0" +
+	"B\xd5VD\xb7\x8dw\x16\xef8\xeca\x1e\x87\xb6\x1c" +
+	"4\xac\x0fv\xdb\x86\xbc\x1aQ\xf7J\x86\xc9\xe4\x87\xfb" +
+	"Tj\x92\x18V\xdb\xe1M0\x15\xd4\xb4\x92f\x97D" +
+	"\xfa\xfe\x90\xa0\x0fW\x9d\x18\xe0:m'\xb1AM\xf1" +
+	"\xe8]\xa9\x10I]5\xe3\xc9\xb7\xcb\xadY\xa1=\xdf" +
+	"./ \xa5)\x1c\xa9,P\xfc\xd6|g\xf1\x18L" +
+	"\xc6#\x85\x12h\x1a\xc2,\xd7}\x0bZN%\xe4+" +
+	"\x92\x83\xb2\xde\x96\x96\x93!L\xcf\x93\x02\x92.\x15 " +
+	"U@<S\x82\x91\xcbh\x0b\x1d\x82\x838\x04\xe2\xaa" +
+	"\x14\xab\x8d\x7f\xc7\xe5\xb2\xac\xe9\xe5\x9a\x9c\x04\x8e\x0a\xb6" +
+	"\xae/\xe4I\xd6\x8b\xda\x92\xacygx\xcbY\xc8x" +
+	"\xc4\xf4q\xa4\x1c\xc8\x93\x02\xe3U\xcd\x15%\xd2g\xba" +
+	"\xaa\xa2-\x85$M&\xa5X\x93IY\xe3\xbb\xe2\x88" +
+	"\xc4\xc9\xc91\"\xa7\xdaHm\xd9\xa3\xa5\xd4\x96NQ" +
+	"4\x15\xef/\xff\xe2\x0d\xb9\xeb\xb3\x07\xdf\x8f\xdb\x0f " +
+	"_\xa9\xcb\x03\x96-\xa7\x94i;l{\xf0\x0e\xdb\xac" +
+	"\xb8\x0f\xdbB3\xed3\xb3\x9f\xd9\x18\xbe\x1a\x81G\x8b" +
+	"\xdd\xf4}*\x9e\x0cz\xbbFq\xbc\xaa\x84ep*" +
+	"\x8f+\xe3k!\x8f\xa5\"\x97\x09\xfb3\x9a\xb0?\x93" +
+	"\x09\xfb3\xc4\xa3\x83\x99L\xd8\x9f\xc1R\xf1n&\xc3" +
+	"ga\xb0T\x1c\xce2\x05en\xcaN\xeaxb\xc1" +
+	"\x1a\xab\xac'\x81\xf5\x9e\xd4\x9a\xde ;T\x17\xf2\xa9" +
+	"J;\xdd\xd1\xc7\x1b\x19\xed\xff\xdb\x91q\xecN\xd9\x92" +
+	"\xe8\x97\xd8V\x0e\x9ch\xe6\xd1\xb8B\xf7\xb2Z\xf7\xd1" +
+	"\xb6\x9d\xb0\xd9J\x04\xbd\x97Z\xf5\xd4n;\x9faI" +
+	"\x04\xa2\x1f\xc3Dj9\xf3v\xac\x86r&\xb3J\x1c" +
+	"\x0e^\xeam''\xf56x\\\xdc\x06\x1c_L\xbc" +
+	"\xb5%\xb9\x98\x91t\xd7\xe8\xdd:Q\x93\xed\xe1\xe4m" +
+	"\xef\x83r\x1ds`\xb4\xd5\x92J\xa6\xd2FKfW" +
+	"\x98\x95\x8e\xb5\xa4A3=\xbb\x9at5\xdf\x9e\x8d\x16" +
+	"0\x08\x19\xb6\x19O\xe0\xd6\x01e\x05\xa7\xde`\x05g" +
+	"Gd!=\xc45\xfa$\xb6z0\xd1C\x97\x8d\x82" +
+	"mK[\xc1\xa4}\xb4\xa6\xc5kB\xff\xc8\x09\x9a*" +
+	"$EB\xed\xb9\xc5M\x95*\x8b@\x17\x91\xee\x95\x9a" +
+	"\xbb\xf8\xf75o]F\x15\xd6U\xb0\x1e\xfe\x84\xe7\xe1" +
+	"\x1f\xb5\xdfn\xcd0S\x98\xc7\xc4\xf0m\xb9\x8c\xdb\x7f" +
+	"\xa2\x83\xeeT;\x16\x99[\xa2\xabV2]r\xd0\xc3" +
+	"\xa2L\xb7\xc6\xd2W\x89r\x00\x12\xef\x09\xd9\xba\x14\x0c" +
+	"\xe9\x8c\x950\xcc8Y\x19\xce\x1a\xcd\xd5Y@\x16\x00" +
+	"j\x0a\"Q'\xb6\xd6T^\x89mu\x1b5\x05y" +
+	"\xa5\xecp,5\x123\x86\x95\x1c\x7f:#\x8cg\"" +
+	"\xac#|z\xbc*\xb8\xd8\xac\xd0\x145\x95\x8d_\xdd" +
+	"\xe4z\xa1\xa9\xf2\xf3\xbd\xf1\x9e\x9e\xb8\x89\xe6F\xc2\xa4" +
+	"!\x1e\x8fN\xd6\xa37\x9e\xe0\x9d\xb6\xed\x99\xd1\x8d(" +
+	"]2\xd2\xc1\xb3\x19\x99s[\xc8\xc8\x9ciq\xf4\x88" +
+	"%d\xce\xb4&dv\x18\x09\x99\xb3\xac\x09\x99\x89\x91" +
+	"\x90\xb9\xd2\x92x\xd9\xb0[m%\x85\x96l\x83\x86\xdd" +
+	"j\x07\xd1,Y\x05]\x0e\xaa\xb8\xd9M2,Y\x05" +
+	";&R\xbb\xd5\x1e\x92a\xc9*hd\x13\xb5g\x15" +
+	"\xec\xec\xa2v\xab\xfd$\xcb\x92U\xf0\x8a\x8eFB\xe6" +
+	"TKV\xc1.\x9d\xa8\xdd\xea]\x92j\xc9*\xd8\xb5" +
+	"3\xb5[\x1d&Y\x96\xac\x82W^A\xedVG\x89" +
+	"\xc6f\x0flD\xb9\x9d\xb1sZ\xb6\x88tY\x99\x12" +
+	"\x96\x9aO\xf6l$\xeb\x0a[\xc84\xc6\xc7r\x855" +
+	"_\x1b\xe0\x83\x07I{\xd9-3\x86\xa5\xdf\xc1\x06}" +
+	"[<I\xac\x81\xafAY\x81D\xbf\xa6\xa4`\x09\xfd" +
+	"\xe0i\x81\xd1\xe8![\x8d\x1e\xfe\x08\xf0\xbfI\xaa\xc2" +
+	"\xb9\x9c\xb6\xc6\xd0\x00\xe9;\xff\xa3\x8bi\x0b\"uB" +
+	"k\x99\xd4d\xa5:f\xe4\xe7'SnC\xa4\xcdj" +
+	"C\xa4\xcd\x067\x18\xc9t\x8c\xc4\x9f\xa6q\x03\xc4\x1f" +
+	"\xd6]?\xa2\xabP\xadv]\x15\xed\xfb^\x9bW\xc5" +
+	"0\x9cn\x8aOj\xd7WLu\xefx\xb4\xcd\x13\xbf" +
+	"\xed\x0b\xac\xb7R\xfb\x06\xc2\xe0\x86H\x1f\x0fd\x9d\xb6" +
+	"\xa1\xc8\xe4\x0dE!OD\xca\xe5\x89H\x19\xe6P\xb4" +
+	"\x9d\x1c\xdf\xeev\x90\xa4\xf1\xd4;\xadh\x15\xc0+8" +
+	",%\xc1\x9d\x1d\x9a13\xb6\xc9\xae@.\x82\x07\x1d" +
+	"`5e9\x13V9\xfaZ8\x07\xa2\x8dq\xafA" +
+	"|%\xe0\xebY\xce\x84u\x8e\xbe\x16.\x02\x833a" +
+	"\x83\xa3\xaf\x85\x8b\xc0\xf0\x1f\xda\xe4\xe8k\xe1\"0\x1c" +
+	"\x88\xb6 \xbe\x11\xf0\x17X\xce\x84\xad\x8e\x0c\x0bG\x81" +
+	"\xe1A\xb4\x0d\xf1\xe7\x01\xdf\xc9r&\xecpdZ\xb8" +
+	"\x0b\x0c\xce\x84]\x8e,\x0bw\x81\xc1\x99\xb0\x1b9\x0d" +
+	"v\x02\xfe\x06\xcb\x99\xb0\xc7\x91a\xe1408\x13\xf6" +
+	":2,\x9c\x06\x06g\xc2~G\x86\x85\xd3\xc0\xe0L" +
+	"8\xe8\xc8\xb0p\x1a\x18\x9c\x09\xef:2XN\x83\x18" +
+	"g\xc2a\xc4\xdf\x01\xfc\x18\xcb\x99p\xd4\x91a\x90\x1d" +
+	"\x9c`9\x13\x8ec{\x8f\x00\xfe\x09\xcb\x99p\x12\xf1" +
+	"c\x80\x9f\x06\xbc[\x944\xe1\x14\xf6\xc3\x09\xc0\xcf\x02" +
+	"~\xd5U\xd4;\xe0\x0c\xf6\xc3'\x06\x99BJZ\x1a" +
+	"\xf5\x0e8\x87\xdc\x0eg\x01\xff\xda\xe1h\xd9\x04\xcb\x1a" +
+	"W\x19\x13\xac\x8b%\xd3a\x8c\xae\xac\xc96\xa9J\xd6" +
+	"Xf\x05\x86/\xdb\xc2q\x0c\xdb\x7f\x98a\xe7\xf4\xcb" +
+	"a\x98\xdc\xe6\xcdM\xd72\x99\x1f\xa2\xf9C\xac\x96\xcd" +
+	"\x1f~\xa9\xde\xfc!+&\xb9A\xd2\x1c9l\xfe\xf0" +
+	"\xd5\x98/H\x87\x1af\xb2\xc4\xe2&\x85D6\xd61" +
+	"\xf6\xb3\x91V2\xd3T\x06\xd3Zf\xf2\xf6\xb6\x16O" +
+	"\x9f\xa4\\P\xc4\xb7\x18kb&~N\xe5%~N" +
+	"\xe5%~N\xe5%~\xce5\x15\xe3\xffQ*\xc8\xb8" +
+	"\xe3\x87J\x92\xd4\xa8q\xa4-\x0f\xb6\xa7\x04\xa1t\xb8" +
+	"\x93\x94\x8es\xc4tg%\x82-\xf6\xd3'j\x92\xa4" +
+	"\x95k\x84\xfeY\x95]\xbc\xf0\xceV\xc8dtMV" +
+	"\xaa[\xb1\xc0Rv\x9a\xb8\xcf\x8d<U\xd5\x803\xd3" +
+	"\xa9\x84mM\xe4\xe9\xc52[\xd2\x8b\xa5\xfbU\x95\xf5" +
+	"I\x95\xaf\xd8\x96z\xdf\xa4\xfcKq\xab\xc6r\"\xba" +
+	":1\xa2\xe8\xd9e(\xb5\xc5\x9b\xac\xdfF\xa7\xe10" +
+	"\xe842-\x86Kc\xe7\xefF\xb2X\xc7\x7f\x83\xc1" +
+	"\xb3;\xa9\xb0\xc4\xba$\x12\x83M#\xcb\xca\xa6\x91`" +
+	"\xb0i\xf4\xb0$\xf17\xdc\xc2\x06\x92L#\xf2u\x08" +
+	"\x9b\xf8}0\xc9\xb0\xb0l\x18na6\x96\x0dT\x9b" +
+	"\xd4I\x8ci,\xac3\x84q\xad{n\x87u5\xe4" +
+	"\x95\xc4\xb0\xe04\xed\x0a\xd9~\xf4\xef5~:kZ" +
+	"Pg%\x05[\xf3\x81\x89\x8b\xc0\xa0X\x0aVfK" +
+	"\x9aq3b\xd4\x82\x95\x9c\\\xb2\x16\xeeZ\xc2\xe3\xae" +
+	"\x8d\xde\xb5-\x0a@#4\xe4\xddY\xa6\xae/\x96K" +
+	"\xf6\xa8\xc6r\xd7Fs\xc9\x9e,4\xad\xe24*{" +
+	"R$(\xb8\x18eT\x13\xf5\xa6\xb6\x99\xb8\xe2aD" +
+	"\xf0b\xa0\xb6\x90d%Dh\x81(!(\xcaJ\xb9" +
+	"\xa8\xd4\xb6\xcf|\x11\x0d#\x8fF\x91\xe7\x90\x1c{\xae" +
+	"\xf9\xb9\x96\x9c\xf2\xc6\xaa\xd8E\x1e\xb0\xdc\xe6\x8cU\xb1" +
+	"\x97\xfc\xd9r\x0b3V\xc5a\xf2\xb2%W\xbb!\x0f" +
+	"\x9d$\x85\x96\x9c\xec\x86<t\x86\x14\xb2\xb9\xd7c\xf2" +
+	"\xd0yH~Sv\x818\x89\x97\x15\x87.c\xf1\xef" +
+	"\xa0xGV\x1cJt\xbc\x08\x8b\x17\x8ei\x0f+\x0e" +
+	"\xa58\xa0\x9aW\x03\xde\x8f\x15\x87\xfa8\xa0\x9a\xfd\x00" +
+	"\x1f\xc2\x8aC\x83\x1do\x81S$\xe0\xe3Xqh," +
+	"\xbe'\x0f\xf0\x12V\x1c*v@5K\x00\xbf\x8b\x15" +
+	"\x87\xa6;>\x03'D\xc0\x03\xac8$#gS\x0d" +
+	"\xe0\xba\xc3\x98G9b\xb1\x90-j\xb5,\x17c\xf4" +
+	"\x0f\x0a\x09\xf9&Jb@\xaf\x11\x04\xfb\xdf\xf2\x1cR" +
+	"@\xaa\x16u\xa9X\x94\x95\x9cpX\x0e\x13\xbdY\x19" +
+	"b\x94i\xe1\x03\xc3\x85\xe6/\x1e\xc1\xc1\x0a\x08\x8d\x8c" +
+	"\xc8\x8d\xb8\xaa\xaa\xc2\xf6\xbf\x8e\xe4<QF \xf4." +
+	"g\x8e\xa8\xa5K\x0a\xcb\xb7\x12\xfd\xfbdGU\x95\xa4" +
+	"\x84\xa5|\xa5\x06|\xdf\x82\x92\xd2\xbc\xfa\xc5DTD" +
+	",\x90\x8d%\x9a\x15\x98\xe8\xc0\xce1\xdf\xa1\x0bB\x0b" +
+	"e\xbcR5\xcd\xd2-\xabD\xb1\x97\xb9\x8d\x80\xc0!" +
+	"\xea5\xe5.\xb5\xc4\xd7\xc2\x1b&W\x95\xa3\xe2?\\" +
+	".&\xc1\x7f\xed\xc5\x86\x0adD;\x89\xee\xd0)\xee" +
+	"6HF \xbbt)l\xd3\x97W\xf0\xacoY\x1c" +
+	"r\x99E\xd18\xf8@\x9c\xe1\x7f\x98\xfe@\xd6%\x12" +
+	"\x8dS\x14ZrSh-PF\x97\x03\x81\xbc$5" +
+	"R\xa9\xff`Kr&cI\x96\x0b\x182\x96\x1c\xd3" +
+	"\xeb1]\xb68\x17\xb4\xad5\xa6\xe1\xca9\x98\x82i" +
+	"\xa2\x1c\xf5\xe1`\x14\xb6\x99\x1c\x85m\x06\xc3\xd3b\x1c" +
+	"\"\x1b\xfa2Z\\\xe3\x10\xd9\x04\xdd\xbf\xdeIJ\x9f" +
+	"g\x0e\x91-\xb9\x8cj\xd78D\xb6f\x99\xaa]k" +
+	"n\x1d\xcbm\xdcU#\xeb-\xd9\xd8 \xcf\x05\x13&" +
+	"\x95])\xd5\xc8J;\xb4\x8c`\x18\x00\xa7\xa7\xec\x12" +
+	"\xd1W+\xe9\xff\x0dk\x0c\xd8,\x18\xcf\x8f\x88R\xab" +
+	"\xce\xf9\xa1\xbc9\x18\xcc(\xf9\xcb]R}<vT" +
+	"\xd6\x0b\x91\xe662.S\xc1pu\xfc_E\xef$" +
+	"M\xadr\xfd\xff\xa3\x88\xfe\xef\xa3\x88@\xd0\x01\xa1\xdc" +
+	"\x19\x09\xb7|\xbd\x8b\xdd\xee\xb2xT\x02\xac\xcb\xa6\xb1" +
+	"*Y\x97\xcd\x98s\xe2\xaa\xdc\xa8\x8b\xd3\xf3\x0e\x92\x0d" +
+	"\x016\xed\x0a\x8d\x83\x10i\x10D-\x8e\x9a\xcd\xe3\xe5" +
+	"\xf6\xab'\"\x07\xf7g\x1e\x88\x9f\xebF\xaa,\x93\xc2" +
+	"a\xd9\xa9*-\xef\xf6\xb1\xcd\xbe\x90u\xb5\x88\xf6\xc0" +
+	"\x94\x0a\xc6\xd5B\x93\x82\xaa.\xe5\xf8\x05\xc8\x88eT" +
+	"\x9d\x82\x05!+\xfb\x09`%\xaa\xe0\xd4\xda\x11\xec\x84" +
+	"\x0a\xc0rMt\xf9%\xcd\xb6Dy\xaep\x85\xa6\xdd" +
+	"&\xc9gq\x00\x82\x1cPjX\x17\x84\xf6\x1f5^" +
+	"\xd1G\x1dz\xed\x1by\x8fx7\xf2,\xdeF^\xc1" +
+	"\xec\xd9\x86\xe5m\xab\x97\xb5\xbcE\xfdYY\xc2\xad\x96" +
+	"\xa2\x1c\xb2\x15\x15\xdc<\x19\xd9\x1d\xacg\xd6\xc4><" +
+	"\xda\x02;\xcfA\x9c\x94 QmBN\xa5\x1c\x80\xc4" +
+	"'qp\xd2\xe42\x9c$6Z\xecFJc\xd9@" +
+	"\x92\x9b\x9e\x9c\xfc\xfc\xbff\x0d\x1b\xf8d\xdc&\xbe\xa8" +
+	"u\x16\xe8i\x90\xae\x97Hv5\xab\xd7\xa2N\x8d\xd9" +
+	"\xb2\x1c\xb3,jS\xe3Z\xb1\xc11\xd7\xa2\x1e5\xae" +
+	"\x15[\x1cY\x16\xf5\xa8q\xad\xd8\xea\xf0Z\xd4\xa0\xc6" +
+	"\x06\xbb\xc3QhQw\x1a;\xecn\xa4\x945\xd5\x9a" +
+	"\xc6\x16\xbb\xd7\x91iQk\x1a[\xec~G\xa1E}" +
+	"i\xdc+\xdeuTZ\xd4\x94\xc6\xbd\xe2\xa8#\xd7\xa2" +
+	"\xa64\xee\x15\xc7\x1dY\x165\xa5q\xaf8\xe9\xf0Z" +
+	"\xd4\x91\xc6\xbd\xe2\x0c\xf6\xcfi\xc0/\xb0\xf7\x8a\xf3\x8e" +
+	"LV\x1d\x19\xbbW\\\xc4z^p8\x89\xd7\xc9h" +
+	"Y/cu\xbe\x86\xe2\x09N\x07!Q%+qB" +
+	"V\x06'h.\x9cL\xb2\x8c\xeeNT]\x00\xde\xcf" +
+	"\xc9$\xcb\xe8\x83xo\xc0\x07\x01\xee!T\xc7:\xd0" +
+	"\x89\x89\xb2\x01\x1f\xe2d\"\xb0\x06c\xf9A\x80\x8f\x02" +
+	"\xfc*\x07\xd5\xb1\x8epbv\x04\xc0\xc7\x01\x9e\xe6\xa1" +
+	":\xd6\xb1N\xe8\xcc1\x80Ot\xc6\\n&\xcal" +
+	"\xe46\xc5\xf2D!\xc9\x92g\x90\xc2\xe35![\xd6" +
+	"e\x9f\xe9\x8b\x98\xad\x84|\x13M\xc1\x0ac=@\xb6" +
+	"\x12\x88\xfd\xd9\x89![\xfa\x9e\x06I+\x16\x05\x17\xa3" +
+	"\xd2\x00;\xc5\xc4\x10\xfb\xaeb\xb1\xde\xfa\x1c\x124\xd9" +
+	"\xf5\x01\x8d\xf0U\x99\x91\xdfB\x92\xceV*$\xe9\xcd" +
+	"*\xa5\x84|x\xc9\x10\x92t\x96[\x1e\x9e\x0c\xb1\x0f" +
+	"\xdak\xd0\x08/c\xbe\xd5\x14\xa6bp\x98\xd9q\x93" +
+	"\x9b^^x\xcf\xd0\xe2\xe5O_0N+%\xe4+" +
+	"\x03\x19Sp2O*!\xdfm\x01I\x92\x05'\x93" +
+	"`\x00S\xceA\x88\xea\xe6\xd7f\xa6v~x\xd7v" +
+	"c\x8bPB\xbe\xf1\x015l)m\xb6\x82\x981\xf2" +
+	"xI\xca\x13\x83\x82\x8b\x1d\xc0\xf8\xf6;\x1a\xd0\x90\xe3" +
+	"\xf3I!\xa2\xb7\x1c?\x9f\xc2\xa3\xb54\xc4X\xd6\x08" +
+	"\x18\x8bj\x88@A\xddIJ\x178Hc4\xcc " +
+	"VcE\x9a\x83\xe7\xbd@\xd8\xa3\xb5\xb9\xb3\"\x87d" +
+	")\x0e\xc7\x1d\xda\xae(\x8d\x0fs\xaa\xe5\xc6{\xaae" +
+	"\xb0\xa7Z\xb4=\x9b2\xd8\xebIT\x10\xdaR\xc18" +
+	"\x99\x18\xa7\xda\xb6\xcc\xe8Q\xb7\xaf\xf9\x89`\xcdD\xa5" +
+	"\x89J-\xe3\xd6\xab\x9b\xb9\xe8\xe0G\x99\xa4\xeb\x984" +
+	".\x0aBr\xc2ZF@\x1a\x1b\xf9h\xc8\x94\x8a=" +
+	"\xcb\xdb\xc1o$\xfbj\x192\xc0\xb6\xbc\xb1\x0bM\xdf" +
+	"\x0c\x1b\x1f^t5\xf3\x89\x06Z\xbe\x97\x8b\xe1Z#" +
+	"\xd8\x0c#\x97[\xf1b\xe3\xdfn\xed\x14h\xd3\xc0\xcb" +
+	"N\x0c\xd7\xb6\xd0\xc1H\xc6\x09\x8e\xbfB\xba\xbf\\\xe6" +
+	"p\xd3u\x887\xe2\xc7\x1b\x9b\x9c?8T\xa0\x90\x11" +
+	"\xa4\x8d\x8b\xcd\xaaBfJF\xef5\xeb\x0a\xcd\xd9\xd7" +
+	"\x9e\x88\x19\x11\xd6\xb0.\xf9\xed\xb19q\xc4\xeb\xc4w" +
+	"\xbb\x00\x1a\x0a\x97\x1c\x08\xb7\xec@\x10\xdb$*x\xdc" +
+	"\xb7^\xde.Qh\xee\x126\x7fD~X\x03K\x0c" +
+	"\x9e\xdct\xc1\xf1\xda\xf4mo\x1c;el\x99\xf4\x91" +
+	"vN\xcb2I7\xcc\x1a\xac3\x1c\x7f\xe3\x88\x0d\xf2" +
+	":\x8d\xc75\xbb\x88\xc75[\xc9\xe3\x9ae\xb3\xc5\x19" +
+	">\xb3\xbb_4]n\x1b%\x05\\\x16\xfd\xac3\xb7" +
+	">1T\x02d\x0b>\xc9\xc2+\x1b\xd6\xd1\x12P\"" +
+	"\xe1\x1f\x04\x8b\xb1!G/\xb2\xa5J\x0c\x8a\xf5y`" +
+	"\x94`\xd3\xa7@\xc9\xa95\x92B\xcch\x81\xdb\"\x81" +
+	"@\xfc}\x18s\xff\x8c:8\x10\xfd\xbfME;I" +
+	"\x02\xb7\xa0J\xd9\xa9H\xf1\x18\xf9\x1e`\x8c|-\xd1" +
+	"T\xe0\xde\xack2\x01\x89\xbf\x0cC\xba\xe2\xaf\xcf\x14" +
+	"\x08\x9c\x9e\xa3j\xce\x80?\x1e7\x8eT\x9eGM*" +
+	"\xcf\xa3&\xd5\xf4\xedH\xc7\x1c<\x1csi}+\xb1" +
+	"\xa9-\x9b~\xc1\x88\x08u\x1d\x12\xbbD\xe4\x90\x0c\x96" +
+	"\xd5\xd5\xb8C\xe4#<\x0e\xe0\"\x96\x04\xb6\x80dX" +
+	"\xc8^\x0d\x87\xb8b\x92a!{5H`KI\xae" +
+	"\x85\xec\xd5\xe0\x07\x9bBr-d\xaf\x06A\xd8t\x92" +
+	"a!{5H`g\xa0\x85o\x1a\xe0~\x96\xff^" +
+	"D\x9b\xc5L\xc0\x03\x84\xc9\xb9'\x93Y\x16\xe2)#" +
+	"\xe7\xdelRi!\x98\x8a\xe5\xdc\xb3\x12LY\x0f\xe9" +
+	"\x86\x125\x96\xcd'\xa9\x9e\xfd1\x97\xf9a\xb7[7" +
+	"\xca\x8a\x0f\x18\xb5\xb8y~\xb3\xc1\x0c\xcbl\xddjH" +
+	"j\x96\x92\x15\xdc\xe2\xa4\x1c\xbdLHB\xeaQ6&" +
+	"\\\xd3m)\xa1\x9a\xe0}%\xa2&\x0a\xa4\x1d\xf9`" +
+	"\x8c\x10\x01\xeb\xd9\xc62\x04\xe7\xb2\xf6Scr\xd8\xcd" +
+	"\xa7\xc6\xe4\x18L2-\xe6S\x83\xe5\xc3\x9e\xa4\xc0`" +
+	"\xf9\x18A2\xd9$\x05\x86;\xae{4)\xb40\x10" +
+	"wp\xd2\xc9\x91CfY&\xa5\x11\xe6Z@\x16Y" +
+	"&\x9fA\x81XJfY&Y\xa7\x04:9\xa6\x93" +
+	"\xc7,,f\x9d\x13\xe9\xe4\x90\xc8\"\x96\xc5\xacu\xbe" +
+	"5\xabo#\\G&\xb5HI\xc7e\x97\x8b\x065" +
+	"\xdc!$Y\xd2\xd4D\xe12\xa2\x8b4\x9dT[t" +
+	"tT\x10\xf3\xaa$\xa2\xf8\xc3\xe5\xea\xed\xb23\x10\x88" +
+	"m\x09J\xc8\x87\x0a\x04\x82\x7f\x09\x04\x84V7\x8b\x18" +
+	"\xdb \xa4\xf8\xcdV1\x8b\xb8\x9dq0\xee .\xc6" +
+	"#9\x8et\x1b\x99-\xa4\xdb\xb0\xf9\x078\xb8\xfe\x01" +
+	"\xb1\x19e'\xc34\xfco\xfb\x90Jv\"\xc7f\xd4" +
+	"@Ra\x99\xb1\xae\x04:\xa3\x86\x12\xafef\x1a\xfe" +
+	"\xb7\xa3I\x85ef\x1a\xe96rH\xa113\xefj" +
+	"{\xe6\xb4\xea\x1f@\xcd\x14y\x92\xe0\x12\x03z\x8c3" +
+	"\x8e\xa2\xe5\xa2\xe0\xaa\x95\x94\x18Z#W\x83&z\xa2" +
+	"\xe0dn\xbeR}(_\xd4(5\x9cQ\x10B\x8f" +
+	"\x01\xc4\xbb\xa8\x01*!\x1fL\x09\xeb-\xf6\xbd\xef\x17" +
+	",y\xae\xd7C\xef\xc5\xcdQ6U\xaa\x04u\\~" +
+	"\x9d\xa4\xa1+\xa6u/\xe1\x87\xbb\xc5T\xb0\xb9m\x10" +
+	"OG\x1d\xd4\xad\x94\xf9-{\xd6s\xdd\xf5\xe3\xe0\xe0" +
+	"\x15\xe7(\x13K\x18*z\xa6\xd6\x99?\x84\xef\xa9U" +
+	"*\x93x\xa8\xbc\x9b\x1b3s|>\x97\x14\xb2\xcbT" +
+	"^\x96\x86\xff\x87\xf8\xca\x83T\x0f\xf1D\xcef\x0d\xe7" +
+	"\xdd\xc4*x\xc3Uh\x0eW\\\xf2{k\x12z\x8b" +
+	"\xf5,\x91t\xbc\x8beO\x89\x0d\x12C\xbe\xe0e\xfc" +
+	"Lb\xec\x0b\x99L\xe2dc\x90\x8eg1\xce'\x86" +
+	"\xbc\xc5:\x9f\x18W\xb03^\xc6\\\x15u3J9" +
+	"\xefe,SFr\xbbK\x85\xac\x11\xcap\xe0'\xa4" +
+	"\x90\xb16\x81RI\x89D\xb5\x1d\x8c\xa7\x1f\x93&\xdf" +
+	"\xee\x03/):\xe4\xd3a;I\xf4\xa1'\x17\xaaB" +
+	"b\x8a\x9f \x96T\x05\xa2\xc6\xb0\xd9\x11IWu+" +
+	"?;'\xeb^\xdb;:e\xfb\x80\xachp\xb4e" +
+	"\x0a\xed\xf5\x81n\x99\x0a@\xd5\x82\xa2\xaeK\xfebJ" +
+	"\x05\xd9\xda^a\x1a\xe738\xc6\xf9\\S\x0d\xd0\x14" +
+	"F\x8f;\xcb\xc4\xb2\xb9\xfc\xb7\xc0V\xdd\xca\xeaP\xfc" +
+	"j\xd0L>\xc2\x88\xf8}y\"~F\x1bN\xf3\x06" +
+	"A@0\xc3\x94\xf0]\x01uN\xac\xba\xb0\xa1\x9bj" +
+	"A\xa4I\xf8\xc1\xb9\x92\xa03x\xe9%\xfa\x9a\xc3\x86" +
+	"\x1e\xb7\xf1\x9b\x94\x03j\x18\xd8e\xb2\xf1*\xa5\xc53" +
+	"dY\xec\x86a\x8cY\x063f\x1c-\x92M\xd1\x94" +
+	"\x04\xa2q;U\x9a4B\xb2\x85\xec\x8e\xedr\xddg" +
+	"9v`\x11\xe4\x84B\x9a\x0a\x81\xf9-sp\xf0g" +
+	"\x83A4\xea\xe5\x11\x8df\x9az\x11\xbbz\xd0'Y" +
+	"\xd6\xb0\xdd\xd9\xcd\xc2\xe5\x15\x87\xf3f4CB\xb3\xf0" +
+	"\xd6\xf8i\x0d\x99!\xa5G\x99\xcc\x90\xc3\xe3e\xd5\x18" +
+	"G\xdba\xd0F|~\x99\x14\x08\x80O\x85Epl" +
+	"K\x03S\xc8\xd3\xc0\xb0jZc\x8f\xdf\x92\xc5Z$" +
+	"\xa3\x9b<\xebEbd\xfb\xd9Vh\x1a$c\x92\x86" +
+	"A\x93\xf4\x9f\xb2yE]F\xda\xa5w\x03\x1a\x0d\xe8" +
+	"\x97$\xc3e\xb3\x0d\xc5\x1bw\xd6e\xb0j\x06Gs" +
+	"\xe2\x17\x9b\xeb\x89u\x12\xb2\xd9\x9b\xda\x9f\xdc\xa1 \x10" +
+	"\x88\x84!\xb9\x87`W<\xe4\xf2\x15\x0f\x85\x16\x05\x83" +
+	"q\x13\xb0+\x18\x8c\x9b@)\xc9\xb2(\x18\x0c\xc5\x83" +
+	"]\xc1`(\x1e\xa6\x93J\x8b\"!\x96\xec\x9fdX" +
+	"\xee\x84\xb1d\xff\xc4\xcb\xde\x09c\x8a\x87 \xf1Z\x18" +
+	"\xac\x0d\xc5C\x84dZ\x14\x0c\x86\xe2\xa1\x81T\xb0\x0a" +
+	"\x86\x98\xe2a!~w\x1e\xe0KI\xf3%\xc5;\xb8" +
+	"-\xbb\x82\x8d\xbf\xafQ\xa7T\xbe\xb1\xe7!\x113`" +
+	"\x82\x8bA-,\x7f\xdc\xd4\xd4\xbct\xdd\x96\xac\xd3\xdc" +
+	"\xe4\xd5\x96d\xe0\xff\xbf\x01\x00\xa9\x0c\xc6\xd3"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -42086,6 +47157,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x86f0f1c44a113f21,
 			0x873927d4562f9ba5,
 			0x87681fa437f7497d,
+			0x87b505340222092e,
 			0x87d72af46493bddd,
 			0x88007635e342bb94,
 			0x880f6cbde51358e0,
@@ -42101,8 +47173,10 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x8a5026d84a4c9312,
 			0x8acccc8118bd1f80,
 			0x8b2666611a37b434,
+			0x8b4a5b60a0196e49,
 			0x8be97fa3ad641ab0,
 			0x8cf4d94985ccea99,
+			0x8dc15a5530df753d,
 			0x8dd957cd7b2a3e0c,
 			0x8e44cedc506249f3,
 			0x8e7e41c509c2c8c0,
@@ -42114,6 +47188,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x9044b7f5fe5563b0,
 			0x9078ec69fd7d9560,
 			0x9099feab65cf05fe,
+			0x912df186f42698b4,
 			0x917f02f6029babd0,
 			0x919eb8a95b9f8a07,
 			0x91ab76ec9c2c1b6f,
@@ -42128,15 +47203,18 @@ func RegisterSchema(reg *schemas.Registry) {
 			0x94d039e60a812076,
 			0x9598dccef1d83c38,
 			0x95ca47192b7d5b43,
+			0x965581a04adbcfe6,
 			0x9664dc9fe28bc0aa,
 			0x971031099b134c74,
 			0x9726e35ff31ca532,
 			0x97eb57637b332ff5,
+			0x986cc0963ee4cde6,
 			0x9910215bbf6992b3,
 			0x99618626b3ec1f42,
 			0x998ca559e52e226b,
 			0x9a90a23b04d7e7b8,
 			0x9b02ee5625ec21fb,
+			0x9b165192c3123c99,
 			0x9b4767b704c3f415,
 			0x9b932a4905396044,
 			0x9bb334389bc94f2e,
@@ -42162,6 +47240,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xa012f4912c4151f9,
 			0xa0a594ed993e78ca,
 			0xa0ec151dfc4791ad,
+			0xa160c0ca525d295c,
 			0xa1914008041e7c44,
 			0xa23c8c68e3798bb0,
 			0xa2fef4c64b89cc83,
@@ -42192,6 +47271,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xab1d6befe41d5e7c,
 			0xab1ebc3e87d43ca9,
 			0xaba4e5389c6116b1,
+			0xabedabc85e222f6e,
 			0xaca9868658c82d61,
 			0xadd206cd099784fe,
 			0xae87b106322248a8,
@@ -42201,19 +47281,23 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xaf015f84859d0028,
 			0xaf898798e12e23da,
 			0xb0746148ee56b888,
+			0xb09bd8a1ad1ed10f,
 			0xb0d71e9e634d1529,
 			0xb176d4b98cf5afa6,
 			0xb26bddc14e66729a,
 			0xb2ea7b1704b448ca,
 			0xb3307c029a338d76,
+			0xb332c883f556a2c0,
 			0xb372c3983eb6f2ef,
 			0xb3a8f9a49aa3ccf8,
 			0xb3f8feecbb635492,
 			0xb4452aee08031161,
+			0xb4b9900a1260c0a8,
 			0xb513764d5512a334,
 			0xb52cdc5b0d00dfde,
 			0xb5a6abce79a6f063,
 			0xb5df3e8353d89d09,
+			0xb646c486ebcbf5e6,
 			0xb66a9193d2300649,
 			0xb6c8b8141b0acfa3,
 			0xb6db3a4bd35d7d66,
@@ -42237,6 +47321,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xbadafe4de857fe71,
 			0xbae3c3ae8a00e8a6,
 			0xbb2eb4d03dfe2a7d,
+			0xbb3d1850b12cd31b,
 			0xbcba10c194c8e450,
 			0xbd4e03b89e6514fb,
 			0xbd94d13b8b1bcadf,
@@ -42272,11 +47357,15 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xc5c8431019d453a8,
 			0xc5d32cc234a53620,
 			0xc5eb62ff00b0072f,
+			0xc61bb50b732910ae,
+			0xc61e43f35673e291,
 			0xc644c15adcdd09cb,
+			0xc683efbf7bffa2b4,
 			0xc6cfb8d85c850055,
 			0xc73e9eb76d70ee0a,
 			0xc7587c9f16a1fc72,
 			0xc76af8996e024e54,
+			0xc7de66ce8d7e08ad,
 			0xc7e12a7583590729,
 			0xc80a4395be2631b5,
 			0xc80da15169d336da,
@@ -42284,6 +47373,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xc854a2272dea6789,
 			0xc8768679ec52e012,
 			0xc8d069bf9cf2487f,
+			0xc947f6cd1e07d9da,
 			0xc97630777502511a,
 			0xc998874f2bcc5999,
 			0xca688fde6bcd4bbd,
@@ -42292,6 +47382,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xcab56e851899421c,
 			0xcac443c397628440,
 			0xcafb4dbd23ee299c,
+			0xcc32c9cd75e06fc9,
 			0xcc4575715bad9f24,
 			0xcc521ab8cc5e91c0,
 			0xcc98a627a696d586,
@@ -42308,6 +47399,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xd09020015ade2993,
 			0xd0f511db04f5194f,
 			0xd1052d0564f25e0d,
+			0xd228a5a668861786,
 			0xd2768707959c3ea9,
 			0xd351f43ee97d7196,
 			0xd35ac5679adaf993,
@@ -42318,6 +47410,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xd61435667434c9c3,
 			0xd65b5d6ec837db5d,
 			0xd68209c5c40dc249,
+			0xd68f1dab8580fed6,
 			0xd6aded5b005488d5,
 			0xd74a1be2552282a4,
 			0xd75aa49a213b1c70,
@@ -42348,6 +47441,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xdd9072d437eab9a1,
 			0xde51be80c580aa82,
 			0xdea4ce0866a280a7,
+			0xdea59dd927f499c3,
 			0xded56c2c8b009f0e,
 			0xdf0c1226c90e00ad,
 			0xdf6175fbc7aa8195,
@@ -42363,6 +47457,9 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xe130b601260e44b5,
 			0xe2a2a4743dccd2e6,
 			0xe323be47b82c6682,
+			0xe344a4b7d9ec90b6,
+			0xe3788914da7f63bb,
+			0xe39f1f4235358285,
 			0xe3ae917210919c50,
 			0xe430fa7b7d850130,
 			0xe4e7eeb23bebb1f8,
@@ -42382,8 +47479,11 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xe89b2535f3e88eb7,
 			0xe8b71523b2ef78ed,
 			0xe8dc5ddc1cdb5438,
+			0xe8e34f0364644d08,
+			0xe8f4085bcd9c6dcd,
 			0xe930a7e80f737844,
 			0xe9a04e0d39785218,
+			0xea06c39fbc9cb2d5,
 			0xea44e8bfb6132911,
 			0xeabe3c2d8178a916,
 			0xeafef4c5dcc4b5b5,
@@ -42409,28 +47509,35 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xf1f7baa2935a0cd7,
 			0xf23307bee1ce0b8d,
 			0xf304800fc3af267f,
+			0xf30b9ceadbcbce7a,
 			0xf32339f8d6ffe524,
 			0xf418cd61a8543b3c,
 			0xf42a44d24baf1780,
 			0xf47ddd36364c797f,
+			0xf4a38d4d317b81ba,
 			0xf4c3318dcf329728,
 			0xf55818d39f2c0c72,
 			0xf5a87fa04392d0a1,
+			0xf5ea129a0bfa1035,
 			0xf613029b64a06aeb,
 			0xf640e180eb6f7e34,
 			0xf6dcd5fc2f04b44a,
 			0xf6ebf8b8d23d794f,
 			0xf70ec2d8ba38e7dd,
 			0xf7787292d29bdcd7,
+			0xf78f5141a05c8566,
 			0xf7af0983a9ca61c5,
 			0xf7b756860a5b5804,
 			0xf7df715b4e24efb3,
 			0xf87e75def99cdba4,
+			0xf880e264f3b349d0,
 			0xf89cd60c9713ce58,
 			0xf8dcc5cd22965ff5,
 			0xf90c9c3f6c27a1c2,
 			0xf9454e8612b10b69,
+			0xf9f5042b9a64b7e6,
 			0xfa10659ae02f2093,
+			0xfa21bf17a15f69d1,
 			0xfa35d04887272ed9,
 			0xfa4d6a8cac27c611,
 			0xfa5626b7b9dcd44b,
@@ -42441,9 +47548,11 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xfc446c448d940ff7,
 			0xfc766ac7f0d6e4f5,
 			0xfd58a2914a0296e2,
+			0xfd88ee75ec56953a,
 			0xfe88232d771013d6,
 			0xfe931b1705a8e8a8,
 			0xff5e32957fd88ada,
+			0xffd2adfdcf38c652,
 			0xfffc6cb5879fa108,
 		},
 		Compressed: true,
@@ -110,12 +110,12 @@ type CharacterSelectEntry capnp.Struct
 const CharacterSelectEntry_TypeID = 0xca9e1a4afe3dca58
 
 func NewCharacterSelectEntry(s *capnp.Segment) (CharacterSelectEntry, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 112, PointerCount: 2})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 112, PointerCount: 3})
 	return CharacterSelectEntry(st), err
 }
 
 func NewRootCharacterSelectEntry(s *capnp.Segment) (CharacterSelectEntry, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 112, PointerCount: 2})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 112, PointerCount: 3})
 	return CharacterSelectEntry(st), err
 }
 
@@ -416,12 +416,30 @@ func (s CharacterSelectEntry) SetWis(v int32) {
 	capnp.Struct(s).SetUint32(108, uint32(v))
 }
 
+func (s CharacterSelectEntry) GuildName() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s CharacterSelectEntry) HasGuildName() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s CharacterSelectEntry) GuildNameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s CharacterSelectEntry) SetGuildName(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
 // CharacterSelectEntry_List is a list of CharacterSelectEntry.
 type CharacterSelectEntry_List = capnp.StructList[CharacterSelectEntry]
 
 // NewCharacterSelectEntry creates a new list of CharacterSelectEntry.
 func NewCharacterSelectEntry_List(s *capnp.Segment, sz int32) (CharacterSelectEntry_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 112, PointerCount: 2}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 112, PointerCount: 3}, sz)
 	return capnp.StructList[CharacterSelectEntry](l), err
 }
 
@@ -2312,3 +2330,177 @@ func (f Tint_Future) Struct() (Tint, error) {
 	p, err := f.Future.Ptr()
 	return Tint(p.Struct()), err
 }
+
+type InspectInfo capnp.Struct
+
+// InspectInfo_TypeID is the unique identifier for the type InspectInfo.
+const InspectInfo_TypeID = 0x986cc0963ee4cde6
+
+func NewInspectInfo(s *capnp.Segment) (InspectInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return InspectInfo(st), err
+}
+
+func NewRootInspectInfo(s *capnp.Segment) (InspectInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return InspectInfo(st), err
+}
+
+func ReadRootInspectInfo(msg *capnp.Message) (InspectInfo, error) {
+	root, err := msg.Root()
+	return InspectInfo(root.Struct()), err
+}
+
+func (s InspectInfo) String() string {
+	str, _ := text.Marshal(0x986cc0963ee4cde6, capnp.Struct(s))
+	return str
+}
+
+func (s InspectInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (InspectInfo) DecodeFromPtr(p capnp.Ptr) InspectInfo {
+	return InspectInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s InspectInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s InspectInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s InspectInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s InspectInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s InspectInfo) Name() (string, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.Text(), err
+}
+
+func (s InspectInfo) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s InspectInfo) NameBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s InspectInfo) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s InspectInfo) Level() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
+}
+
+func (s InspectInfo) SetLevel(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
+}
+
+func (s InspectInfo) CharClass() int32 {
+	return int32(capnp.Struct(s).Uint32(4))
+}
+
+func (s InspectInfo) SetCharClass(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v))
+}
+
+func (s InspectInfo) Race() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
+}
+
+func (s InspectInfo) SetRace(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
+}
+
+func (s InspectInfo) Guild() (string, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.Text(), err
+}
+
+func (s InspectInfo) HasGuild() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s InspectInfo) GuildBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s InspectInfo) SetGuild(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+func (s InspectInfo) Anon() int32 {
+	return int32(capnp.Struct(s).Uint32(12))
+}
+
+func (s InspectInfo) SetAnon(v int32) {
+	capnp.Struct(s).SetUint32(12, uint32(v))
+}
+
+func (s InspectInfo) Text() (string, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.Text(), err
+}
+
+func (s InspectInfo) HasText() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s InspectInfo) TextBytes() ([]byte, error) {
+	p, err := capnp.Struct(s).Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s InspectInfo) SetText(v string) error {
+	return capnp.Struct(s).SetText(2, v)
+}
+
+func (s InspectInfo) Items() (ItemInstance_List, error) {
+	p, err := capnp.Struct(s).Ptr(3)
+	return ItemInstance_List(p.List()), err
+}
+
+func (s InspectInfo) HasItems() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s InspectInfo) SetItems(v ItemInstance_List) error {
+	return capnp.Struct(s).SetPtr(3, v.ToPtr())
+}
+
+// NewItems sets the items field to a newly
+// allocated ItemInstance_List, preferring placement in s's segment.
+func (s InspectInfo) NewItems(n int32) (ItemInstance_List, error) {
+	l, err := NewItemInstance_List(capnp.Struct(s).Segment(), n)
+	if err != nil {
+		return ItemInstance_List{}, err
+	}
+	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
+	return l, err
+}
+
+// InspectInfo_List is a list of InspectInfo.
+type InspectInfo_List = capnp.StructList[InspectInfo]
+
+// NewInspectInfo creates a new list of InspectInfo.
+func NewInspectInfo_List(s *capnp.Segment, sz int32) (InspectInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4}, sz)
+	return capnp.StructList[InspectInfo](l), err
+}
+
+// InspectInfo_Future is a wrapper for a InspectInfo promised by a client call.
+type InspectInfo_Future struct{ *capnp.Future }
+
+func (f InspectInfo_Future) Struct() (InspectInfo, error) {
+	p, err := f.Future.Ptr()
+	return InspectInfo(p.Struct()), err
+}
@@ -670,4 +670,60 @@ const (
 	GetRecipeDetailsResponse
 	CraftRecipeRequest
 	CraftRecipeResponse
+
+	// IdleQuest consider response (request uses the legacy Consider opcode)
+	ConsiderResponse
+
+	// IdleQuest combat statistics
+	GetCombatStatsRequest
+	GetCombatStatsResponse
+
+	// IdleQuest server-side auto-hunt
+	SetAutoHunt
+	AutoHuntStatus
+
+	// IdleQuest "while you were away" summary sent at login
+	OfflineProgress
+
+	// IdleQuest auto-retreat HP threshold
+	SetAutoRetreat
+
+	// IdleQuest groups (invites, joining and leaving use the legacy Group* opcodes)
+	GroupStatus
+	SetGroupLootMode
+
+	// IdleQuest raids (invites and joining use the legacy RaidInvite and RaidJoin opcodes)
+	RaidAction
+	RaidStatus
+
+	// IdleQuest guilds (the rest use the legacy Guild* opcodes)
+	GuildInfo
+	SetGuildPermission
+
+	// IdleQuest trading (requests, coin, accepting and cancelling use the legacy Trade* opcodes)
+	TradeItem
+	TradeStatus
+
+	// IdleQuest user chat channels
+	ChatChannelAction
+	ChatChannelList
+
+	// IdleQuest chat moderation (reports use the legacy Report opcode)
+	ChatModeration
+	ChatIgnoreList
+
+	// IdleQuest anonymous and roleplay flag for who (who itself uses the legacy WhoAll* opcodes)
+	SetAnon
+
+	// IdleQuest friends list (the friend query uses the legacy FriendsWho opcode)
+	FriendAction
+	FriendList
+
+	// IdleQuest mail and parcels
+	MailSend
+	MailAction
+	Mailbox
+
+	// IdleQuest inspection
+	SetInspectOptOut
 )
@@ -0,0 +1,214 @@
+package chat
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Chat channels, sent as the messageType of a chat message
+const (
+	Say     = "say"     // The sender's zone
+	Shout   = "shout"   // The sender's zone
+	Emote   = "emote"   // The sender's zone
+	OOC     = "ooc"     // Out of character, the sender's zone
+	Auction = "auction" // Every zone
+	General = "general" // Out of character, every zone
+	Tell    = "tell"    // One character, by name
+	Group   = "group"
+	Raid    = "raid"
+	Guild   = "guild"
+	Channel = "channel" // A user-created channel, by name
+)
+
+// Scope is who a chat channel reaches
+type Scope int
+
+const (
+	ScopeZone Scope = iota
+	ScopeServer
+	ScopeTell
+	ScopeGroup
+	ScopeRaid
+	ScopeGuild
+	ScopeUser
+)
+
+var scopes = map[string]Scope{
+	Say:     ScopeZone,
+	Shout:   ScopeZone,
+	Emote:   ScopeZone,
+	OOC:     ScopeZone,
+	Auction: ScopeServer,
+	General: ScopeServer,
+	Tell:    ScopeTell,
+	Group:   ScopeGroup,
+	Raid:    ScopeRaid,
+	Guild:   ScopeGuild,
+	Channel: ScopeUser,
+}
+
+// ScopeOf returns who the chat channel reaches, or false for an unknown channel
+func ScopeOf(messageType string) (Scope, bool) {
+	scope, ok := scopes[messageType]
+	return scope, ok
+}
+
+// legacyChannels maps the legacy ChannelMessage chanNum to its chat channel
+var legacyChannels = map[int32]string{
+	0:  Guild,
+	2:  Group,
+	3:  Shout,
+	4:  Auction,
+	5:  OOC,
+	7:  Tell,
+	8:  Say,
+	15: Raid,
+}
+
+// LegacyChannel returns the chat channel for a legacy ChannelMessage chanNum
+func LegacyChannel(chanNum int32) (string, bool) {
+	messageType, ok := legacyChannels[chanNum]
+	return messageType, ok
+}
+
+// MaxJoined is the most user channels a character can be in at once
+const MaxJoined = 10
+
+// Errors returned to the character who tried the action, worded for the client
+var (
+	ErrUnknownChannel  = errors.New("There is no such chat channel.")
+	ErrBadChannelName  = errors.New("Channel names are 3 to 16 letters and numbers.")
+	ErrWrongPassword   = errors.New("That channel needs a different password.")
+	ErrNotInChannel    = errors.New("You are not in that channel.")
+	ErrTooManyChannels = errors.New("You are in too many channels.")
+)
+
+// ValidateChannelName checks a user channel's name: 3 to 16 ASCII letters and digits, and not
+// the name of a built-in channel
+func ValidateChannelName(name string) error {
+	if len(name) < 3 || len(name) > 16 {
+		return ErrBadChannelName
+	}
+	for _, r := range name {
+		if r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r)) {
+			return ErrBadChannelName
+		}
+	}
+	if _, ok := scopes[strings.ToLower(name)]; ok {
+		return ErrBadChannelName
+	}
+	return nil
+}
+
+// Channels tracks who is in each user channel. Membership lives only in memory; the channels
+// themselves are kept in the chatchannels table.
+type Channels struct {
+	mu      sync.Mutex
+	names   map[string]string             // Lowercase name -> name as created
+	members map[string]map[int64]struct{} // Lowercase name -> character IDs
+	joined  map[int64]map[string]struct{} // Character ID -> lowercase names
+}
+
+var globalChannels = NewChannels()
+
+// GetChannels returns the global user channels
+func GetChannels() *Channels {
+	return globalChannels
+}
+
+// NewChannels creates an empty set of user channels
+func NewChannels() *Channels {
+	return &Channels{
+		names:   make(map[string]string),
+		members: make(map[string]map[int64]struct{}),
+		joined:  make(map[int64]map[string]struct{}),
+	}
+}
+
+// Join adds the character to the channel
+func (c *Channels) Join(charID int64, name string) error {
+	key := strings.ToLower(name)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.joined[charID][key]; ok {
+		return nil
+	}
+	if len(c.joined[charID]) >= MaxJoined {
+		return ErrTooManyChannels
+	}
+	if c.members[key] == nil {
+		c.members[key] = make(map[int64]struct{})
+		c.names[key] = name
+	}
+	if c.joined[charID] == nil {
+		c.joined[charID] = make(map[string]struct{})
+	}
+	c.members[key][charID] = struct{}{}
+	c.joined[charID][key] = struct{}{}
+	return nil
+}
+
+// Leave takes the character out of the channel
+func (c *Channels) Leave(charID int64, name string) error {
+	key := strings.ToLower(name)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.joined[charID][key]; !ok {
+		return ErrNotInChannel
+	}
+	c.remove(charID, key)
+	return nil
+}
+
+// LeaveAll takes the character out of every channel, as they leave the world
+func (c *Channels) LeaveAll(charID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.joined[charID] {
+		c.remove(charID, key)
+	}
+}
+
+// remove takes the character out of a channel, forgetting the channel once it's empty. c.mu
+// must be held.
+func (c *Channels) remove(charID int64, key string) {
+	delete(c.members[key], charID)
+	if len(c.members[key]) == 0 {
+		delete(c.members, key)
+		delete(c.names, key)
+	}
+	delete(c.joined[charID], key)
+	if len(c.joined[charID]) == 0 {
+		delete(c.joined, charID)
+	}
+}
+
+// Members returns the channel's name as created and the characters in it. ok is false if the
+// character isn't one of them.
+func (c *Channels) Members(charID int64, name string) (channelName string, members []int64, ok bool) {
+	key := strings.ToLower(name)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.members[key][charID]; !ok {
+		return "", nil, false
+	}
+	for id := range c.members[key] {
+		members = append(members, id)
+	}
+	return c.names[key], members, true
+}
+
+// Joined returns the names of the channels the character is in, sorted
+func (c *Channels) Joined(charID int64) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var names []string
+	for key := range c.joined[charID] {
+		names = append(names, c.names[key])
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,85 @@
+package chat
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestScopeOf(t *testing.T) {
+	tests := []struct {
+		messageType string
+		want        Scope
+	}{
+		{Say, ScopeZone},
+		{OOC, ScopeZone},
+		{Auction, ScopeServer},
+		{General, ScopeServer},
+		{Tell, ScopeTell},
+		{Guild, ScopeGuild},
+		{Channel, ScopeUser},
+	}
+	for _, tt := range tests {
+		if got, ok := ScopeOf(tt.messageType); !ok || got != tt.want {
+			t.Errorf("ScopeOf(%q) = %v, %v, want %v", tt.messageType, got, ok, tt.want)
+		}
+	}
+	if _, ok := ScopeOf("everyone"); ok {
+		t.Error("Expected an unknown channel to have no scope")
+	}
+	if messageType, ok := LegacyChannel(7); !ok || messageType != Tell {
+		t.Errorf("Expected legacy channel 7 to be tells, got %q", messageType)
+	}
+}
+
+func TestValidateChannelName(t *testing.T) {
+	for _, name := range []string{"Traders", "raid42"} {
+		if err := ValidateChannelName(name); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"ab", "Two Words", "Shout", "AVeryLongChannelName"} {
+		if err := ValidateChannelName(name); !errors.Is(err, ErrBadChannelName) {
+			t.Errorf("Expected %q to be rejected, got %v", name, err)
+		}
+	}
+}
+
+func TestChannels(t *testing.T) {
+	c := NewChannels()
+	if err := c.Join(1, "Traders"); err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	if err := c.Join(2, "traders"); err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+	name, members, ok := c.Members(2, "TRADERS")
+	slices.Sort(members)
+	if !ok || name != "Traders" || !slices.Equal(members, []int64{1, 2}) {
+		t.Errorf("Expected Traders with both members, got %q %v %v", name, members, ok)
+	}
+	if _, _, ok := c.Members(3, "Traders"); ok {
+		t.Error("Expected a non-member not to see the channel")
+	}
+
+	if err := c.Leave(3, "Traders"); !errors.Is(err, ErrNotInChannel) {
+		t.Errorf("Expected ErrNotInChannel, got %v", err)
+	}
+	c.LeaveAll(1)
+	c.LeaveAll(2)
+	if len(c.Joined(1)) != 0 || len(c.names) != 0 {
+		t.Errorf("Expected the empty channel to be forgotten, got %v", c.names)
+	}
+
+	for i := 0; i < MaxJoined; i++ {
+		if err := c.Join(1, string(rune('a'+i))+"chan"); err != nil {
+			t.Fatalf("Join %d failed: %v", i, err)
+		}
+	}
+	if err := c.Join(1, "onemore"); !errors.Is(err, ErrTooManyChannels) {
+		t.Errorf("Expected ErrTooManyChannels, got %v", err)
+	}
+	if joined := c.Joined(1); len(joined) != MaxJoined || joined[0] != "achan" {
+		t.Errorf("Expected sorted channel names, got %v", joined)
+	}
+}
@@ -0,0 +1,320 @@
+package chat
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// GMStatus is the account status needed to mute other players
+const GMStatus = 80
+
+// Spam limits: a character may say floodLimit lines in floodWindow, and the same line
+// repeatLimit times in repeatWindow
+const (
+	floodLimit   = 5
+	floodWindow  = 5 * time.Second
+	repeatLimit  = 3
+	repeatWindow = 30 * time.Second
+)
+
+// Chat history limits: a character's last HistoryLength lines are kept for reports, each for
+// HistoryRetention after it was said
+const (
+	HistoryLength    = 20
+	HistoryRetention = time.Hour
+)
+
+// Errors returned to the character who tried the action, worded for the client
+var (
+	ErrFlooding    = errors.New("You are sending messages too quickly.")
+	ErrRepeating   = errors.New("You have already said that.")
+	ErrNotGM       = errors.New("You do not have permission to do that.")
+	ErrIgnoreSelf  = errors.New("You cannot ignore yourself.")
+	ErrNotIgnored  = errors.New("You are not ignoring that player.")
+	ErrIgnoreLimit = errors.New("Your ignore list is full.")
+)
+
+// MaxIgnored is the most players a character can ignore
+const MaxIgnored = 100
+
+// HistoryLine is one line a character said, kept as context for reports
+type HistoryLine struct {
+	Time        time.Time
+	MessageType string
+	Text        string
+}
+
+func (h HistoryLine) String() string {
+	return fmt.Sprintf("[%s] %s: %s", h.Time.UTC().Format(time.DateTime), h.MessageType, h.Text)
+}
+
+// spamState is a character's recent chat, for flood and repeat detection
+type spamState struct {
+	sent     []time.Time // Within floodWindow
+	lastText string
+	repeats  int
+	lastSent time.Time
+}
+
+// Moderation holds the profanity filter, account mutes, ignore lists, spam detection and recent
+// chat history. Mutes and ignore lists are loaded from the database as characters enter the
+// world; the rest lives only in memory.
+type Moderation struct {
+	mu      sync.Mutex
+	words   []string                    // Lowercase
+	mutes   map[int64]time.Time         // Account ID -> muted until
+	ignores map[int64]map[string]string // Character ID -> lowercase name -> name
+	spam    map[int64]*spamState        // Character ID
+	history map[string][]HistoryLine    // Lowercase character name -> oldest first
+	pruned  time.Time                   // When history was last swept for characters gone quiet
+	now     func() time.Time
+}
+
+var globalModeration = NewModeration()
+
+// GetModeration returns the global chat moderation
+func GetModeration() *Moderation {
+	return globalModeration
+}
+
+// NewModeration creates chat moderation with no filtered words, mutes or ignores
+func NewModeration() *Moderation {
+	return &Moderation{
+		mutes:   make(map[int64]time.Time),
+		ignores: make(map[int64]map[string]string),
+		spam:    make(map[int64]*spamState),
+		history: make(map[string][]HistoryLine),
+		now:     time.Now,
+	}
+}
+
+// SetWords replaces the filtered words
+func (m *Moderation) SetWords(words []string) {
+	filtered := make([]string, 0, len(words))
+	for _, w := range words {
+		if w = strings.ToLower(strings.TrimSpace(w)); w != "" {
+			filtered = append(filtered, w)
+		}
+	}
+	m.mu.Lock()
+	m.words = filtered
+	m.mu.Unlock()
+}
+
+// Filter masks every filtered word in text with asterisks. Words match whole, ignoring case.
+func (m *Moderation) Filter(text string) string {
+	m.mu.Lock()
+	words := m.words
+	m.mu.Unlock()
+	if len(words) == 0 {
+		return text
+	}
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(lower) != len(runes) {
+		return text
+	}
+	for _, w := range words {
+		word := []rune(w)
+		for i := 0; i+len(word) <= len(lower); i++ {
+			if string(lower[i:i+len(word)]) != w {
+				continue
+			}
+			if i > 0 && isWordRune(lower[i-1]) {
+				continue
+			}
+			if end := i + len(word); end < len(lower) && isWordRune(lower[end]) {
+				continue
+			}
+			for j := i; j < i+len(word); j++ {
+				runes[j] = '*'
+			}
+			i += len(word) - 1
+		}
+	}
+	return string(runes)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// SetMute mutes the account until the time given. A zero time lifts the mute.
+func (m *Moderation) SetMute(accountID int64, until time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if until.IsZero() {
+		delete(m.mutes, accountID)
+		return
+	}
+	m.mutes[accountID] = until
+}
+
+// MutedUntil returns when the account's mute ends, or false if it isn't muted
+func (m *Moderation) MutedUntil(accountID int64) (time.Time, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	until, ok := m.mutes[accountID]
+	if !ok {
+		return time.Time{}, false
+	}
+	if !m.now().Before(until) {
+		delete(m.mutes, accountID)
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// SetIgnores replaces the character's ignore list, as they enter the world
+func (m *Moderation) SetIgnores(charID int64, names []string) {
+	set := make(map[string]string, len(names))
+	for _, name := range names {
+		set[strings.ToLower(name)] = name
+	}
+	m.mu.Lock()
+	m.ignores[charID] = set
+	m.mu.Unlock()
+}
+
+// Ignore adds a name to the character's ignore list
+func (m *Moderation) Ignore(charID int64, charName, name string) error {
+	if strings.EqualFold(charName, name) {
+		return ErrIgnoreSelf
+	}
+	key := strings.ToLower(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.ignores[charID][key]; ok {
+		return nil
+	}
+	if len(m.ignores[charID]) >= MaxIgnored {
+		return ErrIgnoreLimit
+	}
+	if m.ignores[charID] == nil {
+		m.ignores[charID] = make(map[string]string)
+	}
+	m.ignores[charID][key] = name
+	return nil
+}
+
+// Unignore takes a name off the character's ignore list
+func (m *Moderation) Unignore(charID int64, name string) error {
+	key := strings.ToLower(name)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.ignores[charID][key]; !ok {
+		return ErrNotIgnored
+	}
+	delete(m.ignores[charID], key)
+	return nil
+}
+
+// Ignores reports whether the character is ignoring the named sender
+func (m *Moderation) Ignores(charID int64, sender string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.ignores[charID][strings.ToLower(sender)]
+	return ok
+}
+
+// IgnoreList returns the names the character is ignoring, sorted
+func (m *Moderation) IgnoreList(charID int64) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var names []string
+	for _, name := range m.ignores[charID] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Check decides whether the character may say text now, counting it towards flood and repeat
+// detection if so
+func (m *Moderation) Check(charID int64, text string) error {
+	now := m.now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := m.spam[charID]
+	if s == nil {
+		s = &spamState{}
+		m.spam[charID] = s
+	}
+	recent := s.sent[:0]
+	for _, t := range s.sent {
+		if now.Sub(t) < floodWindow {
+			recent = append(recent, t)
+		}
+	}
+	s.sent = recent
+	if len(s.sent) >= floodLimit {
+		return ErrFlooding
+	}
+	repeats := 0
+	if strings.EqualFold(text, s.lastText) && now.Sub(s.lastSent) < repeatWindow {
+		repeats = s.repeats
+	}
+	if repeats >= repeatLimit {
+		return ErrRepeating
+	}
+	s.sent = append(s.sent, now)
+	s.lastText = text
+	s.repeats = repeats + 1
+	s.lastSent = now
+	return nil
+}
+
+// Record keeps a line the character said as context for reports. Once every HistoryRetention it
+// also drops the history of characters who have said nothing since, so the history of players
+// who logged out doesn't pile up.
+func (m *Moderation) Record(name, messageType, text string) {
+	key := strings.ToLower(name)
+	now := m.now()
+	line := HistoryLine{Time: now, MessageType: messageType, Text: text}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if now.Sub(m.pruned) >= HistoryRetention {
+		for k, lines := range m.history {
+			if now.Sub(lines[len(lines)-1].Time) >= HistoryRetention {
+				delete(m.history, k)
+			}
+		}
+		m.pruned = now
+	}
+	lines := append(recentLines(m.history[key], now), line)
+	if len(lines) > HistoryLength {
+		lines = lines[len(lines)-HistoryLength:]
+	}
+	m.history[key] = lines
+}
+
+// History returns the named character's chat lines from the last HistoryRetention, oldest first
+func (m *Moderation) History(name string) []HistoryLine {
+	now := m.now()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]HistoryLine(nil), recentLines(m.history[strings.ToLower(name)], now)...)
+}
+
+// recentLines drops the lines said more than HistoryRetention before now
+func recentLines(lines []HistoryLine, now time.Time) []HistoryLine {
+	for len(lines) > 0 && now.Sub(lines[0].Time) >= HistoryRetention {
+		lines = lines[1:]
+	}
+	return lines
+}
+
+// Forget drops the character's ignore list and spam counters, as they leave the world. Their chat
+// history is kept until it ages out so they can still be reported, and mutes belong to the
+// account and last until they expire.
+func (m *Moderation) Forget(charID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.ignores, charID)
+	delete(m.spam, charID)
+}
@@ -0,0 +1,157 @@
+package chat
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFilter(t *testing.T) {
+	m := NewModeration()
+	m.SetWords([]string{"Darn", " heck ", ""})
+	tests := []struct {
+		text, want string
+	}{
+		{"darn it", "**** it"},
+		{"DARN, what the Heck!", "****, what the ****!"},
+		{"darnation and checkers", "darnation and checkers"},
+		{"nothing to see", "nothing to see"},
+	}
+	for _, tt := range tests {
+		if got := m.Filter(tt.text); got != tt.want {
+			t.Errorf("Filter(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestMutes(t *testing.T) {
+	m := NewModeration()
+	now := time.Unix(1000, 0)
+	m.now = func() time.Time { return now }
+
+	m.SetMute(7, now.Add(time.Minute))
+	if _, muted := m.MutedUntil(7); !muted {
+		t.Fatal("Expected the account to be muted")
+	}
+	if _, muted := m.MutedUntil(8); muted {
+		t.Error("Expected another account not to be muted")
+	}
+	now = now.Add(time.Minute)
+	if _, muted := m.MutedUntil(7); muted {
+		t.Error("Expected the mute to have expired")
+	}
+	m.SetMute(7, now.Add(time.Hour))
+	m.SetMute(7, time.Time{})
+	if _, muted := m.MutedUntil(7); muted {
+		t.Error("Expected a zero time to lift the mute")
+	}
+}
+
+func TestIgnores(t *testing.T) {
+	m := NewModeration()
+	m.SetIgnores(1, []string{"Spammer"})
+	if !m.Ignores(1, "spammer") {
+		t.Error("Expected ignores to match regardless of case")
+	}
+	if err := m.Ignore(1, "Alice", "alice"); !errors.Is(err, ErrIgnoreSelf) {
+		t.Errorf("Expected ErrIgnoreSelf, got %v", err)
+	}
+	if err := m.Ignore(1, "Alice", "Bob"); err != nil {
+		t.Fatalf("Ignore failed: %v", err)
+	}
+	if got := m.IgnoreList(1); !slices.Equal(got, []string{"Bob", "Spammer"}) {
+		t.Errorf("IgnoreList = %v", got)
+	}
+	if err := m.Unignore(1, "BOB"); err != nil {
+		t.Errorf("Unignore failed: %v", err)
+	}
+	if err := m.Unignore(1, "Bob"); !errors.Is(err, ErrNotIgnored) {
+		t.Errorf("Expected ErrNotIgnored, got %v", err)
+	}
+	m.Forget(1)
+	if m.Ignores(1, "Spammer") {
+		t.Error("Expected Forget to drop the ignore list")
+	}
+}
+
+func TestCheckSpam(t *testing.T) {
+	m := NewModeration()
+	now := time.Unix(1000, 0)
+	m.now = func() time.Time { return now }
+
+	for i := 0; i < repeatLimit; i++ {
+		if err := m.Check(1, "WTS sword"); err != nil {
+			t.Fatalf("Line %d refused: %v", i, err)
+		}
+		now = now.Add(2 * time.Second)
+	}
+	if err := m.Check(1, "wts SWORD"); !errors.Is(err, ErrRepeating) {
+		t.Errorf("Expected ErrRepeating, got %v", err)
+	}
+	now = now.Add(repeatWindow)
+	if err := m.Check(1, "WTS sword"); err != nil {
+		t.Errorf("Expected the repeat to be allowed after the window, got %v", err)
+	}
+
+	for i := 0; i < floodLimit-1; i++ {
+		if err := m.Check(2, string(rune('a'+i))); err != nil {
+			t.Fatalf("Line %d refused: %v", i, err)
+		}
+	}
+	if err := m.Check(2, "e"); err != nil {
+		t.Fatalf("Expected the last line within the limit to pass, got %v", err)
+	}
+	if err := m.Check(2, "f"); !errors.Is(err, ErrFlooding) {
+		t.Errorf("Expected ErrFlooding, got %v", err)
+	}
+	now = now.Add(floodWindow)
+	if err := m.Check(2, "f"); err != nil {
+		t.Errorf("Expected chat to resume after the flood window, got %v", err)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	m := NewModeration()
+	for i := 0; i < HistoryLength+5; i++ {
+		m.Record("Troll", Say, string(rune('a'+i)))
+	}
+	lines := m.History("troll")
+	if len(lines) != HistoryLength {
+		t.Fatalf("Expected %d lines, got %d", HistoryLength, len(lines))
+	}
+	if lines[0].Text != "f" {
+		t.Errorf("Expected the oldest lines to be dropped, first is %q", lines[0].Text)
+	}
+	m.Forget(1)
+	if len(m.History("Troll")) == 0 {
+		t.Error("Expected history to outlast Forget so players can still be reported")
+	}
+}
+
+func TestHistoryRetention(t *testing.T) {
+	m := NewModeration()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+
+	m.Record("Troll", Say, "old")
+	m.Record("Gone", Say, "bye")
+	now = now.Add(HistoryRetention / 2)
+	m.Record("Troll", Say, "new")
+
+	// Lines past the retention window are no longer reported
+	now = now.Add(HistoryRetention / 2)
+	lines := m.History("Troll")
+	if len(lines) != 1 || lines[0].Text != "new" {
+		t.Errorf("Expected only the line inside the retention window, got %v", lines)
+	}
+
+	// The next line recorded sweeps out characters who have gone quiet
+	m.Record("Troll", Say, "newer")
+	if _, ok := m.history["gone"]; ok {
+		t.Error("Expected a quiet character's history to be dropped")
+	}
+	if lines := m.history["troll"]; len(lines) != 2 || lines[0].Text != "new" {
+		t.Errorf("Expected the stale line trimmed as the next one is recorded, got %v", lines)
+	}
+}
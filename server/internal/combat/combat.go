@@ -5,6 +5,7 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 
@@ -16,7 +17,23 @@ import (
 )
 
 // dependency injection for testing
-var getCharacterBind = db_character.GetCharacterBind
+var (
+	getCharacterBind    = db_character.GetCharacterBind
+	getLootTable        = db_combat.GetLootTable
+	getGlobalLootTables = db_combat.GetGlobalLootTables
+)
+
+// nextSeed picks the RNG seed for a new fight. Setting IDLEQUEST_COMBAT_SEED
+// forces every fight to use that seed, to replay a reported fight exactly.
+var nextSeed = func() int64 {
+	if env := os.Getenv("IDLEQUEST_COMBAT_SEED"); env != "" {
+		if seed, err := strconv.ParseInt(env, 10, 64); err == nil {
+			return seed
+		}
+		log.Printf("Ignoring invalid IDLEQUEST_COMBAT_SEED %q", env)
+	}
+	return time.Now().UnixNano()
+}
 
 // CombatState represents the current state of combat for a player
 type CombatState struct {
@@ -25,14 +42,35 @@ type CombatState struct {
 	NPCCurrentHP int64
 	RoundNumber  int
 	LastAttack   time.Time
+	Pet          *Pet // Owner's pet fighting alongside them, nil if none
+	// Running totals for this fight, recorded to the character's combat statistics when it ends
+	DamageDealt int // Player and pet damage to the NPC
+	DamageTaken int // Damage to the player (not the pet)
+	HighestHit  int
+	// Special attacks
+	SpecialReadyRound int  // Round the class's special attack timer is up
+	NPCStunned        bool // Bashed: the NPC loses its next attack
+	NPCOnPet          bool // The NPC's last attack went at the pet, leaving its back to the player
+	NPCFleeing        bool // The NPC is running at low HP instead of fighting back
+	// Ranged pulls
+	MeleeRound       int // Round the NPC reaches melee range, after closing from a ranged pull
+	RangedReadyRound int // Round the ranged weapon can fire again
 }
 
 // CombatManager manages all active combat sessions
 type CombatManager struct {
 	mu       sync.RWMutex
 	sessions map[int64]*CombatSession // keyed by character ID
+	pets     map[int64]*Pet           // keyed by owner character ID
+	hunts    map[int64]*Hunt          // keyed by character ID
+	zones    map[string]*zoneSpawns   // keyed by zone short name, loaded on first fight
 	ticker   *time.Ticker
 	done     chan struct{}
+
+	// groupCallbacks relays fights to group members pulled in by a groupmate, set by the world server
+	groupCallbacks func(*session.Session) FightCallbacks
+	// raidMinMembers is how many raid members must be in the zone to engage a raid target
+	raidMinMembers int
 }
 
 // CombatSession represents a single player's combat session
@@ -40,9 +78,30 @@ type CombatSession struct {
 	mu      sync.Mutex
 	Session *session.Session
 	State   CombatState
+	Seed    int64      // Seed for rng, logged at combat start so a fight can be replayed
+	rng     *rand.Rand // All rolls for this fight (NPC pick, attacks, loot) come from here
 	onRound func(result *RoundResult)
 	onEnd   func(result *EndResult)
 	onLoot  func(loot []db_combat.LootDropItem, money db_combat.MoneyDrop)
+
+	// Spawn point the NPC was pulled from, emptied for its respawn time when the NPC dies
+	// and freed for other players if the fight ends any other way
+	zone   *zoneSpawns
+	spawn  *spawnPoint
+	charID int64 // Character the spawn point is engaged by
+
+	skills      map[int]int // Trained skill values for special attacks, nil in simulated fights
+	consumeAmmo bool        // Ranged attacks use up ammo; only live fights do
+
+	party *groupFight // Group fight the character is in, nil when fighting alone
+
+	// managed is set for fights the CombatManager runs on its tick loop, which remove
+	// themselves from it when they end. RunFight's simulated fights leave it false.
+	managed bool
+
+	// recordStats adds the fight to the character's combat statistics when it ends.
+	// Only live fights record; simulated and test fights leave it false.
+	recordStats bool
 }
 
 // RoundResult contains the result of a combat round
@@ -58,11 +117,27 @@ type RoundResult struct {
 	NPCMaxHP       int
 	RoundNumber    int
 	NPCDied        bool // NPC died this round, don't show NPC attack message
+	NPCStunned     bool // NPC was stunned and skipped its attack this round
+	NPCFleeing     bool // NPC is fleeing and didn't attack this round
+	Specials       []SpecialAttackResult
+	Ranged         *RangedAttackResult // Shot fired while the NPC closed to melee, nil if none
+	NPCClosing     bool                // NPC is still closing to melee after a ranged pull and didn't attack
+	// Pet info (zero values when the player has no pet)
+	PetHit       bool
+	PetDamage    int
+	NPCTargetPet bool // NPC attacked the pet instead of the player this round
+	PetHP        int
+	PetMaxHP     int
+	PetDied      bool
+	// Group fights (zero values when fighting alone)
+	NPCTarget   string // Member the NPC went for this round, when it wasn't this character or their pet
+	GroupDamage int    // Damage the rest of the group and their pets did this round
 }
 
 // EndResult contains the result of combat ending
 type EndResult struct {
-	Victory     bool
+	Outcome     string // One of the Outcome constants
+	Victory     bool   // The NPC was killed (Outcome is OutcomeVictory)
 	NPCName     string
 	ExpGained   int
 	PlayerHP    int
@@ -82,6 +157,9 @@ func GetManager() *CombatManager {
 	managerOnce.Do(func() {
 		globalManager = &CombatManager{
 			sessions: make(map[int64]*CombatSession),
+			pets:     make(map[int64]*Pet),
+			hunts:    make(map[int64]*Hunt),
+			zones:    make(map[string]*zoneSpawns),
 			done:     make(chan struct{}),
 		}
 		globalManager.Start()
@@ -129,16 +207,31 @@ func (m *CombatManager) processTick() {
 	}
 	m.mu.RUnlock()
 
+	// A group fight has a session per member but plays one round for all of them
+	fights := make(map[*groupFight]bool)
 	for _, cs := range sessions {
-		cs.processCombatRound()
+		if cs.party == nil {
+			cs.processCombatRound()
+		} else if !fights[cs.party] {
+			fights[cs.party] = true
+			cs.party.processRound()
+		}
 	}
+
+	m.processHunts()
 }
 
-// StartCombat begins combat for a player session
+// StartCombat begins combat for a player session against the chosen target, or a random NPC
+// that is up near the player's level if no target is set. The NPC is engaged at its spawn point
+// so other players in the zone can't pull it. An unavailable target returns a *TargetUnavailableError.
+// A grouped or raiding character joins their group's or raid's fight in the zone if there is one;
+// otherwise the members in the zone who aren't fighting are pulled in with them. Raid targets
+// can only be engaged by a raid with enough members in the zone.
 func (m *CombatManager) StartCombat(
 	ses *session.Session,
 	zoneShortName string,
 	playerLevel int,
+	target CombatTarget,
 	onRound func(*RoundResult),
 	onEnd func(*EndResult),
 	onLoot func([]db_combat.LootDropItem, db_combat.MoneyDrop),
@@ -153,39 +246,98 @@ func (m *CombatManager) StartCombat(
 	}
 	m.mu.Unlock()
 
-	// Select a random NPC for combat
-	npc, err := db_combat.GetRandomNPCForZone(context.Background(), zoneShortName, playerLevel, 5)
+	// A grouped or raiding character assists their party's fight in the zone before pulling
+	// anything new
+	party := getParty(charID)
+	if party != nil {
+		if fight := m.findGroupFight(party, ses); fight != nil {
+			cs := m.newLiveSession(ses, fight.state.NPC, fight.rng, fight.seed, onRound, onEnd, onLoot)
+			if fight.join(cs) {
+				return cs.State.NPC, nil
+			}
+		}
+	}
+
+	// Select the target, or a random NPC for combat
+	seed := nextSeed()
+	rng := rand.New(rand.NewSource(seed))
+	now := time.Now()
+	zone, err := m.getZoneSpawns(zoneShortName, now)
+	if err != nil {
+		return nil, err
+	}
+	raidReady := m.raidReady(party, ses.Client.CharData().ZoneID)
+	var npc *db_combat.NPCForCombat
+	var spawn *spawnPoint
+	if target.IsSet() {
+		npc, spawn, err = zone.claimTarget(charID, target, raidReady, rng, now)
+	} else {
+		npc, spawn, err = zone.claimRandom(charID, playerLevel, 5, raidReady, rng, now)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	cs := m.newLiveSession(ses, npc, rng, seed, onRound, onEnd, onLoot)
+	if party != nil {
+		m.startGroupFight(party, cs, zone, spawn)
+	} else {
+		cs.zone, cs.spawn = zone, spawn
+		m.mu.Lock()
+		m.sessions[charID] = cs
+		m.mu.Unlock()
+	}
+
+	log.Printf("Combat started for character %d vs %s (level %d, HP %d, seed %d)", charID, npc.Name, npc.Level, npc.HP, seed)
+	return npc, nil
+}
+
+// newLiveSession builds the CombatSession for a character's live fight
+func (m *CombatManager) newLiveSession(
+	ses *session.Session,
+	npc *db_combat.NPCForCombat,
+	rng *rand.Rand,
+	seed int64,
+	onRound func(*RoundResult),
+	onEnd func(*EndResult),
+	onLoot func([]db_combat.LootDropItem, db_combat.MoneyDrop),
+) *CombatSession {
+	charID := int64(ses.Client.CharData().ID)
 	cs := &CombatSession{
 		Session: ses,
+		Seed:    seed,
+		rng:     rng,
 		State: CombatState{
 			Active:       true,
 			NPC:          npc,
 			NPCCurrentHP: npc.HP,
 			RoundNumber:  0,
 			LastAttack:   time.Now(),
+			Pet:          m.ensurePet(ses),
 		},
-		onRound: onRound,
-		onEnd:   onEnd,
-		onLoot:  onLoot,
+		onRound:     onRound,
+		onEnd:       onEnd,
+		onLoot:      onLoot,
+		recordStats: true,
+		consumeAmmo: true,
+		managed:     true,
+		charID:      charID,
 	}
-
-	m.mu.Lock()
-	m.sessions[charID] = cs
-	m.mu.Unlock()
-
-	log.Printf("Combat started for character %d vs %s (level %d, HP %d)", charID, npc.Name, npc.Level, npc.HP)
-	return npc, nil
+	cs.loadSkills(charID, uint8(ses.Client.CharData().Class))
+	return cs
 }
 
-// StopCombat ends combat for a player
+// StopCombat ends combat for a player. The rest of their group fights on without them.
 func (m *CombatManager) StopCombat(charID int64) {
 	m.mu.Lock()
+	cs, ok := m.sessions[charID]
 	delete(m.sessions, charID)
 	m.mu.Unlock()
+	if ok && cs.party != nil {
+		cs.party.leave(cs)
+	} else if ok {
+		cs.releaseSpawn()
+	}
 	log.Printf("Combat stopped for character %d", charID)
 }
 
@@ -203,7 +355,13 @@ func (m *CombatManager) DebugSetNPCLowHP(charID int64) {
 	cs, ok := m.sessions[charID]
 	m.mu.RUnlock()
 
-	if ok {
+	if ok && cs.party != nil {
+		cs.party.mu.Lock()
+		if cs.party.active {
+			cs.party.state.NPCCurrentHP = 1
+		}
+		cs.party.mu.Unlock()
+	} else if ok {
 		cs.mu.Lock()
 		if cs.State.Active {
 			cs.State.NPCCurrentHP = 1
@@ -226,9 +384,15 @@ func (cs *CombatSession) processCombatRound() {
 		return
 	}
 
+	// Sessions built without StartCombat (tests, replays) roll from their Seed
+	if cs.rng == nil {
+		cs.rng = rand.New(rand.NewSource(cs.Seed))
+	}
+
 	// Check if session is still valid before processing
 	if cs.Session == nil || cs.Session.Client == nil || cs.Session.Client.CharData() == nil {
 		cs.State.Active = false
+		cs.releaseSpawn()
 		return
 	}
 
@@ -238,16 +402,18 @@ func (cs *CombatSession) processCombatRound() {
 	client := cs.Session.Client
 	maxHP := client.GetMaxHp()
 
-	// Calculate player attack
-	playerHit, playerDamage, playerCrit := cs.calculatePlayerAttack()
-
-	// Apply player damage to NPC
-	if playerHit {
-		cs.State.NPCCurrentHP -= int64(playerDamage)
-		if cs.State.NPCCurrentHP < 0 {
-			cs.State.NPCCurrentHP = 0
-		}
+	// A character with a ranged weapon pulls with it: the NPC spends its first rounds closing
+	// to melee while the character shoots, and neither side can melee until it arrives
+	if cs.State.RoundNumber == 1 && cs.findRangedAttack() != nil {
+		cs.State.MeleeRound = 1 + npcCloseRounds
 	}
+	npcClosing := cs.State.RoundNumber < cs.State.MeleeRound
+
+	turn := cs.takePlayerTurn(npcClosing)
+	playerHit, playerDamage, playerCrit := turn.hit, turn.damage, turn.crit
+	specials, ranged := turn.specials, turn.ranged
+	petHit, petDamage := turn.petHit, turn.petDamage
+	pet := cs.State.Pet
 
 	// Check if NPC is dead
 	if cs.State.NPCCurrentHP <= 0 {
@@ -270,23 +436,58 @@ func (cs *CombatSession) processCombatRound() {
 				NPCMaxHP:       int(cs.State.NPC.HP),
 				RoundNumber:    cs.State.RoundNumber,
 				NPCDied:        true, // NPC died this round, skip NPC attack message
+				Specials:       specials,
+				Ranged:         ranged,
+				PetHit:         petHit,
+				PetDamage:      petDamage,
+				PetHP:          petHP(pet),
+				PetMaxHP:       petMaxHP(pet),
 			})
 		}
 		cs.handleNPCDeath()
 		return
 	}
 
-	// Calculate NPC attack
-	npcHit, npcDamage := cs.calculateNPCAttack()
-
-	// Apply NPC damage to player using synchronized method
+	// A badly hurt NPC turns to flee instead of fighting back. It can get away from the next round on.
+	wasFleeing := cs.State.NPCFleeing
+	if cs.npcStartsFleeing() {
+		cs.State.NPCFleeing = true
+	}
+	npcFleeing := cs.State.NPCFleeing
+
+	// NPC attacks the pet while it holds aggro, otherwise the player. A stunned or fleeing NPC
+	// loses its attack, as does one still closing to melee.
+	npcStunned := cs.State.NPCStunned
+	cs.State.NPCStunned = false
+	npcAttacks := !npcStunned && !npcFleeing && !npcClosing
+	npcTargetPet := npcAttacks && pet.Alive() && cs.rng.Intn(100) < petAggroChance
+	cs.State.NPCOnPet = npcTargetPet
+	var npcHit bool
+	var npcDamage int
+	var petDied bool
 	var currentHP int
 	var alive bool
-	if npcHit {
-		currentHP, alive = client.TakeDamage(npcDamage)
-	} else {
+	if !npcAttacks {
+		currentHP = client.GetCurrentHp()
+		alive = currentHP > 0
+	} else if npcTargetPet {
+		npcHit, npcDamage = cs.calculateNPCAttackOnPet()
+		if npcHit {
+			petDied = GetManager().damagePet(pet, int64(npcDamage))
+		}
 		currentHP = client.GetCurrentHp()
 		alive = currentHP > 0
+	} else {
+		npcHit, npcDamage = cs.calculateNPCAttack()
+
+		// Apply NPC damage to player using synchronized method
+		if npcHit {
+			currentHP, alive = client.TakeDamage(npcDamage)
+			cs.State.DamageTaken += npcDamage
+		} else {
+			currentHP = client.GetCurrentHp()
+			alive = currentHP > 0
+		}
 	}
 
 	// Send round update (including the killing blow if player dies)
@@ -302,6 +503,17 @@ func (cs *CombatSession) processCombatRound() {
 			NPCHP:          int(cs.State.NPCCurrentHP),
 			NPCMaxHP:       int(cs.State.NPC.HP),
 			RoundNumber:    cs.State.RoundNumber,
+			NPCStunned:     npcStunned,
+			NPCFleeing:     npcFleeing,
+			NPCClosing:     npcClosing,
+			Specials:       specials,
+			Ranged:         ranged,
+			PetHit:         petHit,
+			PetDamage:      petDamage,
+			NPCTargetPet:   npcTargetPet,
+			PetHP:          petHP(pet),
+			PetMaxHP:       petMaxHP(pet),
+			PetDied:        petDied,
 		})
 	}
 
@@ -310,6 +522,85 @@ func (cs *CombatSession) processCombatRound() {
 		cs.handlePlayerDeath()
 		return
 	}
+
+	if wasFleeing && cs.rng.Intn(100) < npcEscapeChance {
+		cs.handleEscape(OutcomeNPCFled)
+		return
+	}
+	if cs.shouldRetreat(currentHP, maxHP) {
+		cs.handleEscape(OutcomeRetreated)
+	}
+}
+
+// playerTurn is one character's attacks in a round
+type playerTurn struct {
+	hit       bool
+	damage    int
+	crit      bool
+	specials  []SpecialAttackResult
+	ranged    *RangedAttackResult
+	petHit    bool
+	petDamage int
+}
+
+// total is the damage the turn did to the NPC, the pet's included
+func (t playerTurn) total() int {
+	total := t.damage + t.petDamage
+	if t.ranged != nil {
+		total += t.ranged.Damage
+	}
+	for _, special := range t.specials {
+		total += special.Damage
+	}
+	return total
+}
+
+// takePlayerTurn makes the character's attacks for the round and applies them to the NPC: a
+// ranged shot while the NPC is still closing to melee, otherwise a melee swing, the class special
+// attack when its timer is up and the pet's attack, stopping once the NPC is dead
+func (cs *CombatSession) takePlayerTurn(npcClosing bool) playerTurn {
+	var turn playerTurn
+	if npcClosing {
+		turn.ranged = cs.tryRangedAttack()
+		if turn.ranged != nil && turn.ranged.Hit {
+			cs.damageNPC(turn.ranged.Damage, true)
+		}
+		return turn
+	}
+
+	turn.hit, turn.damage, turn.crit = cs.calculatePlayerAttack()
+	if turn.hit {
+		cs.damageNPC(turn.damage, true)
+	}
+
+	// Class special attack (kick, bash, backstab) when its timer is up
+	if cs.State.NPCCurrentHP > 0 {
+		if special := cs.trySpecialAttack(); special != nil {
+			turn.specials = append(turn.specials, *special)
+			if special.Hit {
+				cs.damageNPC(special.Damage, true)
+			}
+		}
+	}
+
+	// Pet attacks after its owner
+	if cs.State.Pet.Alive() && cs.State.NPCCurrentHP > 0 {
+		turn.petHit, turn.petDamage = cs.calculatePetAttack()
+		if turn.petHit {
+			cs.damageNPC(turn.petDamage, false)
+		}
+	}
+	return turn
+}
+
+// damageNPC takes damage off the NPC's HP and adds it to the fight's totals. Only the
+// character's own hits count toward their highest hit.
+func (cs *CombatSession) damageNPC(damage int, ownHit bool) {
+	cs.State.NPCCurrentHP = max(cs.State.NPCCurrentHP-int64(damage), 0)
+	cs.State.DamageDealt += damage
+	if ownHit {
+		cs.State.HighestHit = max(cs.State.HighestHit, damage)
+	}
 }
 
 // calculatePlayerAC calculates AC from level, race, and equipped items
@@ -327,7 +618,7 @@ func (cs *CombatSession) calculatePlayerAttack() (hit bool, damage int, critical
 	// Simple hit calculation: 80% base hit chance, modified by level difference
 	hitChance := mechanics.CalculateHitChance(int(charData.Level), int(npc.Level), 80, 20, 95)
 
-	if rand.Intn(100) >= hitChance {
+	if cs.rng.Intn(100) >= hitChance {
 		return false, 0, false
 	}
 
@@ -341,7 +632,7 @@ func (cs *CombatSession) calculatePlayerAttack() (hit bool, damage int, critical
 
 	// Add STR bonus
 	strBonus := int(charData.Str) / 10
-	damage = baseDamage + strBonus + rand.Intn(baseDamage+1)
+	damage = baseDamage + strBonus + cs.rng.Intn(baseDamage+1)
 
 	// Apply NPC AC mitigation
 	// Apply NPC AC mitigation
@@ -353,7 +644,7 @@ func (cs *CombatSession) calculatePlayerAttack() (hit bool, damage int, critical
 	}
 
 	// Critical hit chance (5%)
-	if rand.Intn(100) < 5 {
+	if cs.rng.Intn(100) < 5 {
 		damage *= 2
 		critical = true
 	}
@@ -369,7 +660,7 @@ func (cs *CombatSession) calculateNPCAttack() (hit bool, damage int) {
 	// Simple hit calculation for NPC
 	hitChance := mechanics.CalculateHitChance(int(npc.Level), int(charData.Level), 70, 20, 90)
 
-	if rand.Intn(100) >= hitChance {
+	if cs.rng.Intn(100) >= hitChance {
 		return false, 0
 	}
 
@@ -383,7 +674,7 @@ func (cs *CombatSession) calculateNPCAttack() (hit bool, damage int) {
 		maxDmg = minDmg
 	}
 
-	damage = minDmg + rand.Intn(maxDmg-minDmg+1)
+	damage = minDmg + cs.rng.Intn(maxDmg-minDmg+1)
 
 	// Apply player AC mitigation
 	// Apply player AC mitigation
@@ -419,6 +710,7 @@ func (cs *CombatSession) handleNPCDeath() {
 	maxHP := client.GetMaxHp()
 	if cs.onEnd != nil {
 		cs.onEnd(&EndResult{
+			Outcome:     OutcomeVictory,
 			Victory:     true,
 			NPCName:     npc.Name,
 			ExpGained:   expGained,
@@ -428,10 +720,15 @@ func (cs *CombatSession) handleNPCDeath() {
 	}
 
 	// Generate and send loot
-	cs.generateLoot()
+	coin := cs.generateLoot()
+
+	cs.recordFight(OutcomeVictory, expGained, coin)
 
-	// Remove from manager
-	GetManager().StopCombat(int64(charData.ID))
+	if cs.spawn != nil {
+		cs.zone.killed(cs.spawn, cs.rng, time.Now())
+	}
+
+	cs.unregister()
 }
 
 func (cs *CombatSession) handlePlayerDeath() {
@@ -441,6 +738,11 @@ func (cs *CombatSession) handlePlayerDeath() {
 	// Mark combat as ended
 	cs.State.Active = false
 
+	// Pets don't survive their owner's death
+	if cs.State.Pet != nil {
+		GetManager().damagePet(cs.State.Pet, cs.State.Pet.CurrentHP)
+	}
+
 	// Get bind point for respawn
 	bind, err := getCharacterBind(context.Background(), charData.ID)
 	if err != nil {
@@ -464,6 +766,7 @@ func (cs *CombatSession) handlePlayerDeath() {
 	// Send end result with 0 HP (death state) and bind zone info
 	if cs.onEnd != nil {
 		cs.onEnd(&EndResult{
+			Outcome:     OutcomeDeath,
 			Victory:     false,
 			NPCName:     npc.Name,
 			ExpGained:   0,
@@ -477,34 +780,77 @@ func (cs *CombatSession) handlePlayerDeath() {
 		})
 	}
 
-	// Remove from manager
-	GetManager().StopCombat(int64(charData.ID))
+	cs.recordFight(OutcomeDeath, 0, 0)
+	cs.releaseSpawn()
+
+	cs.unregister()
 }
 
-func (cs *CombatSession) generateLoot() {
-	npc := cs.State.NPC
+// unregister removes a solo fight from the manager once it ends. A group fight removes its
+// members itself, and simulated fights were never registered.
+func (cs *CombatSession) unregister() {
+	if cs.managed && cs.party == nil {
+		GetManager().StopCombat(int64(cs.Session.Client.CharData().ID))
+	}
+}
 
-	// Get potential loot
-	potentialLoot, err := db_combat.GetNPCLoot(context.Background(), npc.LoottableID)
-	if err != nil {
-		log.Printf("Failed to get loot for NPC %s: %v", npc.Name, err)
+// releaseSpawn frees the session's NPC for other players. A no-op once the NPC has been killed.
+func (cs *CombatSession) releaseSpawn() {
+	if cs.spawn != nil {
+		cs.zone.release(cs.spawn, cs.charID)
+	}
+}
+
+// recordFight adds the finished fight to the character's combat statistics
+func (cs *CombatSession) recordFight(outcome string, expGained int, coin uint64) {
+	if !cs.recordStats {
 		return
 	}
+	GetStatsRecorder().Record(FightStats{
+		CharID:      cs.Session.Client.CharData().ID,
+		NPCID:       cs.State.NPC.ID,
+		NPCName:     cs.State.NPC.Name,
+		Victory:     outcome == OutcomeVictory,
+		Escaped:     outcome == OutcomeNPCFled || outcome == OutcomeRetreated,
+		DamageDealt: cs.State.DamageDealt,
+		DamageTaken: cs.State.DamageTaken,
+		HighestHit:  cs.State.HighestHit,
+		ExpGained:   expGained,
+		Coin:        coin,
+	})
+}
+
+// generateLoot rolls and sends the NPC's loot table and any global loot that applies to it,
+// returning the value of the coin dropped in copper
+func (cs *CombatSession) generateLoot() uint64 {
+	droppedLoot, copper := cs.rollLoot()
+
+	// Send loot to callback
+	money := db_combat.NewMoneyDrop(copper)
+	if cs.onLoot != nil && (len(droppedLoot) > 0 || copper > 0) {
+		cs.onLoot(droppedLoot, money)
+	}
+	return uint64(copper)
+}
 
-	// Roll for loot
-	droppedLoot := db_combat.RollLoot(potentialLoot)
+// rollLoot rolls the NPC's loot table and any global loot that applies to it
+func (cs *CombatSession) rollLoot() ([]db_combat.LootDropItem, uint32) {
+	npc := cs.State.NPC
+	ctx := context.Background()
 
-	// Generate money drop from loottable database (mincash/maxcash)
-	var money db_combat.MoneyDrop
-	currency, err := db_combat.GetLoottableCurrency(context.Background(), npc.LoottableID)
+	lootTable, err := getLootTable(ctx, npc.LoottableID)
 	if err != nil {
-		log.Printf("Failed to get loottable currency for NPC %s: %v", npc.Name, err)
-	} else if currency != nil {
-		money.Platinum, money.Gold, money.Silver, money.Copper = db_combat.GenerateCurrencyDrop(currency)
+		log.Printf("Failed to get loot for NPC %s: %v", npc.Name, err)
 	}
+	droppedLoot, copper := db_combat.RollLootTable(cs.rng, lootTable, npc.Level, false)
 
-	// Send loot to callback
-	if cs.onLoot != nil && (len(droppedLoot) > 0 || money.Copper > 0 || money.Silver > 0 || money.Gold > 0 || money.Platinum > 0) {
-		cs.onLoot(droppedLoot, money)
+	globalTables, err := getGlobalLootTables(ctx, npc, int(cs.Session.Client.CharData().ZoneID))
+	if err != nil {
+		log.Printf("Failed to get global loot for NPC %s: %v", npc.Name, err)
+	}
+	for _, lt := range globalTables {
+		items, _ := db_combat.RollLootTable(cs.rng, lt, npc.Level, true)
+		droppedLoot = append(droppedLoot, items...)
 	}
+	return droppedLoot, copper
 }
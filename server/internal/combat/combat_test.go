@@ -2,20 +2,28 @@ package combat
 
 import (
 	"context"
+	"errors"
+	"math/rand"
+	"reflect"
 	"testing"
 	"time"
 
 	"idlequest/internal/constants"
 	db_combat "idlequest/internal/db/combat"
 	"idlequest/internal/db/jetgen/eqgo/model"
+	db_stats "idlequest/internal/db/stats"
+	db_zone "idlequest/internal/db/zone"
+	"idlequest/internal/group"
 	"idlequest/internal/session"
 	entity "idlequest/internal/zone/interface"
 )
 
 // MockClient implements entity.Client interface for testing
 type MockClient struct {
-	charData *model.CharacterData
-	mob      *entity.Mob
+	charData   *model.CharacterData
+	mob        *entity.Mob
+	equipped   map[constants.InventoryKey]*constants.ItemWithInstance
+	retreatPct int
 }
 
 func (m *MockClient) Level() uint8                 { return uint8(m.charData.Level) }
@@ -23,7 +31,10 @@ func (m *MockClient) Class() uint8                 { return uint8(m.charData.Cla
 func (m *MockClient) Race() uint8                  { return uint8(m.charData.Race) }
 func (m *MockClient) Position() entity.MobPosition { return entity.MobPosition{X: 0, Y: 0, Z: 0} }
 func (m *MockClient) Items() map[constants.InventoryKey]*constants.ItemWithInstance {
-	return make(map[constants.InventoryKey]*constants.ItemWithInstance)
+	if m.equipped == nil {
+		return make(map[constants.InventoryKey]*constants.ItemWithInstance)
+	}
+	return m.equipped
 }
 func (m *MockClient) WithItems(caller func(map[constants.InventoryKey]*constants.ItemWithInstance)) {}
 func (m *MockClient) SetPosition(entity.MobPosition)                                                {}
@@ -39,12 +50,20 @@ func (m *MockClient) Say(msg string)
 func (m *MockClient) Type() int32                                                                   { return 1 } // Player
 func (m *MockClient) MoveItem(fromKey, toKey constants.InventoryKey) error                          { return nil }
 func (m *MockClient) SwapItems(fromKey, toKey constants.InventoryKey) error                         { return nil }
-func (m *MockClient) DeleteItem(key constants.InventoryKey) *constants.ItemWithInstance             { return nil }
-func (m *MockClient) GetItem(key constants.InventoryKey) *constants.ItemWithInstance                { return nil }
-func (m *MockClient) SetItem(key constants.InventoryKey, item *constants.ItemWithInstance)          {}
-func (m *MockClient) GetEquippedAC() int                                                            { return 0 }
-func (m *MockClient) AutoSellEnabled() bool                                                         { return false }
-func (m *MockClient) SetAutoSellEnabled(enabled bool)                                               {}
+func (m *MockClient) DeleteItem(key constants.InventoryKey) *constants.ItemWithInstance {
+	item := m.equipped[key]
+	delete(m.equipped, key)
+	return item
+}
+func (m *MockClient) GetItem(key constants.InventoryKey) *constants.ItemWithInstance {
+	return m.equipped[key]
+}
+func (m *MockClient) SetItem(key constants.InventoryKey, item *constants.ItemWithInstance) {}
+func (m *MockClient) GetEquippedAC() int                                                   { return 0 }
+func (m *MockClient) AutoSellEnabled() bool                                                { return false }
+func (m *MockClient) SetAutoSellEnabled(enabled bool)                                      {}
+func (m *MockClient) AutoRetreatPercent() int                                              { return m.retreatPct }
+func (m *MockClient) SetAutoRetreatPercent(percent int)                                    { m.retreatPct = percent }
 
 // HP/Mana management methods
 func (m *MockClient) SetCurrentHp(hp int) {
@@ -270,4 +289,1063 @@ func TestCombatFlow(t *testing.T) {
 			t.Errorf("Character XP did not increase: %d -> %d", initialExp, charData.Exp)
 		}
 	})
+
+	t.Run("Pet Fights And Can Die", func(t *testing.T) {
+		pet := &Pet{
+			Name:      "TestHero`s pet",
+			Level:     10,
+			MaxHP:     5,
+			CurrentHP: 5,
+			MinDmg:    1,
+			MaxDmg:    3,
+		}
+
+		var petDamage int
+		var petDied bool
+		cs.onRound = func(res *RoundResult) {
+			petDamage += res.PetDamage
+			if res.PetDied {
+				petDied = true
+			}
+			if res.PetMaxHP != 5 {
+				t.Errorf("Expected pet max HP 5 in round result, got %d", res.PetMaxHP)
+			}
+		}
+		defer func() { cs.onRound = nil }()
+
+		cs.State.Active = true
+		cs.State.NPCCurrentHP = 100000 // Nobody wins, the pet should die first
+		cs.State.Pet = pet
+		mockClient.SetCurrentHp(1000)
+
+		for i := 0; i < 500 && !petDied; i++ {
+			cs.processCombatRound()
+		}
+
+		if !petDied {
+			t.Fatal("Pet survived 500 rounds at 5 HP")
+		}
+		if pet.Alive() {
+			t.Errorf("Pet reported dead but still has %d HP", pet.CurrentHP)
+		}
+		if petDamage <= 0 {
+			t.Error("Pet never damaged the NPC")
+		}
+
+		// A dead pet sits out the following rounds
+		petDamage = 0
+		cs.processCombatRound()
+		if petDamage != 0 {
+			t.Errorf("Dead pet dealt %d damage", petDamage)
+		}
+		cs.State.Pet = nil
+	})
+}
+
+// TestCombatReplayIsDeterministic verifies that two fights with the same seed play out identically
+func TestCombatReplayIsDeterministic(t *testing.T) {
+	originalGetBind := getCharacterBind
+	getCharacterBind = func(ctx context.Context, charID uint32) (*model.CharacterBind, error) {
+		return &model.CharacterBind{ZoneID: 1}, nil
+	}
+	defer func() { getCharacterBind = originalGetBind }()
+
+	runFight := func(seed int64) []RoundResult {
+		mockClient := &MockClient{
+			charData: &model.CharacterData{ID: 54321, Name: "ReplayHero", Level: 10, CurHp: 200, Str: 75, Class: 1, Race: 1},
+			mob:      &entity.Mob{MaxHp: 200, CurrentHp: 200},
+		}
+		var rounds []RoundResult
+		cs := &CombatSession{
+			Session: &session.Session{SessionID: 2, Client: mockClient},
+			Seed:    seed,
+			State: CombatState{
+				Active:       true,
+				NPC:          &db_combat.NPCForCombat{ID: 1, Name: "ReplayDummy", Level: 11, HP: 300, AC: 20, MinDmg: 2, MaxDmg: 12},
+				NPCCurrentHP: 300,
+				Pet:          &Pet{Name: "ReplayHero`s pet", Level: 8, MaxHP: 80, CurrentHP: 80, MinDmg: 1, MaxDmg: 6},
+			},
+			onRound: func(res *RoundResult) { rounds = append(rounds, *res) },
+		}
+		for i := 0; i < 200 && cs.State.Active; i++ {
+			cs.processCombatRound()
+		}
+		return rounds
+	}
+
+	first := runFight(42)
+	second := runFight(42)
+	if len(first) == 0 {
+		t.Fatal("Fight produced no rounds")
+	}
+	if len(first) != len(second) {
+		t.Fatalf("Replay lasted %d rounds, original lasted %d", len(second), len(first))
+	}
+	for i := range first {
+		if !reflect.DeepEqual(first[i], second[i]) {
+			t.Fatalf("Round %d differs on replay:\n  original %+v\n  replay   %+v", i+1, first[i], second[i])
+		}
+	}
+
+	// Loot and currency rolls replay the same way
+	lootTable := &db_combat.LootTable{
+		MinCash: 10, MaxCash: 5000, AvgCoin: 800,
+		Drops: []db_combat.LootTableDrop{{
+			Multiplier: 1, DropLimit: 2, Probability: 75,
+			Entries: []db_combat.LootDropEntry{{ItemID: 1, Chance: 50}, {ItemID: 2, Chance: 25}, {ItemID: 3, Chance: 75}},
+		}},
+	}
+	rngA := rand.New(rand.NewSource(7))
+	rngB := rand.New(rand.NewSource(7))
+	for i := 0; i < 20; i++ {
+		lootA, copperA := db_combat.RollLootTable(rngA, lootTable, 10, false)
+		lootB, copperB := db_combat.RollLootTable(rngB, lootTable, 10, false)
+		if len(lootA) != len(lootB) || copperA != copperB {
+			t.Fatalf("Loot roll %d differs: %v %d vs %v %d", i, lootA, copperA, lootB, copperB)
+		}
+	}
+}
+
+func TestPetSharing(t *testing.T) {
+	m := &CombatManager{
+		sessions: make(map[int64]*CombatSession),
+		pets:     make(map[int64]*Pet),
+		hunts:    make(map[int64]*Hunt),
+	}
+	pet := &Pet{Name: "Owner`s pet", MaxHP: 100, CurrentHP: 100}
+	m.pets[1] = pet
+
+	// Handlers get a copy, so the combat tick's HP changes never race with their reads
+	copied := m.GetPet(1)
+	if copied == pet || copied.CurrentHP != 100 {
+		t.Fatalf("Expected a copy of the pet, got %p (%+v)", copied, copied)
+	}
+	if m.damagePet(pet, 30) || pet.CurrentHP != 70 || copied.CurrentHP != 100 {
+		t.Errorf("Expected the kept pet at 70 HP and the copy untouched, got %d and %d", pet.CurrentHP, copied.CurrentHP)
+	}
+	if !m.damagePet(pet, 500) || pet.CurrentHP != 0 {
+		t.Errorf("Expected the pet killed at 0 HP, got %d", pet.CurrentHP)
+	}
+
+	// Releasing saves and forgets the pet once; a second release keeps what was saved
+	pet.CurrentHP = 40
+	var saved []model.CharacterPetInfo
+	deleted := 0
+	origSave, origDelete := saveCharacterPetInfo, deleteCharacterPetInfo
+	saveCharacterPetInfo = func(ctx context.Context, info model.CharacterPetInfo) error {
+		saved = append(saved, info)
+		return nil
+	}
+	deleteCharacterPetInfo = func(ctx context.Context, charID int32) error {
+		deleted++
+		return nil
+	}
+	defer func() { saveCharacterPetInfo, deleteCharacterPetInfo = origSave, origDelete }()
+	for range 2 {
+		if err := m.ReleasePet(context.Background(), 1); err != nil {
+			t.Fatalf("ReleasePet failed: %v", err)
+		}
+	}
+	if len(saved) != 1 || saved[0].Hp != 40 || deleted != 0 || m.GetPet(1) != nil {
+		t.Errorf("Expected one save at 40 HP and the pet forgotten, got %+v with %d deletes", saved, deleted)
+	}
+}
+
+// TestRunFight verifies the simulator entry point plays a fight to completion and reports it
+func TestRunFight(t *testing.T) {
+	mockClient := &MockClient{
+		charData: &model.CharacterData{ID: 777, Name: "SimHero", Level: 20, Exp: 5000000, Str: 100, Class: 1, Race: 1},
+		mob:      &entity.Mob{MaxHp: 500, CurrentHp: 500},
+	}
+	ses := &session.Session{SessionID: 3, Client: mockClient}
+	npc := &db_combat.NPCForCombat{ID: 2, Name: "SimDummy", Level: 5, HP: 60, MinDmg: 1, MaxDmg: 3}
+
+	// A live fight the character has going is none of the simulator's business
+	m := GetManager()
+	live := &CombatSession{Session: ses, managed: true}
+	m.mu.Lock()
+	m.sessions[777] = live
+	m.mu.Unlock()
+	defer m.StopCombat(777)
+
+	report := RunFight(ses, npc, nil, 99, 1000)
+	if !report.Victory {
+		t.Fatalf("Expected a level 20 to beat a level 5 NPC, got %+v", report)
+	}
+	m.mu.RLock()
+	registered := m.sessions[777]
+	m.mu.RUnlock()
+	if registered != live {
+		t.Error("Expected a simulated kill to leave the manager's sessions alone")
+	}
+	if report.Rounds == 0 || report.DamageDealt < int(npc.HP) {
+		t.Errorf("Expected rounds and at least %d damage dealt, got %+v", npc.HP, report)
+	}
+	if report.ExpGained != db_combat.CalculateExperience(int(npc.Level)) {
+		t.Errorf("Expected %d exp, got %d", db_combat.CalculateExperience(int(npc.Level)), report.ExpGained)
+	}
+
+	// Same seed, same fight
+	mockClient.RestoreToFull()
+	replay := RunFight(ses, npc, nil, 99, 1000)
+	if replay.Rounds != report.Rounds || replay.DamageTaken != report.DamageTaken {
+		t.Errorf("Replay differs: %+v vs %+v", replay, report)
+	}
+}
+
+func TestStatsRecorderBatchesFights(t *testing.T) {
+	var flushed []db_stats.Totals
+	var flushedNPCs []map[int32]db_stats.NPCTotals
+	failFlush := true
+	originalAdd := addCombatStats
+	addCombatStats = func(ctx context.Context, charID uint32, totals db_stats.Totals, npcs map[int32]db_stats.NPCTotals) error {
+		if failFlush {
+			return errors.New("db down")
+		}
+		flushed = append(flushed, totals)
+		flushedNPCs = append(flushedNPCs, npcs)
+		return nil
+	}
+	defer func() { addCombatStats = originalAdd }()
+
+	r := newStatsRecorder()
+	r.Record(FightStats{CharID: 1, NPCID: 10, NPCName: "a_rat", Victory: true, DamageDealt: 30, DamageTaken: 5, HighestHit: 12, ExpGained: 40, Coin: 7})
+	r.Record(FightStats{CharID: 1, NPCID: 10, NPCName: "a_rat", Victory: true, DamageDealt: 25, DamageTaken: 2, HighestHit: 9, ExpGained: 40, Coin: 3})
+
+	// A failed flush keeps the stats and merges fights recorded afterwards
+	if err := r.FlushCharacter(context.Background(), 1); err == nil {
+		t.Fatal("Expected flush error")
+	}
+	r.Record(FightStats{CharID: 1, NPCID: 11, NPCName: "a_bat", Victory: false, DamageDealt: 4, DamageTaken: 50, HighestHit: 4})
+
+	failFlush = false
+	if err := r.FlushCharacter(context.Background(), 1); err != nil {
+		t.Fatalf("FlushCharacter failed: %v", err)
+	}
+	if len(flushed) != 1 {
+		t.Fatalf("Expected one flush, got %d", len(flushed))
+	}
+	want := db_stats.Totals{Kills: 2, Deaths: 1, DamageDealt: 59, DamageTaken: 57, HighestHit: 12, ExpEarned: 80, CoinEarned: 10}
+	if flushed[0] != want {
+		t.Errorf("Expected totals %+v, got %+v", want, flushed[0])
+	}
+	rat := flushedNPCs[0][10]
+	if rat.Kills != 2 || rat.NpcName != "a_rat" || rat.LastKilledAt == nil {
+		t.Errorf("Unexpected a_rat totals: %+v", rat)
+	}
+	bat := flushedNPCs[0][11]
+	if bat.Deaths != 1 || bat.Kills != 0 || bat.LastKilledAt != nil {
+		t.Errorf("Unexpected a_bat totals: %+v", bat)
+	}
+
+	// Nothing left to write
+	if err := r.FlushCharacter(context.Background(), 1); err != nil || len(flushed) != 1 {
+		t.Errorf("Expected no second flush, got %d flushes (err %v)", len(flushed), err)
+	}
+}
+
+// stubSpawnPool serves pool as every zone's spawn pool with no saved respawn timers.
+// Returns a func restoring the real lookups.
+func stubSpawnPool(pool map[int64]*db_zone.SpawnPoolEntry) func() {
+	originalPool, originalGet, originalSave := getZoneSpawnPool, getRespawnTimes, saveRespawnTime
+	getZoneSpawnPool = func(zoneName string) (map[int64]*db_zone.SpawnPoolEntry, error) { return pool, nil }
+	getRespawnTimes = func(ctx context.Context, spawn2IDs []int32) (map[int32]model.RespawnTimes, error) {
+		return map[int32]model.RespawnTimes{}, nil
+	}
+	saveRespawnTime = func(ctx context.Context, spawn2ID int32, start int32, duration int32) error { return nil }
+	return func() { getZoneSpawnPool, getRespawnTimes, saveRespawnTime = originalPool, originalGet, originalSave }
+}
+
+func TestHuntRestsPullsAndStops(t *testing.T) {
+	originalZone, originalBind := getZoneShortName, getCharacterBind
+	getZoneShortName = func(ctx context.Context, zoneID int) (string, error) { return "qeynos2", nil }
+	getCharacterBind = func(ctx context.Context, charID uint32) (*model.CharacterBind, error) {
+		return &model.CharacterBind{ZoneID: 2}, nil
+	}
+	originalSkills := getCharacterSkills
+	getCharacterSkills = func(ctx context.Context, characterID int64) ([]model.CharacterSkills, error) { return nil, nil }
+	defer func() {
+		getZoneShortName, getCharacterBind, getCharacterSkills = originalZone, originalBind, originalSkills
+	}()
+
+	// One spawn point that repops as soon as it's killed
+	huntTarget := &model.NpcTypes{ID: 5, Name: "HuntTarget", Level: 18, Hp: 20, Mindmg: 1, Maxdmg: 2}
+	defer stubSpawnPool(map[int64]*db_zone.SpawnPoolEntry{
+		1: {
+			Spawn2:       &model.Spawn2{ID: 1},
+			SpawnGroup:   &model.Spawngroup{ID: 1},
+			SpawnEntries: []*db_zone.SpawnEntryWithNPC{{SpawnEntry: &model.Spawnentry{Chance: 100}, NPCType: huntTarget}},
+		},
+	})()
+
+	mockClient := &MockClient{
+		charData: &model.CharacterData{ID: 888, Name: "Hunter", Level: 20, Exp: 5000000, Str: 100, Class: 1, Race: 1},
+		mob:      &entity.Mob{MaxHp: 500, CurrentHp: 100},
+	}
+	ses := &session.Session{SessionID: 4, Client: mockClient}
+	m := &CombatManager{
+		sessions: make(map[int64]*CombatSession),
+		pets:     make(map[int64]*Pet),
+		hunts:    make(map[int64]*Hunt),
+	}
+
+	var statuses []HuntStatus
+	var started int
+	m.StartHunt(ses, HuntSettings{RestHPPercent: 50, MaxDeaths: 1}, HuntCallbacks{
+		OnStarted: func(npc *db_combat.NPCForCombat, pet *Pet) { started++ },
+		OnStatus:  func(s HuntStatus) { statuses = append(statuses, s) },
+	})
+
+	// Below the rest threshold: the first tick rests instead of pulling
+	m.processTick()
+	if started != 0 || mockClient.GetCurrentHp() != 125 {
+		t.Fatalf("Expected one rest tick to 125 HP without pulling, got HP %d and %d pulls", mockClient.GetCurrentHp(), started)
+	}
+
+	for i := 0; i < 200 && started < 3; i++ {
+		m.processTick()
+	}
+	hunt := m.GetHunt(888)
+	if started < 3 || hunt == nil || hunt.Kills < 2 {
+		t.Fatalf("Expected the hunt to keep pulling and killing, got %d pulls, hunt %+v", started, hunt)
+	}
+
+	// A deadly NPC ends the hunt once MaxDeaths is reached
+	huntTarget.Name, huntTarget.Hp, huntTarget.Mindmg, huntTarget.Maxdmg = "Deadly", 100000, 400, 500
+	for i := 0; i < 200 && m.GetHunt(888) != nil; i++ {
+		m.processTick()
+	}
+	if m.GetHunt(888) != nil {
+		t.Fatal("Expected the hunt to stop after a death")
+	}
+	last := statuses[len(statuses)-1]
+	if last.Active || last.StopReason != HuntStopMaxDeaths || last.Deaths != 1 {
+		t.Errorf("Expected a max_deaths stop status, got %+v", last)
+	}
+}
+
+func TestSimulateOffline(t *testing.T) {
+	mockClient := &MockClient{
+		charData: &model.CharacterData{ID: 999, Name: "Sleeper", Level: 20, Exp: 5000000, Str: 100, Class: 1, Race: 1},
+		mob:      &entity.Mob{MaxHp: 500, CurrentHp: 500},
+	}
+	ses := &session.Session{SessionID: 5, Client: mockClient}
+	npcs := []db_combat.NPCForCombat{{ID: 7, Name: "Sheep", Level: 5, HP: 60, MinDmg: 1, MaxDmg: 3}}
+
+	if short := SimulateOffline(ses, npcs, nil, OfflineHunt{}, time.Minute, 0.5, 1); short.Fights != 0 {
+		t.Errorf("Expected no progress for a short absence, got %+v", short)
+	}
+
+	startExp := mockClient.charData.Exp
+	report := SimulateOffline(ses, npcs, nil, OfflineHunt{}, time.Hour, 0.5, 1)
+	if report.Fights == 0 || report.Deaths != 0 || report.Kills == 0 {
+		t.Fatalf("Expected no deaths against a weak NPC, got %+v", report)
+	}
+	// Past the fight bound the rest of the half hour is estimated at the same rate
+	if report.Hunted < 29*time.Minute || report.Hunted > 30*time.Minute || report.Fights <= maxOfflineFights {
+		t.Errorf("Expected about half an hour hunted past the fight bound, got %s over %d fights", report.Hunted, report.Fights)
+	}
+	if report.ExpGained != int(mockClient.charData.Exp-startExp) || report.ExpGained == 0 {
+		t.Errorf("Expected exp gained %d to match the character's exp change", report.ExpGained)
+	}
+	if len(report.Loot) > maxOfflineLoot {
+		t.Errorf("Expected at most %d drops, got %d", maxOfflineLoot, len(report.Loot))
+	}
+
+	// Full efficiency hunts roughly twice as long
+	full := SimulateOffline(ses, npcs, nil, OfflineHunt{}, time.Hour, 1, 1)
+	if full.Hunted < 59*time.Minute || full.Fights <= report.Fights {
+		t.Errorf("Expected a full hour hunted with more fights, got %s over %d fights", full.Hunted, full.Fights)
+	}
+
+	// The hunt stops at its stop level, estimated fights included
+	mockClient.charData.Level, mockClient.charData.Exp = 1, 0
+	leveled := SimulateOffline(ses, npcs, nil, OfflineHunt{StopAtLevel: 3}, 12*time.Hour, 1, 1)
+	if mockClient.charData.Level != 3 || leveled.Hunted >= 12*time.Hour {
+		t.Errorf("Expected the hunt to stop at level 3, got level %d after %s", mockClient.charData.Level, leveled.Hunted)
+	}
+
+	// A death ends the hunt
+	originalGetBind := getCharacterBind
+	getCharacterBind = func(ctx context.Context, charID uint32) (*model.CharacterBind, error) {
+		return &model.CharacterBind{ZoneID: 2}, nil
+	}
+	defer func() { getCharacterBind = originalGetBind }()
+	mockClient.charData.Level, mockClient.charData.Exp = 20, 5000000
+	giant := []db_combat.NPCForCombat{{ID: 8, Name: "Giant", Level: 40, HP: 100000, MinDmg: 100, MaxDmg: 200}}
+	died := SimulateOffline(ses, giant, nil, OfflineHunt{}, time.Hour, 1, 1)
+	if died.Fights != 1 || died.Deaths != 1 {
+		t.Errorf("Expected one fatal fight, got %+v", died)
+	}
+}
+
+func TestRestTime(t *testing.T) {
+	mockClient := &MockClient{
+		charData: &model.CharacterData{ID: 999, Name: "Rester", Level: 20},
+		mob:      &entity.Mob{MaxHp: 500, CurrentHp: 500},
+	}
+	ses := &session.Session{SessionID: 6, Client: mockClient}
+
+	if rest := RestTime(ses); rest != 0 {
+		t.Errorf("Expected no rest at full HP, got %s", rest)
+	}
+	// 5% of 500 is 25 HP a tick, so 60 missing HP takes three ticks
+	mockClient.SetCurrentHp(440)
+	if rest := RestTime(ses); rest != 3*time.Second {
+		t.Errorf("Expected 3s of rest for 60 missing HP, got %s", rest)
+	}
+	mockClient.SetCurrentHp(0)
+	if rest := RestTime(ses); rest != 20*time.Second {
+		t.Errorf("Expected 20s of rest from 0 HP, got %s", rest)
+	}
+}
+
+func TestClaimTarget(t *testing.T) {
+	rare := &model.NpcTypes{ID: 100, Name: "Rare", Level: 10, Hp: 200}
+	placeholder := &model.NpcTypes{ID: 101, Name: "Placeholder", Level: 8, Hp: 100}
+	common := &model.NpcTypes{ID: 102, Name: "Common", Level: 5, Hp: 50}
+	defer stubSpawnPool(map[int64]*db_zone.SpawnPoolEntry{
+		1: {
+			Spawn2:     &model.Spawn2{ID: 1, Respawntime: 600},
+			SpawnGroup: &model.Spawngroup{ID: 10},
+			SpawnEntries: []*db_zone.SpawnEntryWithNPC{
+				{SpawnEntry: &model.Spawnentry{Chance: 0}, NPCType: rare},
+				{SpawnEntry: &model.Spawnentry{Chance: 100}, NPCType: placeholder},
+			},
+		},
+		2: {
+			Spawn2:       &model.Spawn2{ID: 2, Respawntime: 60},
+			SpawnGroup:   &model.Spawngroup{ID: 20},
+			SpawnEntries: []*db_zone.SpawnEntryWithNPC{{SpawnEntry: &model.Spawnentry{Chance: 100}, NPCType: common}},
+		},
+	})()
+
+	m := &CombatManager{}
+	rng := rand.New(rand.NewSource(1))
+	now := time.Now()
+	z, err := m.getZoneSpawns("zone", now)
+	if err != nil {
+		t.Fatalf("Failed to load zone spawns: %v", err)
+	}
+
+	var unavailable *TargetUnavailableError
+	if _, _, err := z.claimTarget(1, CombatTarget{NPCID: 999}, false, rng, now); !errors.As(err, &unavailable) || unavailable.Reason != TargetNotInZone {
+		t.Errorf("Expected not_in_zone, got %v", err)
+	}
+
+	// The rare never wins its spawn group's roll, so its placeholder holds the spawn point
+	if _, _, err := z.claimTarget(1, CombatTarget{NPCID: 100}, false, rng, now); !errors.As(err, &unavailable) || unavailable.Reason != TargetNotUp {
+		t.Errorf("Expected not_up, got %v", err)
+	}
+
+	// Targeting the spawn group fights whatever is up
+	npc, point, err := z.claimTarget(1, CombatTarget{SpawnGroupID: 10}, false, rng, now)
+	if err != nil || npc.ID != placeholder.ID || point.pool.Spawn2.ID != 1 {
+		t.Fatalf("Expected the placeholder at spawn 1, got %v %v %v", npc, point, err)
+	}
+
+	// Spawns are shared: another character can't pull an engaged NPC until it's released
+	if _, _, err := z.claimTarget(2, CombatTarget{SpawnGroupID: 10}, false, rng, now); !errors.As(err, &unavailable) || unavailable.Reason != TargetEngaged {
+		t.Errorf("Expected engaged, got %v", err)
+	}
+	z.release(point, 1)
+	if npc, _, err := z.claimTarget(2, CombatTarget{SpawnGroupID: 10}, false, rng, now); err != nil || npc.ID != placeholder.ID {
+		t.Errorf("Expected the released placeholder, got %v %v", npc, err)
+	}
+
+	// A killed spawn point is empty for every character until its respawn time passes
+	var saved int32
+	saveRespawnTime = func(ctx context.Context, spawn2ID int32, start int32, duration int32) error {
+		saved = spawn2ID
+		return nil
+	}
+	_, common2, err := z.claimTarget(1, CombatTarget{NPCID: 102}, false, rng, now)
+	if err != nil {
+		t.Fatalf("Expected Common up, got %v", err)
+	}
+	z.killed(common2, rng, now)
+	if saved != 2 {
+		t.Errorf("Expected the respawn timer for spawn 2 to be saved, got %d", saved)
+	}
+	if _, _, err := z.claimTarget(2, CombatTarget{NPCID: 102}, false, rng, now.Add(30*time.Second)); !errors.As(err, &unavailable) ||
+		unavailable.Reason != TargetRespawning || unavailable.RespawnIn != 30*time.Second {
+		t.Errorf("Expected respawning in 30s, got %v", err)
+	}
+	if npc, _, err := z.claimTarget(2, CombatTarget{NPCID: 102}, false, rng, now.Add(time.Minute)); err != nil || npc.ID != common.ID {
+		t.Errorf("Expected Common to repop, got %v %v", npc, err)
+	}
+
+	// Random pulls only take free NPCs near the player's level
+	if _, _, err := z.claimRandom(3, 5, 5, false, rng, now.Add(time.Minute)); !errors.As(err, &unavailable) || unavailable.Reason != TargetEngaged {
+		t.Errorf("Expected everything engaged, got %v", err)
+	}
+	if _, _, err := z.claimRandom(3, 50, 5, false, rng, now.Add(time.Minute)); err == nil || errors.As(err, &unavailable) {
+		t.Errorf("Expected no NPCs in level range, got %v", err)
+	}
+}
+
+func TestRaidTargets(t *testing.T) {
+	dragon := &model.NpcTypes{ID: 200, Name: "Lord_Nagafen", Level: 55, Hp: 30000, RaidTarget: 1}
+	defer stubSpawnPool(map[int64]*db_zone.SpawnPoolEntry{
+		1: {
+			Spawn2:       &model.Spawn2{ID: 1, Respawntime: 600},
+			SpawnGroup:   &model.Spawngroup{ID: 10},
+			SpawnEntries: []*db_zone.SpawnEntryWithNPC{{SpawnEntry: &model.Spawnentry{Chance: 100}, NPCType: dragon}},
+		},
+	})()
+
+	m := &CombatManager{}
+	rng := rand.New(rand.NewSource(1))
+	now := time.Now()
+	z, err := m.getZoneSpawns("zone", now)
+	if err != nil {
+		t.Fatalf("Failed to load zone spawns: %v", err)
+	}
+
+	// Without a raid ready the target can't be chosen and random pulls pass it over
+	var unavailable *TargetUnavailableError
+	if _, _, err := z.claimTarget(1, CombatTarget{NPCID: 200}, false, rng, now); !errors.As(err, &unavailable) || unavailable.Reason != TargetRaidOnly {
+		t.Errorf("Expected raid_only, got %v", err)
+	}
+	if _, _, err := z.claimRandom(1, 55, 5, false, rng, now); err == nil || errors.As(err, &unavailable) {
+		t.Errorf("Expected no NPCs for a random pull outside a raid, got %v", err)
+	}
+	if npc, _, err := z.claimRandom(1, 55, 5, true, rng, now); err != nil || npc.ID != dragon.ID {
+		t.Errorf("Expected the raid to pull the raid target, got %v %v", npc, err)
+	}
+
+	// A raid is ready once enough of its members are in the zone
+	m.SetRaidMinMembers(2)
+	member := func(id uint32, zoneID uint32) group.Member {
+		client := &MockClient{charData: &model.CharacterData{ID: id, ZoneID: zoneID}, mob: &entity.Mob{}}
+		return group.Member{CharID: int64(id), Session: &session.Session{SessionID: int(id), Client: client}}
+	}
+	raid := &partyInfo{raidID: 1, members: []group.Member{member(1, 5), member(2, 6)}}
+	if m.raidReady(raid, 5) {
+		t.Error("Expected a raid with one member in the zone not to be ready")
+	}
+	raid.members = append(raid.members, member(3, 5))
+	if !m.raidReady(raid, 5) {
+		t.Error("Expected a raid with two members in the zone to be ready")
+	}
+	if m.raidReady(&partyInfo{groupID: 1, members: raid.members}, 5) {
+		t.Error("Expected a group never to be raid ready")
+	}
+}
+
+func TestLoadZoneSpawnsRestoresRespawnTimers(t *testing.T) {
+	npc := &model.NpcTypes{ID: 1, Name: "Guard", Level: 10, Hp: 100}
+	defer stubSpawnPool(map[int64]*db_zone.SpawnPoolEntry{
+		7: {
+			Spawn2:       &model.Spawn2{ID: 7, Respawntime: 300},
+			SpawnGroup:   &model.Spawngroup{ID: 1},
+			SpawnEntries: []*db_zone.SpawnEntryWithNPC{{SpawnEntry: &model.Spawnentry{Chance: 100}, NPCType: npc}},
+		},
+	})()
+	now := time.Unix(time.Now().Unix(), 0) // respawn_times has whole seconds
+	getRespawnTimes = func(ctx context.Context, spawn2IDs []int32) (map[int32]model.RespawnTimes, error) {
+		return map[int32]model.RespawnTimes{7: {ID: 7, Start: int32(now.Unix()) - 100, Duration: 300}}, nil
+	}
+
+	m := &CombatManager{}
+	z, err := m.getZoneSpawns("zone", now)
+	if err != nil {
+		t.Fatalf("Failed to load zone spawns: %v", err)
+	}
+	var unavailable *TargetUnavailableError
+	rng := rand.New(rand.NewSource(1))
+	if _, _, err := z.claimRandom(1, 10, 5, false, rng, now); !errors.As(err, &unavailable) ||
+		unavailable.Reason != TargetRespawning || unavailable.RespawnIn != 200*time.Second {
+		t.Errorf("Expected the saved timer to repop in 200s, got %v", err)
+	}
+	if again, _ := m.getZoneSpawns("zone", now); again != z {
+		t.Error("Expected the zone's spawn state to be loaded once and shared")
+	}
+}
+
+func TestSpecialAttacks(t *testing.T) {
+	shield := &constants.ItemWithInstance{Item: model.Items{Itemtype: 8, Ac: 50}}
+	dagger := &constants.ItemWithInstance{Item: model.Items{Itemtype: 2, Damage: 8}}
+	primary := constants.InventoryKey{Slot: constants.SlotPrimary}
+	secondary := constants.InventoryKey{Slot: constants.SlotSecondary}
+
+	// fight runs rounds against a sturdy NPC and returns them
+	fight := func(class uint8, level uint32, skills map[int]int, equipped map[constants.InventoryKey]*constants.ItemWithInstance, rounds int) []RoundResult {
+		mockClient := &MockClient{
+			charData: &model.CharacterData{ID: 4242, Name: "Specialist", Level: level, Str: 100, Class: class, Race: 1},
+			mob:      &entity.Mob{MaxHp: 100000, CurrentHp: 100000},
+			equipped: equipped,
+		}
+		var results []RoundResult
+		cs := &CombatSession{
+			Session: &session.Session{SessionID: 6, Client: mockClient},
+			Seed:    1,
+			skills:  skills,
+			State: CombatState{
+				Active:       true,
+				NPC:          &db_combat.NPCForCombat{ID: 1, Name: "Training_Dummy", Level: uint8(level), HP: 1000000, MinDmg: 1, MaxDmg: 2},
+				NPCCurrentHP: 1000000,
+			},
+			onRound: func(res *RoundResult) { results = append(results, *res) },
+		}
+		for i := 0; i < rounds && cs.State.Active; i++ {
+			cs.processCombatRound()
+		}
+		return results
+	}
+	used := func(rounds []RoundResult) []SpecialAttackResult {
+		var specials []SpecialAttackResult
+		for _, r := range rounds {
+			specials = append(specials, r.Specials...)
+		}
+		return specials
+	}
+
+	// A warrior with a shield bashes on the shared six-round timer; a stun costs the NPC its attack that round
+	warrior := fight(constants.Class_Warrior, 20, map[int]int{constants.Skill_Bash: 100, constants.Skill_Kick: 100},
+		map[constants.InventoryKey]*constants.ItemWithInstance{secondary: shield}, 60)
+	bashes := used(warrior)
+	if len(bashes) != 10 {
+		t.Fatalf("Expected a bash every 6 rounds over 60 rounds, got %d", len(bashes))
+	}
+	stuns := 0
+	for i, r := range warrior {
+		if len(r.Specials) > 0 && (r.Specials[0].Name != "Bash" || i%6 != 0) {
+			t.Errorf("Round %d: expected bashes on rounds 1, 7, 13..., got %+v", i+1, r.Specials)
+		}
+		if len(r.Specials) > 0 && r.Specials[0].Stunned {
+			stuns++
+			if !r.NPCStunned || r.NPCHit {
+				t.Errorf("Round %d: expected the stunned NPC to lose its attack, got %+v", i+1, r)
+			}
+		}
+	}
+	if stuns == 0 {
+		t.Error("Expected some bashes to stun an even-level NPC")
+	}
+
+	// Without a shield the warrior kicks instead
+	if kicks := used(fight(constants.Class_Warrior, 20, map[int]int{constants.Skill_Kick: 100}, nil, 6)); len(kicks) != 1 || kicks[0].Name != "Kick" {
+		t.Errorf("Expected one kick, got %+v", kicks)
+	}
+
+	// Armor in the secondary slot is no shield
+	breastplate := &constants.ItemWithInstance{Item: model.Items{Itemtype: 10, Ac: 20}}
+	if kicks := used(fight(constants.Class_Warrior, 20, map[int]int{constants.Skill_Bash: 100, constants.Skill_Kick: 100},
+		map[constants.InventoryKey]*constants.ItemWithInstance{secondary: breastplate}, 6)); len(kicks) != 1 || kicks[0].Name != "Kick" {
+		t.Errorf("Expected a kick with armor in the secondary slot, got %+v", kicks)
+	}
+
+	// Untrained skills are never used
+	if none := used(fight(constants.Class_Warrior, 20, map[int]int{}, nil, 12)); len(none) != 0 {
+		t.Errorf("Expected no special attacks without skills, got %+v", none)
+	}
+
+	// Monks flying kick from level 30 and kick before that
+	if kicks := used(fight(constants.Class_Monk, 30, map[int]int{constants.Skill_FlyingKick: 150, constants.Skill_Kick: 150}, nil, 1)); len(kicks) != 1 || kicks[0].Name != "Flying Kick" {
+		t.Errorf("Expected a flying kick at 30, got %+v", kicks)
+	}
+	if kicks := used(fight(constants.Class_Monk, 29, nil, nil, 1)); len(kicks) != 1 || kicks[0].Name != "Kick" {
+		t.Errorf("Expected a kick at 29, got %+v", kicks)
+	}
+
+	// A solo rogue backstabs from behind only on the opening round
+	stabs := used(fight(constants.Class_Rogue, 20, nil, map[constants.InventoryKey]*constants.ItemWithInstance{primary: dagger}, 11))
+	if len(stabs) != 2 || !stabs[0].Behind || stabs[1].Behind {
+		t.Fatalf("Expected an opening backstab from behind then one from the front, got %+v", stabs)
+	}
+	if stabs[0].Hit && stabs[0].Damage < 20 {
+		t.Errorf("Expected a backstab from behind to hit for at least the rogue's level, got %d", stabs[0].Damage)
+	}
+	if none := used(fight(constants.Class_Rogue, 20, nil, nil, 11)); len(none) != 0 {
+		t.Errorf("Expected no backstab without a piercer, got %+v", none)
+	}
+}
+
+func TestFleeAndRetreat(t *testing.T) {
+	// fight runs a fight to its end against an NPC already at hpPercent of its HP
+	fight := func(npc *db_combat.NPCForCombat, hpPercent int64, retreatPct int, playerHP int) (*EndResult, []RoundResult, bool) {
+		mockClient := &MockClient{
+			charData:   &model.CharacterData{ID: 4343, Name: "Runner", Level: 20, Str: 1, Class: constants.Class_Cleric, Race: 1},
+			mob:        &entity.Mob{MaxHp: 1000, CurrentHp: playerHP},
+			retreatPct: retreatPct,
+		}
+		var end *EndResult
+		var rounds []RoundResult
+		looted := false
+		cs := &CombatSession{
+			Session: &session.Session{SessionID: 7, Client: mockClient},
+			Seed:    3,
+			State: CombatState{
+				Active:       true,
+				NPC:          npc,
+				NPCCurrentHP: npc.HP * hpPercent / 100,
+			},
+			onRound: func(res *RoundResult) { rounds = append(rounds, *res) },
+			onEnd:   func(res *EndResult) { end = res },
+			onLoot:  func([]db_combat.LootDropItem, db_combat.MoneyDrop) { looted = true },
+		}
+		for i := 0; i < 500 && cs.State.Active; i++ {
+			cs.processCombatRound()
+		}
+		return end, rounds, looted
+	}
+
+	// A badly hurt NPC stops fighting back and eventually gets away with no exp or loot
+	end, rounds, looted := fight(&db_combat.NPCForCombat{ID: 1, Name: "Coward", Level: 20, HP: 1000000, MinDmg: 5, MaxDmg: 10}, 20, 0, 1000)
+	if end == nil || end.Outcome != OutcomeNPCFled || end.Victory || end.ExpGained != 0 || looted {
+		t.Fatalf("Expected the NPC to flee with no exp or loot, got %+v (looted %v)", end, looted)
+	}
+	for _, r := range rounds {
+		if !r.NPCFleeing || r.NPCHit {
+			t.Errorf("Round %d: expected a fleeing NPC not to attack, got %+v", r.RoundNumber, r)
+		}
+	}
+	if len(rounds) < 2 {
+		t.Errorf("Expected the NPC to get away no sooner than the round after it turned, got %d rounds", len(rounds))
+	}
+
+	// Raid targets and NPCs that con red never flee
+	for _, npc := range []*db_combat.NPCForCombat{
+		{ID: 2, Name: "Raid_Boss", Level: 20, HP: 1000000, MinDmg: 1, MaxDmg: 2, RaidTarget: true},
+		{ID: 3, Name: "Elder_Wyrm", Level: 40, HP: 1000000, MinDmg: 1, MaxDmg: 2},
+	} {
+		if end, rounds, _ := fight(npc, 10, 0, 1000); end != nil || rounds[len(rounds)-1].NPCFleeing {
+			t.Errorf("Expected %s to stand its ground, got %+v", npc.Name, end)
+		}
+	}
+
+	// The player retreats once their HP falls to the auto-retreat threshold
+	end, rounds, looted = fight(&db_combat.NPCForCombat{ID: 4, Name: "Bruiser", Level: 20, HP: 1000000, MinDmg: 40, MaxDmg: 60}, 100, 50, 1000)
+	if end == nil || end.Outcome != OutcomeRetreated || end.Victory || looted {
+		t.Fatalf("Expected a retreat, got %+v (looted %v)", end, looted)
+	}
+	if end.PlayerHP <= 0 || end.PlayerHP > 500 || end.PlayerHP != rounds[len(rounds)-1].PlayerHP {
+		t.Errorf("Expected to retreat alive at or below half HP, got %d", end.PlayerHP)
+	}
+
+	// Flees and retreats count as neither kills nor deaths
+	r := newStatsRecorder()
+	r.Record(FightStats{CharID: 1, NPCID: 4, NPCName: "Bruiser", Escaped: true, DamageDealt: 20, DamageTaken: 500})
+	if totals := r.pending[1].totals; totals.Kills != 0 || totals.Deaths != 0 || totals.DamageTaken != 500 {
+		t.Errorf("Expected an escape to add damage but no kill or death, got %+v", totals)
+	}
+}
+
+func TestRangedPull(t *testing.T) {
+	var saved []int
+	originalSave := saveItemCharges
+	saveItemCharges = func(playerID int32, item *constants.ItemWithInstance) error {
+		saved = append(saved, int(item.Instance.Charges))
+		return nil
+	}
+	defer func() { saveItemCharges = originalSave }()
+
+	rangeKey := constants.InventoryKey{Slot: constants.SlotRange}
+	ammoKey := constants.InventoryKey{Slot: constants.SlotAmmo}
+	bow := func() *constants.ItemWithInstance {
+		return &constants.ItemWithInstance{Item: model.Items{Name: "Hunting Bow", Itemtype: 5, Damage: 6, Delay: 30}}
+	}
+	arrows := func(charges uint8) *constants.ItemWithInstance {
+		return &constants.ItemWithInstance{Item: model.Items{Name: "Arrow", Itemtype: 27, Damage: 2}, Instance: constants.ItemInstance{Charges: charges}}
+	}
+
+	// fight runs rounds against a sturdy NPC and returns them
+	fight := func(class uint8, inventory map[constants.InventoryKey]*constants.ItemWithInstance, live bool, rounds int) []RoundResult {
+		mockClient := &MockClient{
+			charData: &model.CharacterData{ID: 4444, Name: "Archer", Level: 20, Str: 100, Class: class, Race: 1},
+			mob:      &entity.Mob{MaxHp: 100000, CurrentHp: 100000},
+			equipped: inventory,
+		}
+		var results []RoundResult
+		cs := &CombatSession{
+			Session:     &session.Session{SessionID: 8, Client: mockClient},
+			Seed:        2,
+			consumeAmmo: live,
+			State: CombatState{
+				Active:       true,
+				NPC:          &db_combat.NPCForCombat{ID: 1, Name: "Target_Dummy", Level: 20, HP: 1000000, MinDmg: 5, MaxDmg: 10, RaidTarget: true},
+				NPCCurrentHP: 1000000,
+			},
+			onRound: func(res *RoundResult) { results = append(results, *res) },
+		}
+		for i := 0; i < rounds && cs.State.Active; i++ {
+			cs.processCombatRound()
+		}
+		return results
+	}
+
+	// A bow pull shoots every 3 rounds while the NPC closes, then melee starts; live fights use up ammo
+	inventory := map[constants.InventoryKey]*constants.ItemWithInstance{rangeKey: bow(), ammoKey: arrows(2)}
+	rounds := fight(constants.Class_Ranger, inventory, true, npcCloseRounds+3)
+	var shots []RangedAttackResult
+	for i, r := range rounds {
+		closing := i < npcCloseRounds
+		if r.NPCClosing != closing || (closing && (r.NPCHit || r.PlayerHit || r.PlayerDamage > 0)) {
+			t.Errorf("Round %d: expected closing=%v with no melee while closing, got %+v", i+1, closing, r)
+		}
+		if r.Ranged != nil {
+			if !closing {
+				t.Errorf("Round %d: expected no ranged attacks once in melee", i+1)
+			}
+			shots = append(shots, *r.Ranged)
+		}
+	}
+	if len(shots) != 2 || shots[0].Skill != constants.Skill_Archery || shots[0].AmmoLeft != 1 || shots[1].AmmoLeft != 0 {
+		t.Fatalf("Expected two arrows fired on rounds 1 and 4 leaving 1 then 0, got %+v", shots)
+	}
+	if !reflect.DeepEqual(saved, []int{1, 0}) || inventory[ammoKey] != nil {
+		t.Errorf("Expected each arrow saved and the empty stack removed, saved %v, ammo %v", saved, inventory[ammoKey])
+	}
+
+	// Bandages and spell scrolls share none of the ranged item types
+	bandage := &constants.ItemWithInstance{Item: model.Items{Name: "Bandages", Itemtype: 18}}
+	scroll := &constants.ItemWithInstance{Item: model.Items{Name: "Spell: Minor Healing", Itemtype: 20}, Instance: constants.ItemInstance{Charges: 1}}
+	if rounds := fight(constants.Class_Ranger, map[constants.InventoryKey]*constants.ItemWithInstance{rangeKey: bandage, ammoKey: scroll}, true, 1); rounds[0].NPCClosing || rounds[0].Ranged != nil {
+		t.Errorf("Expected melee from the first round with bandages and a scroll, got %+v", rounds[0])
+	}
+	if rounds := fight(constants.Class_Ranger, map[constants.InventoryKey]*constants.ItemWithInstance{rangeKey: bow(), ammoKey: scroll}, true, 1); rounds[0].NPCClosing || rounds[0].Ranged != nil {
+		t.Errorf("Expected a scroll not to be fired as an arrow, got %+v", rounds[0])
+	}
+
+	// Without ammo there is no ranged pull
+	if rounds := fight(constants.Class_Ranger, map[constants.InventoryKey]*constants.ItemWithInstance{rangeKey: bow()}, true, 2); rounds[0].NPCClosing || rounds[0].Ranged != nil {
+		t.Errorf("Expected melee from the first round without arrows, got %+v", rounds[0])
+	}
+
+	// Arrows are drawn from the inventory once the ammo slot is empty
+	general := constants.InventoryKey{Slot: constants.SlotGeneral3}
+	inventory = map[constants.InventoryKey]*constants.ItemWithInstance{rangeKey: bow(), general: arrows(20)}
+	if shots := fight(constants.Class_Warrior, inventory, true, 1); shots[0].Ranged == nil || shots[0].Ranged.AmmoSlot != general.Slot || shots[0].Ranged.AmmoLeft != 19 {
+		t.Errorf("Expected an arrow from general slot %d, got %+v", general.Slot, shots[0].Ranged)
+	}
+
+	// Throwing items throw themselves; simulated fights don't use them up
+	dagger := &constants.ItemWithInstance{Item: model.Items{Name: "Throwing Dagger", Itemtype: 7, Damage: 4, Delay: 10}, Instance: constants.ItemInstance{Charges: 5}}
+	saved = nil
+	thrown := 0
+	for _, r := range fight(constants.Class_Rogue, map[constants.InventoryKey]*constants.ItemWithInstance{rangeKey: dagger}, false, npcCloseRounds+2) {
+		if r.Ranged != nil {
+			thrown++
+			if r.Ranged.Skill != constants.Skill_Throwing || r.Ranged.AmmoLeft != 5 {
+				t.Errorf("Expected a throw that uses nothing up, got %+v", r.Ranged)
+			}
+		}
+	}
+	if thrown != npcCloseRounds || len(saved) != 0 || dagger.Instance.Charges != 5 {
+		t.Errorf("Expected a throw every round while closing with no ammo used, got %d throws, saved %v", thrown, saved)
+	}
+}
+
+func TestGroupFight(t *testing.T) {
+	type member struct {
+		cs     *CombatSession
+		rounds []RoundResult
+		end    *EndResult
+	}
+	newMember := func(id uint32, name string, level uint32, str uint32, hp int) *member {
+		m := &member{}
+		client := &MockClient{
+			charData: &model.CharacterData{ID: id, Name: name, Level: level, Str: str, Class: constants.Class_Cleric, Race: 1},
+			mob:      &entity.Mob{MaxHp: hp, CurrentHp: hp},
+		}
+		m.cs = &CombatSession{
+			Session: &session.Session{SessionID: int(id), Client: client},
+			charID:  int64(id),
+			onRound: func(res *RoundResult) { m.rounds = append(m.rounds, *res) },
+			onEnd:   func(res *EndResult) { m.end = res },
+		}
+		return m
+	}
+	newFight := func(npc *db_combat.NPCForCombat, members ...*member) *groupFight {
+		g := &groupFight{
+			active:   true,
+			groupID:  1,
+			rng:      rand.New(rand.NewSource(5)),
+			state:    CombatState{Active: true, NPC: npc, NPCCurrentHP: npc.HP},
+			hate:     map[int64]int{members[0].cs.charID: groupPullHate},
+			pullerID: members[0].cs.charID,
+		}
+		for _, m := range members {
+			m.cs.party = g
+			m.cs.State = CombatState{Active: true, NPC: npc, NPCCurrentHP: npc.HP}
+			g.members = append(g.members, m.cs)
+		}
+		return g
+	}
+
+	// The NPC goes for whoever has done it the most damage, and the rest of the group sees it
+	// attack them by name along with the group's damage. Raid targets never flee, so every fight
+	// here ends in a kill or a death.
+	puller := newMember(1, "Puller", 20, 1, 100000)
+	hitter := newMember(2, "Hitter", 20, 255, 100000)
+	npc := &db_combat.NPCForCombat{ID: 7, Name: "Orc_Pawn", Level: 20, HP: 5000, MinDmg: 5, MaxDmg: 10, RaidTarget: true}
+	g := newFight(npc, puller, hitter)
+	for i := 0; i < 500 && g.active; i++ {
+		g.processRound()
+	}
+	if puller.end == nil || hitter.end == nil || !puller.end.Victory || !hitter.end.Victory {
+		t.Fatalf("Expected both members to share the kill, got %+v and %+v", puller.end, hitter.end)
+	}
+	if len(g.members) != 0 {
+		t.Errorf("Expected the finished fight to have no members left, got %d", len(g.members))
+	}
+	wantExp := splitGroupExp(20, []int{20, 20})
+	if puller.end.ExpGained != wantExp[0] || hitter.end.ExpGained != wantExp[1] || wantExp[0] == 0 {
+		t.Errorf("Expected the exp split %v, got %d and %d", wantExp, puller.end.ExpGained, hitter.end.ExpGained)
+	}
+	sawHitterTargeted := false
+	for i, r := range puller.rounds {
+		h := hitter.rounds[i]
+		if r.NPCHP != h.NPCHP || r.RoundNumber != h.RoundNumber {
+			t.Fatalf("Round %d: members disagree on the NPC, %+v vs %+v", i+1, r, h)
+		}
+		if r.GroupDamage != h.PlayerDamage+h.PetDamage {
+			t.Errorf("Round %d: expected the puller to see the hitter's %d damage, got %d", r.RoundNumber, h.PlayerDamage, r.GroupDamage)
+		}
+		if r.NPCTarget == "Hitter" {
+			sawHitterTargeted = true
+			if r.NPCHit || r.NPCDamage != 0 {
+				t.Errorf("Round %d: expected no NPC attack on the puller while it fights the hitter, got %+v", r.RoundNumber, r)
+			}
+		}
+	}
+	if !sawHitterTargeted {
+		t.Error("Expected the NPC to turn on the member doing the most damage")
+	}
+
+	// A member who dies drops out and the rest of the group fights on
+	victim := newMember(3, "Victim", 20, 255, 1)
+	survivor := newMember(4, "Survivor", 20, 100, 100000)
+	orig := getCharacterBind
+	getCharacterBind = func(ctx context.Context, charID uint32) (*model.CharacterBind, error) {
+		return &model.CharacterBind{}, nil
+	}
+	defer func() { getCharacterBind = orig }()
+	g = newFight(&db_combat.NPCForCombat{ID: 8, Name: "Orc_Centurion", Level: 20, HP: 3000, MinDmg: 5, MaxDmg: 10, RaidTarget: true}, victim, survivor)
+	for i := 0; i < 500 && g.active; i++ {
+		g.processRound()
+	}
+	if victim.end == nil || victim.end.Outcome != OutcomeDeath {
+		t.Fatalf("Expected the victim to die, got %+v", victim.end)
+	}
+	if survivor.end == nil || !survivor.end.Victory || len(survivor.rounds) <= len(victim.rounds) {
+		t.Fatalf("Expected the survivor to fight on to a kill, got %+v after %d rounds", survivor.end, len(survivor.rounds))
+	}
+	if want := splitGroupExp(20, []int{20}); survivor.end.ExpGained != want[0] {
+		t.Errorf("Expected the survivor to take the whole kill's exp %d, got %d", want[0], survivor.end.ExpGained)
+	}
+}
+
+func TestStartGroupFightSkipsBusyMembers(t *testing.T) {
+	m := &CombatManager{
+		sessions: make(map[int64]*CombatSession),
+		pets:     make(map[int64]*Pet),
+		hunts:    make(map[int64]*Hunt),
+	}
+	originalSkills := getCharacterSkills
+	getCharacterSkills = func(ctx context.Context, characterID int64) ([]model.CharacterSkills, error) { return nil, nil }
+	defer func() { getCharacterSkills = originalSkills }()
+
+	member := func(id uint32) group.Member {
+		client := &MockClient{
+			charData: &model.CharacterData{ID: id, Level: 20, ZoneID: 5, Class: constants.Class_Cleric, Race: 1},
+			mob:      &entity.Mob{MaxHp: 100, CurrentHp: 100},
+		}
+		return group.Member{CharID: int64(id), Session: &session.Session{SessionID: int(id), Client: client}}
+	}
+	p := &partyInfo{groupID: 1, members: []group.Member{member(1), member(2), member(3)}}
+	npc := &db_combat.NPCForCombat{ID: 7, Name: "Orc_Pawn", Level: 20, HP: 500}
+
+	// Member 3 starts a fight of their own while the group fight is being set up
+	busy := &CombatSession{charID: 3, managed: true, State: CombatState{Active: true, NPC: npc}}
+	m.SetGroupCallbacks(func(ses *session.Session) FightCallbacks {
+		if ses.Client.CharData().ID == 2 {
+			m.mu.Lock()
+			m.sessions[3] = busy
+			m.mu.Unlock()
+		}
+		return FightCallbacks{}
+	})
+	puller := m.newLiveSession(p.members[0].Session, npc, rand.New(rand.NewSource(1)), 1, nil, nil, nil)
+	m.startGroupFight(p, puller, nil, nil)
+
+	g := puller.party
+	if g == nil || len(g.members) != 2 || g.members[1].charID != 2 {
+		t.Fatalf("Expected the puller and member 2 in the fight, got %+v", g)
+	}
+	if m.sessions[3] != busy || busy.party != nil {
+		t.Error("Expected member 3's own fight to be left alone")
+	}
+	if _, ok := g.hate[3]; ok {
+		t.Error("Expected member 3 to have no hate in the group fight")
+	}
+}
+
+func TestSplitGroupExp(t *testing.T) {
+	solo := db_combat.CalculateExperience(20)
+	tests := []struct {
+		name     string
+		npcLevel int
+		levels   []int
+		want     []int
+	}{
+		{"Alone", 20, []int{20}, []int{solo}},
+		{"Pair shares the bonus", 20, []int{20, 20}, []int{solo * 6 / 10, solo * 6 / 10}},
+		{"Shared by level", 20, []int{20, 15}, []int{int(float64(solo) * 1.2 * 20 / 35), int(float64(solo) * 1.2 * 15 / 35)}},
+		{"Too low to share", 20, []int{20, 9}, []int{int(float64(solo) * 1.2), 0}},
+		{"Gray to the highest", 5, []int{20, 12}, []int{0, 0}},
+		{"Capped three levels up", 30, []int{14, 10}, []int{db_combat.CalculateExperience(17), db_combat.CalculateExperience(13)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitGroupExp(tt.npcLevel, tt.levels); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitGroupExp(%d, %v) = %v, want %v", tt.npcLevel, tt.levels, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShareGroupLoot(t *testing.T) {
+	items := []db_combat.LootDropItem{{}, {}, {}, {}, {}}
+	members := []*CombatSession{{charID: 1}, {charID: 2}, {charID: 3}}
+
+	// Round robin goes down the group in turn
+	turn := 0
+	orig := nextGroupLooter
+	nextGroupLooter = func(groupID int64, eligible []int64) int64 {
+		turn++
+		return eligible[(turn-1)%len(eligible)]
+	}
+	defer func() { nextGroupLooter = orig }()
+	g := &groupFight{groupID: 1, lootMode: "round_robin", rng: rand.New(rand.NewSource(1))}
+	loot := g.shareLoot(members, items)
+	if len(loot[0]) != 2 || len(loot[1]) != 2 || len(loot[2]) != 1 {
+		t.Errorf("Expected round robin to deal 2/2/1, got %d/%d/%d", len(loot[0]), len(loot[1]), len(loot[2]))
+	}
+
+	// Free-for-all hands out every drop to someone
+	g.lootMode = "free_for_all"
+	loot = g.shareLoot(members, items)
+	if total := len(loot[0]) + len(loot[1]) + len(loot[2]); total != len(items) || turn != len(items) {
+		t.Errorf("Expected free-for-all to hand out all %d drops without the rotation, got %d", len(items), total)
+	}
+
+	// A raid's drops go by the raid's loot rules
+	var raidCalls int
+	origRaid := nextRaidLooter
+	nextRaidLooter = func(raidID int64, eligible []int64) int64 {
+		raidCalls++
+		return eligible[2]
+	}
+	defer func() { nextRaidLooter = origRaid }()
+	g = &groupFight{raidID: 1, lootMode: "leader", rng: rand.New(rand.NewSource(1))}
+	loot = g.shareLoot(members, items)
+	if len(loot[2]) != len(items) || raidCalls != len(items) {
+		t.Errorf("Expected every drop to the raid's looter, got %d of %d", len(loot[2]), len(items))
+	}
+
+	if coin := splitCoin(1001, 3); !reflect.DeepEqual(coin, []uint32{334, 334, 333}) {
+		t.Errorf("Expected 1001 copper to split 334/334/333, got %v", coin)
+	}
 }
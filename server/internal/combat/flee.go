@@ -0,0 +1,80 @@
+package combat
+
+import (
+	"log"
+
+	"idlequest/internal/mechanics"
+)
+
+// Fight outcomes reported in EndResult.Outcome
+const (
+	OutcomeVictory   = "victory"
+	OutcomeDeath     = "death"
+	OutcomeNPCFled   = "npc_fled"  // The NPC fled at low HP and got away: no exp or loot
+	OutcomeRetreated = "retreated" // The player retreated at their auto-retreat HP
+)
+
+// NPCs turn to flee below this percent of their HP (EQEmu's Combat:FleeHPRatio) and, while
+// fleeing, get away each round with npcEscapeChance percent unless they are killed first
+const (
+	npcFleeHPPercent = 25
+	npcEscapeChance  = 15
+)
+
+// maxAutoRetreatPercent keeps the auto-retreat threshold below full HP, where every fight would end
+// before it began
+const maxAutoRetreatPercent = 90
+
+// ClampAutoRetreatPercent limits an auto-retreat threshold to 0 (off) through maxAutoRetreatPercent
+func ClampAutoRetreatPercent(percent int) int {
+	return min(max(percent, 0), maxAutoRetreatPercent)
+}
+
+// npcStartsFleeing reports whether the NPC turns to flee at its current HP. Raid targets stand
+// their ground, as do NPCs that con red to the player.
+func (cs *CombatSession) npcStartsFleeing() bool {
+	npc := cs.State.NPC
+	if cs.State.NPCFleeing || npc.RaidTarget || npc.HP <= 0 {
+		return false
+	}
+	if cs.State.NPCCurrentHP*100 >= npc.HP*npcFleeHPPercent {
+		return false
+	}
+	playerLevel := int(cs.Session.Client.CharData().Level)
+	return mechanics.GetConColor(playerLevel, int(npc.Level)) != mechanics.ConRed
+}
+
+// shouldRetreat reports whether the player is at or below their auto-retreat threshold
+func (cs *CombatSession) shouldRetreat(currentHP, maxHP int) bool {
+	percent := ClampAutoRetreatPercent(cs.Session.Client.AutoRetreatPercent())
+	return percent > 0 && maxHP > 0 && currentHP*100 <= maxHP*percent
+}
+
+// handleEscape ends a fight that neither side won, after the NPC fled and got away or the player
+// retreated: no exp, loot or death. An NPC that got away returns to its spawn point for other
+// players to pull, with the damage done to it forgotten.
+func (cs *CombatSession) handleEscape(outcome string) {
+	charData := cs.Session.Client.CharData()
+	npc := cs.State.NPC
+	cs.State.Active = false
+
+	client := cs.Session.Client
+	if cs.onEnd != nil {
+		cs.onEnd(&EndResult{
+			Outcome:     outcome,
+			NPCName:     npc.Name,
+			PlayerHP:    client.GetCurrentHp(),
+			PlayerMaxHP: client.GetMaxHp(),
+		})
+	}
+
+	cs.recordFight(outcome, 0, 0)
+	cs.releaseSpawn()
+	if outcome == OutcomeNPCFled {
+		log.Printf("%s fled from character %d", npc.Name, charData.ID)
+	} else {
+		log.Printf("Character %d retreated from %s", charData.ID, npc.Name)
+	}
+
+	cs.unregister()
+}
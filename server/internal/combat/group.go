@@ -0,0 +1,598 @@
+package combat
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	db_combat "idlequest/internal/db/combat"
+	"idlequest/internal/group"
+	"idlequest/internal/mechanics"
+	"idlequest/internal/session"
+)
+
+// dependency injection for testing
+var (
+	getGroup = func(charID int64) *group.Group {
+		return group.GetManager().Get(charID)
+	}
+	nextGroupLooter = func(groupID int64, eligible []int64) int64 {
+		return group.GetManager().NextLooter(groupID, eligible)
+	}
+	getRaid = func(charID int64) *group.Raid {
+		return group.GetManager().GetRaid(charID)
+	}
+	nextRaidLooter = func(raidID int64, eligible []int64) int64 {
+		return group.GetManager().NextRaidLooter(raidID, eligible)
+	}
+)
+
+// defaultRaidMinMembers is how many raid members must be in the zone to engage a raid target
+// when the world server hasn't set it
+const defaultRaidMinMembers = 12
+
+// groupPullHate is the hate the puller starts with, so the NPC goes for them until another
+// member out-damages them
+const groupPullHate = 1
+
+// groupExpModifier is EQEmu's group experience bonus by the number of members in on the kill
+var groupExpModifier = [group.MaxMembers + 1]float64{0, 1.0, 1.2, 1.4, 1.6, 1.8, 2.16}
+
+// partyInfo is who a character fights alongside: their raid if they are in one, otherwise their
+// group
+type partyInfo struct {
+	groupID  int64
+	raidID   int64
+	lootMode string
+	members  []group.Member
+}
+
+// getParty returns the character's raid or group, or nil if they fight alone
+func getParty(charID int64) *partyInfo {
+	if r := getRaid(charID); r != nil {
+		p := &partyInfo{raidID: r.ID, lootMode: r.LootMode}
+		for _, member := range r.Members {
+			p.members = append(p.members, member.Member)
+		}
+		return p
+	}
+	if g := getGroup(charID); g != nil {
+		return &partyInfo{groupID: g.ID, lootMode: g.LootMode, members: g.Members}
+	}
+	return nil
+}
+
+// SetRaidMinMembers sets how many raid members must be in the zone to engage a raid target
+func (m *CombatManager) SetRaidMinMembers(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.raidMinMembers = n
+}
+
+// raidReady reports whether the party is a raid with enough members online in the zone to
+// engage raid targets
+func (m *CombatManager) raidReady(p *partyInfo, zoneID uint32) bool {
+	if p == nil || p.raidID == 0 {
+		return false
+	}
+	m.mu.RLock()
+	need := m.raidMinMembers
+	m.mu.RUnlock()
+	if need <= 0 {
+		need = defaultRaidMinMembers
+	}
+	inZone := 0
+	for _, member := range p.members {
+		if member.Session.HasValidClient() && member.Session.Client.CharData().ZoneID == zoneID {
+			inZone++
+		}
+	}
+	return inZone >= need
+}
+
+// FightCallbacks relay a fight to one character's client
+type FightCallbacks struct {
+	OnStarted func(npc *db_combat.NPCForCombat, pet *Pet) // Pulled into a groupmate's fight
+	OnRound   func(*RoundResult)
+	OnEnd     func(*EndResult)
+	OnLoot    func([]db_combat.LootDropItem, db_combat.MoneyDrop)
+}
+
+// SetGroupCallbacks sets how fights are relayed to group members a groupmate pulls into a fight
+func (m *CombatManager) SetGroupCallbacks(fn func(*session.Session) FightCallbacks) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.groupCallbacks = fn
+}
+
+// memberCallbacks returns the callbacks for a member pulled into a group fight: their hunt's
+// if they are hunting, so the fight counts toward it, otherwise the world server's
+func (m *CombatManager) memberCallbacks(ses *session.Session) FightCallbacks {
+	if hunt := m.GetHunt(int64(ses.Client.CharData().ID)); hunt != nil {
+		return FightCallbacks{
+			OnStarted: hunt.cb.OnStarted,
+			OnRound:   hunt.cb.OnRound,
+			OnEnd:     hunt.onEnd,
+			OnLoot:    hunt.cb.OnLoot,
+		}
+	}
+	m.mu.RLock()
+	fn := m.groupCallbacks
+	m.mu.RUnlock()
+	if fn == nil {
+		return FightCallbacks{}
+	}
+	return fn(ses)
+}
+
+// groupFight is a group's or raid's fight against one NPC. The NPC's side of the fight lives here; each
+// member keeps their own CombatSession for their attacks, timers, pet and statistics. The NPC
+// attacks whoever has done it the most damage.
+type groupFight struct {
+	mu       sync.Mutex
+	active   bool
+	groupID  int64
+	raidID   int64 // Set instead of groupID for a raid's fight
+	lootMode string
+	zoneID   uint32 // Members must be in this zone to join
+	seed     int64
+	rng      *rand.Rand
+	state    CombatState      // The NPC, its HP and the round; the player fields go unused
+	members  []*CombatSession // Still fighting, in the order they joined
+	hate     map[int64]int    // Damage each member and their pet have done, by character ID
+	targetID int64            // Member the NPC last went for
+
+	// Spawn point the NPC was pulled from, engaged by the puller for the whole fight
+	zone     *zoneSpawns
+	spawn    *spawnPoint
+	pullerID int64
+}
+
+// label names the group or raid fighting, for the log
+func (g *groupFight) label() string {
+	if g.raidID != 0 {
+		return fmt.Sprintf("Raid %d", g.raidID)
+	}
+	return fmt.Sprintf("Group %d", g.groupID)
+}
+
+// findGroupFight returns the fight a groupmate or raid member is in, in the character's zone, or nil
+func (m *CombatManager) findGroupFight(p *partyInfo, ses *session.Session) *groupFight {
+	zoneID := ses.Client.CharData().ZoneID
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, member := range p.members {
+		if cs, ok := m.sessions[member.CharID]; ok && cs.party != nil && cs.party.zoneID == zoneID {
+			return cs.party
+		}
+	}
+	return nil
+}
+
+// startGroupFight starts a fight for the puller's group or raid against the NPC they pulled,
+// bringing in every member in the zone who isn't already fighting
+func (m *CombatManager) startGroupFight(p *partyInfo, puller *CombatSession, zone *zoneSpawns, spawn *spawnPoint) {
+	npc := puller.State.NPC
+	g := &groupFight{
+		active:   true,
+		groupID:  p.groupID,
+		raidID:   p.raidID,
+		lootMode: p.lootMode,
+		zoneID:   puller.Session.Client.CharData().ZoneID,
+		seed:     puller.Seed,
+		rng:      puller.rng,
+		state: CombatState{
+			Active:       true,
+			NPC:          npc,
+			NPCCurrentHP: npc.HP,
+			LastAttack:   time.Now(),
+		},
+		members:  []*CombatSession{puller},
+		hate:     map[int64]int{puller.charID: groupPullHate},
+		zone:     zone,
+		spawn:    spawn,
+		pullerID: puller.charID,
+	}
+
+	var candidates []*CombatSession
+	var candidateStarted []func(*db_combat.NPCForCombat, *Pet)
+	for _, member := range p.members {
+		ses := member.Session
+		if member.CharID == puller.charID || ses == nil || !ses.HasValidClient() ||
+			ses.Client.CharData().ZoneID != g.zoneID || m.IsInCombat(member.CharID) {
+			continue
+		}
+		cb := m.memberCallbacks(ses)
+		candidates = append(candidates, m.newLiveSession(ses, npc, g.rng, g.seed, cb.OnRound, cb.OnEnd, cb.OnLoot))
+		candidateStarted = append(candidateStarted, cb.OnStarted)
+	}
+
+	// Members can start a fight of their own while their sessions are built, so who is free is
+	// decided again under the lock, and anyone who started fighting in the meantime is left out
+	var pulledIn []*CombatSession
+	var started []func(*db_combat.NPCForCombat, *Pet)
+	g.mu.Lock()
+	m.mu.Lock()
+	puller.party = g
+	m.sessions[puller.charID] = puller
+	for i, cs := range candidates {
+		if other, ok := m.sessions[cs.charID]; ok && other.State.Active {
+			continue
+		}
+		cs.party = g
+		m.sessions[cs.charID] = cs
+		g.members = append(g.members, cs)
+		g.hate[cs.charID] = 0
+		pulledIn = append(pulledIn, cs)
+		started = append(started, candidateStarted[i])
+	}
+	m.mu.Unlock()
+	g.mu.Unlock()
+
+	for i, cs := range pulledIn {
+		if started[i] != nil {
+			started[i](npc, cs.State.Pet)
+		}
+	}
+	log.Printf("%s fight started by character %d with %d members", g.label(), g.pullerID, len(g.members))
+}
+
+// join adds a member to the fight. False if the fight has already ended.
+func (g *groupFight) join(cs *CombatSession) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.active {
+		return false
+	}
+	cs.party = g
+	g.members = append(g.members, cs)
+	g.hate[cs.charID] = 0
+
+	m := GetManager()
+	m.mu.Lock()
+	m.sessions[cs.charID] = cs
+	m.mu.Unlock()
+	log.Printf("Character %d joined %s's fight against %s", cs.charID, strings.ToLower(g.label()), g.state.NPC.Name)
+	return true
+}
+
+// leave takes a member out of the fight when they stop fighting
+func (g *groupFight) leave(cs *CombatSession) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if slices.Contains(g.members, cs) {
+		cs.State.Active = false
+		g.remove(cs)
+	}
+}
+
+// remove takes a member whose fight is over out of the group fight, ending it, with the NPC
+// freed for other players, once nobody is left. The caller holds g.mu.
+func (g *groupFight) remove(cs *CombatSession) {
+	g.members = slices.DeleteFunc(g.members, func(member *CombatSession) bool { return member == cs })
+	delete(g.hate, cs.charID)
+	GetManager().dropSession(cs)
+
+	if len(g.members) == 0 && g.active {
+		g.active = false
+		if g.spawn != nil {
+			g.zone.release(g.spawn, g.pullerID)
+		}
+	}
+}
+
+// dropSession removes a group member's session once their part in the fight is over
+func (m *CombatManager) dropSession(cs *CombatSession) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.sessions[cs.charID] == cs {
+		delete(m.sessions, cs.charID)
+	}
+}
+
+// syncIn copies the NPC's side of the fight into a member's session before it acts, so the
+// solo round logic sees the shared NPC. The NPC has its back to every member it isn't attacking.
+func (g *groupFight) syncIn(cs *CombatSession) {
+	cs.rng = g.rng
+	cs.State.NPCCurrentHP = g.state.NPCCurrentHP
+	cs.State.RoundNumber = g.state.RoundNumber
+	cs.State.NPCStunned = g.state.NPCStunned
+	cs.State.NPCFleeing = g.state.NPCFleeing
+	cs.State.MeleeRound = g.state.MeleeRound
+	cs.State.NPCOnPet = g.state.NPCOnPet || g.targetID != cs.charID
+}
+
+// syncOut copies what a member's attacks did to the NPC back to the fight
+func (g *groupFight) syncOut(cs *CombatSession) {
+	g.state.NPCCurrentHP = cs.State.NPCCurrentHP
+	g.state.NPCStunned = cs.State.NPCStunned
+}
+
+// topHate returns the member the NPC wants to attack: the most damage done, the earliest to
+// join on a tie
+func (g *groupFight) topHate() *CombatSession {
+	top := g.members[0]
+	for _, cs := range g.members[1:] {
+		if g.hate[cs.charID] > g.hate[top.charID] {
+			top = cs
+		}
+	}
+	return top
+}
+
+// processRound plays one round for the whole group: every member attacks in the order they
+// joined, then the NPC attacks the member at the top of its hate list
+func (g *groupFight) processRound() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.active {
+		return
+	}
+
+	// Members who logged out drop out of the fight
+	for _, cs := range slices.Clone(g.members) {
+		if !cs.Session.HasValidClient() {
+			cs.State.Active = false
+			g.remove(cs)
+		}
+	}
+	if len(g.members) == 0 {
+		return
+	}
+
+	g.state.RoundNumber++
+
+	// The puller's ranged weapon holds the NPC at range, as in a solo fight, and only members
+	// with ranged weapons of their own get to attack while it closes
+	if g.state.RoundNumber == 1 {
+		g.syncIn(g.members[0])
+		if g.members[0].findRangedAttack() != nil {
+			g.state.MeleeRound = 1 + npcCloseRounds
+		}
+	}
+	npcClosing := g.state.RoundNumber < g.state.MeleeRound
+
+	turns := make(map[*CombatSession]playerTurn, len(g.members))
+	totalDamage := 0
+	for _, cs := range g.members {
+		if g.state.NPCCurrentHP <= 0 {
+			break
+		}
+		g.syncIn(cs)
+		turn := cs.takePlayerTurn(npcClosing)
+		g.syncOut(cs)
+		turns[cs] = turn
+		g.hate[cs.charID] += turn.total()
+		totalDamage += turn.total()
+	}
+
+	npc := g.state.NPC
+	base := RoundResult{
+		NPCHP:       int(g.state.NPCCurrentHP),
+		NPCMaxHP:    int(npc.HP),
+		RoundNumber: g.state.RoundNumber,
+	}
+
+	if g.state.NPCCurrentHP <= 0 {
+		base.NPCDied = true
+		for _, cs := range g.members {
+			g.sendRound(cs, base, turns[cs], totalDamage)
+		}
+		g.handleNPCDeath()
+		return
+	}
+
+	// The NPC flees, gets stunned, closes and attacks just as in a solo fight, against the
+	// member at the top of its hate list
+	target := g.topHate()
+	g.syncIn(target)
+	wasFleeing := g.state.NPCFleeing
+	if target.npcStartsFleeing() {
+		g.state.NPCFleeing = true
+	}
+	npcStunned := g.state.NPCStunned
+	g.state.NPCStunned = false
+	npcAttacks := !npcStunned && !g.state.NPCFleeing && !npcClosing
+	base.NPCStunned = npcStunned
+	base.NPCFleeing = g.state.NPCFleeing
+	base.NPCClosing = npcClosing
+
+	pet := target.State.Pet
+	npcTargetPet := npcAttacks && pet.Alive() && g.rng.Intn(100) < petAggroChance
+	var npcHit, petDied bool
+	var npcDamage int
+	targetAlive := true
+	if npcTargetPet {
+		npcHit, npcDamage = target.calculateNPCAttackOnPet()
+		if npcHit {
+			petDied = GetManager().damagePet(pet, int64(npcDamage))
+		}
+	} else if npcAttacks {
+		npcHit, npcDamage = target.calculateNPCAttack()
+		if npcHit {
+			_, targetAlive = target.Session.Client.TakeDamage(npcDamage)
+			target.State.DamageTaken += npcDamage
+		}
+	}
+	if npcAttacks {
+		g.targetID = target.charID
+		g.state.NPCOnPet = npcTargetPet
+	}
+
+	for _, cs := range g.members {
+		res := base
+		if cs == target {
+			res.NPCHit, res.NPCDamage = npcHit, npcDamage
+			res.NPCTargetPet = npcTargetPet
+			res.PetDied = petDied
+		} else if npcAttacks {
+			res.NPCTarget = target.Session.Client.CharData().Name
+		}
+		g.sendRound(cs, res, turns[cs], totalDamage)
+	}
+
+	// A member the NPC killed drops out and the rest fight on
+	if !targetAlive {
+		target.handlePlayerDeath()
+		g.remove(target)
+	}
+
+	if wasFleeing && g.rng.Intn(100) < npcEscapeChance {
+		for _, cs := range slices.Clone(g.members) {
+			cs.handleEscape(OutcomeNPCFled)
+			g.remove(cs)
+		}
+		log.Printf("%s fled from %s", npc.Name, strings.ToLower(g.label()))
+		return
+	}
+	for _, cs := range slices.Clone(g.members) {
+		client := cs.Session.Client
+		if cs.shouldRetreat(client.GetCurrentHp(), client.GetMaxHp()) {
+			cs.handleEscape(OutcomeRetreated)
+			g.remove(cs)
+		}
+	}
+}
+
+// sendRound sends a member their view of the round: their own attacks, the NPC's side from base,
+// and the damage done by the rest of the group
+func (g *groupFight) sendRound(cs *CombatSession, res RoundResult, turn playerTurn, totalDamage int) {
+	if cs.onRound == nil {
+		return
+	}
+	client := cs.Session.Client
+	res.PlayerHit, res.PlayerDamage, res.PlayerCritical = turn.hit, turn.damage, turn.crit
+	res.Specials, res.Ranged = turn.specials, turn.ranged
+	res.PetHit, res.PetDamage = turn.petHit, turn.petDamage
+	res.PlayerHP = client.GetCurrentHp()
+	res.PlayerMaxHP = client.GetMaxHp()
+	res.PetHP = petHP(cs.State.Pet)
+	res.PetMaxHP = petMaxHP(cs.State.Pet)
+	res.GroupDamage = totalDamage - turn.total()
+	cs.onRound(&res)
+}
+
+// handleNPCDeath ends the fight in victory for every member still in it, splitting the
+// experience and sharing out the loot between them
+func (g *groupFight) handleNPCDeath() {
+	npc := g.state.NPC
+	members := slices.Clone(g.members)
+
+	levels := make([]int, len(members))
+	for i, cs := range members {
+		levels[i] = int(cs.Session.Client.CharData().Level)
+	}
+	exp := splitGroupExp(int(npc.Level), levels)
+
+	lead := members[0]
+	g.syncIn(lead)
+	items, copper := lead.rollLoot()
+	loot := g.shareLoot(members, items)
+	coin := splitCoin(copper, len(members))
+
+	for i, cs := range members {
+		charData := cs.Session.Client.CharData()
+		charData.Exp = mechanics.AddExperience(charData.Exp, exp[i])
+		charData.Level = uint32(mechanics.CalculateLevelFromExp(int(charData.Exp)))
+		cs.State.Active = false
+
+		if cs.onEnd != nil {
+			cs.onEnd(&EndResult{
+				Outcome:     OutcomeVictory,
+				Victory:     true,
+				NPCName:     npc.Name,
+				ExpGained:   exp[i],
+				PlayerHP:    int(charData.CurHp),
+				PlayerMaxHP: cs.Session.Client.GetMaxHp(),
+			})
+		}
+		if cs.onLoot != nil && (len(loot[i]) > 0 || coin[i] > 0) {
+			cs.onLoot(loot[i], db_combat.NewMoneyDrop(coin[i]))
+		}
+		cs.recordFight(OutcomeVictory, exp[i], uint64(coin[i]))
+	}
+
+	// The NPC is dead rather than freed, so empty its spawn point before the members leave
+	if g.spawn != nil {
+		g.zone.killed(g.spawn, g.rng, time.Now())
+		g.spawn = nil
+	}
+	for _, cs := range members {
+		g.remove(cs)
+	}
+	log.Printf("%s killed %s", g.label(), npc.Name)
+}
+
+// shareLoot hands out the drops between the members. A raid's drops go by its leader's loot
+// rules. For a group, round robin gives each drop to the next member in the group's rotation,
+// which carries over between fights; free-for-all gives each to a random member. Returns each
+// member's drops, in the order of members.
+func (g *groupFight) shareLoot(members []*CombatSession, items []db_combat.LootDropItem) [][]db_combat.LootDropItem {
+	loot := make([][]db_combat.LootDropItem, len(members))
+	if len(items) == 0 {
+		return loot
+	}
+	eligible := make([]int64, len(members))
+	for i, cs := range members {
+		eligible[i] = cs.charID
+	}
+	for _, item := range items {
+		idx := 0
+		if g.raidID != 0 {
+			idx = max(slices.Index(eligible, nextRaidLooter(g.raidID, eligible)), 0)
+		} else if g.lootMode == group.LootFreeForAll {
+			idx = g.rng.Intn(len(members))
+		} else {
+			idx = max(slices.Index(eligible, nextGroupLooter(g.groupID, eligible)), 0)
+		}
+		loot[idx] = append(loot[idx], item)
+	}
+	return loot
+}
+
+// splitCoin splits the coin evenly, the copper left over going one each to the first members
+func splitCoin(copper uint32, members int) []uint32 {
+	coin := make([]uint32, members)
+	for i := range coin {
+		coin[i] = copper / uint32(members)
+		if uint32(i) < copper%uint32(members) {
+			coin[i]++
+		}
+	}
+	return coin
+}
+
+// splitGroupExp divides the experience for a kill between the members in on it, as EQEmu does:
+// the NPC's experience times the group bonus for their number, a raid getting a full group's,
+// shared out in proportion to level. Members below half the highest member's level get nothing,
+// nobody gets more than they would for a solo kill three levels above their own, and an NPC that
+// cons gray to the highest member gives nothing at all, so a high level member can't farm trivial
+// NPCs for the rest of the group. Solo kills keep this server's full exp for any NPC. Returns
+// each member's experience, in the order of levels.
+func splitGroupExp(npcLevel int, levels []int) []int {
+	exp := make([]int, len(levels))
+	maxLevel := slices.Max(levels)
+	if mechanics.GetConColor(maxLevel, npcLevel) == mechanics.ConGray {
+		return exp
+	}
+
+	weight := 0
+	for _, level := range levels {
+		if level*2 >= maxLevel {
+			weight += level
+		}
+	}
+	total := float64(db_combat.CalculateExperience(npcLevel)) * groupExpModifier[min(len(levels), group.MaxMembers)]
+	for i, level := range levels {
+		if level*2 < maxLevel {
+			continue
+		}
+		share := int(total * float64(level) / float64(weight))
+		exp[i] = min(share, db_combat.CalculateExperience(level+3))
+	}
+	return exp
+}
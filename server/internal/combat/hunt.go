@@ -0,0 +1,267 @@
+package combat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	db_combat "idlequest/internal/db/combat"
+	"idlequest/internal/db/items"
+	db_zone "idlequest/internal/db/zone"
+	"idlequest/internal/session"
+)
+
+// dependency injection for testing
+var getZoneShortName = func(ctx context.Context, zoneID int) (string, error) {
+	zone, err := db_zone.GetZoneById(ctx, zoneID)
+	if err != nil {
+		return "", err
+	}
+	if zone == nil || zone.ShortName == nil {
+		return "", fmt.Errorf("zone %d not found", zoneID)
+	}
+	return *zone.ShortName, nil
+}
+
+// restRegenPercent is the percent of max HP and mana regained per tick while resting between
+// fights, matching the client's out-of-combat regeneration
+const restRegenPercent = 5
+
+// Reasons a hunt stopped, sent to the client in AutoHuntStatus
+const (
+	HuntStopRequested     = "requested"
+	HuntStopInventoryFull = "inventory_full"
+	HuntStopLevelReached  = "level_reached"
+	HuntStopMaxDeaths     = "max_deaths"
+	HuntStopError         = "error"
+)
+
+// HuntSettings controls when a hunt rests and when it stops
+type HuntSettings struct {
+	RestHPPercent         int  // Rest after a fight until HP is at least this percent of max
+	RestManaPercent       int  // Same for mana; ignored for characters without mana
+	StopAtLevel           int  // Stop once the character reaches this level (0 = never)
+	MaxDeaths             int  // Stop after this many deaths (0 = never)
+	StopWhenInventoryFull bool // Stop when there is no room for loot (ignored while auto-sell is on)
+}
+
+// normalize clamps rest thresholds to 1-100, treating 0 as "rest to full"
+func (s *HuntSettings) normalize() {
+	clamp := func(v int) int {
+		if v <= 0 || v > 100 {
+			return 100
+		}
+		return v
+	}
+	s.RestHPPercent = clamp(s.RestHPPercent)
+	s.RestManaPercent = clamp(s.RestManaPercent)
+}
+
+// HuntStatus is a snapshot of a hunt sent to the client whenever it changes
+type HuntStatus struct {
+	Active     bool
+	Resting    bool
+	Kills      int
+	Deaths     int
+	StopReason string // Set once the hunt stops
+	Detail     string
+	HP         int
+	MaxHP      int
+	Mana       int
+	MaxMana    int
+}
+
+// HuntCallbacks are the combat callbacks used for every fight in a hunt, plus hunt notifications
+type HuntCallbacks struct {
+	OnRound   func(*RoundResult)
+	OnEnd     func(*EndResult)
+	OnLoot    func([]db_combat.LootDropItem, db_combat.MoneyDrop)
+	OnStarted func(npc *db_combat.NPCForCombat, pet *Pet) // A new NPC was pulled
+	OnStatus  func(HuntStatus)
+}
+
+// Hunt keeps a character fighting: after each fight it rests to the thresholds in its settings
+// and then pulls the next NPC in the character's zone, until a stop condition is met
+type Hunt struct {
+	Session  *session.Session
+	Settings HuntSettings
+	Kills    int
+	Deaths   int
+	cb       HuntCallbacks
+}
+
+// StartHunt starts (or restarts with new settings) hunting for the session's character.
+// The first pull happens on the next tick once the character is rested.
+func (m *CombatManager) StartHunt(ses *session.Session, settings HuntSettings, cb HuntCallbacks) {
+	settings.normalize()
+	charID := int64(ses.Client.CharData().ID)
+	hunt := &Hunt{Session: ses, Settings: settings, cb: cb}
+
+	m.mu.Lock()
+	if existing, ok := m.hunts[charID]; ok {
+		hunt.Kills = existing.Kills
+		hunt.Deaths = existing.Deaths
+	}
+	m.hunts[charID] = hunt
+	m.mu.Unlock()
+
+	log.Printf("Hunt started for character %d (%+v)", charID, settings)
+	hunt.sendStatus(!m.IsInCombat(charID), "", "")
+}
+
+// StopHunt stops hunting for a character; a fight in progress plays out but no new NPC is pulled
+func (m *CombatManager) StopHunt(charID int64, reason, message string) {
+	m.mu.Lock()
+	hunt, ok := m.hunts[charID]
+	delete(m.hunts, charID)
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	log.Printf("Hunt stopped for character %d: %s %s", charID, reason, message)
+	if hunt.Session.HasValidClient() {
+		hunt.sendStatus(false, reason, message)
+	}
+}
+
+// GetHunt returns the character's active hunt, or nil
+func (m *CombatManager) GetHunt(charID int64) *Hunt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.hunts[charID]
+}
+
+// processHunts rests or pulls for every hunting character that is not in a fight
+func (m *CombatManager) processHunts() {
+	m.mu.RLock()
+	hunts := make(map[int64]*Hunt, len(m.hunts))
+	for charID, h := range m.hunts {
+		hunts[charID] = h
+	}
+	m.mu.RUnlock()
+
+	for charID, hunt := range hunts {
+		if !hunt.Session.HasValidClient() {
+			m.mu.Lock()
+			delete(m.hunts, charID)
+			m.mu.Unlock()
+			continue
+		}
+		if m.IsInCombat(charID) {
+			continue
+		}
+
+		if reason, message := hunt.stopCondition(); reason != "" {
+			m.StopHunt(charID, reason, message)
+			continue
+		}
+		if !hunt.rested() {
+			hunt.rest()
+			hunt.sendStatus(true, "", "")
+			continue
+		}
+		m.pullNext(charID, hunt)
+	}
+}
+
+// pullNext starts the hunt's next fight in the character's current zone
+func (m *CombatManager) pullNext(charID int64, hunt *Hunt) {
+	ses := hunt.Session
+	charData := ses.Client.CharData()
+
+	zoneShortName, err := getZoneShortName(context.Background(), int(charData.ZoneID))
+	if err != nil {
+		m.StopHunt(charID, HuntStopError, "Unknown zone")
+		return
+	}
+
+	npc, err := m.StartCombat(ses, zoneShortName, int(charData.Level), CombatTarget{}, hunt.cb.OnRound, hunt.onEnd, hunt.cb.OnLoot)
+	var unavailable *TargetUnavailableError
+	if errors.As(err, &unavailable) && (unavailable.Reason == TargetRespawning || unavailable.Reason == TargetEngaged) {
+		// Camp the zone until something near the character's level is free
+		hunt.sendStatus(false, "", unavailable.Message)
+		return
+	}
+	if err != nil {
+		m.StopHunt(charID, HuntStopError, err.Error())
+		return
+	}
+	if hunt.cb.OnStarted != nil {
+		hunt.cb.OnStarted(npc, m.GetPet(charID))
+	}
+	hunt.sendStatus(false, "", "")
+}
+
+// onEnd counts the fight's kill or death before passing it on
+func (h *Hunt) onEnd(res *EndResult) {
+	switch res.Outcome {
+	case OutcomeVictory:
+		h.Kills++
+	case OutcomeDeath:
+		h.Deaths++
+	}
+	if h.cb.OnEnd != nil {
+		h.cb.OnEnd(res)
+	}
+}
+
+// stopCondition returns why the hunt should stop before pulling again, or "" to keep going
+func (h *Hunt) stopCondition() (reason, message string) {
+	charData := h.Session.Client.CharData()
+	if h.Settings.StopAtLevel > 0 && int(charData.Level) >= h.Settings.StopAtLevel {
+		return HuntStopLevelReached, fmt.Sprintf("Reached level %d", charData.Level)
+	}
+	if h.Settings.MaxDeaths > 0 && h.Deaths >= h.Settings.MaxDeaths {
+		return HuntStopMaxDeaths, fmt.Sprintf("Died %d times", h.Deaths)
+	}
+	if h.Settings.StopWhenInventoryFull && !h.Session.Client.AutoSellEnabled() {
+		if bag, _ := items.FindFirstAvailableSlot(h.Session.Client.Items(), false); bag == -1 {
+			return HuntStopInventoryFull, "Inventory is full"
+		}
+	}
+	return "", ""
+}
+
+// rested reports whether HP and mana are at or above the rest thresholds. HP must also be above
+// the auto-retreat threshold, or the next fight would end in a retreat as soon as it began.
+func (h *Hunt) rested() bool {
+	c := h.Session.Client
+	restHP := max(h.Settings.RestHPPercent, ClampAutoRetreatPercent(c.AutoRetreatPercent())+restRegenPercent)
+	if c.GetCurrentHp()*100 < c.GetMaxHp()*min(restHP, 100) {
+		return false
+	}
+	if maxMana := c.GetMaxMana(); maxMana > 0 && c.GetCurrentMana()*100 < maxMana*h.Settings.RestManaPercent {
+		return false
+	}
+	return true
+}
+
+// rest regenerates one tick's worth of HP and mana
+func (h *Hunt) rest() {
+	c := h.Session.Client
+	c.HealDamage(max(c.GetMaxHp()*restRegenPercent/100, 1))
+	if maxMana := c.GetMaxMana(); maxMana > 0 {
+		c.SetCurrentMana(min(c.GetCurrentMana()+max(maxMana*restRegenPercent/100, 1), maxMana))
+	}
+}
+
+func (h *Hunt) sendStatus(resting bool, stopReason, message string) {
+	if h.cb.OnStatus == nil {
+		return
+	}
+	c := h.Session.Client
+	h.cb.OnStatus(HuntStatus{
+		Active:     stopReason == "",
+		Resting:    resting,
+		Kills:      h.Kills,
+		Deaths:     h.Deaths,
+		StopReason: stopReason,
+		Detail:     message,
+		HP:         c.GetCurrentHp(),
+		MaxHP:      c.GetMaxHp(),
+		Mana:       c.GetCurrentMana(),
+		MaxMana:    c.GetMaxMana(),
+	})
+}
@@ -0,0 +1,160 @@
+package combat
+
+import (
+	"math/rand"
+	"time"
+
+	db_combat "idlequest/internal/db/combat"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/mechanics"
+	"idlequest/internal/session"
+)
+
+// Offline progression limits
+const (
+	OfflineMinWindow = 5 * time.Minute // Shorter absences award nothing
+	maxOfflineFights = 300             // Fights simulated at login; the rest of the window is estimated from them
+	offlineMaxRounds = 200             // Rounds before an offline fight counts as a loss
+	maxOfflineLoot   = 100             // Drops awarded at most; the rest are left on the corpses
+)
+
+// OfflineHunt is the hunt a character left running when they logged out: where it was and the
+// level it stops at. It is kept as JSON in character_data.offline_hunt.
+type OfflineHunt struct {
+	ZoneID      int `json:"zone_id"`
+	StopAtLevel int `json:"stop_at_level,omitempty"`
+}
+
+// OfflineHunt returns the hunt as it carries on while the character is logged out, in the zone
+// they are hunting now
+func (h *Hunt) OfflineHunt() OfflineHunt {
+	return OfflineHunt{
+		ZoneID:      int(h.Session.Client.CharData().ZoneID),
+		StopAtLevel: h.Settings.StopAtLevel,
+	}
+}
+
+// OfflineReport summarizes the progress made while a character was logged out
+type OfflineReport struct {
+	Away         time.Duration // Time since the character was last seen, capped at the max window
+	Hunted       time.Duration // Simulated hunting time after the efficiency cap
+	Fights       int
+	Kills        int
+	Deaths       int
+	ExpGained    int
+	LevelsGained int
+	Loot         []db_combat.LootDropItem
+	Money        db_combat.MoneyDrop // Normalized to the largest coins
+}
+
+// SimulateOffline fast-forwards the hunt against npcs for the time the character was away,
+// using the same fight logic as live combat. Only efficiency (0-1) of the time away is hunted,
+// and each fight is followed by resting to full as an online hunt would. The hunt ends at its
+// stop level or at the first death.
+// The character's exp and level are updated in place; loot and money are returned to be awarded.
+func SimulateOffline(ses *session.Session, npcs []db_combat.NPCForCombat, pet *Pet, hunt OfflineHunt, away time.Duration, efficiency float64, seed int64) OfflineReport {
+	report := OfflineReport{Away: away}
+	if len(npcs) == 0 || away < OfflineMinWindow || efficiency <= 0 {
+		return report
+	}
+	if efficiency > 1 {
+		efficiency = 1
+	}
+
+	client := ses.Client
+	charData := client.CharData()
+	startLevel := int(charData.Level)
+	startExp := charData.Exp
+	budget := time.Duration(float64(away) * efficiency)
+	rng := rand.New(rand.NewSource(seed))
+	var copper int
+
+	// Offline fights start rested, like the first pull of a hunt
+	client.RestoreToFull()
+	for report.Fights < maxOfflineFights && report.Hunted < budget && report.Deaths == 0 {
+		if hunt.StopAtLevel > 0 && int(charData.Level) >= hunt.StopAtLevel {
+			break
+		}
+		npc := npcs[rng.Intn(len(npcs))]
+		var fightPet *Pet
+		if pet != nil {
+			p := *pet
+			p.CurrentHP = p.MaxHP
+			fightPet = &p
+		}
+
+		fight := RunFight(ses, &npc, fightPet, rng.Int63(), offlineMaxRounds)
+		report.Fights++
+		switch fight.Outcome {
+		case OutcomeVictory:
+			report.Kills++
+		case OutcomeNPCFled, OutcomeRetreated:
+			// Neither a kill nor a death
+		default:
+			report.Deaths++ // Including fights that ran out of rounds
+		}
+		report.Loot = append(report.Loot, fight.Loot...)
+		copper += fight.Money.Platinum*1000 + fight.Money.Gold*100 + fight.Money.Silver*10 + fight.Money.Copper
+
+		// Time spent fighting, then resting back to full
+		report.Hunted += time.Duration(fight.Rounds)*time.Second + RestTime(ses)
+		client.RestoreToFull()
+	}
+
+	// Past the fight bound, the rest of the window is estimated from the fights simulated
+	if report.Fights == maxOfflineFights && report.Deaths == 0 && report.Hunted < budget {
+		copper += report.estimate(charData, hunt, int(charData.Exp)-int(startExp), copper, budget, rng)
+	}
+
+	report.ExpGained = int(charData.Exp) - int(startExp)
+	report.LevelsGained = int(charData.Level) - startLevel
+	report.Money = db_combat.MoneyDrop{
+		Platinum: copper / 1000,
+		Gold:     copper % 1000 / 100,
+		Silver:   copper % 100 / 10,
+		Copper:   copper % 10,
+	}
+	return report
+}
+
+// RestTime returns how long the character takes to rest from its current HP back to full at
+// the hunt's regen rate, one tick a second
+func RestTime(ses *session.Session) time.Duration {
+	client := ses.Client
+	maxHP := client.GetMaxHp()
+	if maxHP <= 0 {
+		return 0
+	}
+	missing := max(maxHP-client.GetCurrentHp(), 0)
+	perTick := max(maxHP*restRegenPercent/100, 1)
+	return time.Duration((missing+perTick-1)/perTick) * time.Second
+}
+
+// estimate carries the hunt on to the end of the budget at the average rate of the fights
+// simulated so far, which earned exp and copper, and returns the extra copper. Exp is added a
+// fight at a time so the hunt still stops at its stop level.
+func (r *OfflineReport) estimate(charData *model.CharacterData, hunt OfflineHunt, exp, copper int, budget time.Duration, rng *rand.Rand) int {
+	sampled := r.Fights
+	perFight := r.Hunted / time.Duration(sampled)
+	if perFight <= 0 {
+		return 0
+	}
+	extra := 0
+	for r.Hunted+perFight <= budget {
+		if hunt.StopAtLevel > 0 && int(charData.Level) >= hunt.StopAtLevel {
+			break
+		}
+		charData.Exp = mechanics.AddExperience(charData.Exp, exp/sampled)
+		charData.Level = uint32(mechanics.CalculateLevelFromExp(int(charData.Exp)))
+		r.Hunted += perFight
+		extra++
+	}
+
+	r.Fights += extra
+	r.Kills += r.Kills * extra / sampled
+	drops := len(r.Loot) * extra / sampled
+	for i := 0; i < drops && len(r.Loot) < maxOfflineLoot; i++ {
+		r.Loot = append(r.Loot, r.Loot[rng.Intn(len(r.Loot))])
+	}
+	return copper * extra / sampled
+}
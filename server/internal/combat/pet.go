@@ -0,0 +1,286 @@
+package combat
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"idlequest/internal/constants"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	db_pet "idlequest/internal/db/pet"
+	"idlequest/internal/mechanics"
+	"idlequest/internal/session"
+)
+
+// dependency injection for testing
+var (
+	getBestPetSpellID      = db_pet.GetBestPetSpellID
+	getPetForSpell         = db_pet.GetPetForSpell
+	saveCharacterPetInfo   = db_pet.SaveCharacterPetInfo
+	deleteCharacterPetInfo = db_pet.DeleteCharacterPetInfo
+)
+
+// petAggroChance is the percent chance each round that a living pet draws the NPC's attack
+const petAggroChance = 60
+
+// Pet is a summoned pet that fights alongside its owner
+type Pet struct {
+	SpellID   int32
+	Name      string
+	Level     uint8
+	MaxHP     int64
+	CurrentHP int64
+	AC        int16
+	MinDmg    uint32
+	MaxDmg    uint32
+}
+
+// Alive reports whether the pet exists and has HP left
+func (p *Pet) Alive() bool {
+	return p != nil && p.CurrentHP > 0
+}
+
+// petHP and petMaxHP report a possibly-nil pet's HP for round results
+func petHP(pet *Pet) int {
+	if pet == nil {
+		return 0
+	}
+	return int(pet.CurrentHP)
+}
+
+func petMaxHP(pet *Pet) int {
+	if pet == nil {
+		return 0
+	}
+	return int(pet.MaxHP)
+}
+
+// IsPetClass returns true for classes that fight with a summoned pet
+func IsPetClass(class uint8) bool {
+	switch class {
+	case constants.Class_Necromancer, constants.Class_Magician,
+		constants.Class_Enchanter, constants.Class_Beastlord:
+		return true
+	}
+	return false
+}
+
+// petName returns the EQ-style name for a character's pet
+func petName(ownerName string, class uint8) string {
+	if class == constants.Class_Beastlord {
+		return ownerName + "`s warder"
+	}
+	return ownerName + "`s pet"
+}
+
+// newPet builds a full-HP pet from the pet summoned by spellID
+func newPet(ctx context.Context, spellID int32, name string) (*Pet, error) {
+	stats, err := getPetForSpell(ctx, spellID)
+	if err != nil {
+		return nil, err
+	}
+	return &Pet{
+		SpellID:   spellID,
+		Name:      name,
+		Level:     stats.Level,
+		MaxHP:     stats.HP,
+		CurrentHP: stats.HP,
+		AC:        stats.AC,
+		MinDmg:    stats.MinDmg,
+		MaxDmg:    stats.MaxDmg,
+	}, nil
+}
+
+// GetPet returns a copy of the character's current pet, or nil if they have none. The combat
+// tick changes the pet's HP under the manager's lock, so the copy is taken under it too.
+func (m *CombatManager) GetPet(charID int64) *Pet {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	pet, ok := m.pets[charID]
+	if !ok {
+		return nil
+	}
+	p := *pet
+	return &p
+}
+
+// damagePet takes damage off the pet's HP, returning true if that killed it
+func (m *CombatManager) damagePet(pet *Pet, damage int64) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pet.CurrentHP = max(pet.CurrentHP-damage, 0)
+	return pet.CurrentHP == 0
+}
+
+// SummonPet summons the best pet the character can cast, replacing any existing pet.
+// Returns nil (and no error) if the character is not a pet class or has no pet spell yet.
+func (m *CombatManager) SummonPet(ses *session.Session) (*Pet, error) {
+	charData := ses.Client.CharData()
+	if !IsPetClass(uint8(charData.Class)) {
+		return nil, nil
+	}
+
+	spellID, err := getBestPetSpellID(context.Background(), uint8(charData.Class), int(charData.Level))
+	if err != nil {
+		return nil, err
+	}
+	if spellID == 0 {
+		return nil, nil
+	}
+
+	pet, err := newPet(context.Background(), spellID, petName(charData.Name, uint8(charData.Class)))
+	if err != nil {
+		return nil, fmt.Errorf("summon pet spell %d: %w", spellID, err)
+	}
+
+	m.mu.Lock()
+	m.pets[int64(charData.ID)] = pet
+	m.mu.Unlock()
+
+	log.Printf("Character %d summoned %s (level %d, HP %d)", charData.ID, pet.Name, pet.Level, pet.MaxHP)
+	return pet, nil
+}
+
+// LoadPet restores a character's saved pet from character_pet_info, e.g. when they log in
+func (m *CombatManager) LoadPet(ctx context.Context, charID int64) error {
+	info, err := db_pet.GetCharacterPetInfo(ctx, int32(charID))
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	delete(m.pets, charID)
+	m.mu.Unlock()
+
+	if info == nil || info.SpellID == 0 || info.Hp <= 0 {
+		return nil
+	}
+
+	pet, err := newPet(ctx, info.SpellID, info.Petname)
+	if err != nil {
+		return err
+	}
+	if int64(info.Hp) < pet.MaxHP {
+		pet.CurrentHP = int64(info.Hp)
+	}
+
+	m.mu.Lock()
+	m.pets[charID] = pet
+	m.mu.Unlock()
+	return nil
+}
+
+// SavePet persists the character's pet to character_pet_info, removing the row if the pet is dead
+func (m *CombatManager) SavePet(ctx context.Context, charID int64) error {
+	return savePet(ctx, charID, m.GetPet(charID))
+}
+
+func savePet(ctx context.Context, charID int64, pet *Pet) error {
+	if !pet.Alive() {
+		return deleteCharacterPetInfo(ctx, int32(charID))
+	}
+	return saveCharacterPetInfo(ctx, model.CharacterPetInfo{
+		CharID:  int32(charID),
+		Petname: pet.Name,
+		SpellID: pet.SpellID,
+		Hp:      int32(pet.CurrentHP),
+		Size:    1,
+	})
+}
+
+// ReleasePet saves the character's pet and forgets it as they leave the world. It does nothing
+// if the pet was already released, so a disconnect after camping keeps the saved pet.
+func (m *CombatManager) ReleasePet(ctx context.Context, charID int64) error {
+	m.mu.Lock()
+	pet, ok := m.pets[charID]
+	delete(m.pets, charID)
+	var p Pet
+	if ok {
+		p = *pet
+	}
+	m.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return savePet(ctx, charID, &p)
+}
+
+// ensurePet returns the character's living pet, resummoning one if it died or was never summoned.
+// The pet returned is the one the manager keeps, for the fight to change its HP.
+func (m *CombatManager) ensurePet(ses *session.Session) *Pet {
+	charID := int64(ses.Client.CharData().ID)
+	m.mu.RLock()
+	pet := m.pets[charID]
+	m.mu.RUnlock()
+	if pet.Alive() {
+		return pet
+	}
+	pet, err := m.SummonPet(ses)
+	if err != nil {
+		log.Printf("Failed to summon pet for character %d: %v", charID, err)
+		return nil
+	}
+	return pet
+}
+
+func (cs *CombatSession) calculatePetAttack() (hit bool, damage int) {
+	pet := cs.State.Pet
+	npc := cs.State.NPC
+
+	hitChance := mechanics.CalculateHitChance(int(pet.Level), int(npc.Level), 70, 20, 90)
+	if cs.rng.Intn(100) >= hitChance {
+		return false, 0
+	}
+
+	minDmg := int(pet.MinDmg)
+	maxDmg := int(pet.MaxDmg)
+	if minDmg < 1 {
+		minDmg = 1
+	}
+	if maxDmg < minDmg {
+		maxDmg = minDmg
+	}
+
+	damage = minDmg + cs.rng.Intn(maxDmg-minDmg+1)
+
+	// Apply NPC AC mitigation
+	acMitigation := mechanics.CalculateMitigation(int(npc.AC))
+	damage = int(float64(damage) * (1.0 - acMitigation))
+
+	if damage < 1 {
+		damage = 1
+	}
+
+	return true, damage
+}
+
+// calculateNPCAttackOnPet rolls the NPC's attack against the pet instead of the player
+func (cs *CombatSession) calculateNPCAttackOnPet() (hit bool, damage int) {
+	pet := cs.State.Pet
+	npc := cs.State.NPC
+
+	hitChance := mechanics.CalculateHitChance(int(npc.Level), int(pet.Level), 70, 20, 90)
+	if cs.rng.Intn(100) >= hitChance {
+		return false, 0
+	}
+
+	minDmg := int(npc.MinDmg)
+	maxDmg := int(npc.MaxDmg)
+	if minDmg < 1 {
+		minDmg = 1
+	}
+	if maxDmg < minDmg {
+		maxDmg = minDmg
+	}
+
+	damage = minDmg + cs.rng.Intn(maxDmg-minDmg+1)
+
+	acMitigation := mechanics.CalculateMitigation(int(pet.AC))
+	damage = int(float64(damage) * (1.0 - acMitigation))
+
+	if damage < 1 {
+		damage = 1
+	}
+
+	return true, damage
+}
@@ -0,0 +1,163 @@
+package combat
+
+import (
+	"log"
+
+	"idlequest/internal/constants"
+	"idlequest/internal/db/items"
+	"idlequest/internal/mechanics"
+)
+
+// dependency injection for testing
+var saveItemCharges = items.SaveItemCharges
+
+// npcCloseRounds is how many rounds an NPC pulled with a ranged attack takes to close to melee.
+// The player keeps shooting while it closes, and neither side can melee.
+const npcCloseRounds = 4
+
+// rangerArcheryBonusLevel is the level from which rangers do double archery damage
+const rangerArcheryBonusLevel = 51
+
+// RangedAttackResult is one arrow fired or item thrown while the NPC closes to melee
+type RangedAttackResult struct {
+	Skill    int    // Archery or Throwing
+	Name     string // Arrow or throwing item used
+	Hit      bool
+	Damage   int
+	AmmoBag  int8 // Where the ammo came from
+	AmmoSlot int8
+	AmmoLeft int // Charges left in that stack, 0 once it is used up
+}
+
+// rangedAttack is the character's ranged weapon and the ammo it uses up
+type rangedAttack struct {
+	skill   int
+	weapon  *constants.ItemWithInstance
+	ammo    *constants.ItemWithInstance // The weapon itself for throwing items
+	ammoKey constants.InventoryKey
+}
+
+// findRangedAttack returns the character's ranged attack: a bow in the range slot firing arrows
+// from the ammo slot (or, once that is empty, the first arrows in the inventory), or a throwing
+// item in the range slot. Nil without a ranged weapon or ammo for it, or without the skill.
+func (cs *CombatSession) findRangedAttack() *rangedAttack {
+	client := cs.Session.Client
+	level := int(client.CharData().Level)
+	rangeKey := constants.InventoryKey{Slot: constants.SlotRange}
+	weapon := client.GetItem(rangeKey)
+
+	var attack *rangedAttack
+	switch {
+	case weapon.IsTypeThrowing():
+		attack = &rangedAttack{constants.Skill_Throwing, weapon, weapon, rangeKey}
+	case weapon.IsTypeBow():
+		ammoKey := constants.InventoryKey{Slot: constants.SlotAmmo}
+		ammo := client.GetItem(ammoKey)
+		if !ammo.IsTypeArrow() {
+			ammoKey, ammo = findInventoryArrows(client.Items())
+		}
+		if ammo != nil {
+			attack = &rangedAttack{constants.Skill_Archery, weapon, ammo, ammoKey}
+		}
+	}
+	if attack == nil || cs.skillValue(attack.skill, level) <= 0 {
+		return nil
+	}
+	return attack
+}
+
+// findInventoryArrows returns the first arrows in the general inventory, then inside bags,
+// in the order FindFirstAvailableSlot fills them
+func findInventoryArrows(inventory map[constants.InventoryKey]*constants.ItemWithInstance) (constants.InventoryKey, *constants.ItemWithInstance) {
+	for s := int8(constants.SlotGeneral1); s <= int8(constants.SlotGeneral8); s++ {
+		key := constants.InventoryKey{Bag: 0, Slot: s}
+		if item := inventory[key]; item.IsTypeArrow() {
+			return key, item
+		}
+	}
+	for s := int8(constants.SlotGeneral1); s <= int8(constants.SlotGeneral8); s++ {
+		container := inventory[constants.InventoryKey{Bag: 0, Slot: s}]
+		if container == nil || !container.IsContainer() {
+			continue
+		}
+		for slotIdx := int8(0); slotIdx < int8(container.Item.Bagslots); slotIdx++ {
+			key := constants.InventoryKey{Bag: s + 1, Slot: slotIdx}
+			if item := inventory[key]; item.IsTypeArrow() {
+				return key, item
+			}
+		}
+	}
+	return constants.InventoryKey{}, nil
+}
+
+// tryRangedAttack fires the character's ranged weapon if its delay has passed since the last
+// shot, using up one piece of ammo
+func (cs *CombatSession) tryRangedAttack() *RangedAttackResult {
+	if cs.State.RoundNumber < cs.State.RangedReadyRound {
+		return nil
+	}
+	attack := cs.findRangedAttack()
+	if attack == nil {
+		return nil
+	}
+
+	// Weapon delay is in tenths of a second and a round is one second
+	cs.State.RangedReadyRound = cs.State.RoundNumber + max((int(attack.weapon.Item.Delay)+9)/10, 1)
+
+	result := cs.doRangedAttack(attack)
+	result.AmmoBag, result.AmmoSlot = attack.ammoKey.Bag, attack.ammoKey.Slot
+	result.AmmoLeft = cs.useAmmo(attack)
+	return result
+}
+
+// doRangedAttack rolls one shot. Archery adds the arrow's damage to the bow's; both scale with
+// skill like the melee special attacks do.
+func (cs *CombatSession) doRangedAttack(attack *rangedAttack) *RangedAttackResult {
+	charData := cs.Session.Client.CharData()
+	npc := cs.State.NPC
+	skill := cs.skillValue(attack.skill, int(charData.Level))
+	result := &RangedAttackResult{Skill: attack.skill, Name: attack.ammo.Item.Name}
+
+	hitChance := mechanics.CalculateHitChance(int(charData.Level), int(npc.Level), 70+skill/20, 20, 95)
+	if cs.rng.Intn(100) >= hitChance {
+		return result
+	}
+
+	itemDamage := int(attack.weapon.Item.Damage)
+	if attack.skill == constants.Skill_Archery {
+		itemDamage += int(attack.ammo.Item.Damage)
+	}
+	maxDamage := max(itemDamage, 1)*2 + skill/10
+	if attack.skill == constants.Skill_Archery && charData.Class == constants.Class_Ranger && charData.Level >= rangerArcheryBonusLevel {
+		maxDamage *= 2
+	}
+
+	// Roll between half and full damage, then NPC AC mitigation
+	damage := maxDamage/2 + cs.rng.Intn(maxDamage/2+1)
+	damage = int(float64(damage) * (1.0 - mechanics.CalculateMitigation(int(npc.AC))))
+	result.Hit = true
+	result.Damage = max(damage, 1)
+	return result
+}
+
+// useAmmo uses up one charge of the attack's ammo, removing the stack once it is empty, and
+// returns the charges left. Only live fights use up ammo; simulated fights shoot for free.
+func (cs *CombatSession) useAmmo(attack *rangedAttack) int {
+	ammo := attack.ammo
+	if !cs.consumeAmmo {
+		return int(ammo.Instance.Charges)
+	}
+
+	if ammo.Instance.Charges > 0 {
+		ammo.Instance.Charges--
+	}
+	client := cs.Session.Client
+	charID := client.CharData().ID
+	if err := saveItemCharges(int32(charID), ammo); err != nil {
+		log.Printf("Failed to save ammo for character %d: %v", charID, err)
+	}
+	if ammo.Instance.Charges == 0 {
+		client.DeleteItem(attack.ammoKey)
+	}
+	return int(ammo.Instance.Charges)
+}
@@ -0,0 +1,73 @@
+package combat
+
+import (
+	"math/rand"
+
+	db_combat "idlequest/internal/db/combat"
+	"idlequest/internal/session"
+)
+
+// FightReport summarizes a single fight played out by RunFight
+type FightReport struct {
+	Outcome     string // One of the Outcome constants, "" if the fight ran out of rounds
+	Victory     bool
+	Rounds      int
+	DamageDealt int // Player and pet damage to the NPC
+	DamageTaken int // Damage to the player (not the pet)
+	HighestHit  int
+	ExpGained   int
+	Loot        []db_combat.LootDropItem
+	Money       db_combat.MoneyDrop
+}
+
+// RunFight plays one fight to completion on the calling goroutine using the same round,
+// death and loot logic as live combat, without registering it with the CombatManager tick loop.
+// Fights still going after maxRounds are reported as losses with no Outcome.
+// The character's exp, level and HP are changed exactly as a live fight would change them.
+func RunFight(ses *session.Session, npc *db_combat.NPCForCombat, pet *Pet, seed int64, maxRounds int) FightReport {
+	var report FightReport
+
+	cs := &CombatSession{
+		Session: ses,
+		Seed:    seed,
+		rng:     rand.New(rand.NewSource(seed)),
+		State: CombatState{
+			Active:       true,
+			NPC:          npc,
+			NPCCurrentHP: npc.HP,
+			Pet:          pet,
+		},
+		onRound: func(res *RoundResult) {
+			report.Rounds = res.RoundNumber
+			report.DamageDealt += res.PlayerDamage + res.PetDamage
+			if res.Ranged != nil {
+				report.DamageDealt += res.Ranged.Damage
+				report.HighestHit = max(report.HighestHit, res.Ranged.Damage)
+			}
+			for _, special := range res.Specials {
+				report.DamageDealt += special.Damage
+				report.HighestHit = max(report.HighestHit, special.Damage)
+			}
+			if !res.NPCTargetPet {
+				report.DamageTaken += res.NPCDamage
+			}
+			if res.PlayerDamage > report.HighestHit {
+				report.HighestHit = res.PlayerDamage
+			}
+		},
+		onEnd: func(res *EndResult) {
+			report.Outcome = res.Outcome
+			report.Victory = res.Victory
+			report.ExpGained = res.ExpGained
+		},
+		onLoot: func(loot []db_combat.LootDropItem, money db_combat.MoneyDrop) {
+			report.Loot = loot
+			report.Money = money
+		},
+	}
+
+	for i := 0; i < maxRounds && cs.State.Active; i++ {
+		cs.processCombatRound()
+	}
+	return report
+}
@@ -0,0 +1,233 @@
+package combat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	db_combat "idlequest/internal/db/combat"
+	db_zone "idlequest/internal/db/zone"
+)
+
+// dependency injection for testing
+var (
+	getZoneSpawnPool = db_zone.GetZoneSpawnPool
+	getRespawnTimes  = db_zone.GetRespawnTimes
+	saveRespawnTime  = db_zone.SaveRespawnTime
+)
+
+// zoneSpawns is the live spawn state of one zone, shared by every player fighting there.
+// Each spawn point (spawn2 row) holds at most one NPC rolled from its spawn group; killing it
+// empties the point until the respawn timer, persisted in respawn_times, runs out.
+type zoneSpawns struct {
+	mu     sync.Mutex
+	zone   string
+	points map[int64]*spawnPoint
+	order  []int64 // spawn2 IDs sorted, so the same seed replays the same pick
+}
+
+// spawnPoint is one spawn2 row's live state
+type spawnPoint struct {
+	pool      *db_zone.SpawnPoolEntry
+	upNPCID   int32     // NPC currently up, 0 while empty
+	respawnAt time.Time // An empty point repops with a fresh roll of its spawn group at this time
+	engagedBy int64     // Character fighting the NPC that's up, 0 if nobody is
+}
+
+// getZoneSpawns returns the zone's live spawn state, loading it from the spawn pool and
+// respawn_times the first time anyone fights there
+func (m *CombatManager) getZoneSpawns(zoneShortName string, now time.Time) (*zoneSpawns, error) {
+	m.mu.RLock()
+	z, ok := m.zones[zoneShortName]
+	m.mu.RUnlock()
+	if ok {
+		return z, nil
+	}
+
+	pool, err := getZoneSpawnPool(zoneShortName)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int32, 0, len(pool))
+	for spawn2ID := range pool {
+		ids = append(ids, int32(spawn2ID))
+	}
+	respawns, err := getRespawnTimes(context.Background(), ids)
+	if err != nil {
+		return nil, err
+	}
+
+	z = &zoneSpawns{zone: zoneShortName, points: make(map[int64]*spawnPoint, len(pool))}
+	for spawn2ID, entry := range pool {
+		point := &spawnPoint{pool: entry}
+		if r, ok := respawns[int32(spawn2ID)]; ok {
+			point.respawnAt = time.Unix(int64(r.Start)+int64(r.Duration), 0)
+		}
+		z.points[spawn2ID] = point
+		z.order = append(z.order, spawn2ID)
+	}
+	sort.Slice(z.order, func(i, j int) bool { return z.order[i] < z.order[j] })
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.zones[zoneShortName]; ok {
+		return existing, nil // Another fight loaded the zone first
+	}
+	if m.zones == nil {
+		m.zones = make(map[string]*zoneSpawns)
+	}
+	m.zones[zoneShortName] = z
+	log.Printf("Loaded %d spawn points for zone %s", len(z.points), zoneShortName)
+	return z, nil
+}
+
+// repop rolls the spawn group of every empty spawn point whose respawn timer has run out.
+// Caller holds z.mu.
+func (z *zoneSpawns) repop(rng *rand.Rand, now time.Time) {
+	for _, spawn2ID := range z.order {
+		point := z.points[spawn2ID]
+		if point.upNPCID == 0 && !now.Before(point.respawnAt) {
+			point.upNPCID = rollSpawnEntry(rng, point.pool.SpawnEntries)
+		}
+	}
+}
+
+// claimRandom engages a random NPC that is up and unengaged within levelRange of the player's
+// level. Raid targets are only picked when raidReady.
+func (z *zoneSpawns) claimRandom(charID int64, playerLevel, levelRange int, raidReady bool, rng *rand.Rand, now time.Time) (*db_combat.NPCForCombat, *spawnPoint, error) {
+	minLevel := max(playerLevel-levelRange, 1)
+	maxLevel := playerLevel + levelRange
+	inRange := func(se *db_zone.SpawnEntryWithNPC) bool {
+		return se.NPCType != nil && se.NPCType.Hp > 0 && (raidReady || se.NPCType.RaidTarget == 0) &&
+			int(se.NPCType.Level) >= minLevel && int(se.NPCType.Level) <= maxLevel
+	}
+
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	z.repop(rng, now)
+
+	var eligible []*spawnPoint
+	var engaged bool
+	var nextRespawn time.Duration
+	for _, spawn2ID := range z.order {
+		point := z.points[spawn2ID]
+		if point.upNPCID == 0 {
+			for _, se := range point.pool.SpawnEntries {
+				if inRange(se) {
+					if wait := point.respawnAt.Sub(now); wait > 0 && (nextRespawn == 0 || wait < nextRespawn) {
+						nextRespawn = wait
+					}
+					break
+				}
+			}
+			continue
+		}
+		if se := point.upEntry(); se != nil && inRange(se) {
+			if point.engagedBy != 0 && point.engagedBy != charID {
+				engaged = true
+				continue
+			}
+			eligible = append(eligible, point)
+		}
+	}
+
+	if len(eligible) > 0 {
+		point := eligible[rng.Intn(len(eligible))]
+		point.engagedBy = charID
+		npc := db_combat.NewNPCForCombat(*point.upEntry().NPCType)
+		return &npc, point, nil
+	}
+	if nextRespawn > 0 {
+		return nil, nil, &TargetUnavailableError{
+			Reason:    TargetRespawning,
+			Message:   fmt.Sprintf("Nothing near your level is up; the next repop is in %s", nextRespawn.Round(time.Second)),
+			RespawnIn: nextRespawn,
+		}
+	}
+	if engaged {
+		return nil, nil, &TargetUnavailableError{Reason: TargetEngaged, Message: "Everything near your level is already engaged"}
+	}
+	return nil, nil, fmt.Errorf("no NPCs found in zone %s for level range %d-%d", z.zone, minLevel, maxLevel)
+}
+
+// upEntry returns the spawn entry of the NPC that is up, nil if the point is empty
+func (p *spawnPoint) upEntry() *db_zone.SpawnEntryWithNPC {
+	if p.upNPCID == 0 {
+		return nil
+	}
+	for _, se := range p.pool.SpawnEntries {
+		if se.NPCType != nil && se.NPCType.ID == p.upNPCID {
+			return se
+		}
+	}
+	return nil
+}
+
+// killed empties the spawn point for its respawn time, spawn2 respawntime plus or minus up to
+// variance seconds, and persists the timer so it survives a restart
+func (z *zoneSpawns) killed(point *spawnPoint, rng *rand.Rand, now time.Time) {
+	spawn2 := point.pool.Spawn2
+	respawn := spawn2.Respawntime
+	if spawn2.Variance > 0 {
+		respawn += rng.Int31n(2*spawn2.Variance+1) - spawn2.Variance
+	}
+	respawn = max(respawn, 0)
+
+	z.mu.Lock()
+	point.upNPCID = 0
+	point.engagedBy = 0
+	point.respawnAt = now.Add(time.Duration(respawn) * time.Second)
+	z.mu.Unlock()
+
+	if err := saveRespawnTime(context.Background(), spawn2.ID, int32(now.Unix()), respawn); err != nil {
+		log.Printf("Failed to save respawn timer for spawn2 %d in %s: %v", spawn2.ID, z.zone, err)
+	}
+}
+
+// release frees the NPC the character was fighting for other players, leaving it up
+func (z *zoneSpawns) release(point *spawnPoint, charID int64) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	if point.engagedBy == charID {
+		point.engagedBy = 0
+	}
+}
+
+// rollSpawnEntry picks the NPC that spawns from a spawn group, weighted by spawnentry.chance
+func rollSpawnEntry(rng *rand.Rand, entries []*db_zone.SpawnEntryWithNPC) int32 {
+	total := 0
+	for _, se := range entries {
+		if se.NPCType != nil && se.SpawnEntry.Chance > 0 {
+			total += int(se.SpawnEntry.Chance)
+		}
+	}
+	if total == 0 {
+		// No chances set: every entry is equally likely
+		var ids []int32
+		for _, se := range entries {
+			if se.NPCType != nil {
+				ids = append(ids, se.NPCType.ID)
+			}
+		}
+		if len(ids) == 0 {
+			return 0
+		}
+		return ids[rng.Intn(len(ids))]
+	}
+
+	roll := rng.Intn(total)
+	for _, se := range entries {
+		if se.NPCType == nil || se.SpawnEntry.Chance <= 0 {
+			continue
+		}
+		roll -= int(se.SpawnEntry.Chance)
+		if roll < 0 {
+			return se.NPCType.ID
+		}
+	}
+	return 0
+}
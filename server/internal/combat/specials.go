@@ -0,0 +1,168 @@
+package combat
+
+import (
+	"context"
+	"log"
+
+	"idlequest/internal/constants"
+	db_character "idlequest/internal/db/character"
+	"idlequest/internal/mechanics"
+)
+
+// dependency injection for testing
+var getCharacterSkills = db_character.GetCharacterSkills
+
+// specialAttack is a class skill fired automatically whenever its reuse timer is up
+type specialAttack struct {
+	skill    int
+	name     string
+	minLevel int
+	reuse    int // Rounds between uses
+}
+
+var (
+	specialKick       = specialAttack{constants.Skill_Kick, "Kick", 1, 6}
+	specialBash       = specialAttack{constants.Skill_Bash, "Bash", 6, 6}
+	specialBackstab   = specialAttack{constants.Skill_Backstab, "Backstab", 10, 10}
+	specialFlyingKick = specialAttack{constants.Skill_FlyingKick, "Flying Kick", 30, 8}
+)
+
+// classSpecialAttacks lists each class's special attacks, best first. A class shares one reuse
+// timer between them, like the combat ability timer in the original game, and each round uses the
+// first one it can.
+var classSpecialAttacks = map[uint8][]specialAttack{
+	constants.Class_Warrior: {specialBash, specialKick},
+	constants.Class_Paladin: {specialBash},
+	constants.Class_Rogue:   {specialBackstab},
+	constants.Class_Monk:    {specialFlyingKick, specialKick},
+}
+
+// Bash stuns NPCs up to this many levels above the player, skipping the NPC's next attack
+const (
+	bashStunChance   = 30
+	bashStunMaxLevel = 3
+)
+
+// SpecialAttackResult is one special attack made during a round, shown as its own combat line
+type SpecialAttackResult struct {
+	Skill   int
+	Name    string
+	Hit     bool
+	Damage  int
+	Stunned bool // Bash stunned the NPC, which loses its next attack
+	Behind  bool // Backstab landed from behind for full damage
+}
+
+// loadSkills reads the character's trained skills for special attacks. Classes without
+// special attacks skip the query.
+func (cs *CombatSession) loadSkills(charID int64, class uint8) {
+	if _, ok := classSpecialAttacks[class]; !ok {
+		return
+	}
+	skills, err := getCharacterSkills(context.Background(), charID)
+	if err != nil {
+		log.Printf("Failed to load skills for character %d: %v", charID, err)
+		return
+	}
+	cs.skills = make(map[int]int, len(skills))
+	for _, s := range skills {
+		cs.skills[int(s.SkillID)] = int(s.Value)
+	}
+}
+
+// skillValue returns the character's skill. Without trained values (simulated fights) a skill
+// counts as raised to the melee skill curve for the character's level.
+func (cs *CombatSession) skillValue(skill int, level int) int {
+	if cs.skills == nil {
+		return min(level*5+5, 200)
+	}
+	return cs.skills[skill]
+}
+
+// trySpecialAttack fires the character's best ready special attack, if any, and starts its reuse timer
+func (cs *CombatSession) trySpecialAttack() *SpecialAttackResult {
+	charData := cs.Session.Client.CharData()
+	attacks, ok := classSpecialAttacks[uint8(charData.Class)]
+	if !ok || cs.State.RoundNumber < cs.State.SpecialReadyRound {
+		return nil
+	}
+
+	level := int(charData.Level)
+	for _, attack := range attacks {
+		skill := cs.skillValue(attack.skill, level)
+		if level < attack.minLevel || skill <= 0 || !cs.canUseSpecial(attack) {
+			continue
+		}
+		cs.State.SpecialReadyRound = cs.State.RoundNumber + attack.reuse
+		return cs.doSpecialAttack(attack, skill)
+	}
+	return nil
+}
+
+// canUseSpecial checks equipment: bash needs a shield, backstab a piercing weapon
+func (cs *CombatSession) canUseSpecial(attack specialAttack) bool {
+	client := cs.Session.Client
+	switch attack.skill {
+	case constants.Skill_Bash:
+		return client.GetItem(constants.InventoryKey{Slot: constants.SlotSecondary}).IsTypeShield()
+	case constants.Skill_Backstab:
+		return client.GetItem(constants.InventoryKey{Slot: constants.SlotPrimary}).IsType1HPiercing()
+	}
+	return true
+}
+
+// doSpecialAttack rolls one special attack. Damage follows EQEmu's base skill damage: kicks and
+// bash scale with skill (bash also with shield AC), backstab multiplies the piercer's damage.
+func (cs *CombatSession) doSpecialAttack(attack specialAttack, skill int) *SpecialAttackResult {
+	charData := cs.Session.Client.CharData()
+	npc := cs.State.NPC
+	result := &SpecialAttackResult{Skill: attack.skill, Name: attack.name}
+
+	// There is no positioning in idle combat: a rogue is behind the NPC on the opening round,
+	// before it turns to fight, and whenever it is busy with the rogue's pet. From the front
+	// a backstab is just another swing of the weapon.
+	if attack.skill == constants.Skill_Backstab {
+		result.Behind = cs.State.RoundNumber == 1 || cs.State.NPCOnPet
+		if !result.Behind {
+			result.Hit, result.Damage, _ = cs.calculatePlayerAttack()
+			return result
+		}
+	}
+
+	hitChance := mechanics.CalculateHitChance(int(charData.Level), int(npc.Level), 80, 20, 95)
+	if cs.rng.Intn(100) >= hitChance {
+		return result
+	}
+
+	var maxDamage int
+	switch attack.skill {
+	case constants.Skill_Kick:
+		maxDamage = 3 + skill/10
+	case constants.Skill_FlyingKick:
+		maxDamage = 25 + skill/9
+	case constants.Skill_Bash:
+		maxDamage = 2 + skill/10
+		if shield := cs.Session.Client.GetItem(constants.InventoryKey{Slot: constants.SlotSecondary}); shield != nil {
+			maxDamage += int(shield.Item.Ac) / 25
+		}
+	case constants.Skill_Backstab:
+		weapon := cs.Session.Client.GetItem(constants.InventoryKey{Slot: constants.SlotPrimary})
+		maxDamage = int(float64(max(int(weapon.Item.Damage), 3)) * (float64(skill)*0.02 + 2))
+	}
+
+	// Roll between half and full damage, then NPC AC mitigation
+	damage := maxDamage/2 + cs.rng.Intn(maxDamage/2+1)
+	damage = int(float64(damage) * (1.0 - mechanics.CalculateMitigation(int(npc.AC))))
+	if attack.skill == constants.Skill_Backstab {
+		damage = max(damage, int(charData.Level)) // Backstab from behind hits for at least the rogue's level
+	}
+	result.Hit = true
+	result.Damage = max(damage, 1)
+
+	if attack.skill == constants.Skill_Bash && !npc.RaidTarget &&
+		int(npc.Level) <= int(charData.Level)+bashStunMaxLevel && cs.rng.Intn(100) < bashStunChance {
+		result.Stunned = true
+		cs.State.NPCStunned = true
+	}
+	return result
+}
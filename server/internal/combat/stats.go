@@ -0,0 +1,198 @@
+package combat
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"idlequest/internal/db"
+	db_stats "idlequest/internal/db/stats"
+)
+
+// dependency injection for testing
+var addCombatStats = db_stats.AddCombatStats
+
+// statsFlushInterval is how often buffered combat stats are written to the database.
+// Fights end every few seconds per character, so stats are batched instead of written per kill.
+const statsFlushInterval = 30 * time.Second
+
+// FightStats is what one finished fight adds to a character's combat statistics
+type FightStats struct {
+	CharID      uint32
+	NPCID       int32
+	NPCName     string
+	Victory     bool
+	Escaped     bool // The NPC fled or the player retreated: neither a kill nor a death
+	DamageDealt int
+	DamageTaken int
+	HighestHit  int
+	ExpGained   int
+	Coin        uint64 // in copper
+}
+
+// pendingStats are the counters recorded for a character since their last flush
+type pendingStats struct {
+	totals db_stats.Totals
+	npcs   map[int32]db_stats.NPCTotals
+}
+
+// StatsRecorder buffers per-character combat statistics and periodically adds them to the database
+type StatsRecorder struct {
+	mu      sync.Mutex
+	pending map[uint32]*pendingStats // keyed by character ID
+	ticker  *time.Ticker
+	done    chan struct{}
+}
+
+var globalStatsRecorder *StatsRecorder
+var statsRecorderOnce sync.Once
+
+// GetStatsRecorder returns the global stats recorder singleton
+func GetStatsRecorder() *StatsRecorder {
+	statsRecorderOnce.Do(func() {
+		globalStatsRecorder = newStatsRecorder()
+		globalStatsRecorder.Start()
+	})
+	return globalStatsRecorder
+}
+
+func newStatsRecorder() *StatsRecorder {
+	return &StatsRecorder{
+		pending: make(map[uint32]*pendingStats),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start begins the periodic flush loop
+func (r *StatsRecorder) Start() {
+	r.ticker = time.NewTicker(statsFlushInterval)
+	go func() {
+		for {
+			select {
+			case <-r.done:
+				return
+			case <-r.ticker.C:
+				r.Flush(context.Background())
+			}
+		}
+	}()
+}
+
+// Stop stops the flush loop and writes out anything still buffered
+func (r *StatsRecorder) Stop() {
+	close(r.done)
+	if r.ticker != nil {
+		r.ticker.Stop()
+	}
+	r.Flush(context.Background())
+}
+
+// Record adds a finished fight to the character's buffered statistics
+func (r *StatsRecorder) Record(f FightStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.pending[f.CharID]
+	if !ok {
+		p = &pendingStats{npcs: make(map[int32]db_stats.NPCTotals)}
+		r.pending[f.CharID] = p
+	}
+
+	fight := db_stats.Totals{
+		DamageDealt: uint64(f.DamageDealt),
+		DamageTaken: uint64(f.DamageTaken),
+		HighestHit:  uint32(f.HighestHit),
+		ExpEarned:   uint64(f.ExpGained),
+		CoinEarned:  f.Coin,
+	}
+	if f.Victory {
+		fight.Kills = 1
+	} else if !f.Escaped {
+		fight.Deaths = 1
+	}
+	addTotals(&p.totals, fight)
+
+	npc := p.npcs[f.NPCID]
+	npc.NpcName = f.NPCName
+	addTotals(&npc.Totals, fight)
+	if f.Victory {
+		now := time.Now()
+		npc.LastKilledAt = &now
+	}
+	p.npcs[f.NPCID] = npc
+}
+
+// addTotals adds b to a; highest hit keeps the larger value
+func addTotals(a *db_stats.Totals, b db_stats.Totals) {
+	a.Kills += b.Kills
+	a.Deaths += b.Deaths
+	a.DamageDealt += b.DamageDealt
+	a.DamageTaken += b.DamageTaken
+	a.ExpEarned += b.ExpEarned
+	a.CoinEarned += b.CoinEarned
+	if b.HighestHit > a.HighestHit {
+		a.HighestHit = b.HighestHit
+	}
+}
+
+// Flush writes every character's buffered statistics to the database
+func (r *StatsRecorder) Flush(ctx context.Context) {
+	if db.GlobalWorldDB == nil {
+		return
+	}
+
+	r.mu.Lock()
+	charIDs := make([]uint32, 0, len(r.pending))
+	for charID := range r.pending {
+		charIDs = append(charIDs, charID)
+	}
+	r.mu.Unlock()
+
+	for _, charID := range charIDs {
+		if err := r.FlushCharacter(ctx, charID); err != nil {
+			log.Printf("Failed to flush combat stats for character %d: %v", charID, err)
+		}
+	}
+}
+
+// FlushCharacter writes one character's buffered statistics to the database, e.g. when they camp
+// or before their statistics are read. Stats that fail to write are kept for the next flush.
+func (r *StatsRecorder) FlushCharacter(ctx context.Context, charID uint32) error {
+	r.mu.Lock()
+	p, ok := r.pending[charID]
+	delete(r.pending, charID)
+	r.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	if err := addCombatStats(ctx, charID, p.totals, p.npcs); err != nil {
+		r.restore(charID, p)
+		return err
+	}
+	return nil
+}
+
+// restore puts back stats that failed to flush, merging any recorded since
+func (r *StatsRecorder) restore(charID uint32, p *pendingStats) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.pending[charID]
+	if !ok {
+		r.pending[charID] = p
+		return
+	}
+	addTotals(&p.totals, current.totals)
+	for npcID, npc := range current.npcs {
+		merged := p.npcs[npcID]
+		merged.NpcName = npc.NpcName
+		addTotals(&merged.Totals, npc.Totals)
+		if npc.LastKilledAt != nil {
+			merged.LastKilledAt = npc.LastKilledAt
+		}
+		p.npcs[npcID] = merged
+	}
+	r.pending[charID] = p
+}
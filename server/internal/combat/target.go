@@ -0,0 +1,121 @@
+package combat
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	db_combat "idlequest/internal/db/combat"
+)
+
+// CombatTarget optionally names what a player wants to fight. The zero value picks a random NPC
+// that is up near the player's level.
+type CombatTarget struct {
+	NPCID        int32 // npc_types ID
+	SpawnGroupID int32 // Fight whatever is up at this spawn group's spawn points
+}
+
+// IsSet reports whether the player chose a target
+func (t CombatTarget) IsSet() bool {
+	return t.NPCID != 0 || t.SpawnGroupID != 0
+}
+
+// Reasons a target can't be fought, sent to the client in CombatStartedResponse
+const (
+	TargetNotInZone     = "not_in_zone"
+	TargetNotAttackable = "not_attackable"
+	TargetNotUp         = "not_up"     // Another NPC from the spawn group is up instead
+	TargetRespawning    = "respawning" // Every spawn point for the target is waiting to repop
+	TargetEngaged       = "engaged"    // Another player is fighting the target
+	TargetRaidOnly      = "raid_only"  // A raid target, and the player's raid isn't big enough in the zone
+)
+
+// TargetUnavailableError explains why a target can't be fought right now
+type TargetUnavailableError struct {
+	Reason    string
+	Message   string
+	RespawnIn time.Duration // For TargetRespawning, time until the first spawn point repops
+}
+
+func (e *TargetUnavailableError) Error() string {
+	return e.Message
+}
+
+// claimTarget engages the target at a spawn point where it is up and nobody else is fighting it.
+// Spawn points that have repopped roll their spawn group's chances first, so a rare may be held
+// by its placeholder. Raid targets can only be claimed when raidReady.
+func (z *zoneSpawns) claimTarget(charID int64, target CombatTarget, raidReady bool, rng *rand.Rand, now time.Time) (*db_combat.NPCForCombat, *spawnPoint, error) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	var candidates []*spawnPoint
+	for _, spawn2ID := range z.order {
+		point := z.points[spawn2ID]
+		if target.SpawnGroupID != 0 && point.pool.SpawnGroup != nil && point.pool.SpawnGroup.ID == target.SpawnGroupID {
+			candidates = append(candidates, point)
+			continue
+		}
+		if target.NPCID != 0 {
+			for _, se := range point.pool.SpawnEntries {
+				if se.NPCType != nil && se.NPCType.ID == target.NPCID {
+					candidates = append(candidates, point)
+					break
+				}
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil, &TargetUnavailableError{Reason: TargetNotInZone, Message: "That target does not spawn in this zone"}
+	}
+	z.repop(rng, now)
+
+	var placeholder, engaged string
+	var nextRespawn time.Duration
+	for _, point := range candidates {
+		se := point.upEntry()
+		if se == nil {
+			if wait := point.respawnAt.Sub(now); wait > 0 && (nextRespawn == 0 || wait < nextRespawn) {
+				nextRespawn = wait
+			}
+			continue
+		}
+		if target.NPCID != 0 && se.NPCType.ID != target.NPCID {
+			placeholder = se.NPCType.Name
+			continue
+		}
+		if se.NPCType.Hp <= 0 {
+			return nil, nil, &TargetUnavailableError{Reason: TargetNotAttackable, Message: fmt.Sprintf("%s cannot be attacked", se.NPCType.Name)}
+		}
+		if se.NPCType.RaidTarget != 0 && !raidReady {
+			return nil, nil, &TargetUnavailableError{
+				Reason:  TargetRaidOnly,
+				Message: fmt.Sprintf("%s can only be engaged by a raid with enough members in the zone", se.NPCType.Name),
+			}
+		}
+		if point.engagedBy != 0 && point.engagedBy != charID {
+			engaged = se.NPCType.Name
+			continue
+		}
+		point.engagedBy = charID
+		npc := db_combat.NewNPCForCombat(*se.NPCType)
+		return &npc, point, nil
+	}
+
+	if engaged != "" {
+		return nil, nil, &TargetUnavailableError{
+			Reason:  TargetEngaged,
+			Message: fmt.Sprintf("%s is already engaged by another player", engaged),
+		}
+	}
+	if placeholder != "" {
+		return nil, nil, &TargetUnavailableError{
+			Reason:  TargetNotUp,
+			Message: fmt.Sprintf("Your target is not up; %s holds its spawn point", placeholder),
+		}
+	}
+	return nil, nil, &TargetUnavailableError{
+		Reason:    TargetRespawning,
+		Message:   fmt.Sprintf("Your target has been killed and repops in %s", nextRespawn.Round(time.Second)),
+		RespawnIn: nextRespawn,
+	}
+}
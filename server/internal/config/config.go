@@ -45,6 +45,14 @@ type Config struct {
 	LocalQuests bool   `json:"localQuests"`
 	GracePeriod int    `json:"gracePeriod"`
 	OpenAIKey   string `json:"openai_key"`
+
+	// Offline progression: the share of an online hunt's gains awarded while logged out,
+	// and the longest absence that counts
+	OfflineEfficiency float64 `json:"offline_efficiency"`
+	OfflineMaxHours   int     `json:"offline_max_hours"`
+
+	// Raids: how many members of a raid must be in the zone to engage a raid target
+	RaidMinMembers int `json:"raid_min_members"`
 }
 
 var config *Config
@@ -64,6 +72,11 @@ func Get() (*Config, error) {
 		Local:       true,        // Default local setting
 		LocalQuests: false,       // Default local setting
 		GracePeriod: 5,           // Default local setting
+
+		OfflineEfficiency: 0.5, // Half the rate of hunting online
+		OfflineMaxHours:   12,
+
+		RaidMinMembers: 12, // Two full groups
 	}
 
 	// Load embedded default config (public-safe values)
@@ -12,6 +12,7 @@ const (
 	OwnerTypeCharacter OwnerType = 0
 	OwnerTypeMerchant  OwnerType = 1
 	OwnerTypeGuild     OwnerType = 2
+	OwnerTypeParcel    OwnerType = 3 // Attached to mail; OwnerID is the character_parcels row
 )
 
 const (
@@ -90,15 +91,23 @@ const (
 	SlotCursor
 )
 
+// Weapon and shield item types, with the EQEmu values src/entities/ItemType.ts uses
 const (
-	ItemTypeShield uint8 = iota + 10 // placeholder start
-	ItemType1HBlunt
-	ItemType1HSlash
-	ItemType1HPiercing
-	ItemType2HBlunt
-	ItemType2HSlash
-	ItemType2HPiercing
-	ItemTypeMartial
+	ItemType1HSlash    uint8 = 0
+	ItemType2HSlash    uint8 = 1
+	ItemType1HPiercing uint8 = 2
+	ItemType1HBlunt    uint8 = 3
+	ItemType2HBlunt    uint8 = 4
+	ItemTypeShield     uint8 = 8
+	ItemType2HPiercing uint8 = 35
+	ItemTypeMartial    uint8 = 45
+)
+
+// Ranged item types, with the EQEmu values src/entities/ItemType.ts uses
+const (
+	ItemTypeBow      uint8 = 5
+	ItemTypeThrowing uint8 = 7
+	ItemTypeArrow    uint8 = 27
 )
 
 func IsEquipSlot(slot int8) bool {
@@ -203,13 +212,27 @@ func (item *ItemWithInstance) IsType1HWeapon() bool {
 	if item == nil {
 		return false
 	}
-	return uint8(item.Item.Itemtype) >= ItemType1HBlunt && uint8(item.Item.Itemtype) <= ItemType1HPiercing
+	switch uint8(item.Item.Itemtype) {
+	case ItemType1HSlash, ItemType1HPiercing, ItemType1HBlunt:
+		return true
+	}
+	return false
 }
 func (item *ItemWithInstance) IsType2HWeapon() bool {
 	if item == nil {
 		return false
 	}
-	return uint8(item.Item.Itemtype) >= ItemType2HBlunt && uint8(item.Item.Itemtype) <= ItemType2HPiercing
+	switch uint8(item.Item.Itemtype) {
+	case ItemType2HSlash, ItemType2HBlunt, ItemType2HPiercing:
+		return true
+	}
+	return false
+}
+func (item *ItemWithInstance) IsType1HPiercing() bool {
+	if item == nil {
+		return false
+	}
+	return uint8(item.Item.Itemtype) == ItemType1HPiercing
 }
 func (item *ItemWithInstance) IsTypeMartial() bool {
 	if item == nil {
@@ -217,6 +240,24 @@ func (item *ItemWithInstance) IsTypeMartial() bool {
 	}
 	return uint8(item.Item.Itemtype) == ItemTypeMartial
 }
+func (item *ItemWithInstance) IsTypeBow() bool {
+	if item == nil {
+		return false
+	}
+	return uint8(item.Item.Itemtype) == ItemTypeBow
+}
+func (item *ItemWithInstance) IsTypeThrowing() bool {
+	if item == nil {
+		return false
+	}
+	return uint8(item.Item.Itemtype) == ItemTypeThrowing
+}
+func (item *ItemWithInstance) IsTypeArrow() bool {
+	if item == nil {
+		return false
+	}
+	return uint8(item.Item.Itemtype) == ItemTypeArrow
+}
 func (item *ItemWithInstance) IsTypeShield() bool {
 	if item == nil {
 		return false
@@ -151,7 +151,8 @@ func GetCharacterItems(ctx context.Context, id int) ([]constants.ItemWithSlot, e
 				EQ(table.ItemInstances.ID),
 		)).
 		WHERE(
-			table.ItemInstances.OwnerID.EQ(mysql.Int(int64(id))),
+			table.ItemInstances.OwnerID.EQ(mysql.Int(int64(id))).
+				AND(table.ItemInstances.OwnerType.EQ(mysql.Int(int64(constants.OwnerTypeCharacter)))),
 		)
 
 	if err := stmt.QueryContext(ctx, db.GlobalWorldDB.DB, &charItems); err != nil {
@@ -683,3 +684,92 @@ func SetAutoSellEnabled(ctx context.Context, charID int32, enabled bool) error {
 	}
 	return nil
 }
+
+// SetAutoRetreatPercent updates the auto_retreat_percent threshold for a character
+func SetAutoRetreatPercent(ctx context.Context, charID int32, percent uint8) error {
+	_, err := table.CharacterData.
+		UPDATE(table.CharacterData.AutoRetreatPercent).
+		SET(percent).
+		WHERE(table.CharacterData.ID.EQ(mysql.Int32(charID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("update auto_retreat_percent for char %d: %w", charID, err)
+	}
+	return nil
+}
+
+// SetAnon updates whether a character is anonymous or roleplaying in who
+func SetAnon(ctx context.Context, charID int32, anon uint8) error {
+	_, err := table.CharacterData.
+		UPDATE(table.CharacterData.Anon).
+		SET(anon).
+		WHERE(table.CharacterData.ID.EQ(mysql.Int32(charID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("update anon for char %d: %w", charID, err)
+	}
+	return nil
+}
+
+// SetInspectOptOut updates whether a character refuses inspection by other players
+func SetInspectOptOut(ctx context.Context, charID int32, optOut uint8) error {
+	_, err := table.CharacterData.
+		UPDATE(table.CharacterData.InspectOptOut).
+		SET(optOut).
+		WHERE(table.CharacterData.ID.EQ(mysql.Int32(charID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("update inspect_opt_out for char %d: %w", charID, err)
+	}
+	return nil
+}
+
+// GetInspectMessage returns the message a character shows those who inspect them, empty if
+// they have none
+func GetInspectMessage(ctx context.Context, charID int32) (string, error) {
+	var rows []model.CharacterInspectMessages
+	m := table.CharacterInspectMessages
+	err := m.
+		SELECT(m.InspectMessage).
+		WHERE(m.ID.EQ(mysql.Uint32(uint32(charID)))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return "", fmt.Errorf("query inspect message for char %d: %w", charID, err)
+	}
+	if len(rows) == 0 {
+		return "", nil
+	}
+	return rows[0].InspectMessage, nil
+}
+
+// SetInspectMessage saves the message a character shows those who inspect them
+func SetInspectMessage(ctx context.Context, charID int32, message string) error {
+	m := table.CharacterInspectMessages
+	_, err := m.
+		INSERT(m.ID, m.InspectMessage).
+		VALUES(charID, message).
+		ON_DUPLICATE_KEY_UPDATE(m.InspectMessage.SET(mysql.String(message))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("set inspect message for char %d: %w", charID, err)
+	}
+	return nil
+}
+
+// SetOfflineHunt records the hunt a character left running as they logged out, and when they
+// left. A nil hunt records that they weren't hunting.
+func SetOfflineHunt(ctx context.Context, charID int32, hunt *string, lastLogin uint32) error {
+	var value mysql.Expression = mysql.NULL
+	if hunt != nil {
+		value = mysql.String(*hunt)
+	}
+	_, err := table.CharacterData.
+		UPDATE(table.CharacterData.OfflineHunt, table.CharacterData.LastLogin).
+		SET(value, lastLogin).
+		WHERE(table.CharacterData.ID.EQ(mysql.Int32(charID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("update offline_hunt for char %d: %w", charID, err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package db_chat
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// GetChannel loads a user chat channel by name, ignoring case. It returns nil if there is none.
+func GetChannel(ctx context.Context, name string) (*model.Chatchannels, error) {
+	var channels []model.Chatchannels
+	err := table.Chatchannels.
+		SELECT(table.Chatchannels.AllColumns).
+		WHERE(mysql.LOWER(table.Chatchannels.Name).EQ(mysql.LOWER(mysql.String(name)))).
+		LIMIT(1).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &channels)
+	if err != nil {
+		return nil, fmt.Errorf("query chatchannels %q: %w", name, err)
+	}
+	if len(channels) == 0 {
+		return nil, nil
+	}
+	return &channels[0], nil
+}
+
+// CreateChannel saves a new user chat channel, owned by the character who created it
+func CreateChannel(ctx context.Context, name, owner, password string) error {
+	c := table.Chatchannels
+	_, err := c.
+		INSERT(c.Name, c.Owner, c.Password, c.Minstatus).
+		VALUES(name, owner, password, 0).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("insert chatchannels %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetProfanity loads the words the chat filter masks
+func GetProfanity(ctx context.Context) ([]string, error) {
+	var rows []model.ProfanityList
+	err := table.ProfanityList.
+		SELECT(table.ProfanityList.Word).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query profanity_list: %w", err)
+	}
+	words := make([]string, len(rows))
+	for i, r := range rows {
+		words[i] = r.Word
+	}
+	return words, nil
+}
+
+// muteFlag is the account_flags flag holding when an account's chat mute ends, in Unix seconds
+const muteFlag = "chat_muted_until"
+
+// GetMute returns when the account's chat mute ends, the zero time if it has none
+func GetMute(ctx context.Context, accountID int32) (time.Time, error) {
+	var rows []model.AccountFlags
+	a := table.AccountFlags
+	err := a.
+		SELECT(a.PValue).
+		WHERE(a.PAccid.EQ(mysql.Int32(accountID)).AND(a.PFlag.EQ(mysql.String(muteFlag)))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("query mute for account %d: %w", accountID, err)
+	}
+	if len(rows) == 0 {
+		return time.Time{}, nil
+	}
+	secs, err := strconv.ParseInt(rows[0].PValue, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse mute for account %d: %w", accountID, err)
+	}
+	return time.Unix(secs, 0), nil
+}
+
+// SetMute mutes the account's chat until the time given. A zero time lifts the mute.
+func SetMute(ctx context.Context, accountID int32, until time.Time) error {
+	a := table.AccountFlags
+	var err error
+	if until.IsZero() {
+		_, err = a.
+			DELETE().
+			WHERE(a.PAccid.EQ(mysql.Int32(accountID)).AND(a.PFlag.EQ(mysql.String(muteFlag)))).
+			ExecContext(ctx, db.GlobalWorldDB.DB)
+	} else {
+		value := strconv.FormatInt(until.Unix(), 10)
+		_, err = a.
+			INSERT(a.PAccid, a.PFlag, a.PValue).
+			VALUES(accountID, muteFlag, value).
+			ON_DUPLICATE_KEY_UPDATE(a.PValue.SET(mysql.String(value))).
+			ExecContext(ctx, db.GlobalWorldDB.DB)
+	}
+	if err != nil {
+		return fmt.Errorf("set mute for account %d: %w", accountID, err)
+	}
+	return nil
+}
+
+// GetAccountStatus returns the account's status, which decides what GM commands it may use
+func GetAccountStatus(ctx context.Context, accountID int32) (int32, error) {
+	var acc model.Account
+	err := table.Account.
+		SELECT(table.Account.Status).
+		WHERE(table.Account.ID.EQ(mysql.Int32(accountID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &acc)
+	if err != nil {
+		return 0, fmt.Errorf("query status for account %d: %w", accountID, err)
+	}
+	return acc.Status, nil
+}
+
+// SaveReport stores a player's report of another, with the reported player's recent chat
+func SaveReport(ctx context.Context, reporter, reported, text string) error {
+	r := table.Reports
+	_, err := r.
+		INSERT(r.Name, r.Reported, r.ReportedText).
+		VALUES(reporter, reported, text).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("insert report of %q by %q: %w", reported, reporter, err)
+	}
+	return nil
+}
+
+// GetReports loads the reports waiting for review, oldest first
+func GetReports(ctx context.Context, limit int64) ([]model.Reports, error) {
+	reports := []model.Reports{}
+	err := table.Reports.
+		SELECT(table.Reports.AllColumns).
+		ORDER_BY(table.Reports.ID.ASC()).
+		LIMIT(limit).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &reports)
+	if err != nil {
+		return nil, fmt.Errorf("query reports: %w", err)
+	}
+	return reports, nil
+}
+
+// DeleteReport removes a report once it has been reviewed. It returns false if there was none.
+func DeleteReport(ctx context.Context, id uint32) (bool, error) {
+	res, err := table.Reports.
+		DELETE().
+		WHERE(table.Reports.ID.EQ(mysql.Uint32(id))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return false, fmt.Errorf("delete report %d: %w", id, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("delete report %d: %w", id, err)
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,326 @@
+package combat
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+	db_npc "idlequest/internal/db/npc"
+	db_zone "idlequest/internal/db/zone"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// LootQuery picks the NPCs to report on. Exactly one field should be set.
+type LootQuery struct {
+	NPCID int32  // npc_types ID
+	Name  string // NPC name, matched as a substring
+	Zone  string // Zone short name: every NPC that spawns there
+}
+
+// ItemDropRate is how often one item drops from a kill
+type ItemDropRate struct {
+	ItemID        int32   `json:"itemId"`
+	Name          string  `json:"name"`
+	Chance        float64 `json:"chance"`        // Probability at least one copy drops per kill, 0-1
+	Expected      float64 `json:"expected"`      // Average copies per kill
+	ExpectedKills float64 `json:"expectedKills"` // Average kills until the first copy, 0 if it never drops
+	Global        bool    `json:"global"`        // Comes from global loot rather than the NPC's loottable
+}
+
+// NPCLootReport is the expected loot from killing an NPC once
+type NPCLootReport struct {
+	NPCID        int32          `json:"npcId"`
+	Name         string         `json:"name"`
+	Level        uint8          `json:"level"`
+	LoottableID  uint32         `json:"loottableId"`
+	ExpectedCoin float64        `json:"expectedCoin"` // Copper
+	Items        []ItemDropRate `json:"items"`        // Most likely first
+	Trials       int            `json:"trials"`       // Simulated kills, 0 when the rates are exact
+}
+
+// GetLootReports builds a loot report for every NPC matching the query. With trials > 0 the rates
+// come from rolling the loot trials times with RollLootTable instead of being calculated exactly.
+// Global loot limited to certain zones is only included for zone queries.
+func GetLootReports(ctx context.Context, query LootQuery, trials int, seed int64) ([]NPCLootReport, error) {
+	npcs, zoneID, err := findLootNPCs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	reports := make([]NPCLootReport, 0, len(npcs))
+	for i := range npcs {
+		npc := &npcs[i]
+		lootTable, err := GetLootTable(ctx, npc.LoottableID)
+		if err != nil {
+			return nil, err
+		}
+		globals, err := GetGlobalLootTables(ctx, npc, zoneID)
+		if err != nil {
+			return nil, err
+		}
+		if trials > 0 {
+			reports = append(reports, SimulateLootReport(rng, npc, lootTable, globals, trials))
+		} else {
+			reports = append(reports, BuildLootReport(npc, lootTable, globals))
+		}
+	}
+	return reports, nil
+}
+
+// findLootNPCs resolves a query to NPCs, and the zone ID for zone queries
+func findLootNPCs(ctx context.Context, query LootQuery) ([]NPCForCombat, int, error) {
+	switch {
+	case query.NPCID != 0:
+		npc, err := GetNPCForCombat(ctx, query.NPCID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return []NPCForCombat{*npc}, 0, nil
+
+	case query.Name != "":
+		var rows []model.NpcTypes
+		err := table.NpcTypes.
+			SELECT(table.NpcTypes.AllColumns).
+			FROM(table.NpcTypes).
+			WHERE(table.NpcTypes.Name.LIKE(mysql.String("%"+query.Name+"%"))).
+			ORDER_BY(table.NpcTypes.ID.ASC()).
+			LIMIT(50).
+			QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to query NPCs named %q: %w", query.Name, err)
+		}
+		scale, err := db_npc.GetScaleTable(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		npcs := make([]NPCForCombat, len(rows))
+		for i, row := range rows {
+			scale.Scale(&row)
+			npcs[i] = NewNPCForCombat(row)
+		}
+		return npcs, 0, nil
+
+	case query.Zone != "":
+		npcs, err := GetZoneNPCsForCombat(ctx, query.Zone, 1, math.MaxUint8, true)
+		if err != nil {
+			return nil, 0, err
+		}
+		zones, err := db_zone.GetAllZones(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		zoneID := 0
+		for _, z := range zones {
+			if z.ShortName != nil && *z.ShortName == query.Zone {
+				zoneID = int(z.Zoneidnumber)
+				break
+			}
+		}
+		sort.Slice(npcs, func(i, j int) bool { return npcs[i].ID < npcs[j].ID })
+		return npcs, zoneID, nil
+	}
+	return nil, 0, fmt.Errorf("one of NPC ID, name or zone is required")
+}
+
+// BuildLootReport calculates the exact drop rates for one kill of the NPC under RollLootTable's rules
+func BuildLootReport(npc *NPCForCombat, lootTable *LootTable, globals []*LootTable) NPCLootReport {
+	report := NPCLootReport{NPCID: npc.ID, Name: npc.Name, Level: npc.Level, LoottableID: npc.LoottableID}
+	rates := make(map[int32]*itemOdds)
+	if lootTable != nil {
+		report.ExpectedCoin = expectedCash(lootTable)
+		addTableOdds(rates, lootTable, npc.Level, false)
+	}
+	for _, lt := range globals {
+		addTableOdds(rates, lt, npc.Level, true)
+	}
+
+	for id, odds := range rates {
+		report.Items = append(report.Items, newItemDropRate(id, odds.name, 1-odds.none, odds.expected, odds.global))
+	}
+	sortItemRates(report.Items)
+	return report
+}
+
+// SimulateLootReport estimates drop rates by rolling the NPC's loot trials times
+func SimulateLootReport(rng *rand.Rand, npc *NPCForCombat, lootTable *LootTable, globals []*LootTable, trials int) NPCLootReport {
+	report := NPCLootReport{NPCID: npc.ID, Name: npc.Name, Level: npc.Level, LoottableID: npc.LoottableID, Trials: trials}
+
+	type tally struct {
+		name   string
+		kills  int // Kills dropping at least one copy
+		copies int
+		global bool
+	}
+	tallies := make(map[int32]*tally)
+	count := func(items []LootDropItem, global bool) map[int32]bool {
+		seen := make(map[int32]bool)
+		for _, item := range items {
+			t, ok := tallies[item.ItemID]
+			if !ok {
+				t = &tally{name: item.Name, global: global}
+				tallies[item.ItemID] = t
+			}
+			t.copies++
+			seen[item.ItemID] = true
+		}
+		return seen
+	}
+
+	var coin uint64
+	for i := 0; i < trials; i++ {
+		items, copper := RollLootTable(rng, lootTable, npc.Level, false)
+		coin += uint64(copper)
+		seen := count(items, false)
+		for _, lt := range globals {
+			globalItems, _ := RollLootTable(rng, lt, npc.Level, true)
+			for id := range count(globalItems, true) {
+				seen[id] = true
+			}
+		}
+		for id := range seen {
+			tallies[id].kills++
+		}
+	}
+
+	report.ExpectedCoin = float64(coin) / float64(trials)
+	for id, t := range tallies {
+		report.Items = append(report.Items, newItemDropRate(id, t.name,
+			float64(t.kills)/float64(trials), float64(t.copies)/float64(trials), t.global))
+	}
+	sortItemRates(report.Items)
+	return report
+}
+
+// itemOdds accumulates one item's odds across every lootdrop it appears in
+type itemOdds struct {
+	name     string
+	none     float64 // Probability no copy drops
+	expected float64 // Average copies
+	global   bool
+}
+
+func addTableOdds(rates map[int32]*itemOdds, lt *LootTable, npcLevel uint8, global bool) {
+	for _, drop := range lt.Drops {
+		p := drop.Probability / 100
+		if drop.Probability >= 100 {
+			p = 1
+		}
+		for _, e := range lootDropOdds(drop, npcLevel) {
+			odds, ok := rates[e.itemID]
+			if !ok {
+				odds = &itemOdds{name: e.name, none: 1, global: global}
+				rates[e.itemID] = odds
+			}
+			// The lootdrop is rolled Multiplier times, each time with probability p
+			odds.none *= math.Pow(1-p+p*e.none, float64(drop.Multiplier))
+			odds.expected += float64(drop.Multiplier) * p * e.expected
+		}
+	}
+}
+
+// entryOdds is one item's odds from a single roll of a lootdrop
+type entryOdds struct {
+	itemID   int32
+	name     string
+	none     float64
+	expected float64
+}
+
+// lootDropOdds mirrors rollLootDrop, returning each item's odds from one roll of the lootdrop
+func lootDropOdds(drop LootTableDrop, npcLevel uint8) []entryOdds {
+	var active []LootDropEntry
+	for _, e := range drop.Entries {
+		if e.meetsLevel(npcLevel) {
+			active = append(active, e)
+		}
+	}
+	var result []entryOdds
+
+	if drop.DropLimit == 0 && drop.MinDrop == 0 {
+		for _, e := range active {
+			c := math.Min(e.Chance/100, 1)
+			result = append(result, entryOdds{e.ItemID, e.Name, math.Pow(1-c, float64(e.Multiplier)), float64(e.Multiplier) * c})
+		}
+		return result
+	}
+
+	dropLimit := int(drop.DropLimit)
+	if len(drop.Entries) > 100 && dropLimit == 0 {
+		dropLimit = 10
+	}
+	dropLimit = max(dropLimit, int(drop.MinDrop))
+
+	total := 0.0
+	noLoot := 1.0
+	alwaysDrops := false
+	for _, e := range active {
+		total += e.Chance
+		if e.Chance >= 100 {
+			alwaysDrops = true
+		} else {
+			noLoot *= (100 - e.Chance) / 100
+		}
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	// The first mindrop picks always happen; the rest happen with probability 1-noLoot
+	guaranteed := min(int(drop.MinDrop), dropLimit)
+	optional := dropLimit - guaranteed
+	pickChance := 1 - noLoot
+	if alwaysDrops {
+		pickChance = 1
+	}
+	expectedPicks := float64(guaranteed) + float64(optional)*pickChance
+
+	for _, e := range active {
+		w := e.Chance / total
+		c := math.Min(e.Chance/100, 1)
+		none := math.Pow(1-w, float64(guaranteed)) * math.Pow(1-pickChance*w, float64(optional))
+		copies := 1 + float64(max(int(e.Multiplier), 1)-1)*c
+		result = append(result, entryOdds{e.ItemID, e.Name, none, expectedPicks * w * copies})
+	}
+	return result
+}
+
+// expectedCash is the average coin in copper rollCash drops
+func expectedCash(lt *LootTable) float64 {
+	minCash, maxCash := float64(lt.MinCash), float64(lt.MaxCash)
+	if minCash > maxCash {
+		minCash, maxCash = maxCash, minCash
+	}
+	avg := float64(lt.AvgCoin)
+	if maxCash > 0 && avg > 0 && avg >= minCash && avg <= maxCash {
+		if maxCash == minCash {
+			return minCash
+		}
+		upper := (avg - minCash) / (maxCash - minCash)
+		return upper*(avg+maxCash)/2 + (1-upper)*(minCash+avg)/2
+	}
+	return (minCash + maxCash) / 2
+}
+
+func newItemDropRate(itemID int32, name string, chance, expected float64, global bool) ItemDropRate {
+	rate := ItemDropRate{ItemID: itemID, Name: name, Chance: chance, Expected: expected, Global: global}
+	if chance > 0 {
+		rate.ExpectedKills = 1 / chance
+	}
+	return rate
+}
+
+func sortItemRates(items []ItemDropRate) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Chance != items[j].Chance {
+			return items[i].Chance > items[j].Chance
+		}
+		return items[i].ItemID < items[j].ItemID
+	})
+}
@@ -0,0 +1,378 @@
+package combat
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+
+	"idlequest/internal/cache"
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// LootTable is a loottable row with its lootdrops, everything needed to roll an NPC's loot
+type LootTable struct {
+	ID      uint32
+	MinCash uint32
+	MaxCash uint32
+	AvgCoin uint32
+	Drops   []LootTableDrop
+}
+
+// LootTableDrop is a loottable_entries row. Its lootdrop is rolled Multiplier times, each time
+// with Probability percent chance.
+type LootTableDrop struct {
+	LootdropID  uint32
+	Multiplier  uint8
+	DropLimit   uint8
+	MinDrop     uint8
+	Probability float64
+	Entries     []LootDropEntry
+}
+
+// LootDropEntry is a lootdrop_entries row joined with its item
+type LootDropEntry struct {
+	ItemID      int32
+	Name        string
+	Icon        int32
+	ItemCharges int32
+	Chance      float64
+	Multiplier  uint8  // Extra copies rolled when the item drops
+	NPCMinLevel uint16 // 0 means no minimum
+	NPCMaxLevel uint16 // 0 means no maximum
+}
+
+// GetLootTable loads a loottable with its lootdrops and items, nil if it doesn't exist
+func GetLootTable(ctx context.Context, loottableID uint32) (*LootTable, error) {
+	if loottableID == 0 {
+		return nil, nil
+	}
+
+	cacheKey := fmt.Sprintf("loot:table:%d", loottableID)
+	if val, found, err := cache.GetCache().Get(cacheKey); err == nil && found {
+		if lt, ok := val.(*LootTable); ok {
+			return lt, nil
+		}
+	}
+
+	var loottables []model.Loottable
+	err := table.Loottable.
+		SELECT(table.Loottable.ID, table.Loottable.Mincash, table.Loottable.Maxcash, table.Loottable.Avgcoin).
+		FROM(table.Loottable).
+		WHERE(table.Loottable.ID.EQ(mysql.Uint32(loottableID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &loottables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query loottable %d: %w", loottableID, err)
+	}
+	if len(loottables) == 0 {
+		return nil, nil
+	}
+
+	type entryResult struct {
+		LootdropID      uint32  `alias:"loottable_entries.lootdrop_id"`
+		TableMultiplier uint8   `alias:"loottable_entries.multiplier"`
+		Droplimit       uint8   `alias:"loottable_entries.droplimit"`
+		Mindrop         uint8   `alias:"loottable_entries.mindrop"`
+		Probability     float64 `alias:"loottable_entries.probability"`
+		ItemID          int32   `alias:"lootdrop_entries.item_id"`
+		ItemCharges     int32   `alias:"lootdrop_entries.item_charges"`
+		Chance          float64 `alias:"lootdrop_entries.chance"`
+		Multiplier      uint8   `alias:"lootdrop_entries.multiplier"`
+		NpcMinLevel     uint16  `alias:"lootdrop_entries.npc_min_level"`
+		NpcMaxLevel     uint16  `alias:"lootdrop_entries.npc_max_level"`
+		Name            string  `alias:"items.name"`
+		Icon            int32   `alias:"items.icon"`
+	}
+
+	var results []entryResult
+	stmt := mysql.SELECT(
+		table.LoottableEntries.LootdropID.AS("loottable_entries.lootdrop_id"),
+		table.LoottableEntries.Multiplier.AS("loottable_entries.multiplier"),
+		table.LoottableEntries.Droplimit.AS("loottable_entries.droplimit"),
+		table.LoottableEntries.Mindrop.AS("loottable_entries.mindrop"),
+		table.LoottableEntries.Probability.AS("loottable_entries.probability"),
+		table.LootdropEntries.ItemID.AS("lootdrop_entries.item_id"),
+		table.LootdropEntries.ItemCharges.AS("lootdrop_entries.item_charges"),
+		table.LootdropEntries.Chance.AS("lootdrop_entries.chance"),
+		table.LootdropEntries.Multiplier.AS("lootdrop_entries.multiplier"),
+		table.LootdropEntries.NpcMinLevel.AS("lootdrop_entries.npc_min_level"),
+		table.LootdropEntries.NpcMaxLevel.AS("lootdrop_entries.npc_max_level"),
+		table.Items.Name.AS("items.name"),
+		table.Items.Icon.AS("items.icon"),
+	).FROM(
+		table.LoottableEntries.
+			INNER_JOIN(table.LootdropEntries, table.LootdropEntries.LootdropID.EQ(table.LoottableEntries.LootdropID)).
+			INNER_JOIN(table.Items, table.Items.ID.EQ(table.LootdropEntries.ItemID)),
+	).WHERE(
+		table.LoottableEntries.LoottableID.EQ(mysql.Uint32(loottableID)),
+	).ORDER_BY(
+		table.LoottableEntries.LootdropID.ASC(),
+		table.LootdropEntries.ItemID.ASC(),
+	)
+	if err := stmt.QueryContext(ctx, db.GlobalWorldDB.DB, &results); err != nil {
+		return nil, fmt.Errorf("failed to query loot for loottable %d: %w", loottableID, err)
+	}
+
+	lt := &LootTable{
+		ID:      loottables[0].ID,
+		MinCash: loottables[0].Mincash,
+		MaxCash: loottables[0].Maxcash,
+		AvgCoin: loottables[0].Avgcoin,
+	}
+	for _, r := range results {
+		if n := len(lt.Drops); n == 0 || lt.Drops[n-1].LootdropID != r.LootdropID {
+			lt.Drops = append(lt.Drops, LootTableDrop{
+				LootdropID:  r.LootdropID,
+				Multiplier:  r.TableMultiplier,
+				DropLimit:   r.Droplimit,
+				MinDrop:     r.Mindrop,
+				Probability: r.Probability,
+			})
+		}
+		drop := &lt.Drops[len(lt.Drops)-1]
+		drop.Entries = append(drop.Entries, LootDropEntry{
+			ItemID:      r.ItemID,
+			Name:        r.Name,
+			Icon:        r.Icon,
+			ItemCharges: r.ItemCharges,
+			Chance:      r.Chance,
+			Multiplier:  r.Multiplier,
+			NPCMinLevel: r.NpcMinLevel,
+			NPCMaxLevel: r.NpcMaxLevel,
+		})
+	}
+
+	cache.GetCache().Set(cacheKey, lt)
+	return lt, nil
+}
+
+// GetGlobalLootTables returns the global_loot tables that apply to the NPC in the zone
+func GetGlobalLootTables(ctx context.Context, npc *NPCForCombat, zoneID int) ([]*LootTable, error) {
+	if db.GlobalWorldDB == nil {
+		return nil, fmt.Errorf("database not initialized")
+	}
+
+	const cacheKey = "loot:global"
+	var rules []model.GlobalLoot
+	if val, found, err := cache.GetCache().Get(cacheKey); err == nil && found {
+		rules, _ = val.([]model.GlobalLoot)
+	} else {
+		err := table.GlobalLoot.
+			SELECT(table.GlobalLoot.AllColumns).
+			FROM(table.GlobalLoot).
+			WHERE(table.GlobalLoot.Enabled.NOT_EQ(mysql.Int(0))).
+			QueryContext(ctx, db.GlobalWorldDB.DB, &rules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query global_loot: %w", err)
+		}
+		cache.GetCache().Set(cacheKey, rules)
+	}
+
+	var tables []*LootTable
+	for _, rule := range rules {
+		if !GlobalLootApplies(rule, npc, zoneID) {
+			continue
+		}
+		lt, err := GetLootTable(ctx, uint32(rule.LoottableID))
+		if err != nil {
+			return nil, err
+		}
+		if lt != nil {
+			tables = append(tables, lt)
+		}
+	}
+	return tables, nil
+}
+
+// GlobalLootApplies reports whether a global_loot rule matches the NPC. Unset columns match
+// everything; race, class, bodytype and zone are pipe-separated ID lists. No zone is a hot zone.
+func GlobalLootApplies(rule model.GlobalLoot, npc *NPCForCombat, zoneID int) bool {
+	if rule.Enabled == 0 {
+		return false
+	}
+	if rule.MinLevel > 0 && int32(npc.Level) < rule.MinLevel {
+		return false
+	}
+	if rule.MaxLevel > 0 && int32(npc.Level) > rule.MaxLevel {
+		return false
+	}
+	if rule.Rare != nil && (*rule.Rare != 0) != npc.RareSpawn {
+		return false
+	}
+	if rule.Raid != nil && (*rule.Raid != 0) != npc.RaidTarget {
+		return false
+	}
+	if rule.HotZone != nil && *rule.HotZone != 0 {
+		return false
+	}
+	return inIDList(rule.Race, int64(npc.Race)) &&
+		inIDList(rule.Class, int64(npc.Class)) &&
+		inIDList(rule.Bodytype, int64(npc.Bodytype)) &&
+		inIDList(rule.Zone, int64(zoneID))
+}
+
+// inIDList reports whether id is in a pipe-separated list like "1|3|12". An empty list matches any ID.
+func inIDList(list *string, id int64) bool {
+	if list == nil || strings.TrimSpace(*list) == "" {
+		return true
+	}
+	return slices.ContainsFunc(strings.Split(*list, "|"), func(s string) bool {
+		v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		return err == nil && v == id
+	})
+}
+
+// RollLootTable rolls a loot table for an NPC of npcLevel the way EQEmu does, returning the items
+// that dropped and the coin in copper. Global loot tables drop items but no coin.
+func RollLootTable(rng *rand.Rand, lt *LootTable, npcLevel uint8, global bool) ([]LootDropItem, uint32) {
+	if lt == nil {
+		return nil, 0
+	}
+
+	var copper uint32
+	if !global {
+		copper = rollCash(rng, lt)
+	}
+
+	var dropped []LootDropItem
+	for _, drop := range lt.Drops {
+		for k := 0; k < int(drop.Multiplier); k++ {
+			if drop.Probability == 0 {
+				continue
+			}
+			if drop.Probability == 100 || rng.Float64()*100 <= drop.Probability {
+				dropped = rollLootDrop(rng, drop, npcLevel, dropped)
+			}
+		}
+	}
+	return dropped, copper
+}
+
+// rollCash picks the coin dropped, in copper. With avgcoin set the roll is split at the average,
+// landing above it often enough that the mean comes out near avgcoin.
+func rollCash(rng *rand.Rand, lt *LootTable) uint32 {
+	minCash, maxCash := lt.MinCash, lt.MaxCash
+	if minCash > maxCash {
+		minCash, maxCash = maxCash, minCash
+	}
+	between := func(lo, hi uint32) uint32 {
+		return lo + uint32(rng.Int63n(int64(hi-lo)+1))
+	}
+
+	avg := lt.AvgCoin
+	if maxCash > 0 && avg > 0 && avg >= minCash && avg <= maxCash {
+		if maxCash > minCash && rng.Float64() < float64(avg-minCash)/float64(maxCash-minCash) {
+			return between(avg, maxCash)
+		}
+		return between(minCash, avg)
+	}
+	return between(minCash, maxCash)
+}
+
+// rollLootDrop rolls one lootdrop, appending what dropped to dropped.
+// Without droplimit or mindrop every item rolls its own chance independently. Otherwise up to
+// droplimit picks are made, each choosing one item weighted by chance; the first mindrop picks
+// always happen, the rest only when a roll beats the chance of no item dropping at all.
+func rollLootDrop(rng *rand.Rand, drop LootTableDrop, npcLevel uint8, dropped []LootDropItem) []LootDropItem {
+	var active []LootDropEntry
+	for _, e := range drop.Entries {
+		if e.meetsLevel(npcLevel) {
+			active = append(active, e)
+		}
+	}
+	if len(active) == 0 {
+		return dropped
+	}
+
+	if drop.DropLimit == 0 && drop.MinDrop == 0 {
+		for _, e := range active {
+			for j := 0; j < int(e.Multiplier); j++ {
+				if rng.Float64()*100 <= e.Chance {
+					dropped = append(dropped, e.item())
+				}
+			}
+		}
+		return dropped
+	}
+
+	dropLimit := int(drop.DropLimit)
+	if len(drop.Entries) > 100 && dropLimit == 0 {
+		dropLimit = 10
+	}
+	dropLimit = max(dropLimit, int(drop.MinDrop))
+
+	total := 0.0
+	noLoot := 1.0
+	alwaysDrops := false
+	for _, e := range active {
+		total += e.Chance
+		if e.Chance >= 100 {
+			alwaysDrops = true
+		} else {
+			noLoot *= (100 - e.Chance) / 100
+		}
+	}
+	if total <= 0 {
+		return dropped
+	}
+
+	drops := 0
+	for i := 0; i < dropLimit; i++ {
+		if drops >= int(drop.MinDrop) && !alwaysDrops && rng.Float64() < noLoot {
+			continue
+		}
+		roll := rng.Float64() * total
+		for _, e := range active {
+			if roll >= e.Chance {
+				roll -= e.Chance
+				continue
+			}
+			dropped = append(dropped, e.item())
+			drops++
+			for k := 1; k < max(int(e.Multiplier), 1); k++ {
+				if rng.Float64()*100 <= e.Chance {
+					dropped = append(dropped, e.item())
+				}
+			}
+			break
+		}
+	}
+	return dropped
+}
+
+// meetsLevel reports whether an NPC of npcLevel can drop the entry
+func (e LootDropEntry) meetsLevel(npcLevel uint8) bool {
+	if e.NPCMinLevel > 0 && uint16(npcLevel) < e.NPCMinLevel {
+		return false
+	}
+	if e.NPCMaxLevel > 0 && uint16(npcLevel) > e.NPCMaxLevel {
+		return false
+	}
+	return true
+}
+
+func (e LootDropEntry) item() LootDropItem {
+	return LootDropItem{
+		ItemID:      e.ItemID,
+		Name:        e.Name,
+		Chance:      e.Chance,
+		ItemCharges: e.ItemCharges,
+		Icon:        e.Icon,
+	}
+}
+
+// NewMoneyDrop splits copper into platinum, gold, silver and copper
+func NewMoneyDrop(copper uint32) MoneyDrop {
+	return MoneyDrop{
+		Platinum: int(copper / 1000),
+		Gold:     int(copper % 1000 / 100),
+		Silver:   int(copper % 100 / 10),
+		Copper:   int(copper % 10),
+	}
+}
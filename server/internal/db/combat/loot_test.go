@@ -0,0 +1,194 @@
+package combat
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"idlequest/internal/db/jetgen/eqgo/model"
+)
+
+const lootTrials = 100000
+
+// dropRates rolls lt lootTrials times and returns the average number of each item dropped per kill
+// and the average coin in copper
+func dropRates(lt *LootTable, npcLevel uint8, global bool) (map[int32]float64, float64) {
+	rng := rand.New(rand.NewSource(1))
+	rates := make(map[int32]float64)
+	var coin float64
+	for i := 0; i < lootTrials; i++ {
+		items, copper := RollLootTable(rng, lt, npcLevel, global)
+		for _, item := range items {
+			rates[item.ItemID] += 1.0 / lootTrials
+		}
+		coin += float64(copper) / lootTrials
+	}
+	return rates, coin
+}
+
+func expectRate(t *testing.T, name string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 0.01 {
+		t.Errorf("%s: expected %.3f per kill, got %.3f", name, want, got)
+	}
+}
+
+func TestRollLootTableIndependentChances(t *testing.T) {
+	// No droplimit or mindrop: every item rolls its own chance, multiplier times
+	lt := &LootTable{Drops: []LootTableDrop{{
+		Multiplier: 1, Probability: 100,
+		Entries: []LootDropEntry{
+			{ItemID: 1, Chance: 25, Multiplier: 1},
+			{ItemID: 2, Chance: 10, Multiplier: 3},
+			{ItemID: 3, Chance: 100, Multiplier: 1, NPCMinLevel: 20}, // Too low level to drop
+		},
+	}}}
+	rates, _ := dropRates(lt, 10, false)
+	expectRate(t, "25% item", rates[1], 0.25)
+	expectRate(t, "10% item x3", rates[2], 0.30)
+	expectRate(t, "level gated item", rates[3], 0)
+}
+
+func TestRollLootTableProbabilityAndMultiplier(t *testing.T) {
+	// The lootdrop is rolled twice at 50%, so its sure item drops once per kill on average
+	lt := &LootTable{Drops: []LootTableDrop{{
+		Multiplier: 2, Probability: 50, DropLimit: 1, MinDrop: 1,
+		Entries: []LootDropEntry{{ItemID: 1, Chance: 100, Multiplier: 1}},
+	}}}
+	rates, _ := dropRates(lt, 10, false)
+	expectRate(t, "50% lootdrop x2", rates[1], 1.0)
+
+	lt.Drops[0].Probability = 0
+	rates, _ = dropRates(lt, 10, false)
+	expectRate(t, "0% lootdrop", rates[1], 0)
+}
+
+func TestRollLootTableDropLimit(t *testing.T) {
+	// mindrop 1, droplimit 1: exactly one item per kill, weighted by chance
+	lt := &LootTable{Drops: []LootTableDrop{{
+		Multiplier: 1, Probability: 100, DropLimit: 1, MinDrop: 1,
+		Entries: []LootDropEntry{
+			{ItemID: 1, Chance: 60, Multiplier: 1},
+			{ItemID: 2, Chance: 30, Multiplier: 1},
+			{ItemID: 3, Chance: 10, Multiplier: 1},
+		},
+	}}}
+	rates, _ := dropRates(lt, 10, false)
+	expectRate(t, "60 weight", rates[1], 0.60)
+	expectRate(t, "30 weight", rates[2], 0.30)
+	expectRate(t, "10 weight", rates[3], 0.10)
+
+	// mindrop 0: a pick only happens when the roll beats the chance of nothing dropping,
+	// 1 - 0.8*0.7 = 44%, then splits 20:30 between the items
+	lt.Drops[0].MinDrop = 0
+	lt.Drops[0].Entries = []LootDropEntry{{ItemID: 1, Chance: 20, Multiplier: 1}, {ItemID: 2, Chance: 30, Multiplier: 1}}
+	rates, _ = dropRates(lt, 10, false)
+	expectRate(t, "20 weight", rates[1], 0.44*0.4)
+	expectRate(t, "30 weight", rates[2], 0.44*0.6)
+
+	// droplimit 3 with a sure item: three picks every kill
+	lt.Drops[0].DropLimit = 3
+	lt.Drops[0].Entries = []LootDropEntry{{ItemID: 1, Chance: 100, Multiplier: 1}, {ItemID: 2, Chance: 100, Multiplier: 1}}
+	rates, _ = dropRates(lt, 10, false)
+	expectRate(t, "sure picks", rates[1]+rates[2], 3)
+	expectRate(t, "even split", rates[1], 1.5)
+}
+
+func TestRollLootTableCash(t *testing.T) {
+	// The roll splits at avgcoin so the mean lands on it
+	lt := &LootTable{MinCash: 100, MaxCash: 1000, AvgCoin: 300}
+	if _, coin := dropRates(lt, 10, false); math.Abs(coin-300) > 5 {
+		t.Errorf("Expected about 300 copper per kill, got %.1f", coin)
+	}
+
+	// Without avgcoin the roll is uniform
+	lt.AvgCoin = 0
+	if _, coin := dropRates(lt, 10, false); math.Abs(coin-550) > 5 {
+		t.Errorf("Expected about 550 copper per kill, got %.1f", coin)
+	}
+
+	// Global loot never drops coin
+	if _, coin := dropRates(lt, 10, true); coin != 0 {
+		t.Errorf("Expected no coin from global loot, got %.1f", coin)
+	}
+}
+
+func TestGlobalLootApplies(t *testing.T) {
+	str := func(s string) *string { return &s }
+	flag := func(v int8) *int8 { return &v }
+	npc := &NPCForCombat{Level: 30, Race: 13, Class: 1, Bodytype: 1}
+
+	tests := []struct {
+		name string
+		rule model.GlobalLoot
+		want bool
+	}{
+		{"disabled", model.GlobalLoot{Enabled: 0}, false},
+		{"no filters", model.GlobalLoot{Enabled: 1}, true},
+		{"level in range", model.GlobalLoot{Enabled: 1, MinLevel: 25, MaxLevel: 35}, true},
+		{"below min level", model.GlobalLoot{Enabled: 1, MinLevel: 31}, false},
+		{"above max level", model.GlobalLoot{Enabled: 1, MaxLevel: 29}, false},
+		{"race listed", model.GlobalLoot{Enabled: 1, Race: str("12|13")}, true},
+		{"race not listed", model.GlobalLoot{Enabled: 1, Race: str("12|14")}, false},
+		{"class and zone listed", model.GlobalLoot{Enabled: 1, Class: str("1"), Zone: str("2|54")}, true},
+		{"zone not listed", model.GlobalLoot{Enabled: 1, Zone: str("1")}, false},
+		{"rares only", model.GlobalLoot{Enabled: 1, Rare: flag(1)}, false},
+		{"non-rares only", model.GlobalLoot{Enabled: 1, Rare: flag(0)}, true},
+		{"raid targets only", model.GlobalLoot{Enabled: 1, Raid: flag(1)}, false},
+		{"hot zones only", model.GlobalLoot{Enabled: 1, HotZone: flag(1)}, false},
+	}
+	for _, tt := range tests {
+		if got := GlobalLootApplies(tt.rule, npc, 54); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+// TestBuildLootReportMatchesSimulation checks the exact report against rolling the same table
+func TestBuildLootReportMatchesSimulation(t *testing.T) {
+	npc := &NPCForCombat{ID: 1, Name: "a_gnoll", Level: 12}
+	lootTable := &LootTable{
+		MinCash: 50, MaxCash: 400, AvgCoin: 120,
+		Drops: []LootTableDrop{
+			{Multiplier: 1, Probability: 100, Entries: []LootDropEntry{
+				{ItemID: 1, Name: "Gnoll Fang", Chance: 40, Multiplier: 1},
+				{ItemID: 2, Name: "Rusty Axe", Chance: 5, Multiplier: 2},
+			}},
+			{Multiplier: 2, Probability: 35, DropLimit: 1, Entries: []LootDropEntry{
+				{ItemID: 3, Name: "Blackened Gem", Chance: 15, Multiplier: 1},
+				{ItemID: 1, Name: "Gnoll Fang", Chance: 45, Multiplier: 1},
+				{ItemID: 4, Name: "Gnoll Ear", Chance: 100, Multiplier: 1, NPCMinLevel: 20},
+			}},
+			{Multiplier: 1, Probability: 100, DropLimit: 2, MinDrop: 1, Entries: []LootDropEntry{
+				{ItemID: 5, Name: "Fish Scales", Chance: 70, Multiplier: 3},
+				{ItemID: 6, Name: "Bone Chips", Chance: 30, Multiplier: 1},
+			}},
+		},
+	}
+	globals := []*LootTable{{Drops: []LootTableDrop{{Multiplier: 1, Probability: 10, Entries: []LootDropEntry{
+		{ItemID: 7, Name: "Globe of Discordant Energy", Chance: 50, Multiplier: 1},
+	}}}}}
+
+	exact := BuildLootReport(npc, lootTable, globals)
+	simulated := SimulateLootReport(rand.New(rand.NewSource(3)), npc, lootTable, globals, lootTrials)
+	if len(exact.Items) != len(simulated.Items) {
+		t.Fatalf("Expected %d items in both reports, simulated %d", len(exact.Items), len(simulated.Items))
+	}
+	sim := make(map[int32]ItemDropRate)
+	for _, item := range simulated.Items {
+		sim[item.ItemID] = item
+	}
+	for _, item := range exact.Items {
+		expectRate(t, item.Name+" chance", sim[item.ItemID].Chance, item.Chance)
+		expectRate(t, item.Name+" copies", sim[item.ItemID].Expected, item.Expected)
+		if item.ExpectedKills != 1/item.Chance {
+			t.Errorf("%s: expected kills %.2f should be 1/chance", item.Name, item.ExpectedKills)
+		}
+	}
+	if !sim[7].Global {
+		t.Error("Expected the global loot item to be marked global")
+	}
+	if math.Abs(exact.ExpectedCoin-simulated.ExpectedCoin) > 3 {
+		t.Errorf("Expected coin %.1f, simulated %.1f", exact.ExpectedCoin, simulated.ExpectedCoin)
+	}
+}
@@ -0,0 +1,124 @@
+package faction
+
+import (
+	"context"
+	"fmt"
+
+	"idlequest/internal/cache"
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// factionBase holds the static parts of a faction: its base value and race/class/deity modifiers
+type factionBase struct {
+	Base int
+	Mods map[string]int // keyed by mod_name, e.g. "r1", "c1", "d201"
+}
+
+// GetNPCPrimaryFaction returns the primary faction ID for an npc_faction_id, or 0 if the NPC has none
+func GetNPCPrimaryFaction(ctx context.Context, npcFactionID int32) (int32, error) {
+	if npcFactionID <= 0 {
+		return 0, nil
+	}
+
+	cacheKey := fmt.Sprintf("npc_faction:primary:%d", npcFactionID)
+	if val, found, err := cache.GetCache().Get(cacheKey); err == nil && found {
+		if primary, ok := val.(int32); ok {
+			return primary, nil
+		}
+	}
+
+	var factions []model.NpcFaction
+	err := table.NpcFaction.
+		SELECT(table.NpcFaction.ID, table.NpcFaction.Primaryfaction).
+		FROM(table.NpcFaction).
+		WHERE(table.NpcFaction.ID.EQ(mysql.Int32(npcFactionID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &factions)
+	if err != nil {
+		return 0, fmt.Errorf("query npc_faction %d: %w", npcFactionID, err)
+	}
+
+	var primary int32
+	if len(factions) > 0 {
+		primary = factions[0].Primaryfaction
+	}
+	cache.GetCache().Set(cacheKey, primary)
+	return primary, nil
+}
+
+// getFactionBase loads (with caching) the base value and modifiers for a faction
+func getFactionBase(ctx context.Context, factionID int32) (*factionBase, error) {
+	cacheKey := fmt.Sprintf("faction_list:%d", factionID)
+	if val, found, err := cache.GetCache().Get(cacheKey); err == nil && found {
+		if base, ok := val.(*factionBase); ok {
+			return base, nil
+		}
+	}
+
+	var factions []model.FactionList
+	err := table.FactionList.
+		SELECT(table.FactionList.ID, table.FactionList.Base).
+		FROM(table.FactionList).
+		WHERE(table.FactionList.ID.EQ(mysql.Int32(factionID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &factions)
+	if err != nil {
+		return nil, fmt.Errorf("query faction_list %d: %w", factionID, err)
+	}
+
+	var mods []model.FactionListMod
+	err = table.FactionListMod.
+		SELECT(table.FactionListMod.ID, table.FactionListMod.Mod, table.FactionListMod.ModName).
+		FROM(table.FactionListMod).
+		WHERE(table.FactionListMod.FactionID.EQ(mysql.Int32(factionID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &mods)
+	if err != nil {
+		return nil, fmt.Errorf("query faction_list_mod %d: %w", factionID, err)
+	}
+
+	base := &factionBase{Mods: make(map[string]int, len(mods))}
+	if len(factions) > 0 {
+		base.Base = int(factions[0].Base)
+	}
+	for _, mod := range mods {
+		base.Mods[mod.ModName] = int(mod.Mod)
+	}
+
+	cache.GetCache().Set(cacheKey, base)
+	return base, nil
+}
+
+// GetCharacterFactionValue returns a character's total standing with a faction:
+// faction base + race/class/deity modifiers + the character's earned faction.
+// Earned faction is never cached since it changes as the character kills things.
+func GetCharacterFactionValue(ctx context.Context, charID int32, race, class, deity int, factionID int32) (int, error) {
+	base, err := getFactionBase(ctx, factionID)
+	if err != nil {
+		return 0, err
+	}
+
+	value := base.Base
+	value += base.Mods[fmt.Sprintf("r%d", race)]
+	value += base.Mods[fmt.Sprintf("c%d", class)]
+	value += base.Mods[fmt.Sprintf("d%d", deity)]
+
+	var earned []model.FactionValues
+	err = table.FactionValues.
+		SELECT(table.FactionValues.CharID, table.FactionValues.FactionID, table.FactionValues.CurrentValue).
+		FROM(table.FactionValues).
+		WHERE(
+			table.FactionValues.CharID.EQ(mysql.Int32(charID)).
+				AND(table.FactionValues.FactionID.EQ(mysql.Int32(factionID))),
+		).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &earned)
+	if err != nil {
+		return 0, fmt.Errorf("query faction_values (char=%d, faction=%d): %w", charID, factionID, err)
+	}
+	if len(earned) > 0 {
+		value += int(earned[0].CurrentValue)
+	}
+
+	return value, nil
+}
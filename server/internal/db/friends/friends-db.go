@@ -0,0 +1,94 @@
+package db_friends
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// Kind is the friends.type of an entry: the friends table holds both friend and ignore lists
+type Kind uint8
+
+const (
+	Ignore Kind = 0
+	Friend Kind = 1
+)
+
+// List loads the names on one of the character's lists
+func List(ctx context.Context, charID int32, kind Kind) ([]string, error) {
+	var rows []model.Friends
+	f := table.Friends
+	err := f.
+		SELECT(f.Name).
+		WHERE(f.Charid.EQ(mysql.Int32(charID)).AND(f.Type.EQ(mysql.Uint8(uint8(kind))))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query friends type %d for char %d: %w", kind, charID, err)
+	}
+	names := make([]string, len(rows))
+	for i, r := range rows {
+		names[i] = r.Name
+	}
+	return names, nil
+}
+
+// Add puts a name on one of the character's lists
+func Add(ctx context.Context, charID int32, kind Kind, name string) error {
+	f := table.Friends
+	_, err := f.
+		INSERT(f.Charid, f.Type, f.Name).
+		VALUES(charID, uint8(kind), name).
+		ON_DUPLICATE_KEY_UPDATE(f.Name.SET(mysql.String(name))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("insert friends type %d %q for char %d: %w", kind, name, charID, err)
+	}
+	return nil
+}
+
+// Remove takes a name off one of the character's lists
+func Remove(ctx context.Context, charID int32, kind Kind, name string) error {
+	f := table.Friends
+	_, err := f.
+		DELETE().
+		WHERE(f.Charid.EQ(mysql.Int32(charID)).
+			AND(f.Type.EQ(mysql.Uint8(uint8(kind)))).
+			AND(f.Name.EQ(mysql.String(name)))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("delete friends type %d %q for char %d: %w", kind, name, charID, err)
+	}
+	return nil
+}
+
+// GetCharacters loads the level, class and last zone of each named character that exists, keyed
+// by lowercase name
+func GetCharacters(ctx context.Context, names []string) (map[string]model.CharacterData, error) {
+	chars := make(map[string]model.CharacterData)
+	if len(names) == 0 {
+		return chars, nil
+	}
+	exprs := make([]mysql.Expression, len(names))
+	for i, name := range names {
+		exprs[i] = mysql.String(name)
+	}
+	var rows []model.CharacterData
+	c := table.CharacterData
+	err := c.
+		SELECT(c.Name, c.Level, c.Class, c.ZoneID, c.Anon).
+		WHERE(c.Name.IN(exprs...).AND(c.DeletedAt.IS_NULL())).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query character_data for friends: %w", err)
+	}
+	for _, r := range rows {
+		chars[strings.ToLower(r.Name)] = r
+	}
+	return chars, nil
+}
@@ -0,0 +1,414 @@
+package db_guild
+
+import (
+	"context"
+	"fmt"
+
+	"idlequest/internal/cache"
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+	"idlequest/internal/guild"
+
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Membership is a character's place in a guild. The zero value means no guild.
+type Membership struct {
+	GuildID   int32
+	GuildName string
+	Rank      uint8
+}
+
+// Member is one row of a guild's roster
+type Member struct {
+	model.GuildMembers
+	Character model.CharacterData // Name, level, class, zone and last login only
+}
+
+func membershipCacheKey(charID int32) string {
+	return fmt.Sprintf("guild:member:%d", charID)
+}
+
+// forgetMembership drops a character's cached membership after it changes
+func forgetMembership(charID int32) {
+	_ = cache.GetCache().Delete(membershipCacheKey(charID))
+}
+
+// GetMembership returns the character's guild and rank, the zero Membership if they have none.
+// Cached, since guild chat and chat messages look it up for every message.
+func GetMembership(ctx context.Context, charID int32) (Membership, error) {
+	cacheKey := membershipCacheKey(charID)
+	if val, found, err := cache.GetCache().Get(cacheKey); err == nil && found {
+		if m, ok := val.(Membership); ok {
+			return m, nil
+		}
+	}
+
+	var rows []struct {
+		model.GuildMembers
+		Guild model.Guilds
+	}
+	err := table.GuildMembers.
+		SELECT(table.GuildMembers.GuildID, table.GuildMembers.Rank, table.Guilds.Name).
+		FROM(table.GuildMembers.INNER_JOIN(table.Guilds, table.Guilds.ID.EQ(table.GuildMembers.GuildID))).
+		WHERE(table.GuildMembers.CharID.EQ(mysql.Int32(charID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return Membership{}, fmt.Errorf("query guild_members for char %d: %w", charID, err)
+	}
+	var m Membership
+	if len(rows) > 0 {
+		m = Membership{GuildID: int32(rows[0].GuildMembers.GuildID), GuildName: rows[0].Guild.Name, Rank: rows[0].GuildMembers.Rank}
+	}
+	cache.GetCache().Set(cacheKey, m)
+	return m, nil
+}
+
+// GetGuildNames returns the guild name of each character that is in a guild, keyed by character ID
+func GetGuildNames(ctx context.Context, charIDs []int32) (map[int32]string, error) {
+	names := make(map[int32]string)
+	if len(charIDs) == 0 {
+		return names, nil
+	}
+	ids := make([]mysql.Expression, len(charIDs))
+	for i, id := range charIDs {
+		ids[i] = mysql.Int32(id)
+	}
+	var rows []struct {
+		model.GuildMembers
+		Guild model.Guilds
+	}
+	err := table.GuildMembers.
+		SELECT(table.GuildMembers.CharID, table.Guilds.Name).
+		FROM(table.GuildMembers.INNER_JOIN(table.Guilds, table.Guilds.ID.EQ(table.GuildMembers.GuildID))).
+		WHERE(table.GuildMembers.CharID.IN(ids...)).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query guild names: %w", err)
+	}
+	for _, row := range rows {
+		names[row.GuildMembers.CharID] = row.Guild.Name
+	}
+	return names, nil
+}
+
+// GetGuild loads a guild
+func GetGuild(ctx context.Context, guildID int32) (*model.Guilds, error) {
+	var guilds []model.Guilds
+	err := table.Guilds.
+		SELECT(table.Guilds.AllColumns).
+		FROM(table.Guilds).
+		WHERE(table.Guilds.ID.EQ(mysql.Int32(guildID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &guilds)
+	if err != nil {
+		return nil, fmt.Errorf("query guild %d: %w", guildID, err)
+	}
+	if len(guilds) == 0 {
+		return nil, fmt.Errorf("guild %d not found", guildID)
+	}
+	return &guilds[0], nil
+}
+
+// GuildNameTaken reports whether a guild already has the name
+func GuildNameTaken(ctx context.Context, name string) (bool, error) {
+	var guilds []model.Guilds
+	err := table.Guilds.
+		SELECT(table.Guilds.ID).
+		FROM(table.Guilds).
+		WHERE(table.Guilds.Name.EQ(mysql.String(name))).
+		LIMIT(1).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &guilds)
+	if err != nil {
+		return false, fmt.Errorf("query guild name %q: %w", name, err)
+	}
+	return len(guilds) > 0, nil
+}
+
+// CreateGuild creates a guild led by the character, with the default rank titles and
+// permissions. Returns the new guild's ID.
+func CreateGuild(ctx context.Context, name string, leaderID int32) (int32, error) {
+	tx, err := db.GlobalWorldDB.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin create guild transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	g := table.Guilds
+	res, err := g.
+		INSERT(g.Name, g.Leader, g.Minstatus, g.Motd, g.Tribute, g.MotdSetter, g.Channel, g.URL, g.Favor).
+		VALUES(name, leaderID, 0, "", 0, "", "", "", 0).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("insert guild %q: %w", name, err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("getting guild id: %w", err)
+	}
+	guildID := int32(id)
+
+	r := table.GuildRanks
+	ranks := r.INSERT(r.GuildID, r.Rank, r.Title)
+	for rank := uint8(1); rank <= guild.RankCount; rank++ {
+		ranks = ranks.VALUES(guildID, rank, guild.DefaultRankTitles[rank])
+	}
+	if _, err := ranks.ExecContext(ctx, tx); err != nil {
+		return 0, fmt.Errorf("insert guild_ranks for guild %d: %w", guildID, err)
+	}
+
+	p := table.GuildPermissions
+	perms := p.INSERT(p.PermID, p.GuildID, p.Permission)
+	for perm, mask := range guild.DefaultPermissions() {
+		perms = perms.VALUES(perm, guildID, mask)
+	}
+	if _, err := perms.ExecContext(ctx, tx); err != nil {
+		return 0, fmt.Errorf("insert guild_permissions for guild %d: %w", guildID, err)
+	}
+
+	if err := insertMember(ctx, tx, guildID, leaderID, guild.RankLeader); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit create guild %q: %w", name, err)
+	}
+	forgetMembership(leaderID)
+	return guildID, nil
+}
+
+func insertMember(ctx context.Context, exec qrm.Executable, guildID, charID int32, rank uint8) error {
+	m := table.GuildMembers
+	_, err := m.
+		INSERT(m.CharID, m.GuildID, m.Rank, m.PublicNote).
+		VALUES(charID, guildID, rank, "").
+		ExecContext(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("insert guild_members for char %d: %w", charID, err)
+	}
+	return nil
+}
+
+// AddMember adds a character to a guild at the rank
+func AddMember(ctx context.Context, guildID, charID int32, rank uint8) error {
+	if err := insertMember(ctx, db.GlobalWorldDB.DB, guildID, charID, rank); err != nil {
+		return err
+	}
+	forgetMembership(charID)
+	return nil
+}
+
+// RemoveMember takes a character out of their guild
+func RemoveMember(ctx context.Context, charID int32) error {
+	_, err := table.GuildMembers.
+		DELETE().
+		WHERE(table.GuildMembers.CharID.EQ(mysql.Int32(charID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("delete guild_members for char %d: %w", charID, err)
+	}
+	forgetMembership(charID)
+	return nil
+}
+
+// SetRank changes a member's rank
+func SetRank(ctx context.Context, charID int32, rank uint8) error {
+	_, err := table.GuildMembers.
+		UPDATE(table.GuildMembers.Rank).
+		SET(rank).
+		WHERE(table.GuildMembers.CharID.EQ(mysql.Int32(charID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("update guild rank for char %d: %w", charID, err)
+	}
+	forgetMembership(charID)
+	return nil
+}
+
+// SetLeader hands the guild to a member, who takes the leader's rank while the old leader
+// drops to senior officer
+func SetLeader(ctx context.Context, guildID, oldLeaderID, newLeaderID int32) error {
+	tx, err := db.GlobalWorldDB.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin guild leader transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	m := table.GuildMembers
+	if _, err := m.UPDATE(m.Rank).SET(guild.RankSeniorOfficer).
+		WHERE(m.CharID.EQ(mysql.Int32(oldLeaderID))).ExecContext(ctx, tx); err != nil {
+		return fmt.Errorf("demote guild leader %d: %w", oldLeaderID, err)
+	}
+	if _, err := m.UPDATE(m.Rank).SET(guild.RankLeader).
+		WHERE(m.CharID.EQ(mysql.Int32(newLeaderID))).ExecContext(ctx, tx); err != nil {
+		return fmt.Errorf("promote guild leader %d: %w", newLeaderID, err)
+	}
+	if _, err := table.Guilds.UPDATE(table.Guilds.Leader).SET(newLeaderID).
+		WHERE(table.Guilds.ID.EQ(mysql.Int32(guildID))).ExecContext(ctx, tx); err != nil {
+		return fmt.Errorf("update leader of guild %d: %w", guildID, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit guild leader change for guild %d: %w", guildID, err)
+	}
+	forgetMembership(oldLeaderID)
+	forgetMembership(newLeaderID)
+	return nil
+}
+
+// SetPublicNote sets a member's public note
+func SetPublicNote(ctx context.Context, charID int32, note string) error {
+	_, err := table.GuildMembers.
+		UPDATE(table.GuildMembers.PublicNote).
+		SET(note).
+		WHERE(table.GuildMembers.CharID.EQ(mysql.Int32(charID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("update public note for char %d: %w", charID, err)
+	}
+	return nil
+}
+
+// SetMOTD sets the guild's message of the day and who set it
+func SetMOTD(ctx context.Context, guildID int32, motd, setter string) error {
+	_, err := table.Guilds.
+		UPDATE(table.Guilds.Motd, table.Guilds.MotdSetter).
+		SET(motd, setter).
+		WHERE(table.Guilds.ID.EQ(mysql.Int32(guildID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("update motd of guild %d: %w", guildID, err)
+	}
+	return nil
+}
+
+// GetMembers returns the guild's roster, most senior first
+func GetMembers(ctx context.Context, guildID int32) ([]Member, error) {
+	var members []Member
+	err := table.GuildMembers.
+		SELECT(
+			table.GuildMembers.AllColumns,
+			table.CharacterData.ID,
+			table.CharacterData.Name,
+			table.CharacterData.Level,
+			table.CharacterData.Class,
+			table.CharacterData.ZoneID,
+			table.CharacterData.LastLogin,
+		).
+		FROM(table.GuildMembers.INNER_JOIN(table.CharacterData, table.CharacterData.ID.EQ(table.GuildMembers.CharID))).
+		WHERE(
+			table.GuildMembers.GuildID.EQ(mysql.Int32(guildID)).
+				AND(table.CharacterData.DeletedAt.IS_NULL()),
+		).
+		ORDER_BY(table.GuildMembers.Rank.ASC(), table.CharacterData.Name.ASC()).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &members)
+	if err != nil {
+		return nil, fmt.Errorf("query roster of guild %d: %w", guildID, err)
+	}
+	return members, nil
+}
+
+// GetRanks returns the guild's rank titles, indexed by rank
+func GetRanks(ctx context.Context, guildID int32) ([guild.RankCount + 1]string, error) {
+	titles := guild.DefaultRankTitles
+	var ranks []model.GuildRanks
+	err := table.GuildRanks.
+		SELECT(table.GuildRanks.Rank, table.GuildRanks.Title).
+		FROM(table.GuildRanks).
+		WHERE(table.GuildRanks.GuildID.EQ(mysql.Int32(guildID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &ranks)
+	if err != nil {
+		return titles, fmt.Errorf("query guild_ranks of guild %d: %w", guildID, err)
+	}
+	for _, r := range ranks {
+		if r.Rank >= 1 && r.Rank <= guild.RankCount && r.Title != "" {
+			titles[r.Rank] = r.Title
+		}
+	}
+	return titles, nil
+}
+
+// GetPermissions returns the guild's permissions, the defaults for any it has no row for
+func GetPermissions(ctx context.Context, guildID int32) (guild.Permissions, error) {
+	perms := guild.DefaultPermissions()
+	var rows []model.GuildPermissions
+	err := table.GuildPermissions.
+		SELECT(table.GuildPermissions.PermID, table.GuildPermissions.Permission).
+		FROM(table.GuildPermissions).
+		WHERE(table.GuildPermissions.GuildID.EQ(mysql.Int32(guildID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return perms, fmt.Errorf("query guild_permissions of guild %d: %w", guildID, err)
+	}
+	for _, row := range rows {
+		perms[row.PermID] = row.Permission
+	}
+	return perms, nil
+}
+
+// SetPermission sets which ranks of the guild hold a permission
+func SetPermission(ctx context.Context, guildID, perm, mask int32) error {
+	tx, err := db.GlobalWorldDB.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin guild permission transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	p := table.GuildPermissions
+	if _, err := p.DELETE().
+		WHERE(p.GuildID.EQ(mysql.Int32(guildID)).AND(p.PermID.EQ(mysql.Int32(perm)))).
+		ExecContext(ctx, tx); err != nil {
+		return fmt.Errorf("delete guild permission %d of guild %d: %w", perm, guildID, err)
+	}
+	if _, err := p.INSERT(p.PermID, p.GuildID, p.Permission).
+		VALUES(perm, guildID, mask).
+		ExecContext(ctx, tx); err != nil {
+		return fmt.Errorf("insert guild permission %d of guild %d: %w", perm, guildID, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit guild permission %d of guild %d: %w", perm, guildID, err)
+	}
+	return nil
+}
+
+// DeleteGuild disbands a guild, removing its members, ranks and permissions. Returns the
+// character IDs of the members it had.
+func DeleteGuild(ctx context.Context, guildID int32) ([]int32, error) {
+	var members []model.GuildMembers
+	if err := table.GuildMembers.
+		SELECT(table.GuildMembers.CharID).
+		FROM(table.GuildMembers).
+		WHERE(table.GuildMembers.GuildID.EQ(mysql.Int32(guildID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &members); err != nil {
+		return nil, fmt.Errorf("query members of guild %d: %w", guildID, err)
+	}
+
+	tx, err := db.GlobalWorldDB.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin delete guild transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	id := mysql.Int32(guildID)
+	if _, err := table.GuildMembers.DELETE().WHERE(table.GuildMembers.GuildID.EQ(id)).ExecContext(ctx, tx); err != nil {
+		return nil, fmt.Errorf("delete members of guild %d: %w", guildID, err)
+	}
+	if _, err := table.GuildRanks.DELETE().WHERE(table.GuildRanks.GuildID.EQ(id)).ExecContext(ctx, tx); err != nil {
+		return nil, fmt.Errorf("delete ranks of guild %d: %w", guildID, err)
+	}
+	if _, err := table.GuildPermissions.DELETE().WHERE(table.GuildPermissions.GuildID.EQ(id)).ExecContext(ctx, tx); err != nil {
+		return nil, fmt.Errorf("delete permissions of guild %d: %w", guildID, err)
+	}
+	if _, err := table.Guilds.DELETE().WHERE(table.Guilds.ID.EQ(id)).ExecContext(ctx, tx); err != nil {
+		return nil, fmt.Errorf("delete guild %d: %w", guildID, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit delete guild %d: %w", guildID, err)
+	}
+
+	charIDs := make([]int32, len(members))
+	for i, m := range members {
+		charIDs[i] = m.CharID
+		forgetMembership(m.CharID)
+	}
+	return charIDs, nil
+}
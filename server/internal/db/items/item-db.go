@@ -570,6 +570,35 @@ func UpdateItemInstance(instance constants.ItemInstance) error {
 	return nil
 }
 
+// SaveItemCharges persists the charges left on a stack after some were used up (ammo fired,
+// throwing items thrown), deleting the item from the player's inventory once none are left
+func SaveItemCharges(playerID int32, item *constants.ItemWithInstance) error {
+	if item.Instance.Charges > 0 {
+		return UpdateItemInstance(item.Instance)
+	}
+
+	_, err := table.CharacterInventory.
+		DELETE().
+		WHERE(
+			table.CharacterInventory.CharacterID.EQ(mysql.Int32(playerID)).
+				AND(table.CharacterInventory.ItemInstanceID.EQ(mysql.Int32(item.ItemInstanceID))),
+		).
+		Exec(db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("failed to delete used up item from inventory: %v", err)
+	}
+	_, err = table.ItemInstances.
+		DELETE().
+		WHERE(table.ItemInstances.ID.EQ(mysql.Int32(item.ItemInstanceID))).
+		Exec(db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("failed to delete used up item instance: %v", err)
+	}
+
+	cache.GetCache().Delete(fmt.Sprintf("iteminstance:guid:%d", item.ItemInstanceID))
+	return nil
+}
+
 // FindFirstAvailableSlot finds the first empty inventory or bag slot.
 // If isContainer is true, it only checks general slots (bag 0) to prevent nested containers.
 func FindFirstAvailableSlot(items map[constants.InventoryKey]*constants.ItemWithInstance, isContainer bool) (bag int8, slot int8) {
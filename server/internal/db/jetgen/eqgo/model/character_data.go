@@ -118,4 +118,7 @@ type CharacterData struct {
 	DeletedAt             *time.Time
 	IllusionBlock         uint8
 	AutosellEnabled       uint8
+	AutoRetreatPercent    uint8
+	InspectOptOut         uint8
+	OfflineHunt           *string
 }
@@ -26,4 +26,5 @@ type CharacterParcels struct {
 	FromName *string
 	Note     *string
 	SentDate *time.Time
+	MailID   uint32
 }
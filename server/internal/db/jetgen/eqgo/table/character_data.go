@@ -123,6 +123,9 @@ type characterDataTable struct {
 	DeletedAt             mysql.ColumnTimestamp
 	IllusionBlock         mysql.ColumnInteger
 	AutosellEnabled       mysql.ColumnInteger
+	AutoRetreatPercent    mysql.ColumnInteger
+	InspectOptOut         mysql.ColumnInteger
+	OfflineHunt           mysql.ColumnString
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
@@ -270,9 +273,12 @@ func newCharacterDataTableImpl(schemaName, tableName, alias string) characterDat
 		DeletedAtColumn             = mysql.TimestampColumn("deleted_at")
 		IllusionBlockColumn         = mysql.IntegerColumn("illusion_block")
 		AutosellEnabledColumn       = mysql.IntegerColumn("autosell_enabled")
-		allColumns                  = mysql.ColumnList{IDColumn, AccountIDColumn, NameColumn, LastNameColumn, TitleColumn, SuffixColumn, ZoneIDColumn, ZoneInstanceColumn, YColumn, XColumn, ZColumn, HeadingColumn, GenderColumn, RaceColumn, ClassColumn, LevelColumn, DeityColumn, BirthdayColumn, LastLoginColumn, TimePlayedColumn, Level2Column, AnonColumn, GmColumn, FaceColumn, HairColorColumn, HairStyleColumn, BeardColumn, BeardColorColumn, EyeColor1Column, EyeColor2Column, DrakkinHeritageColumn, DrakkinTattooColumn, DrakkinDetailsColumn, AbilityTimeSecondsColumn, AbilityNumberColumn, AbilityTimeMinutesColumn, AbilityTimeHoursColumn, ExpColumn, ExpEnabledColumn, AaPointsSpentColumn, AaExpColumn, AaPointsColumn, GroupLeadershipExpColumn, RaidLeadershipExpColumn, GroupLeadershipPointsColumn, RaidLeadershipPointsColumn, PointsColumn, CurHpColumn, ManaColumn, EnduranceColumn, IntoxicationColumn, StrColumn, StaColumn, ChaColumn, DexColumn, IntColumn, AgiColumn, WisColumn, ExtraHasteColumn, ZoneChangeCountColumn, ToxicityColumn, HungerLevelColumn, ThirstLevelColumn, AbilityUpColumn, LdonPointsGukColumn, LdonPointsMirColumn, LdonPointsMmcColumn, LdonPointsRujColumn, LdonPointsTakColumn, LdonPointsAvailableColumn, TributeTimeRemainingColumn, CareerTributePointsColumn, TributePointsColumn, TributeActiveColumn, PvpStatusColumn, PvpKillsColumn, PvpDeathsColumn, PvpCurrentPointsColumn, PvpCareerPointsColumn, PvpBestKillStreakColumn, PvpWorstDeathStreakColumn, PvpCurrentKillStreakColumn, Pvp2Column, PvpTypeColumn, ShowHelmColumn, GroupAutoConsentColumn, RaidAutoConsentColumn, GuildAutoConsentColumn, LeadershipExpOnColumn, RestTimerColumn, AirRemainingColumn, AutosplitEnabledColumn, LfpColumn, LfgColumn, MailkeyColumn, XtargetsColumn, FirstlogonColumn, EAaEffectsColumn, EPercentToAaColumn, EExpendedAaSpentColumn, AaPointsSpentOldColumn, AaPointsOldColumn, ELastInvsnapshotColumn, DeletedAtColumn, IllusionBlockColumn, AutosellEnabledColumn}
-		mutableColumns              = mysql.ColumnList{AccountIDColumn, NameColumn, LastNameColumn, TitleColumn, SuffixColumn, ZoneIDColumn, ZoneInstanceColumn, YColumn, XColumn, ZColumn, HeadingColumn, GenderColumn, RaceColumn, ClassColumn, LevelColumn, DeityColumn, BirthdayColumn, LastLoginColumn, TimePlayedColumn, Level2Column, AnonColumn, GmColumn, FaceColumn, HairColorColumn, HairStyleColumn, BeardColumn, BeardColorColumn, EyeColor1Column, EyeColor2Column, DrakkinHeritageColumn, DrakkinTattooColumn, DrakkinDetailsColumn, AbilityTimeSecondsColumn, AbilityNumberColumn, AbilityTimeMinutesColumn, AbilityTimeHoursColumn, ExpColumn, ExpEnabledColumn, AaPointsSpentColumn, AaExpColumn, AaPointsColumn, GroupLeadershipExpColumn, RaidLeadershipExpColumn, GroupLeadershipPointsColumn, RaidLeadershipPointsColumn, PointsColumn, CurHpColumn, ManaColumn, EnduranceColumn, IntoxicationColumn, StrColumn, StaColumn, ChaColumn, DexColumn, IntColumn, AgiColumn, WisColumn, ExtraHasteColumn, ZoneChangeCountColumn, ToxicityColumn, HungerLevelColumn, ThirstLevelColumn, AbilityUpColumn, LdonPointsGukColumn, LdonPointsMirColumn, LdonPointsMmcColumn, LdonPointsRujColumn, LdonPointsTakColumn, LdonPointsAvailableColumn, TributeTimeRemainingColumn, CareerTributePointsColumn, TributePointsColumn, TributeActiveColumn, PvpStatusColumn, PvpKillsColumn, PvpDeathsColumn, PvpCurrentPointsColumn, PvpCareerPointsColumn, PvpBestKillStreakColumn, PvpWorstDeathStreakColumn, PvpCurrentKillStreakColumn, Pvp2Column, PvpTypeColumn, ShowHelmColumn, GroupAutoConsentColumn, RaidAutoConsentColumn, GuildAutoConsentColumn, LeadershipExpOnColumn, RestTimerColumn, AirRemainingColumn, AutosplitEnabledColumn, LfpColumn, LfgColumn, MailkeyColumn, XtargetsColumn, FirstlogonColumn, EAaEffectsColumn, EPercentToAaColumn, EExpendedAaSpentColumn, AaPointsSpentOldColumn, AaPointsOldColumn, ELastInvsnapshotColumn, DeletedAtColumn, IllusionBlockColumn, AutosellEnabledColumn}
-		defaultColumns              = mysql.ColumnList{AccountIDColumn, NameColumn, LastNameColumn, TitleColumn, SuffixColumn, ZoneIDColumn, ZoneInstanceColumn, YColumn, XColumn, ZColumn, HeadingColumn, GenderColumn, RaceColumn, ClassColumn, LevelColumn, DeityColumn, BirthdayColumn, LastLoginColumn, TimePlayedColumn, Level2Column, AnonColumn, GmColumn, FaceColumn, HairColorColumn, HairStyleColumn, BeardColumn, BeardColorColumn, EyeColor1Column, EyeColor2Column, DrakkinHeritageColumn, DrakkinTattooColumn, DrakkinDetailsColumn, AbilityTimeSecondsColumn, AbilityNumberColumn, AbilityTimeMinutesColumn, AbilityTimeHoursColumn, ExpColumn, ExpEnabledColumn, AaPointsSpentColumn, AaExpColumn, AaPointsColumn, GroupLeadershipExpColumn, RaidLeadershipExpColumn, GroupLeadershipPointsColumn, RaidLeadershipPointsColumn, PointsColumn, CurHpColumn, ManaColumn, EnduranceColumn, IntoxicationColumn, StrColumn, StaColumn, ChaColumn, DexColumn, IntColumn, AgiColumn, WisColumn, ExtraHasteColumn, ZoneChangeCountColumn, ToxicityColumn, HungerLevelColumn, ThirstLevelColumn, AbilityUpColumn, LdonPointsGukColumn, LdonPointsMirColumn, LdonPointsMmcColumn, LdonPointsRujColumn, LdonPointsTakColumn, LdonPointsAvailableColumn, TributeTimeRemainingColumn, CareerTributePointsColumn, TributePointsColumn, TributeActiveColumn, PvpStatusColumn, PvpKillsColumn, PvpDeathsColumn, PvpCurrentPointsColumn, PvpCareerPointsColumn, PvpBestKillStreakColumn, PvpWorstDeathStreakColumn, PvpCurrentKillStreakColumn, Pvp2Column, PvpTypeColumn, ShowHelmColumn, GroupAutoConsentColumn, RaidAutoConsentColumn, GuildAutoConsentColumn, LeadershipExpOnColumn, RestTimerColumn, AirRemainingColumn, AutosplitEnabledColumn, LfpColumn, LfgColumn, MailkeyColumn, XtargetsColumn, FirstlogonColumn, EAaEffectsColumn, EPercentToAaColumn, EExpendedAaSpentColumn, AaPointsSpentOldColumn, AaPointsOldColumn, ELastInvsnapshotColumn, IllusionBlockColumn, AutosellEnabledColumn}
+		AutoRetreatPercentColumn    = mysql.IntegerColumn("auto_retreat_percent")
+		InspectOptOutColumn         = mysql.IntegerColumn("inspect_opt_out")
+		OfflineHuntColumn           = mysql.StringColumn("offline_hunt")
+		allColumns                  = mysql.ColumnList{IDColumn, AccountIDColumn, NameColumn, LastNameColumn, TitleColumn, SuffixColumn, ZoneIDColumn, ZoneInstanceColumn, YColumn, XColumn, ZColumn, HeadingColumn, GenderColumn, RaceColumn, ClassColumn, LevelColumn, DeityColumn, BirthdayColumn, LastLoginColumn, TimePlayedColumn, Level2Column, AnonColumn, GmColumn, FaceColumn, HairColorColumn, HairStyleColumn, BeardColumn, BeardColorColumn, EyeColor1Column, EyeColor2Column, DrakkinHeritageColumn, DrakkinTattooColumn, DrakkinDetailsColumn, AbilityTimeSecondsColumn, AbilityNumberColumn, AbilityTimeMinutesColumn, AbilityTimeHoursColumn, ExpColumn, ExpEnabledColumn, AaPointsSpentColumn, AaExpColumn, AaPointsColumn, GroupLeadershipExpColumn, RaidLeadershipExpColumn, GroupLeadershipPointsColumn, RaidLeadershipPointsColumn, PointsColumn, CurHpColumn, ManaColumn, EnduranceColumn, IntoxicationColumn, StrColumn, StaColumn, ChaColumn, DexColumn, IntColumn, AgiColumn, WisColumn, ExtraHasteColumn, ZoneChangeCountColumn, ToxicityColumn, HungerLevelColumn, ThirstLevelColumn, AbilityUpColumn, LdonPointsGukColumn, LdonPointsMirColumn, LdonPointsMmcColumn, LdonPointsRujColumn, LdonPointsTakColumn, LdonPointsAvailableColumn, TributeTimeRemainingColumn, CareerTributePointsColumn, TributePointsColumn, TributeActiveColumn, PvpStatusColumn, PvpKillsColumn, PvpDeathsColumn, PvpCurrentPointsColumn, PvpCareerPointsColumn, PvpBestKillStreakColumn, PvpWorstDeathStreakColumn, PvpCurrentKillStreakColumn, Pvp2Column, PvpTypeColumn, ShowHelmColumn, GroupAutoConsentColumn, RaidAutoConsentColumn, GuildAutoConsentColumn, LeadershipExpOnColumn, RestTimerColumn, AirRemainingColumn, AutosplitEnabledColumn, LfpColumn, LfgColumn, MailkeyColumn, XtargetsColumn, FirstlogonColumn, EAaEffectsColumn, EPercentToAaColumn, EExpendedAaSpentColumn, AaPointsSpentOldColumn, AaPointsOldColumn, ELastInvsnapshotColumn, DeletedAtColumn, IllusionBlockColumn, AutosellEnabledColumn, AutoRetreatPercentColumn, InspectOptOutColumn, OfflineHuntColumn}
+		mutableColumns              = mysql.ColumnList{AccountIDColumn, NameColumn, LastNameColumn, TitleColumn, SuffixColumn, ZoneIDColumn, ZoneInstanceColumn, YColumn, XColumn, ZColumn, HeadingColumn, GenderColumn, RaceColumn, ClassColumn, LevelColumn, DeityColumn, BirthdayColumn, LastLoginColumn, TimePlayedColumn, Level2Column, AnonColumn, GmColumn, FaceColumn, HairColorColumn, HairStyleColumn, BeardColumn, BeardColorColumn, EyeColor1Column, EyeColor2Column, DrakkinHeritageColumn, DrakkinTattooColumn, DrakkinDetailsColumn, AbilityTimeSecondsColumn, AbilityNumberColumn, AbilityTimeMinutesColumn, AbilityTimeHoursColumn, ExpColumn, ExpEnabledColumn, AaPointsSpentColumn, AaExpColumn, AaPointsColumn, GroupLeadershipExpColumn, RaidLeadershipExpColumn, GroupLeadershipPointsColumn, RaidLeadershipPointsColumn, PointsColumn, CurHpColumn, ManaColumn, EnduranceColumn, IntoxicationColumn, StrColumn, StaColumn, ChaColumn, DexColumn, IntColumn, AgiColumn, WisColumn, ExtraHasteColumn, ZoneChangeCountColumn, ToxicityColumn, HungerLevelColumn, ThirstLevelColumn, AbilityUpColumn, LdonPointsGukColumn, LdonPointsMirColumn, LdonPointsMmcColumn, LdonPointsRujColumn, LdonPointsTakColumn, LdonPointsAvailableColumn, TributeTimeRemainingColumn, CareerTributePointsColumn, TributePointsColumn, TributeActiveColumn, PvpStatusColumn, PvpKillsColumn, PvpDeathsColumn, PvpCurrentPointsColumn, PvpCareerPointsColumn, PvpBestKillStreakColumn, PvpWorstDeathStreakColumn, PvpCurrentKillStreakColumn, Pvp2Column, PvpTypeColumn, ShowHelmColumn, GroupAutoConsentColumn, RaidAutoConsentColumn, GuildAutoConsentColumn, LeadershipExpOnColumn, RestTimerColumn, AirRemainingColumn, AutosplitEnabledColumn, LfpColumn, LfgColumn, MailkeyColumn, XtargetsColumn, FirstlogonColumn, EAaEffectsColumn, EPercentToAaColumn, EExpendedAaSpentColumn, AaPointsSpentOldColumn, AaPointsOldColumn, ELastInvsnapshotColumn, DeletedAtColumn, IllusionBlockColumn, AutosellEnabledColumn, AutoRetreatPercentColumn, InspectOptOutColumn, OfflineHuntColumn}
+		defaultColumns              = mysql.ColumnList{AccountIDColumn, NameColumn, LastNameColumn, TitleColumn, SuffixColumn, ZoneIDColumn, ZoneInstanceColumn, YColumn, XColumn, ZColumn, HeadingColumn, GenderColumn, RaceColumn, ClassColumn, LevelColumn, DeityColumn, BirthdayColumn, LastLoginColumn, TimePlayedColumn, Level2Column, AnonColumn, GmColumn, FaceColumn, HairColorColumn, HairStyleColumn, BeardColumn, BeardColorColumn, EyeColor1Column, EyeColor2Column, DrakkinHeritageColumn, DrakkinTattooColumn, DrakkinDetailsColumn, AbilityTimeSecondsColumn, AbilityNumberColumn, AbilityTimeMinutesColumn, AbilityTimeHoursColumn, ExpColumn, ExpEnabledColumn, AaPointsSpentColumn, AaExpColumn, AaPointsColumn, GroupLeadershipExpColumn, RaidLeadershipExpColumn, GroupLeadershipPointsColumn, RaidLeadershipPointsColumn, PointsColumn, CurHpColumn, ManaColumn, EnduranceColumn, IntoxicationColumn, StrColumn, StaColumn, ChaColumn, DexColumn, IntColumn, AgiColumn, WisColumn, ExtraHasteColumn, ZoneChangeCountColumn, ToxicityColumn, HungerLevelColumn, ThirstLevelColumn, AbilityUpColumn, LdonPointsGukColumn, LdonPointsMirColumn, LdonPointsMmcColumn, LdonPointsRujColumn, LdonPointsTakColumn, LdonPointsAvailableColumn, TributeTimeRemainingColumn, CareerTributePointsColumn, TributePointsColumn, TributeActiveColumn, PvpStatusColumn, PvpKillsColumn, PvpDeathsColumn, PvpCurrentPointsColumn, PvpCareerPointsColumn, PvpBestKillStreakColumn, PvpWorstDeathStreakColumn, PvpCurrentKillStreakColumn, Pvp2Column, PvpTypeColumn, ShowHelmColumn, GroupAutoConsentColumn, RaidAutoConsentColumn, GuildAutoConsentColumn, LeadershipExpOnColumn, RestTimerColumn, AirRemainingColumn, AutosplitEnabledColumn, LfpColumn, LfgColumn, MailkeyColumn, XtargetsColumn, FirstlogonColumn, EAaEffectsColumn, EPercentToAaColumn, EExpendedAaSpentColumn, AaPointsSpentOldColumn, AaPointsOldColumn, ELastInvsnapshotColumn, IllusionBlockColumn, AutosellEnabledColumn, AutoRetreatPercentColumn, InspectOptOutColumn, OfflineHuntColumn}
 	)
 
 	return characterDataTable{
@@ -385,6 +391,9 @@ func newCharacterDataTableImpl(schemaName, tableName, alias string) characterDat
 		DeletedAt:             DeletedAtColumn,
 		IllusionBlock:         IllusionBlockColumn,
 		AutosellEnabled:       AutosellEnabledColumn,
+		AutoRetreatPercent:    AutoRetreatPercentColumn,
+		InspectOptOut:         InspectOptOutColumn,
+		OfflineHunt:           OfflineHuntColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
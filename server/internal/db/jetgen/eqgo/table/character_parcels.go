@@ -31,6 +31,7 @@ type characterParcelsTable struct {
 	FromName mysql.ColumnString
 	Note     mysql.ColumnString
 	SentDate mysql.ColumnTimestamp
+	MailID   mysql.ColumnInteger
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
@@ -86,9 +87,10 @@ func newCharacterParcelsTableImpl(schemaName, tableName, alias string) character
 		FromNameColumn = mysql.StringColumn("from_name")
 		NoteColumn     = mysql.StringColumn("note")
 		SentDateColumn = mysql.TimestampColumn("sent_date")
-		allColumns     = mysql.ColumnList{IDColumn, CharIDColumn, ItemIDColumn, AugSlot1Column, AugSlot2Column, AugSlot3Column, AugSlot4Column, AugSlot5Column, AugSlot6Column, SlotIDColumn, QuantityColumn, FromNameColumn, NoteColumn, SentDateColumn}
-		mutableColumns = mysql.ColumnList{CharIDColumn, ItemIDColumn, AugSlot1Column, AugSlot2Column, AugSlot3Column, AugSlot4Column, AugSlot5Column, AugSlot6Column, SlotIDColumn, QuantityColumn, FromNameColumn, NoteColumn, SentDateColumn}
-		defaultColumns = mysql.ColumnList{CharIDColumn, ItemIDColumn, AugSlot1Column, AugSlot2Column, AugSlot3Column, AugSlot4Column, AugSlot5Column, AugSlot6Column, SlotIDColumn, QuantityColumn}
+		MailIDColumn   = mysql.IntegerColumn("mail_id")
+		allColumns     = mysql.ColumnList{IDColumn, CharIDColumn, ItemIDColumn, AugSlot1Column, AugSlot2Column, AugSlot3Column, AugSlot4Column, AugSlot5Column, AugSlot6Column, SlotIDColumn, QuantityColumn, FromNameColumn, NoteColumn, SentDateColumn, MailIDColumn}
+		mutableColumns = mysql.ColumnList{CharIDColumn, ItemIDColumn, AugSlot1Column, AugSlot2Column, AugSlot3Column, AugSlot4Column, AugSlot5Column, AugSlot6Column, SlotIDColumn, QuantityColumn, FromNameColumn, NoteColumn, SentDateColumn, MailIDColumn}
+		defaultColumns = mysql.ColumnList{CharIDColumn, ItemIDColumn, AugSlot1Column, AugSlot2Column, AugSlot3Column, AugSlot4Column, AugSlot5Column, AugSlot6Column, SlotIDColumn, QuantityColumn, MailIDColumn}
 	)
 
 	return characterParcelsTable{
@@ -109,6 +111,7 @@ func newCharacterParcelsTableImpl(schemaName, tableName, alias string) character
 		FromName: FromNameColumn,
 		Note:     NoteColumn,
 		SentDate: SentDateColumn,
+		MailID:   MailIDColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
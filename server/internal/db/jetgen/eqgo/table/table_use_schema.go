@@ -46,6 +46,7 @@ func UseSchema(schema string) {
 	CharacterBandolier = CharacterBandolier.FromSchema(schema)
 	CharacterBind = CharacterBind.FromSchema(schema)
 	CharacterBuffs = CharacterBuffs.FromSchema(schema)
+	CharacterCombatStats = CharacterCombatStats.FromSchema(schema)
 	CharacterCorpseItems = CharacterCorpseItems.FromSchema(schema)
 	CharacterCorpses = CharacterCorpses.FromSchema(schema)
 	CharacterCurrency = CharacterCurrency.FromSchema(schema)
@@ -63,6 +64,7 @@ func UseSchema(schema string) {
 	CharacterLeadershipAbilities = CharacterLeadershipAbilities.FromSchema(schema)
 	CharacterMaterial = CharacterMaterial.FromSchema(schema)
 	CharacterMemmedSpells = CharacterMemmedSpells.FromSchema(schema)
+	CharacterNpcKills = CharacterNpcKills.FromSchema(schema)
 	CharacterParcels = CharacterParcels.FromSchema(schema)
 	CharacterParcelsContainers = CharacterParcelsContainers.FromSchema(schema)
 	CharacterPeqzoneFlags = CharacterPeqzoneFlags.FromSchema(schema)
@@ -0,0 +1,363 @@
+package db_mail
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"idlequest/internal/cache"
+	"idlequest/internal/constants"
+	"idlequest/internal/db"
+	"idlequest/internal/db/items"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+	db_trade "idlequest/internal/db/trade"
+	"idlequest/internal/mail"
+	"idlequest/internal/trade"
+
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/stmtcache"
+)
+
+// Letters live in the mail table, addressed by charid. What is attached to a letter is kept in
+// character_parcels, one row per item plus one for coin, each in a slot_id of its own with
+// mail_id holding the letter's msgid. An attached item's instance is owned by its parcel row
+// while it travels.
+
+// Letter is a letter in a character's mailbox and what is still attached to it
+type Letter struct {
+	model.Mail
+	Items   []Parcel
+	Copper  int
+	parcels int // character_parcels rows, to take together
+}
+
+// Parcel is an item attached to a letter
+type Parcel struct {
+	ParcelID uint32
+	Item     *constants.ItemWithInstance
+}
+
+// HasAttachments reports whether anything is left to take from the letter
+func (l *Letter) HasAttachments() bool {
+	return len(l.Items) > 0 || l.Copper > 0
+}
+
+// Outgoing is a letter one character sends another
+type Outgoing struct {
+	FromCharID  int64
+	FromName    string
+	ToCharID    int64
+	ToName      string
+	Subject     string
+	Body        string
+	Attachments []mail.Attachment
+	Coin        trade.Coin
+}
+
+// Send delivers a letter in one transaction: the attached items leave the sender's inventory
+// and the coin their purse. If an item has left its slot or the purse can't cover the coin,
+// nothing is sent.
+func Send(ctx context.Context, out Outgoing) (uint32, error) {
+	tx, err := db.GlobalWorldDB.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin mail transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	m := table.Mail
+	res, err := m.
+		INSERT(m.Charid, m.Timestamp, m.From, m.Subject, m.Body, m.To, m.Status).
+		VALUES(out.ToCharID, now.Unix(), out.FromName, out.Subject, out.Body, out.ToName, mail.StatusUnread).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("insert mail to char %d: %w", out.ToCharID, err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("insert mail to char %d: %w", out.ToCharID, err)
+	}
+	msgID := uint32(id)
+
+	used, err := usedParcelSlots(ctx, tx, out.ToCharID)
+	if err != nil {
+		return 0, err
+	}
+	ci, ii := table.CharacterInventory, table.ItemInstances
+	for _, parcel := range mail.Parcels(out.Attachments, out.Coin, used) {
+		a := parcel.Attachment
+		if a == nil {
+			if err := db_trade.TakeCoin(ctx, tx, out.FromCharID, out.Coin); err != nil {
+				if err == sql.ErrNoRows {
+					return 0, mail.ErrNotEnoughCoin
+				}
+				return 0, err
+			}
+			if _, err := insertParcel(ctx, tx, out, msgID, parcel, now); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		res, err := ci.
+			DELETE().
+			WHERE(
+				ci.ItemInstanceID.EQ(mysql.Int32(a.Item.ItemInstanceID)).
+					AND(ci.CharacterID.EQ(mysql.Int64(out.FromCharID))).
+					AND(ci.Bag.EQ(mysql.Int8(a.Key.Bag))).
+					AND(ci.Slot.EQ(mysql.Int8(a.Key.Slot))),
+			).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return 0, fmt.Errorf("remove item instance %d from char %d: %w", a.Item.ItemInstanceID, out.FromCharID, err)
+		}
+		if n, _ := res.RowsAffected(); n != 1 {
+			return 0, fmt.Errorf("%s %w.", a.Item.Item.Name, trade.ErrItemMoved)
+		}
+		parcelID, err := insertParcel(ctx, tx, out, msgID, parcel, now)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := ii.
+			UPDATE(ii.OwnerID, ii.OwnerType).
+			SET(parcelID, constants.OwnerTypeParcel).
+			WHERE(ii.ID.EQ(mysql.Int32(a.Item.ItemInstanceID))).
+			ExecContext(ctx, tx); err != nil {
+			return 0, fmt.Errorf("attach item instance %d to parcel %d: %w", a.Item.ItemInstanceID, parcelID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit mail: %w", err)
+	}
+	for _, a := range out.Attachments {
+		cache.GetCache().Delete(fmt.Sprintf("iteminstance:guid:%d", a.Item.ItemInstanceID))
+	}
+	return msgID, nil
+}
+
+// usedParcelSlots returns the slot_ids the character's parcels hold, locking them so another
+// letter to them can't take the same slots
+func usedParcelSlots(ctx context.Context, tx *stmtcache.Tx, charID int64) (map[uint32]bool, error) {
+	var rows []model.CharacterParcels
+	p := table.CharacterParcels
+	err := p.
+		SELECT(p.SlotID).
+		WHERE(p.CharID.EQ(mysql.Int64(charID))).
+		FOR(mysql.UPDATE()).
+		QueryContext(ctx, tx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query parcel slots for char %d: %w", charID, err)
+	}
+	used := make(map[uint32]bool, len(rows))
+	for _, r := range rows {
+		used[r.SlotID] = true
+	}
+	return used, nil
+}
+
+// insertParcel attaches an item, or coin as MoneyItemID, to a letter
+func insertParcel(ctx context.Context, tx *stmtcache.Tx, out Outgoing, msgID uint32, parcel mail.Parcel, sent time.Time) (uint32, error) {
+	p := table.CharacterParcels
+	res, err := p.
+		INSERT(p.CharID, p.ItemID, p.SlotID, p.Quantity, p.FromName, p.Note, p.SentDate, p.MailID).
+		VALUES(out.ToCharID, parcel.ItemID, parcel.SlotID, parcel.Quantity, out.FromName, out.Subject, sent, msgID).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("insert parcel for mail %d: %w", msgID, err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("insert parcel for mail %d: %w", msgID, err)
+	}
+	return uint32(id), nil
+}
+
+// GetMailbox loads the character's letters, newest first, with what is attached to each
+func GetMailbox(ctx context.Context, charID int32) ([]Letter, error) {
+	var rows []model.Mail
+	err := table.Mail.
+		SELECT(table.Mail.AllColumns).
+		WHERE(table.Mail.Charid.EQ(mysql.Int32(charID))).
+		ORDER_BY(table.Mail.Msgid.DESC()).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query mail for char %d: %w", charID, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	letters := make([]Letter, len(rows))
+	for i, r := range rows {
+		letters[i].Mail = r
+	}
+
+	var parcels []model.CharacterParcels
+	err = table.CharacterParcels.
+		SELECT(table.CharacterParcels.AllColumns).
+		WHERE(table.CharacterParcels.CharID.EQ(mysql.Int32(charID))).
+		ORDER_BY(table.CharacterParcels.ID.ASC()).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &parcels)
+	if err != nil {
+		return nil, fmt.Errorf("query parcels for char %d: %w", charID, err)
+	}
+	attached, err := getParcelItems(ctx, parcels)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uint32]*Letter, len(letters))
+	for i := range letters {
+		byID[letters[i].Msgid] = &letters[i]
+	}
+	for _, p := range parcels {
+		letter := byID[p.MailID]
+		if letter == nil {
+			continue
+		}
+		letter.parcels++
+		if p.ItemID == mail.MoneyItemID {
+			letter.Copper += int(p.Quantity)
+			continue
+		}
+		if item := attached[p.ID]; item != nil {
+			letter.Items = append(letter.Items, Parcel{ParcelID: p.ID, Item: item})
+		}
+	}
+	return letters, nil
+}
+
+// getParcelItems loads the item instances the parcels hold, keyed by parcel ID
+func getParcelItems(ctx context.Context, parcels []model.CharacterParcels) (map[uint32]*constants.ItemWithInstance, error) {
+	attached := make(map[uint32]*constants.ItemWithInstance)
+	var ids []mysql.Expression
+	for _, p := range parcels {
+		if p.ItemID != mail.MoneyItemID {
+			ids = append(ids, mysql.Uint32(p.ID))
+		}
+	}
+	if len(ids) == 0 {
+		return attached, nil
+	}
+	var instances []model.ItemInstances
+	ii := table.ItemInstances
+	err := ii.
+		SELECT(ii.AllColumns).
+		WHERE(ii.OwnerType.EQ(mysql.Int(int64(constants.OwnerTypeParcel))).AND(ii.OwnerID.IN(ids...))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &instances)
+	if err != nil {
+		return nil, fmt.Errorf("query parcel item instances: %w", err)
+	}
+	for _, inst := range instances {
+		template, err := items.GetItemTemplateByID(inst.ItemID)
+		if err != nil {
+			log.Printf("failed to get item template for itemID %d: %v", inst.ItemID, err)
+			continue
+		}
+		instance := items.CreateItemInstanceFromTemplateID(inst.ItemID)
+		instance.ID = inst.ID
+		instance.Charges = inst.Charges
+		instance.Quantity = inst.Quantity
+		if inst.Mods != nil {
+			json.Unmarshal([]byte(*inst.Mods), &instance.Mods)
+		}
+		attached[*inst.OwnerID] = &constants.ItemWithInstance{
+			Item:           template,
+			Instance:       *instance,
+			ItemInstanceID: inst.ID,
+		}
+	}
+	return attached, nil
+}
+
+// MarkRead marks one of the character's letters as read
+func MarkRead(ctx context.Context, charID int32, msgID uint32) error {
+	m := table.Mail
+	_, err := m.
+		UPDATE(m.Status).
+		SET(mail.StatusRead).
+		WHERE(m.Msgid.EQ(mysql.Uint32(msgID)).AND(m.Charid.EQ(mysql.Int32(charID)))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("mark mail %d read: %w", msgID, err)
+	}
+	return nil
+}
+
+// Take moves everything attached to the letter to the character in one transaction: each item
+// into the inventory slot given for it, in order, and the coin into their purse.
+func Take(ctx context.Context, charID int32, letter *Letter, keys []constants.InventoryKey) error {
+	tx, err := db.GlobalWorldDB.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin mail transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	ci, ii := table.CharacterInventory, table.ItemInstances
+	for i, p := range letter.Items {
+		res, err := ii.
+			UPDATE(ii.OwnerID, ii.OwnerType).
+			SET(charID, constants.OwnerTypeCharacter).
+			WHERE(
+				ii.ID.EQ(mysql.Int32(p.Item.ItemInstanceID)).
+					AND(ii.OwnerType.EQ(mysql.Int(int64(constants.OwnerTypeParcel)))).
+					AND(ii.OwnerID.EQ(mysql.Uint32(p.ParcelID))),
+			).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("take item instance %d from parcel %d: %w", p.Item.ItemInstanceID, p.ParcelID, err)
+		}
+		if n, _ := res.RowsAffected(); n != 1 {
+			return mail.ErrNothingToTake
+		}
+		if _, err := ci.
+			INSERT(ci.CharacterID, ci.Slot, ci.ItemInstanceID, ci.Bag).
+			VALUES(charID, keys[i].Slot, p.Item.ItemInstanceID, keys[i].Bag).
+			ExecContext(ctx, tx); err != nil {
+			return fmt.Errorf("insert inventory row for item instance %d: %w", p.Item.ItemInstanceID, err)
+		}
+	}
+
+	// Another take of the same letter would find its parcels already gone
+	p := table.CharacterParcels
+	res, err := p.
+		DELETE().
+		WHERE(p.CharID.EQ(mysql.Int32(charID)).AND(p.MailID.EQ(mysql.Uint32(letter.Msgid)))).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("delete parcels of mail %d: %w", letter.Msgid, err)
+	}
+	if n, _ := res.RowsAffected(); n != int64(letter.parcels) {
+		return mail.ErrNothingToTake
+	}
+	if letter.Copper > 0 {
+		if err := db_trade.GiveCoin(ctx, tx, int64(charID), mail.FromCopper(letter.Copper)); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit mail %d: %w", letter.Msgid, err)
+	}
+	for _, p := range letter.Items {
+		cache.GetCache().Delete(fmt.Sprintf("iteminstance:guid:%d", p.Item.ItemInstanceID))
+	}
+	return nil
+}
+
+// Delete removes one of the character's letters
+func Delete(ctx context.Context, charID int32, msgID uint32) error {
+	m := table.Mail
+	_, err := m.
+		DELETE().
+		WHERE(m.Msgid.EQ(mysql.Uint32(msgID)).AND(m.Charid.EQ(mysql.Int32(charID)))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("delete mail %d: %w", msgID, err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package npc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"idlequest/internal/cache"
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// Scaling types, the npc_scale_global_base.type column
+const (
+	ScaleTypeTrash = 0
+	ScaleTypeNamed = 1
+	ScaleTypeRaid  = 2
+)
+
+// scaleKey identifies a npc_scale_global_base row
+type scaleKey struct {
+	scaleType int32
+	level     int32
+}
+
+// ScaleTable holds the global npc_scale_global_base rows by type and level
+type ScaleTable map[scaleKey]model.NpcScaleGlobalBase
+
+// GetScaleTable loads the npc_scale_global_base rows that apply to every zone
+func GetScaleTable(ctx context.Context) (ScaleTable, error) {
+	const cacheKey = "npc:scale_global_base"
+	if val, found, err := cache.GetCache().Get(cacheKey); err == nil && found {
+		if st, ok := val.(ScaleTable); ok {
+			return st, nil
+		}
+	}
+
+	var rows []model.NpcScaleGlobalBase
+	err := table.NpcScaleGlobalBase.
+		SELECT(table.NpcScaleGlobalBase.AllColumns).
+		FROM(table.NpcScaleGlobalBase).
+		WHERE(
+			table.NpcScaleGlobalBase.ZoneIDList.EQ(mysql.String("")).
+				AND(table.NpcScaleGlobalBase.InstanceVersionList.EQ(mysql.String(""))),
+		).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query npc_scale_global_base: %w", err)
+	}
+
+	st := make(ScaleTable, len(rows))
+	for _, r := range rows {
+		st[scaleKey{r.Type, r.Level}] = r
+	}
+	cache.GetCache().Set(cacheKey, st)
+	return st, nil
+}
+
+// ScaleNPCs fills in the missing stats of each NPC from npc_scale_global_base
+func ScaleNPCs(ctx context.Context, npcs ...*model.NpcTypes) error {
+	st, err := GetScaleTable(ctx)
+	if err != nil {
+		return err
+	}
+	for _, npc := range npcs {
+		st.Scale(npc)
+	}
+	return nil
+}
+
+// Scale fills in the NPC's AC, HP, damage and attack delay from the row for its scaling type and
+// level, where npc_types leaves them at zero. Stats set in npc_types are kept.
+func (st ScaleTable) Scale(npc *model.NpcTypes) {
+	if npc == nil {
+		return
+	}
+	base, ok := st[scaleKey{ScalingType(npc), int32(npc.Level)}]
+	if !ok {
+		return
+	}
+
+	if npc.Ac == 0 {
+		npc.Ac = int16(base.Ac)
+	}
+	if npc.Hp <= 0 {
+		npc.Hp = base.Hp
+	}
+	if npc.Mindmg == 0 && base.MinDmg > 0 {
+		npc.Mindmg = uint32(base.MinDmg)
+	}
+	if npc.Maxdmg == 0 && base.MaxDmg > 0 {
+		npc.Maxdmg = uint32(base.MaxDmg)
+	}
+	if npc.Mindmg > npc.Maxdmg {
+		npc.Mindmg = npc.Maxdmg
+	}
+	if npc.AttackDelay == 0 && base.AttackDelay > 0 {
+		npc.AttackDelay = uint8(base.AttackDelay)
+	}
+}
+
+// ScalingType picks the npc_scale_global_base type for an NPC the way EQEmu does:
+// raid targets, then rares and named NPCs (capitalized or #-prefixed names), then trash
+func ScalingType(npc *model.NpcTypes) int32 {
+	if npc.RaidTarget != 0 {
+		return ScaleTypeRaid
+	}
+	if npc.RareSpawn != nil && *npc.RareSpawn != 0 {
+		return ScaleTypeNamed
+	}
+	name := strings.TrimPrefix(npc.Name, "#")
+	if name != npc.Name || (name != "" && unicode.IsUpper(rune(name[0]))) {
+		return ScaleTypeNamed
+	}
+	return ScaleTypeTrash
+}
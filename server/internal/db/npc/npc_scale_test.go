@@ -0,0 +1,55 @@
+package npc
+
+import (
+	"testing"
+
+	"idlequest/internal/db/jetgen/eqgo/model"
+)
+
+func TestScalingType(t *testing.T) {
+	rare := int8(1)
+	tests := []struct {
+		npc  model.NpcTypes
+		want int32
+	}{
+		{model.NpcTypes{Name: "a_gnoll_pup"}, ScaleTypeTrash},
+		{model.NpcTypes{Name: "Fippy_Darkpaw"}, ScaleTypeNamed},
+		{model.NpcTypes{Name: "#a_gnoll_scout"}, ScaleTypeNamed},
+		{model.NpcTypes{Name: "a_gnoll_scout", RareSpawn: &rare}, ScaleTypeNamed},
+		{model.NpcTypes{Name: "Lord_Nagafen", RaidTarget: 1}, ScaleTypeRaid},
+	}
+	for _, tt := range tests {
+		if got := ScalingType(&tt.npc); got != tt.want {
+			t.Errorf("%s: expected type %d, got %d", tt.npc.Name, tt.want, got)
+		}
+	}
+}
+
+func TestScaleFillsOnlyMissingStats(t *testing.T) {
+	st := ScaleTable{
+		{ScaleTypeTrash, 10}: {Type: ScaleTypeTrash, Level: 10, Ac: 60, Hp: 300, MinDmg: 4, MaxDmg: 24, AttackDelay: 30},
+		{ScaleTypeNamed, 10}: {Type: ScaleTypeNamed, Level: 10, Ac: 80, Hp: 600, MinDmg: 6, MaxDmg: 30, AttackDelay: 28},
+	}
+
+	// A bare row takes every stat from its type and level
+	bare := &model.NpcTypes{Name: "a_skeleton", Level: 10}
+	st.Scale(bare)
+	if bare.Ac != 60 || bare.Hp != 300 || bare.Mindmg != 4 || bare.Maxdmg != 24 || bare.AttackDelay != 30 {
+		t.Errorf("Expected trash level 10 stats, got AC %d HP %d dmg %d-%d delay %d", bare.Ac, bare.Hp, bare.Mindmg, bare.Maxdmg, bare.AttackDelay)
+	}
+
+	// Stats set in npc_types are kept, and the filled min damage never exceeds the max
+	partial := &model.NpcTypes{Name: "Scrawny_Gnoll", Level: 10, Hp: 150, Maxdmg: 5, AttackDelay: 40}
+	st.Scale(partial)
+	if partial.Hp != 150 || partial.Ac != 80 || partial.Mindmg != 5 || partial.Maxdmg != 5 || partial.AttackDelay != 40 {
+		t.Errorf("Expected named stats filled around the set ones, got AC %d HP %d dmg %d-%d delay %d",
+			partial.Ac, partial.Hp, partial.Mindmg, partial.Maxdmg, partial.AttackDelay)
+	}
+
+	// No row for the level leaves the NPC alone
+	unknown := &model.NpcTypes{Name: "a_rat", Level: 70}
+	st.Scale(unknown)
+	if unknown.Hp != 0 || unknown.Ac != 0 {
+		t.Errorf("Expected no scaling without a row, got %+v", unknown)
+	}
+}
@@ -0,0 +1,256 @@
+package pet
+
+import (
+	"context"
+	"fmt"
+
+	"idlequest/internal/cache"
+	"idlequest/internal/constants"
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// Spell effect IDs (SPA) that summon a pet. The pets.type row is named by the spell's teleport_zone.
+const (
+	spaSummonPet    = 33
+	spaNecPet       = 71
+	spaSummonBSTPet = 106
+)
+
+// PetForCombat contains the pet data needed for combat
+type PetForCombat struct {
+	SpellID     int32
+	NpcID       int32
+	Level       uint8
+	HP          int64
+	AC          int16
+	MinDmg      uint32
+	MaxDmg      uint32
+	AttackDelay uint8
+}
+
+// spellClassColumn returns the spells_new classesN column holding the minimum level for a class
+func spellClassColumn(class uint8) mysql.ColumnInteger {
+	switch class {
+	case constants.Class_Warrior:
+		return table.SpellsNew.Classes1
+	case constants.Class_Cleric:
+		return table.SpellsNew.Classes2
+	case constants.Class_Paladin:
+		return table.SpellsNew.Classes3
+	case constants.Class_Ranger:
+		return table.SpellsNew.Classes4
+	case constants.Class_ShadowKnight:
+		return table.SpellsNew.Classes5
+	case constants.Class_Druid:
+		return table.SpellsNew.Classes6
+	case constants.Class_Monk:
+		return table.SpellsNew.Classes7
+	case constants.Class_Bard:
+		return table.SpellsNew.Classes8
+	case constants.Class_Rogue:
+		return table.SpellsNew.Classes9
+	case constants.Class_Shaman:
+		return table.SpellsNew.Classes10
+	case constants.Class_Necromancer:
+		return table.SpellsNew.Classes11
+	case constants.Class_Wizard:
+		return table.SpellsNew.Classes12
+	case constants.Class_Magician:
+		return table.SpellsNew.Classes13
+	case constants.Class_Enchanter:
+		return table.SpellsNew.Classes14
+	case constants.Class_Beastlord:
+		return table.SpellsNew.Classes15
+	case constants.Class_Berserker:
+		return table.SpellsNew.Classes16
+	}
+	return nil
+}
+
+// GetBestPetSpellID returns the highest level pet summoning spell a class can cast at the given level,
+// or 0 if the class has no pet spell yet
+func GetBestPetSpellID(ctx context.Context, class uint8, level int) (int32, error) {
+	classColumn := spellClassColumn(class)
+	if classColumn == nil {
+		return 0, nil
+	}
+
+	cacheKey := fmt.Sprintf("pet:spell:%d:%d", class, level)
+	if val, found, err := cache.GetCache().Get(cacheKey); err == nil && found {
+		if spellID, ok := val.(int32); ok {
+			return spellID, nil
+		}
+	}
+
+	var spells []model.SpellsNew
+	err := table.SpellsNew.
+		SELECT(table.SpellsNew.ID).
+		FROM(table.SpellsNew).
+		WHERE(
+			table.SpellsNew.Effectid1.IN(mysql.Int32(spaSummonPet), mysql.Int32(spaNecPet), mysql.Int32(spaSummonBSTPet)).
+				AND(classColumn.LT_EQ(mysql.Int32(int32(level)))).
+				AND(table.SpellsNew.TeleportZone.IS_NOT_NULL()),
+		).
+		ORDER_BY(classColumn.DESC(), table.SpellsNew.ID.DESC()).
+		LIMIT(1).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &spells)
+	if err != nil {
+		return 0, fmt.Errorf("query pet spell (class=%d, level=%d): %w", class, level, err)
+	}
+
+	var spellID int32
+	if len(spells) > 0 {
+		spellID = spells[0].ID
+	}
+	cache.GetCache().Set(cacheKey, spellID)
+	return spellID, nil
+}
+
+// GetPetForSpell builds the combat stats for the pet summoned by a spell: spells_new.teleport_zone -> pets.type -> npc_types
+func GetPetForSpell(ctx context.Context, spellID int32) (*PetForCombat, error) {
+	cacheKey := fmt.Sprintf("pet:for_spell:%d", spellID)
+	if val, found, err := cache.GetCache().Get(cacheKey); err == nil && found {
+		if pet, ok := val.(*PetForCombat); ok {
+			return pet, nil
+		}
+	}
+
+	var spells []model.SpellsNew
+	err := table.SpellsNew.
+		SELECT(table.SpellsNew.ID, table.SpellsNew.TeleportZone).
+		FROM(table.SpellsNew).
+		WHERE(table.SpellsNew.ID.EQ(mysql.Int32(spellID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &spells)
+	if err != nil {
+		return nil, fmt.Errorf("query pet spell %d: %w", spellID, err)
+	}
+	if len(spells) == 0 || spells[0].TeleportZone == nil || *spells[0].TeleportZone == "" {
+		return nil, fmt.Errorf("spell %d does not summon a pet", spellID)
+	}
+
+	// Several pets rows can share a type at different pet powers; use the base (lowest) one
+	var pets []model.Pets
+	err = table.Pets.
+		SELECT(table.Pets.ID, table.Pets.NpcID, table.Pets.Petpower).
+		FROM(table.Pets).
+		WHERE(table.Pets.Type.EQ(mysql.String(*spells[0].TeleportZone))).
+		ORDER_BY(table.Pets.Petpower.ASC()).
+		LIMIT(1).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &pets)
+	if err != nil {
+		return nil, fmt.Errorf("query pets type %s: %w", *spells[0].TeleportZone, err)
+	}
+	if len(pets) == 0 {
+		return nil, fmt.Errorf("no pets row for type %s", *spells[0].TeleportZone)
+	}
+
+	var npcs []model.NpcTypes
+	err = table.NpcTypes.
+		SELECT(
+			table.NpcTypes.ID,
+			table.NpcTypes.Level,
+			table.NpcTypes.Hp,
+			table.NpcTypes.Ac,
+			table.NpcTypes.Mindmg,
+			table.NpcTypes.Maxdmg,
+			table.NpcTypes.AttackDelay,
+		).
+		FROM(table.NpcTypes).
+		WHERE(table.NpcTypes.ID.EQ(mysql.Int32(pets[0].NpcID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &npcs)
+	if err != nil {
+		return nil, fmt.Errorf("query pet npc_types %d: %w", pets[0].NpcID, err)
+	}
+	if len(npcs) == 0 {
+		return nil, fmt.Errorf("pet npc_types %d not found", pets[0].NpcID)
+	}
+
+	npc := npcs[0]
+	pet := &PetForCombat{
+		SpellID:     spellID,
+		NpcID:       npc.ID,
+		Level:       npc.Level,
+		HP:          npc.Hp,
+		AC:          npc.Ac,
+		MinDmg:      npc.Mindmg,
+		MaxDmg:      npc.Maxdmg,
+		AttackDelay: npc.AttackDelay,
+	}
+	cache.GetCache().Set(cacheKey, pet)
+	return pet, nil
+}
+
+// GetCharacterPetInfo returns the saved pet for a character, or nil if they have none
+func GetCharacterPetInfo(ctx context.Context, charID int32) (*model.CharacterPetInfo, error) {
+	var infos []model.CharacterPetInfo
+	err := table.CharacterPetInfo.
+		SELECT(table.CharacterPetInfo.AllColumns).
+		FROM(table.CharacterPetInfo).
+		WHERE(
+			table.CharacterPetInfo.CharID.EQ(mysql.Int32(charID)).
+				AND(table.CharacterPetInfo.Pet.EQ(mysql.Int32(0))),
+		).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &infos)
+	if err != nil {
+		return nil, fmt.Errorf("query character_pet_info for char %d: %w", charID, err)
+	}
+	if len(infos) == 0 {
+		return nil, nil
+	}
+	return &infos[0], nil
+}
+
+// SaveCharacterPetInfo upserts a character's active pet (pet slot 0)
+func SaveCharacterPetInfo(ctx context.Context, info model.CharacterPetInfo) error {
+	_, err := table.CharacterPetInfo.
+		INSERT(
+			table.CharacterPetInfo.CharID,
+			table.CharacterPetInfo.Pet,
+			table.CharacterPetInfo.Petname,
+			table.CharacterPetInfo.Petpower,
+			table.CharacterPetInfo.SpellID,
+			table.CharacterPetInfo.Hp,
+			table.CharacterPetInfo.Mana,
+			table.CharacterPetInfo.Size,
+			table.CharacterPetInfo.Taunting,
+		).
+		VALUES(
+			info.CharID,
+			0,
+			info.Petname,
+			info.Petpower,
+			info.SpellID,
+			info.Hp,
+			info.Mana,
+			info.Size,
+			info.Taunting,
+		).
+		ON_DUPLICATE_KEY_UPDATE(
+			table.CharacterPetInfo.Petname.SET(mysql.String(info.Petname)),
+			table.CharacterPetInfo.Petpower.SET(mysql.Int32(info.Petpower)),
+			table.CharacterPetInfo.SpellID.SET(mysql.Int32(info.SpellID)),
+			table.CharacterPetInfo.Hp.SET(mysql.Int32(info.Hp)),
+			table.CharacterPetInfo.Mana.SET(mysql.Int32(info.Mana)),
+		).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("save character_pet_info for char %d: %w", info.CharID, err)
+	}
+	return nil
+}
+
+// DeleteCharacterPetInfo removes a character's saved pet (e.g. after it dies)
+func DeleteCharacterPetInfo(ctx context.Context, charID int32) error {
+	_, err := table.CharacterPetInfo.
+		DELETE().
+		WHERE(table.CharacterPetInfo.CharID.EQ(mysql.Int32(charID))).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("delete character_pet_info for char %d: %w", charID, err)
+	}
+	return nil
+}
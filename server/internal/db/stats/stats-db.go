@@ -0,0 +1,216 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// Totals are combat counters for a character, either running totals or a batch to add to them
+type Totals struct {
+	Kills       uint32
+	Deaths      uint32
+	DamageDealt uint64
+	DamageTaken uint64
+	HighestHit  uint32
+	ExpEarned   uint64
+	CoinEarned  uint64 // in copper
+}
+
+// NPCTotals are a character's combat counters against one NPC type
+type NPCTotals struct {
+	Totals
+	NpcName      string
+	LastKilledAt *time.Time
+}
+
+// LeaderboardEntry is one row of a combat stat leaderboard
+type LeaderboardEntry struct {
+	CharacterID uint32 `json:"characterId"`
+	Name        string `json:"name"`
+	Level       uint32 `json:"level"`
+	Class       uint8  `json:"class"`
+	Value       uint64 `json:"value"`
+}
+
+// leaderboardColumns are the stats a leaderboard can be ranked by, keyed by column name
+var leaderboardColumns = map[string]mysql.ColumnInteger{
+	"kills":        table.CharacterCombatStats.Kills,
+	"deaths":       table.CharacterCombatStats.Deaths,
+	"damage_dealt": table.CharacterCombatStats.DamageDealt,
+	"damage_taken": table.CharacterCombatStats.DamageTaken,
+	"highest_hit":  table.CharacterCombatStats.HighestHit,
+	"exp_earned":   table.CharacterCombatStats.ExpEarned,
+	"coin_earned":  table.CharacterCombatStats.CoinEarned,
+}
+
+// IsLeaderboardStat reports whether a leaderboard can be ranked by the named stat
+func IsLeaderboardStat(stat string) bool {
+	_, ok := leaderboardColumns[stat]
+	return ok
+}
+
+// AddCombatStats adds a batch of counters to a character's totals and per-NPC totals in one transaction.
+// Highest hit keeps the larger of the stored and batch values.
+func AddCombatStats(ctx context.Context, charID uint32, totals Totals, npcs map[int32]NPCTotals) error {
+	tx, err := db.GlobalWorldDB.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin combat stats transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	t := table.CharacterCombatStats
+	_, err = t.
+		INSERT(t.CharacterID, t.Kills, t.Deaths, t.DamageDealt, t.DamageTaken, t.HighestHit, t.ExpEarned, t.CoinEarned).
+		VALUES(charID, totals.Kills, totals.Deaths, totals.DamageDealt, totals.DamageTaken, totals.HighestHit, totals.ExpEarned, totals.CoinEarned).
+		ON_DUPLICATE_KEY_UPDATE(
+			t.Kills.SET(t.Kills.ADD(mysql.Uint32(totals.Kills))),
+			t.Deaths.SET(t.Deaths.ADD(mysql.Uint32(totals.Deaths))),
+			t.DamageDealt.SET(t.DamageDealt.ADD(mysql.Uint64(totals.DamageDealt))),
+			t.DamageTaken.SET(t.DamageTaken.ADD(mysql.Uint64(totals.DamageTaken))),
+			t.HighestHit.SET(mysql.IntExp(mysql.GREATEST(t.HighestHit, mysql.Uint32(totals.HighestHit)))),
+			t.ExpEarned.SET(t.ExpEarned.ADD(mysql.Uint64(totals.ExpEarned))),
+			t.CoinEarned.SET(t.CoinEarned.ADD(mysql.Uint64(totals.CoinEarned))),
+		).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("add character_combat_stats for char %d: %w", charID, err)
+	}
+
+	k := table.CharacterNpcKills
+	for npcID, npc := range npcs {
+		updates := []mysql.ColumnAssigment{
+			k.NpcName.SET(mysql.String(npc.NpcName)),
+			k.Kills.SET(k.Kills.ADD(mysql.Uint32(npc.Kills))),
+			k.Deaths.SET(k.Deaths.ADD(mysql.Uint32(npc.Deaths))),
+			k.DamageDealt.SET(k.DamageDealt.ADD(mysql.Uint64(npc.DamageDealt))),
+			k.DamageTaken.SET(k.DamageTaken.ADD(mysql.Uint64(npc.DamageTaken))),
+			k.ExpEarned.SET(k.ExpEarned.ADD(mysql.Uint64(npc.ExpEarned))),
+			k.CoinEarned.SET(k.CoinEarned.ADD(mysql.Uint64(npc.CoinEarned))),
+		}
+		if npc.LastKilledAt != nil {
+			updates = append(updates, k.LastKilledAt.SET(mysql.TimestampT(*npc.LastKilledAt)))
+		}
+		_, err = k.
+			INSERT(k.CharacterID, k.NpcTypeID, k.NpcName, k.Kills, k.Deaths, k.DamageDealt, k.DamageTaken, k.ExpEarned, k.CoinEarned, k.LastKilledAt).
+			VALUES(charID, npcID, npc.NpcName, npc.Kills, npc.Deaths, npc.DamageDealt, npc.DamageTaken, npc.ExpEarned, npc.CoinEarned, npc.LastKilledAt).
+			ON_DUPLICATE_KEY_UPDATE(updates...).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("add character_npc_kills for char %d npc %d: %w", charID, npcID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit combat stats for char %d: %w", charID, err)
+	}
+	return nil
+}
+
+// GetCharacterCombatStats returns a character's combat totals, or zero totals if they have never fought
+func GetCharacterCombatStats(ctx context.Context, charID uint32) (Totals, error) {
+	var rows []model.CharacterCombatStats
+	err := table.CharacterCombatStats.
+		SELECT(table.CharacterCombatStats.AllColumns).
+		FROM(table.CharacterCombatStats).
+		WHERE(table.CharacterCombatStats.CharacterID.EQ(mysql.Uint32(charID))).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return Totals{}, fmt.Errorf("query character_combat_stats for char %d: %w", charID, err)
+	}
+	if len(rows) == 0 {
+		return Totals{}, nil
+	}
+	r := rows[0]
+	return Totals{
+		Kills:       r.Kills,
+		Deaths:      r.Deaths,
+		DamageDealt: r.DamageDealt,
+		DamageTaken: r.DamageTaken,
+		HighestHit:  r.HighestHit,
+		ExpEarned:   r.ExpEarned,
+		CoinEarned:  r.CoinEarned,
+	}, nil
+}
+
+// GetCharacterNPCKills returns a character's kill history by NPC type, most killed first
+func GetCharacterNPCKills(ctx context.Context, charID uint32, limit int64) ([]model.CharacterNpcKills, error) {
+	var rows []model.CharacterNpcKills
+	err := table.CharacterNpcKills.
+		SELECT(table.CharacterNpcKills.AllColumns).
+		FROM(table.CharacterNpcKills).
+		WHERE(table.CharacterNpcKills.CharacterID.EQ(mysql.Uint32(charID))).
+		ORDER_BY(table.CharacterNpcKills.Kills.DESC(), table.CharacterNpcKills.LastKilledAt.DESC()).
+		LIMIT(limit).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query character_npc_kills for char %d: %w", charID, err)
+	}
+	return rows, nil
+}
+
+// GetLeaderboard returns the top characters ranked by a combat stat (see IsLeaderboardStat)
+func GetLeaderboard(ctx context.Context, stat string, limit int64) ([]LeaderboardEntry, error) {
+	column, ok := leaderboardColumns[stat]
+	if !ok {
+		return nil, fmt.Errorf("unknown leaderboard stat %q", stat)
+	}
+
+	var rows []struct {
+		model.CharacterCombatStats
+		model.CharacterData
+	}
+	err := table.CharacterCombatStats.
+		SELECT(
+			table.CharacterCombatStats.AllColumns,
+			table.CharacterData.ID,
+			table.CharacterData.Name,
+			table.CharacterData.Level,
+			table.CharacterData.Class,
+		).
+		FROM(table.CharacterCombatStats.
+			INNER_JOIN(table.CharacterData, table.CharacterData.ID.EQ(table.CharacterCombatStats.CharacterID)),
+		).
+		WHERE(table.CharacterData.DeletedAt.IS_NULL().AND(column.GT(mysql.Int(0)))).
+		ORDER_BY(column.DESC(), table.CharacterData.Name.ASC()).
+		LIMIT(limit).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query %s leaderboard: %w", stat, err)
+	}
+
+	entries := make([]LeaderboardEntry, 0, len(rows))
+	for _, r := range rows {
+		var value uint64
+		switch stat {
+		case "kills":
+			value = uint64(r.Kills)
+		case "deaths":
+			value = uint64(r.Deaths)
+		case "damage_dealt":
+			value = r.DamageDealt
+		case "damage_taken":
+			value = r.DamageTaken
+		case "highest_hit":
+			value = uint64(r.HighestHit)
+		case "exp_earned":
+			value = r.ExpEarned
+		case "coin_earned":
+			value = r.CoinEarned
+		}
+		entries = append(entries, LeaderboardEntry{
+			CharacterID: r.CharacterCombatStats.CharacterID,
+			Name:        r.Name,
+			Level:       r.Level,
+			Class:       r.Class,
+			Value:       value,
+		})
+	}
+	return entries, nil
+}
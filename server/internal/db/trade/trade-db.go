@@ -0,0 +1,173 @@
+package db_trade
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"idlequest/internal/cache"
+	"idlequest/internal/constants"
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/table"
+	"idlequest/internal/trade"
+
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/stmtcache"
+)
+
+// Exchange commits an accepted trade in one transaction: it moves every item and the coin each
+// side offered, and logs the trade to player_event_trade. If an item has left its slot or a
+// purse can't cover its offer, nothing changes hands.
+func Exchange(ctx context.Context, t *trade.Trade, transfers []trade.Transfer) error {
+	tx, err := db.GlobalWorldDB.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin trade transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Every item leaves its slot for a stash slot of its own before any is placed, so an item
+	// moving into the slot its new owner just gave up never meets the one leaving it
+	ci, ii := table.CharacterInventory, table.ItemInstances
+	stash := func(i int) int8 { return int8(-1 - i) }
+	for i, tr := range transfers {
+		res, err := ci.
+			UPDATE(ci.Slot).
+			SET(stash(i)).
+			WHERE(
+				ci.ItemInstanceID.EQ(mysql.Int32(tr.Item.ItemInstanceID)).
+					AND(ci.CharacterID.EQ(mysql.Int64(tr.FromCharID))).
+					AND(ci.Bag.EQ(mysql.Int8(tr.From.Bag))).
+					AND(ci.Slot.EQ(mysql.Int8(tr.From.Slot))),
+			).
+			ExecContext(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("stash item instance %d: %w", tr.Item.ItemInstanceID, err)
+		}
+		if n, _ := res.RowsAffected(); n != 1 {
+			return fmt.Errorf("%s %w.", tr.Item.Item.Name, trade.ErrItemMoved)
+		}
+	}
+	for i, tr := range transfers {
+		if _, err := ci.
+			UPDATE(ci.CharacterID, ci.Bag, ci.Slot).
+			SET(tr.ToCharID, tr.To.Bag, tr.To.Slot).
+			WHERE(
+				ci.ItemInstanceID.EQ(mysql.Int32(tr.Item.ItemInstanceID)).
+					AND(ci.CharacterID.EQ(mysql.Int64(tr.FromCharID))).
+					AND(ci.Bag.EQ(mysql.Int8(tr.From.Bag))).
+					AND(ci.Slot.EQ(mysql.Int8(stash(i)))),
+			).
+			ExecContext(ctx, tx); err != nil {
+			return fmt.Errorf("move item instance %d to character %d: %w", tr.Item.ItemInstanceID, tr.ToCharID, err)
+		}
+		if _, err := ii.
+			UPDATE(ii.OwnerID, ii.OwnerType).
+			SET(tr.ToCharID, constants.OwnerTypeCharacter).
+			WHERE(ii.ID.EQ(mysql.Int32(tr.Item.ItemInstanceID))).
+			ExecContext(ctx, tx); err != nil {
+			return fmt.Errorf("change owner of item instance %d: %w", tr.Item.ItemInstanceID, err)
+		}
+	}
+
+	for side, trader := range t.Traders {
+		coin := trader.Offer.Coin
+		if coin.IsZero() {
+			continue
+		}
+		if err := TakeCoin(ctx, tx, trader.CharID, coin); err != nil {
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("%s %w.", trader.Name, trade.ErrNotEnoughCoin)
+			}
+			return err
+		}
+		if err := GiveCoin(ctx, tx, t.Traders[1-side].CharID, coin); err != nil {
+			return err
+		}
+	}
+
+	if err := logTrade(ctx, tx, t, transfers); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit trade: %w", err)
+	}
+
+	for _, tr := range transfers {
+		cache.GetCache().Delete(fmt.Sprintf("iteminstance:guid:%d", tr.Item.ItemInstanceID))
+	}
+	return nil
+}
+
+// TakeCoin takes coin from a character's purse, returning sql.ErrNoRows if any denomination
+// falls short
+func TakeCoin(ctx context.Context, tx *stmtcache.Tx, charID int64, coin trade.Coin) error {
+	res, err := tx.ExecContext(ctx, `
+		UPDATE character_currency
+		SET platinum = platinum - ?, gold = gold - ?, silver = silver - ?, copper = copper - ?
+		WHERE id = ? AND platinum >= ? AND gold >= ? AND silver >= ? AND copper >= ?`,
+		coin.Platinum, coin.Gold, coin.Silver, coin.Copper,
+		charID, coin.Platinum, coin.Gold, coin.Silver, coin.Copper)
+	if err != nil {
+		return fmt.Errorf("take coin from char %d: %w", charID, err)
+	}
+	if n, _ := res.RowsAffected(); n != 1 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// GiveCoin adds coin to a character's purse, as AddCurrency does
+func GiveCoin(ctx context.Context, tx *stmtcache.Tx, charID int64, coin trade.Coin) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO character_currency (id, platinum, gold, silver, copper)
+		VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			platinum = platinum + VALUES(platinum),
+			gold = gold + VALUES(gold),
+			silver = silver + VALUES(silver),
+			copper = copper + VALUES(copper)`,
+		charID, coin.Platinum, coin.Gold, coin.Silver, coin.Copper)
+	if err != nil {
+		return fmt.Errorf("give coin to char %d: %w", charID, err)
+	}
+	return nil
+}
+
+// logTrade records the trade's coin in player_event_trade and each item that changed hands,
+// by its giver and the slot it left, in player_event_trade_entries
+func logTrade(ctx context.Context, tx *stmtcache.Tx, t *trade.Trade, transfers []trade.Transfer) error {
+	now := time.Now()
+	a, b := t.Traders[0], t.Traders[1]
+	pe := table.PlayerEventTrade
+	res, err := pe.
+		INSERT(pe.Char1ID, pe.Char2ID,
+			pe.Char1Platinum, pe.Char1Gold, pe.Char1Silver, pe.Char1Copper,
+			pe.Char2Platinum, pe.Char2Gold, pe.Char2Silver, pe.Char2Copper,
+			pe.CreatedAt).
+		VALUES(a.CharID, b.CharID,
+			a.Offer.Coin.Platinum, a.Offer.Coin.Gold, a.Offer.Coin.Silver, a.Offer.Coin.Copper,
+			b.Offer.Coin.Platinum, b.Offer.Coin.Gold, b.Offer.Coin.Silver, b.Offer.Coin.Copper,
+			now).
+		ExecContext(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("insert player_event_trade: %w", err)
+	}
+	if len(transfers) == 0 {
+		return nil
+	}
+	eventID, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("getting trade event id: %w", err)
+	}
+
+	e := table.PlayerEventTradeEntries
+	entries := e.INSERT(e.PlayerEventTradeID, e.CharID, e.Slot, e.ItemID, e.Charges, e.InBag, e.CreatedAt)
+	for _, tr := range transfers {
+		entries = entries.VALUES(eventID, tr.FromCharID, tr.From.Slot, tr.Item.Item.ID, tr.Item.Instance.Charges, tr.From.Bag, now)
+	}
+	if _, err := entries.ExecContext(ctx, tx); err != nil {
+		return fmt.Errorf("insert player_event_trade_entries for trade %d: %w", eventID, err)
+	}
+	return nil
+}
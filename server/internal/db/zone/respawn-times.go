@@ -0,0 +1,60 @@
+package db_zone
+
+import (
+	"context"
+	"fmt"
+
+	"idlequest/internal/db"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/db/jetgen/eqgo/table"
+
+	"github.com/go-jet/jet/v2/mysql"
+)
+
+// GetRespawnTimes returns the respawn_times rows (keyed by spawn2 ID) for the given spawn points.
+// A row means the spawn point's NPC was killed at start and repops duration seconds later.
+func GetRespawnTimes(ctx context.Context, spawn2IDs []int32) (map[int32]model.RespawnTimes, error) {
+	result := make(map[int32]model.RespawnTimes)
+	if len(spawn2IDs) == 0 {
+		return result, nil
+	}
+
+	ids := make([]mysql.Expression, len(spawn2IDs))
+	for i, id := range spawn2IDs {
+		ids[i] = mysql.Int32(id)
+	}
+
+	var rows []model.RespawnTimes
+	err := table.RespawnTimes.
+		SELECT(table.RespawnTimes.AllColumns).
+		FROM(table.RespawnTimes).
+		WHERE(
+			table.RespawnTimes.ID.IN(ids...).
+				AND(table.RespawnTimes.InstanceID.EQ(mysql.Int(0))),
+		).
+		QueryContext(ctx, db.GlobalWorldDB.DB, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("query respawn_times: %w", err)
+	}
+	for _, r := range rows {
+		result[r.ID] = r
+	}
+	return result, nil
+}
+
+// SaveRespawnTime records that a spawn point's NPC was killed at start (unix seconds)
+// and repops duration seconds later
+func SaveRespawnTime(ctx context.Context, spawn2ID int32, start int32, duration int32) error {
+	_, err := table.RespawnTimes.
+		INSERT(table.RespawnTimes.ID, table.RespawnTimes.Start, table.RespawnTimes.Duration, table.RespawnTimes.InstanceID).
+		VALUES(spawn2ID, start, duration, 0).
+		ON_DUPLICATE_KEY_UPDATE(
+			table.RespawnTimes.Start.SET(mysql.Int32(start)),
+			table.RespawnTimes.Duration.SET(mysql.Int32(duration)),
+		).
+		ExecContext(ctx, db.GlobalWorldDB.DB)
+	if err != nil {
+		return fmt.Errorf("save respawn_times for spawn2 %d: %w", spawn2ID, err)
+	}
+	return nil
+}
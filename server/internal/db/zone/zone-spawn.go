@@ -3,11 +3,13 @@ package db_zone
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"idlequest/internal/cache"
 	"idlequest/internal/db"
 	"idlequest/internal/db/jetgen/eqgo/model"
 	"idlequest/internal/db/jetgen/eqgo/table"
+	db_npc "idlequest/internal/db/npc"
 
 	"github.com/go-jet/jet/v2/mysql"
 	_ "github.com/go-sql-driver/mysql"
@@ -51,6 +53,7 @@ func GetZoneSpawnPool(zoneName string) (map[int64]*SpawnPoolEntry, error) {
 			table.Spawn2.Heading,
 			table.Spawn2.Pathgrid,
 			table.Spawn2.Respawntime,
+			table.Spawn2.Variance,
 			table.Spawngroup.ID.AS("spawngroup.id"),
 			table.Spawngroup.Name.AS("spawngroup.name"),
 			table.Spawnentry.SpawngroupID.AS("spawnentry.spawngroup_id"),
@@ -108,6 +111,17 @@ func GetZoneSpawnPool(zoneName string) (map[int64]*SpawnPoolEntry, error) {
 		}
 	}
 
+	// Fill in stats npc_types leaves at zero before anything reads the cached rows
+	var npcs []*model.NpcTypes
+	for _, entry := range spawnPool {
+		for _, se := range entry.SpawnEntries {
+			npcs = append(npcs, se.NPCType)
+		}
+	}
+	if err := db_npc.ScaleNPCs(ctx, npcs...); err != nil {
+		log.Printf("Failed to scale NPCs for zone %s: %v", zoneName, err)
+	}
+
 	if ok, err := cache.GetCache().Set(cacheKey, spawnPool); err != nil || !ok {
 		return nil, fmt.Errorf("cache set error: %w", err)
 	}
@@ -0,0 +1,126 @@
+package friends
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MaxFriends is the most friends a character can have
+const MaxFriends = 100
+
+// Errors returned to the character who tried the action, worded for the client
+var (
+	ErrFriendSelf  = errors.New("You cannot add yourself as a friend.")
+	ErrNotFriend   = errors.New("That player is not on your friends list.")
+	ErrFriendLimit = errors.New("Your friends list is full.")
+)
+
+// Lists tracks the friends of each character in the world. Lists are loaded from the friends
+// table as characters enter the world and dropped as they leave.
+type Lists struct {
+	mu      sync.Mutex
+	friends map[int64]map[string]string // Character ID -> lowercase name -> name
+	names   map[int64]string            // Character ID -> name, for those online
+}
+
+var globalLists = NewLists()
+
+// GetLists returns the global friends lists
+func GetLists() *Lists {
+	return globalLists
+}
+
+// NewLists creates friends lists with no one online
+func NewLists() *Lists {
+	return &Lists{
+		friends: make(map[int64]map[string]string),
+		names:   make(map[int64]string),
+	}
+}
+
+// Online records the character entering the world with their friends list. It returns false
+// if they were already online, so their friends are only told once.
+func (l *Lists) Online(charID int64, name string, friends []string) bool {
+	set := make(map[string]string, len(friends))
+	for _, f := range friends {
+		set[strings.ToLower(f)] = f
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, was := l.names[charID]
+	l.friends[charID] = set
+	l.names[charID] = name
+	return !was
+}
+
+// Offline drops the character's list as they leave the world. It returns false if they weren't
+// online, so their friends are only told once.
+func (l *Lists) Offline(charID int64) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, was := l.names[charID]
+	delete(l.friends, charID)
+	delete(l.names, charID)
+	return was
+}
+
+// Add puts a name on the character's friends list
+func (l *Lists) Add(charID int64, name string) error {
+	key := strings.ToLower(name)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if strings.EqualFold(l.names[charID], name) {
+		return ErrFriendSelf
+	}
+	if _, ok := l.friends[charID][key]; ok {
+		return nil
+	}
+	if len(l.friends[charID]) >= MaxFriends {
+		return ErrFriendLimit
+	}
+	if l.friends[charID] == nil {
+		l.friends[charID] = make(map[string]string)
+	}
+	l.friends[charID][key] = name
+	return nil
+}
+
+// Remove takes a name off the character's friends list
+func (l *Lists) Remove(charID int64, name string) error {
+	key := strings.ToLower(name)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.friends[charID][key]; !ok {
+		return ErrNotFriend
+	}
+	delete(l.friends[charID], key)
+	return nil
+}
+
+// Friends returns the names on the character's friends list, sorted
+func (l *Lists) Friends(charID int64) []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var names []string
+	for _, name := range l.friends[charID] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FriendedBy returns the characters online who have the named character as a friend
+func (l *Lists) FriendedBy(name string) []int64 {
+	key := strings.ToLower(name)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var ids []int64
+	for charID, friends := range l.friends {
+		if _, ok := friends[key]; ok {
+			ids = append(ids, charID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,63 @@
+package friends
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestLists(t *testing.T) {
+	l := NewLists()
+	if !l.Online(1, "Alice", []string{"Bob"}) {
+		t.Fatal("Expected the first login to count")
+	}
+	if l.Online(1, "Alice", []string{"Bob"}) {
+		t.Error("Expected a second login to be ignored")
+	}
+	if err := l.Add(1, "alice"); !errors.Is(err, ErrFriendSelf) {
+		t.Errorf("Expected ErrFriendSelf, got %v", err)
+	}
+	if err := l.Add(1, "Carol"); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if got := l.Friends(1); !slices.Equal(got, []string{"Bob", "Carol"}) {
+		t.Errorf("Friends = %v", got)
+	}
+
+	l.Online(2, "Dave", []string{"carol"})
+	got := l.FriendedBy("CAROL")
+	slices.Sort(got)
+	if !slices.Equal(got, []int64{1, 2}) {
+		t.Errorf("FriendedBy = %v", got)
+	}
+
+	if err := l.Remove(1, "bob"); err != nil {
+		t.Errorf("Remove failed: %v", err)
+	}
+	if err := l.Remove(1, "Bob"); !errors.Is(err, ErrNotFriend) {
+		t.Errorf("Expected ErrNotFriend, got %v", err)
+	}
+
+	if !l.Offline(1) {
+		t.Error("Expected the first logout to count")
+	}
+	if l.Offline(1) {
+		t.Error("Expected a second logout to be ignored")
+	}
+	if got := l.FriendedBy("Carol"); !slices.Equal(got, []int64{2}) {
+		t.Errorf("Expected only Dave once Alice logs out, got %v", got)
+	}
+}
+
+func TestFriendLimit(t *testing.T) {
+	l := NewLists()
+	l.Online(1, "Alice", nil)
+	for i := 0; i < MaxFriends; i++ {
+		if err := l.Add(1, string(rune('A'+i/26))+string(rune('a'+i%26))); err != nil {
+			t.Fatalf("Add %d failed: %v", i, err)
+		}
+	}
+	if err := l.Add(1, "Overflow"); !errors.Is(err, ErrFriendLimit) {
+		t.Errorf("Expected ErrFriendLimit, got %v", err)
+	}
+}
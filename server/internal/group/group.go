@@ -0,0 +1,324 @@
+package group
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"idlequest/internal/session"
+)
+
+// MaxMembers is the most characters a group can hold
+const MaxMembers = 6
+
+// inviteTimeout is how long an invitation can be accepted for
+const inviteTimeout = 2 * time.Minute
+
+// Loot modes, set by the group leader
+const (
+	LootRoundRobin = "round_robin"  // Each drop goes to the next member in turn
+	LootFreeForAll = "free_for_all" // Each drop goes to a random member
+)
+
+// Errors returned to the character who tried the action, worded for the client
+var (
+	ErrInviteSelf     = errors.New("You cannot invite yourself.")
+	ErrAlreadyGrouped = errors.New("That player is already in a group.")
+	ErrInvitePending  = errors.New("That player is considering another invitation.")
+	ErrGroupFull      = errors.New("The group is full.")
+	ErrNotLeader      = errors.New("Only the group leader can do that.")
+	ErrNoInvite       = errors.New("You have not been invited to a group.")
+	ErrNotGrouped     = errors.New("You are not in a group.")
+	ErrNotMember      = errors.New("That player is not in your group.")
+	ErrBadLootMode    = errors.New("Unknown loot mode.")
+)
+
+// Member is one character in a group, with the session it's playing on
+type Member struct {
+	CharID  int64
+	Name    string
+	Session *session.Session
+}
+
+// Group is a snapshot of a group. Changes go through the Manager; a snapshot doesn't see them.
+type Group struct {
+	ID       int64
+	Leader   int64    // Character ID of the leader
+	Members  []Member // In the order they joined, the leader first when the group formed
+	LootMode string
+
+	lootNext int // Round robin: index in Members of the next member in line for a drop
+}
+
+// LeaderName returns the leader's name
+func (g *Group) LeaderName() string {
+	if m, ok := g.Member(g.Leader); ok {
+		return m.Name
+	}
+	return ""
+}
+
+// Member returns the member with the character ID
+func (g *Group) Member(charID int64) (Member, bool) {
+	for _, m := range g.Members {
+		if m.CharID == charID {
+			return m, true
+		}
+	}
+	return Member{}, false
+}
+
+// memberByName returns the member with the name, ignoring case
+func (g *Group) memberByName(name string) (Member, bool) {
+	for _, m := range g.Members {
+		if strings.EqualFold(m.Name, name) {
+			return m, true
+		}
+	}
+	return Member{}, false
+}
+
+func (g *Group) clone() *Group {
+	c := *g
+	c.Members = append([]Member(nil), g.Members...)
+	return &c
+}
+
+// invite is an invitation waiting for the invitee to accept or decline
+type invite struct {
+	inviter Member
+	expires time.Time
+}
+
+// Manager tracks every group and raid and their pending invitations. Groups and raids live only
+// in memory: they break up when the server restarts, as they did in the original game when a
+// zone went down.
+type Manager struct {
+	mu      sync.Mutex
+	nextID  int64            // Shared by groups and raids
+	groups  map[int64]*Group // keyed by group ID
+	byChar  map[int64]*Group // keyed by member character ID
+	invites map[int64]invite // keyed by invitee character ID
+
+	raids       map[int64]*Raid  // keyed by raid ID
+	raidByChar  map[int64]*Raid  // keyed by member character ID
+	raidInvites map[int64]invite // keyed by invitee character ID
+}
+
+var globalManager *Manager
+var managerOnce sync.Once
+
+// GetManager returns the global group manager singleton
+func GetManager() *Manager {
+	managerOnce.Do(func() {
+		globalManager = NewManager()
+	})
+	return globalManager
+}
+
+// NewManager creates an empty group manager
+func NewManager() *Manager {
+	return &Manager{
+		groups:  make(map[int64]*Group),
+		byChar:  make(map[int64]*Group),
+		invites: make(map[int64]invite),
+
+		raids:       make(map[int64]*Raid),
+		raidByChar:  make(map[int64]*Raid),
+		raidInvites: make(map[int64]invite),
+	}
+}
+
+// Get returns a snapshot of the character's group, or nil if they aren't in one
+func (m *Manager) Get(charID int64) *Group {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if g, ok := m.byChar[charID]; ok {
+		return g.clone()
+	}
+	return nil
+}
+
+// Invite invites a character to the inviter's group, or to form one with the inviter.
+// Only the leader can invite into an existing group.
+func (m *Manager) Invite(inviter, invitee Member, now time.Time) error {
+	if inviter.CharID == invitee.CharID {
+		return ErrInviteSelf
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.byChar[invitee.CharID]; ok {
+		return ErrAlreadyGrouped
+	}
+	if g, ok := m.byChar[inviter.CharID]; ok {
+		if g.Leader != inviter.CharID {
+			return ErrNotLeader
+		}
+		if len(g.Members) >= MaxMembers {
+			return ErrGroupFull
+		}
+	}
+	if pending, ok := m.invites[invitee.CharID]; ok && now.Before(pending.expires) && pending.inviter.CharID != inviter.CharID {
+		return ErrInvitePending
+	}
+
+	m.invites[invitee.CharID] = invite{inviter: inviter, expires: now.Add(inviteTimeout)}
+	return nil
+}
+
+// Accept joins the invitee to the group they were invited to, forming it if the inviter wasn't
+// grouped yet. inviterName, if set, must match the invitation. Returns a snapshot of the group.
+func (m *Manager) Accept(invitee Member, inviterName string, now time.Time) (*Group, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pending, ok := m.invites[invitee.CharID]
+	if !ok || !now.Before(pending.expires) || (inviterName != "" && !strings.EqualFold(inviterName, pending.inviter.Name)) {
+		return nil, ErrNoInvite
+	}
+	delete(m.invites, invitee.CharID)
+	if _, ok := m.byChar[invitee.CharID]; ok {
+		return nil, ErrAlreadyGrouped
+	}
+
+	g, ok := m.byChar[pending.inviter.CharID]
+	if !ok {
+		m.nextID++
+		g = &Group{
+			ID:       m.nextID,
+			Leader:   pending.inviter.CharID,
+			Members:  []Member{pending.inviter},
+			LootMode: LootRoundRobin,
+		}
+		m.groups[g.ID] = g
+		m.byChar[pending.inviter.CharID] = g
+		log.Printf("Group %d formed by character %d", g.ID, g.Leader)
+	}
+	if len(g.Members) >= MaxMembers {
+		return nil, ErrGroupFull
+	}
+
+	g.Members = append(g.Members, invitee)
+	m.byChar[invitee.CharID] = g
+	log.Printf("Character %d joined group %d", invitee.CharID, g.ID)
+	return g.clone(), nil
+}
+
+// Decline turns down the character's invitation, returning who sent it
+func (m *Manager) Decline(charID int64) (Member, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pending, ok := m.invites[charID]
+	delete(m.invites, charID)
+	return pending.inviter, ok
+}
+
+// Leave takes the character out of their group and drops any invitation they had. Leadership
+// passes to the longest-standing member, and a group left with one member disbands.
+// Returns a snapshot of the group as it was left, nil if the character wasn't grouped.
+func (m *Manager) Leave(charID int64) *Group {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.invites, charID)
+	g, ok := m.byChar[charID]
+	if !ok {
+		return nil
+	}
+	m.removeMember(g, charID)
+	return g.clone()
+}
+
+// Kick removes a member from the leader's group. Returns a snapshot of the group and the member
+// removed.
+func (m *Manager) Kick(leaderID int64, name string) (*Group, Member, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	g, ok := m.byChar[leaderID]
+	if !ok {
+		return nil, Member{}, ErrNotGrouped
+	}
+	if g.Leader != leaderID {
+		return nil, Member{}, ErrNotLeader
+	}
+	member, ok := g.memberByName(name)
+	if !ok {
+		return nil, Member{}, ErrNotMember
+	}
+	m.removeMember(g, member.CharID)
+	return g.clone(), member, nil
+}
+
+// removeMember takes the character out of the group, handing off leadership and disbanding a
+// group of one. The caller holds m.mu.
+func (m *Manager) removeMember(g *Group, charID int64) {
+	for i, member := range g.Members {
+		if member.CharID != charID {
+			continue
+		}
+		g.Members = append(g.Members[:i], g.Members[i+1:]...)
+		if i < g.lootNext {
+			g.lootNext--
+		}
+		break
+	}
+	delete(m.byChar, charID)
+	log.Printf("Character %d left group %d", charID, g.ID)
+
+	if len(g.Members) < 2 {
+		for _, member := range g.Members {
+			delete(m.byChar, member.CharID)
+		}
+		delete(m.groups, g.ID)
+		log.Printf("Group %d disbanded", g.ID)
+		return
+	}
+	if g.Leader == charID {
+		g.Leader = g.Members[0].CharID
+	}
+}
+
+// SetLootMode changes how the leader's group shares out drops. Returns a snapshot of the group.
+func (m *Manager) SetLootMode(leaderID int64, mode string) (*Group, error) {
+	if mode != LootRoundRobin && mode != LootFreeForAll {
+		return nil, ErrBadLootMode
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	g, ok := m.byChar[leaderID]
+	if !ok {
+		return nil, ErrNotGrouped
+	}
+	if g.Leader != leaderID {
+		return nil, ErrNotLeader
+	}
+	g.LootMode = mode
+	return g.clone(), nil
+}
+
+// NextLooter returns the next member in the group's round robin among those eligible for a
+// drop, moving the group's turn on past them. The turn carries over from fight to fight.
+// Falls back to the first eligible member once the group has broken up.
+func (m *Manager) NextLooter(groupID int64, eligible []int64) int64 {
+	if len(eligible) == 0 {
+		return 0
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	g, ok := m.groups[groupID]
+	if !ok {
+		return eligible[0]
+	}
+	for i := range g.Members {
+		idx := (g.lootNext + i) % len(g.Members)
+		for _, charID := range eligible {
+			if g.Members[idx].CharID == charID {
+				g.lootNext = (idx + 1) % len(g.Members)
+				return charID
+			}
+		}
+	}
+	return eligible[0]
+}
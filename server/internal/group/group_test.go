@@ -0,0 +1,269 @@
+package group
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestInviteAndAccept(t *testing.T) {
+	m := NewManager()
+	now := time.Now()
+	leader := Member{CharID: 1, Name: "Leader"}
+	member := func(id int64, name string) Member { return Member{CharID: id, Name: name} }
+
+	if err := m.Invite(leader, leader, now); !errors.Is(err, ErrInviteSelf) {
+		t.Errorf("Expected ErrInviteSelf, got %v", err)
+	}
+	if _, err := m.Accept(member(2, "Second"), "", now); !errors.Is(err, ErrNoInvite) {
+		t.Errorf("Expected ErrNoInvite without an invitation, got %v", err)
+	}
+
+	// Accepting the first invitation forms the group with the inviter as leader
+	if err := m.Invite(leader, member(2, "Second"), now); err != nil {
+		t.Fatalf("Invite failed: %v", err)
+	}
+	if err := m.Invite(Member{CharID: 9, Name: "Other"}, member(2, "Second"), now); !errors.Is(err, ErrInvitePending) {
+		t.Errorf("Expected ErrInvitePending for a second inviter, got %v", err)
+	}
+	if _, err := m.Accept(member(2, "Second"), "Other", now); !errors.Is(err, ErrNoInvite) {
+		t.Errorf("Expected ErrNoInvite when accepting the wrong inviter, got %v", err)
+	}
+	if err := m.Invite(leader, member(2, "Second"), now); err != nil {
+		t.Fatalf("Invite failed: %v", err)
+	}
+	g, err := m.Accept(member(2, "Second"), "leader", now)
+	if err != nil {
+		t.Fatalf("Accept failed: %v", err)
+	}
+	if g.Leader != 1 || len(g.Members) != 2 || g.LootMode != LootRoundRobin || g.LeaderName() != "Leader" {
+		t.Errorf("Expected a new group led by Leader, got %+v", g)
+	}
+
+	// Only the leader invites, and invitations expire
+	if err := m.Invite(member(2, "Second"), member(3, "Third"), now); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("Expected ErrNotLeader, got %v", err)
+	}
+	if err := m.Invite(leader, member(3, "Third"), now); err != nil {
+		t.Fatalf("Invite failed: %v", err)
+	}
+	if _, err := m.Accept(member(3, "Third"), "", now.Add(inviteTimeout)); !errors.Is(err, ErrNoInvite) {
+		t.Errorf("Expected an expired invitation to fail, got %v", err)
+	}
+
+	// The group fills up at MaxMembers
+	for id := int64(3); id <= MaxMembers; id++ {
+		if err := m.Invite(leader, member(id, "Member"), now); err != nil {
+			t.Fatalf("Invite %d failed: %v", id, err)
+		}
+		if _, err := m.Accept(member(id, "Member"), "", now); err != nil {
+			t.Fatalf("Accept %d failed: %v", id, err)
+		}
+	}
+	if err := m.Invite(leader, member(99, "Extra"), now); !errors.Is(err, ErrGroupFull) {
+		t.Errorf("Expected ErrGroupFull, got %v", err)
+	}
+	if err := m.Invite(leader, member(2, "Second"), now); !errors.Is(err, ErrAlreadyGrouped) {
+		t.Errorf("Expected ErrAlreadyGrouped, got %v", err)
+	}
+}
+
+func TestLeaveKickAndDisband(t *testing.T) {
+	m := NewManager()
+	now := time.Now()
+	members := []Member{{CharID: 1, Name: "One"}, {CharID: 2, Name: "Two"}, {CharID: 3, Name: "Three"}}
+	for _, invitee := range members[1:] {
+		if err := m.Invite(members[0], invitee, now); err != nil {
+			t.Fatalf("Invite failed: %v", err)
+		}
+		if _, err := m.Accept(invitee, "", now); err != nil {
+			t.Fatalf("Accept failed: %v", err)
+		}
+	}
+
+	// Leadership passes on when the leader leaves
+	g := m.Leave(1)
+	if g == nil || g.Leader != 2 || len(g.Members) != 2 || m.Get(1) != nil {
+		t.Fatalf("Expected Two to lead the rest, got %+v", g)
+	}
+	if _, _, err := m.Kick(3, "Two"); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("Expected ErrNotLeader, got %v", err)
+	}
+	if _, _, err := m.Kick(2, "One"); !errors.Is(err, ErrNotMember) {
+		t.Errorf("Expected ErrNotMember, got %v", err)
+	}
+
+	// A group of one disbands
+	g, kicked, err := m.Kick(2, "three")
+	if err != nil || kicked.CharID != 3 || len(g.Members) != 1 {
+		t.Fatalf("Expected Three kicked leaving one member, got %+v %+v %v", g, kicked, err)
+	}
+	if m.Get(2) != nil || m.Get(3) != nil || len(m.groups) != 0 {
+		t.Error("Expected the group to disband")
+	}
+}
+
+func TestLootRotation(t *testing.T) {
+	m := NewManager()
+	now := time.Now()
+	leader := Member{CharID: 1, Name: "One"}
+	for _, invitee := range []Member{{CharID: 2, Name: "Two"}, {CharID: 3, Name: "Three"}} {
+		_ = m.Invite(leader, invitee, now)
+		if _, err := m.Accept(invitee, "", now); err != nil {
+			t.Fatalf("Accept failed: %v", err)
+		}
+	}
+	g := m.Get(1)
+
+	if _, err := m.SetLootMode(2, LootFreeForAll); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("Expected ErrNotLeader, got %v", err)
+	}
+	if _, err := m.SetLootMode(1, "need_before_greed"); !errors.Is(err, ErrBadLootMode) {
+		t.Errorf("Expected ErrBadLootMode, got %v", err)
+	}
+	if g, err := m.SetLootMode(1, LootFreeForAll); err != nil || g.LootMode != LootFreeForAll {
+		t.Errorf("Expected free-for-all, got %+v %v", g, err)
+	}
+
+	// The turn goes round the group, skipping members not in on the kill, and carries over
+	var got []int64
+	for range 4 {
+		got = append(got, m.NextLooter(g.ID, []int64{1, 2, 3}))
+	}
+	got = append(got, m.NextLooter(g.ID, []int64{1, 3}))
+	got = append(got, m.NextLooter(g.ID, []int64{1, 3}))
+	want := []int64{1, 2, 3, 1, 3, 1}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected looters %v, got %v", want, got)
+		}
+	}
+}
+
+func TestRaidFormAndLead(t *testing.T) {
+	m := NewManager()
+	now := time.Now()
+	member := func(id int64, name string) Member { return Member{CharID: id, Name: name} }
+	formGroup := func(leader Member, members ...Member) {
+		for _, invitee := range members {
+			_ = m.Invite(leader, invitee, now)
+			if _, err := m.Accept(invitee, "", now); err != nil {
+				t.Fatalf("Accept failed: %v", err)
+			}
+		}
+	}
+	formGroup(member(1, "One"), member(2, "Two"))
+	formGroup(member(3, "Three"), member(4, "Four"), member(5, "Five"))
+
+	// Only group leaders and ungrouped characters can be invited
+	if err := m.RaidInvite(member(1, "One"), member(4, "Four"), now); !errors.Is(err, ErrNotGroupLeader) {
+		t.Errorf("Expected ErrNotGroupLeader, got %v", err)
+	}
+
+	// A group leader accepting brings their whole group, joining the inviter's group
+	if err := m.RaidInvite(member(1, "One"), member(3, "Three"), now); err != nil {
+		t.Fatalf("RaidInvite failed: %v", err)
+	}
+	r, err := m.RaidAccept(member(3, "Three"), "one", now)
+	if err != nil {
+		t.Fatalf("RaidAccept failed: %v", err)
+	}
+	if r.Leader != 1 || len(r.Members) != 5 || r.LootMode != RaidLootLeader || r.LeaderName() != "One" {
+		t.Fatalf("Expected a raid of both groups led by One, got %+v", r)
+	}
+	if four, _ := r.Member(4); four.GroupID != m.Get(3).ID {
+		t.Errorf("Expected Four to raid with Three's group, got %+v", four)
+	}
+
+	// Ungrouped characters join alone, and only the raid leader invites
+	if err := m.RaidInvite(member(2, "Two"), member(6, "Six"), now); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("Expected ErrNotLeader, got %v", err)
+	}
+	_ = m.RaidInvite(member(1, "One"), member(6, "Six"), now)
+	if r, err = m.RaidAccept(member(6, "Six"), "", now); err != nil || len(r.Members) != 6 {
+		t.Fatalf("Expected Six to join alone, got %+v %v", r, err)
+	}
+	if six, _ := r.Member(6); six.GroupID != 0 {
+		t.Errorf("Expected Six without a group, got %+v", six)
+	}
+
+	// Leader controls
+	if _, err := m.SetRaidLootMode(2, RaidLootRaid); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("Expected ErrNotLeader, got %v", err)
+	}
+	if r, err = m.ToggleRaidLooter(1, "four"); err != nil {
+		t.Fatalf("ToggleRaidLooter failed: %v", err)
+	}
+	if four, _ := r.Member(4); !four.Looter {
+		t.Error("Expected Four to be a looter")
+	}
+	if _, _, err := m.RaidKick(1, "Nobody"); !errors.Is(err, ErrNotRaidMember) {
+		t.Errorf("Expected ErrNotRaidMember, got %v", err)
+	}
+	if r, kicked, err := m.RaidKick(1, "Six"); err != nil || kicked.CharID != 6 || len(r.Members) != 5 || m.GetRaid(6) != nil {
+		t.Errorf("Expected Six kicked, got %+v %+v %v", r, kicked, err)
+	}
+	if r, err = m.SetRaidLeader(1, "Three"); err != nil || r.Leader != 3 {
+		t.Fatalf("Expected Three to lead, got %+v %v", r, err)
+	}
+
+	// Leadership passes on when the leader leaves, and disbanding clears everyone
+	if r = m.RaidLeave(3); r == nil || r.Leader != 1 {
+		t.Fatalf("Expected One to lead again, got %+v", r)
+	}
+	if _, err := m.RaidDisband(2); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("Expected ErrNotLeader, got %v", err)
+	}
+	if _, err := m.RaidDisband(1); err != nil {
+		t.Fatalf("RaidDisband failed: %v", err)
+	}
+	if m.GetRaid(1) != nil || m.GetRaid(4) != nil || len(m.raids) != 0 {
+		t.Error("Expected the raid to disband")
+	}
+	if m.Get(3) == nil {
+		t.Error("Expected groups to outlast their raid")
+	}
+}
+
+func TestRaidLoot(t *testing.T) {
+	m := NewManager()
+	now := time.Now()
+	leader := Member{CharID: 1, Name: "One"}
+	for _, invitee := range []Member{{CharID: 2, Name: "Two"}, {CharID: 3, Name: "Three"}, {CharID: 4, Name: "Four"}} {
+		_ = m.RaidInvite(leader, invitee, now)
+		if _, err := m.RaidAccept(invitee, "", now); err != nil {
+			t.Fatalf("RaidAccept failed: %v", err)
+		}
+	}
+	r := m.GetRaid(1)
+	all := []int64{1, 2, 3, 4}
+	looters := func(n int, eligible []int64) []int64 {
+		var got []int64
+		for range n {
+			got = append(got, m.NextRaidLooter(r.ID, eligible))
+		}
+		return got
+	}
+
+	// Leader mode gives everything to the leader, or goes round the rest without them
+	if got := looters(2, all); !slices.Equal(got, []int64{1, 1}) {
+		t.Errorf("Expected the leader to take every drop, got %v", got)
+	}
+	if got := looters(2, []int64{2, 3}); !slices.Equal(got, []int64{2, 3}) {
+		t.Errorf("Expected the drops to go round without the leader, got %v", got)
+	}
+
+	// Looters mode goes round the leader and the picked looters
+	_, _ = m.SetRaidLootMode(1, RaidLootLooters)
+	_, _ = m.ToggleRaidLooter(1, "Three")
+	if got := looters(3, all); !slices.Equal(got, []int64{1, 3, 1}) {
+		t.Errorf("Expected drops to alternate between One and Three, got %v", got)
+	}
+
+	// Raid mode goes round everyone
+	_, _ = m.SetRaidLootMode(1, RaidLootRaid)
+	if got := looters(4, all); !slices.Equal(got, []int64{2, 3, 4, 1}) {
+		t.Errorf("Expected drops to go round the raid, got %v", got)
+	}
+}
@@ -0,0 +1,364 @@
+package group
+
+import (
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxRaidMembers is the most characters a raid can hold: twelve full groups
+const MaxRaidMembers = 12 * MaxMembers
+
+// Raid loot modes, set by the raid leader
+const (
+	RaidLootLeader  = "leader"  // Every drop goes to the raid leader
+	RaidLootLooters = "looters" // Drops go round the leader and the looters they picked
+	RaidLootRaid    = "raid"    // Drops go round the whole raid
+)
+
+// Raid errors, worded for the client like the group ones
+var (
+	ErrAlreadyInRaid  = errors.New("That player is already in a raid.")
+	ErrNotGroupLeader = errors.New("Only group leaders and players without a group can be invited to a raid.")
+	ErrRaidFull       = errors.New("The raid is full.")
+	ErrNotInRaid      = errors.New("You are not in a raid.")
+	ErrNotRaidMember  = errors.New("That player is not in your raid.")
+)
+
+// RaidMember is one character in a raid
+type RaidMember struct {
+	Member
+	GroupID int64 // Group the character raids with, 0 if they joined without one
+	Looter  bool  // Picked by the leader to share drops in the looters loot mode
+}
+
+// Raid is a snapshot of a raid: several groups fighting together under one leader.
+// Changes go through the Manager; a snapshot doesn't see them.
+type Raid struct {
+	ID       int64
+	Leader   int64        // Character ID of the leader
+	Members  []RaidMember // In the order they joined, a group's members together
+	LootMode string
+
+	lootNext int // Index in Members of the next member in line for a drop
+}
+
+// LeaderName returns the leader's name
+func (r *Raid) LeaderName() string {
+	if m, ok := r.Member(r.Leader); ok {
+		return m.Name
+	}
+	return ""
+}
+
+// Member returns the member with the character ID
+func (r *Raid) Member(charID int64) (RaidMember, bool) {
+	for _, m := range r.Members {
+		if m.CharID == charID {
+			return m, true
+		}
+	}
+	return RaidMember{}, false
+}
+
+func (r *Raid) memberIndex(name string) int {
+	return slices.IndexFunc(r.Members, func(m RaidMember) bool { return strings.EqualFold(m.Name, name) })
+}
+
+func (r *Raid) clone() *Raid {
+	c := *r
+	c.Members = append([]RaidMember(nil), r.Members...)
+	return &c
+}
+
+// GetRaid returns a snapshot of the character's raid, or nil if they aren't in one
+func (m *Manager) GetRaid(charID int64) *Raid {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if r, ok := m.raidByChar[charID]; ok {
+		return r.clone()
+	}
+	return nil
+}
+
+// RaidInvite invites a group leader, with their group, or a character without a group to the
+// inviter's raid, or to form one with the inviter. Only the raid leader can invite into a raid.
+func (m *Manager) RaidInvite(inviter, invitee Member, now time.Time) error {
+	if inviter.CharID == invitee.CharID {
+		return ErrInviteSelf
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.raidByChar[invitee.CharID]; ok {
+		return ErrAlreadyInRaid
+	}
+	if g, ok := m.byChar[invitee.CharID]; ok && g.Leader != invitee.CharID {
+		return ErrNotGroupLeader
+	}
+	if r, ok := m.raidByChar[inviter.CharID]; ok {
+		if r.Leader != inviter.CharID {
+			return ErrNotLeader
+		}
+		if len(r.Members) >= MaxRaidMembers {
+			return ErrRaidFull
+		}
+	}
+	if pending, ok := m.raidInvites[invitee.CharID]; ok && now.Before(pending.expires) && pending.inviter.CharID != inviter.CharID {
+		return ErrInvitePending
+	}
+
+	m.raidInvites[invitee.CharID] = invite{inviter: inviter, expires: now.Add(inviteTimeout)}
+	return nil
+}
+
+// RaidAccept joins the invitee, and the rest of their group if they lead one, to the raid they
+// were invited to, forming it with the inviter's group if the inviter wasn't raiding yet.
+// inviterName, if set, must match the invitation. Returns a snapshot of the raid.
+func (m *Manager) RaidAccept(invitee Member, inviterName string, now time.Time) (*Raid, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pending, ok := m.raidInvites[invitee.CharID]
+	if !ok || !now.Before(pending.expires) || (inviterName != "" && !strings.EqualFold(inviterName, pending.inviter.Name)) {
+		return nil, ErrNoInvite
+	}
+	delete(m.raidInvites, invitee.CharID)
+	if _, ok := m.raidByChar[invitee.CharID]; ok {
+		return nil, ErrAlreadyInRaid
+	}
+
+	joining := m.raidJoiners(invitee)
+	r, ok := m.raidByChar[pending.inviter.CharID]
+	if !ok {
+		founders := m.raidJoiners(pending.inviter)
+		if len(founders)+len(joining) > MaxRaidMembers {
+			return nil, ErrRaidFull
+		}
+		m.nextID++
+		r = &Raid{ID: m.nextID, Leader: pending.inviter.CharID, LootMode: RaidLootLeader}
+		m.raids[r.ID] = r
+		m.addRaidMembers(r, founders)
+		log.Printf("Raid %d formed by character %d", r.ID, r.Leader)
+	}
+	if len(r.Members)+len(joining) > MaxRaidMembers {
+		return nil, ErrRaidFull
+	}
+	m.addRaidMembers(r, joining)
+	log.Printf("Character %d joined raid %d with %d members", invitee.CharID, r.ID, len(joining))
+	return r.clone(), nil
+}
+
+// raidJoiners returns the character and, if they lead a group, the rest of it, skipping anyone
+// already raiding. The caller holds m.mu.
+func (m *Manager) raidJoiners(leader Member) []RaidMember {
+	g, ok := m.byChar[leader.CharID]
+	if !ok {
+		return []RaidMember{{Member: leader}}
+	}
+	var joiners []RaidMember
+	for _, member := range g.Members {
+		if _, raiding := m.raidByChar[member.CharID]; !raiding {
+			joiners = append(joiners, RaidMember{Member: member, GroupID: g.ID})
+		}
+	}
+	return joiners
+}
+
+// addRaidMembers adds the members to the raid. The caller holds m.mu.
+func (m *Manager) addRaidMembers(r *Raid, members []RaidMember) {
+	for _, member := range members {
+		r.Members = append(r.Members, member)
+		m.raidByChar[member.CharID] = r
+	}
+}
+
+// RaidDecline turns down the character's raid invitation, returning who sent it
+func (m *Manager) RaidDecline(charID int64) (Member, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pending, ok := m.raidInvites[charID]
+	delete(m.raidInvites, charID)
+	return pending.inviter, ok
+}
+
+// RaidLeave takes the character out of their raid and drops any raid invitation they had.
+// Leadership passes to the longest-standing member, and a raid left with one member disbands.
+// Returns a snapshot of the raid as it was left, nil if the character wasn't raiding.
+func (m *Manager) RaidLeave(charID int64) *Raid {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.raidInvites, charID)
+	r, ok := m.raidByChar[charID]
+	if !ok {
+		return nil
+	}
+	m.removeRaidMember(r, charID)
+	return r.clone()
+}
+
+// RaidKick removes a member from the leader's raid. Returns a snapshot of the raid and the
+// member removed.
+func (m *Manager) RaidKick(leaderID int64, name string) (*Raid, RaidMember, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, err := m.ledRaid(leaderID)
+	if err != nil {
+		return nil, RaidMember{}, err
+	}
+	idx := r.memberIndex(name)
+	if idx < 0 {
+		return nil, RaidMember{}, ErrNotRaidMember
+	}
+	member := r.Members[idx]
+	m.removeRaidMember(r, member.CharID)
+	return r.clone(), member, nil
+}
+
+// RaidDisband breaks up the leader's raid. Returns a snapshot of the raid as it was.
+func (m *Manager) RaidDisband(leaderID int64) (*Raid, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, err := m.ledRaid(leaderID)
+	if err != nil {
+		return nil, err
+	}
+	former := r.clone()
+	for _, member := range r.Members {
+		delete(m.raidByChar, member.CharID)
+	}
+	r.Members = nil
+	delete(m.raids, r.ID)
+	log.Printf("Raid %d disbanded by character %d", r.ID, leaderID)
+	return former, nil
+}
+
+// SetRaidLeader hands the leader's raid to another member. Returns a snapshot of the raid.
+func (m *Manager) SetRaidLeader(leaderID int64, name string) (*Raid, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, err := m.ledRaid(leaderID)
+	if err != nil {
+		return nil, err
+	}
+	idx := r.memberIndex(name)
+	if idx < 0 {
+		return nil, ErrNotRaidMember
+	}
+	r.Leader = r.Members[idx].CharID
+	return r.clone(), nil
+}
+
+// SetRaidLootMode changes how the leader's raid shares out drops. Returns a snapshot of the raid.
+func (m *Manager) SetRaidLootMode(leaderID int64, mode string) (*Raid, error) {
+	if mode != RaidLootLeader && mode != RaidLootLooters && mode != RaidLootRaid {
+		return nil, ErrBadLootMode
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, err := m.ledRaid(leaderID)
+	if err != nil {
+		return nil, err
+	}
+	r.LootMode = mode
+	return r.clone(), nil
+}
+
+// ToggleRaidLooter adds a member to the leader's looters, or takes them off. Returns a snapshot
+// of the raid.
+func (m *Manager) ToggleRaidLooter(leaderID int64, name string) (*Raid, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, err := m.ledRaid(leaderID)
+	if err != nil {
+		return nil, err
+	}
+	idx := r.memberIndex(name)
+	if idx < 0 {
+		return nil, ErrNotRaidMember
+	}
+	r.Members[idx].Looter = !r.Members[idx].Looter
+	return r.clone(), nil
+}
+
+// NextRaidLooter returns who gets the next drop from a kill among the members in on it, by the
+// raid's loot mode: the leader, the leader and their looters in turn, or everyone in turn. If none
+// of those were in on the kill the drop goes round everyone who was. The turn carries over from
+// fight to fight. Falls back to the first eligible member once the raid has broken up.
+func (m *Manager) NextRaidLooter(raidID int64, eligible []int64) int64 {
+	if len(eligible) == 0 {
+		return 0
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.raids[raidID]
+	if !ok {
+		return eligible[0]
+	}
+
+	var pool []int64
+	for _, charID := range eligible {
+		member, _ := r.Member(charID)
+		switch {
+		case r.LootMode == RaidLootRaid,
+			charID == r.Leader,
+			r.LootMode == RaidLootLooters && member.Looter:
+			pool = append(pool, charID)
+		}
+	}
+	if len(pool) == 0 {
+		pool = eligible
+	}
+
+	for i := range r.Members {
+		idx := (r.lootNext + i) % len(r.Members)
+		if slices.Contains(pool, r.Members[idx].CharID) {
+			r.lootNext = (idx + 1) % len(r.Members)
+			return r.Members[idx].CharID
+		}
+	}
+	return pool[0]
+}
+
+// ledRaid returns the raid the character leads. The caller holds m.mu.
+func (m *Manager) ledRaid(leaderID int64) (*Raid, error) {
+	r, ok := m.raidByChar[leaderID]
+	if !ok {
+		return nil, ErrNotInRaid
+	}
+	if r.Leader != leaderID {
+		return nil, ErrNotLeader
+	}
+	return r, nil
+}
+
+// removeRaidMember takes the character out of the raid, handing off leadership and disbanding a
+// raid of one. The caller holds m.mu.
+func (m *Manager) removeRaidMember(r *Raid, charID int64) {
+	for i, member := range r.Members {
+		if member.CharID != charID {
+			continue
+		}
+		r.Members = append(r.Members[:i], r.Members[i+1:]...)
+		if i < r.lootNext {
+			r.lootNext--
+		}
+		break
+	}
+	delete(m.raidByChar, charID)
+	log.Printf("Character %d left raid %d", charID, r.ID)
+
+	if len(r.Members) < 2 {
+		for _, member := range r.Members {
+			delete(m.raidByChar, member.CharID)
+		}
+		delete(m.raids, r.ID)
+		log.Printf("Raid %d disbanded", r.ID)
+		return
+	}
+	if r.Leader == charID {
+		r.Leader = r.Members[0].CharID
+	}
+}
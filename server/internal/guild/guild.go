@@ -0,0 +1,183 @@
+package guild
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Guild ranks, stored in guild_members.rank. Lower is more senior.
+const (
+	RankLeader        uint8 = 1
+	RankSeniorOfficer uint8 = 2
+	RankOfficer       uint8 = 3
+	RankSeniorMember  uint8 = 4
+	RankMember        uint8 = 5
+	RankJuniorMember  uint8 = 6
+	RankInitiate      uint8 = 7
+	RankRecruit       uint8 = 8
+)
+
+// RankCount is how many ranks a guild has
+const RankCount = 8
+
+// DefaultRankTitles are the titles a new guild's ranks start with, by rank
+var DefaultRankTitles = [RankCount + 1]string{
+	"", "Leader", "Senior Officer", "Officer", "Senior Member", "Member", "Junior Member", "Initiate", "Recruit",
+}
+
+// Permissions a guild grants by rank, stored in guild_permissions.perm_id. The leader always has
+// every permission; only the leader can change them.
+const (
+	PermInvite      int32 = 1 // Invite new members
+	PermRemove      int32 = 2 // Remove members of a lower rank
+	PermPromote     int32 = 3 // Raise members of a lower rank, up to one below their own
+	PermDemote      int32 = 4 // Lower members of a lower rank
+	PermMOTD        int32 = 5 // Set the message of the day
+	PermPublicNotes int32 = 6 // Edit other members' public notes
+	PermGuildChat   int32 = 7 // Speak in guild chat
+)
+
+// PermissionCount is how many permissions there are, numbered from 1
+const PermissionCount = 7
+
+// Permissions maps each permission to the ranks holding it, one bit per rank from bit 0 for the
+// leader. Stored in guild_permissions.permission.
+type Permissions map[int32]int32
+
+// RankBit returns the rank's bit in a Permissions mask
+func RankBit(rank uint8) int32 {
+	return 1 << (rank - 1)
+}
+
+// ranksThrough returns a mask of every rank from the leader down to rank
+func ranksThrough(rank uint8) int32 {
+	return 1<<rank - 1
+}
+
+// DefaultPermissions returns the permissions a new guild starts with: officers run the guild and
+// everyone can talk in guild chat
+func DefaultPermissions() Permissions {
+	return Permissions{
+		PermInvite:      ranksThrough(RankOfficer),
+		PermRemove:      ranksThrough(RankSeniorOfficer),
+		PermPromote:     ranksThrough(RankSeniorOfficer),
+		PermDemote:      ranksThrough(RankSeniorOfficer),
+		PermMOTD:        ranksThrough(RankOfficer),
+		PermPublicNotes: ranksThrough(RankOfficer),
+		PermGuildChat:   ranksThrough(RankRecruit),
+	}
+}
+
+// Allowed reports whether a member of the rank holds the permission
+func (p Permissions) Allowed(rank uint8, perm int32) bool {
+	return rank == RankLeader || p[perm]&RankBit(rank) != 0
+}
+
+// Errors returned to the character who tried the action, worded for the client
+var (
+	ErrAlreadyInGuild = errors.New("That player is already in a guild.")
+	ErrYouAreInGuild  = errors.New("You are already in a guild.")
+	ErrNotInGuild     = errors.New("You are not in a guild.")
+	ErrNotGuildMember = errors.New("That player is not in your guild.")
+	ErrNoPermission   = errors.New("Your guild rank does not allow that.")
+	ErrOutranked      = errors.New("You can only do that to members below your rank.")
+	ErrBadRank        = errors.New("That is not a rank you can give.")
+	ErrNoGuildInvite  = errors.New("You have not been invited to a guild.")
+	ErrInvitePending  = errors.New("That player is considering another guild invitation.")
+	ErrLeaderLeaving  = errors.New("Make another member leader before leaving the guild.")
+	ErrBadGuildName   = errors.New("Guild names are 4 to 32 letters and spaces.")
+	ErrGuildNameTaken = errors.New("That guild name is already taken.")
+	ErrBadPermission  = errors.New("Unknown guild permission.")
+)
+
+// ValidateName checks a new guild's name: 4 to 32 letters and single spaces, with no leading or
+// trailing space
+func ValidateName(name string) error {
+	if len(name) < 4 || len(name) > 32 || strings.TrimSpace(name) != name || strings.Contains(name, "  ") {
+		return ErrBadGuildName
+	}
+	for _, r := range name {
+		if r > unicode.MaxASCII || (!unicode.IsLetter(r) && r != ' ') {
+			return ErrBadGuildName
+		}
+	}
+	return nil
+}
+
+// CanManage reports whether a member of actorRank can act on a member of targetRank: remove,
+// promote, demote or edit their note. Only more senior ranks can.
+func CanManage(actorRank, targetRank uint8) bool {
+	return actorRank < targetRank
+}
+
+// CheckRankChange checks that a member of actorRank may move a member from oldRank to newRank
+// with the promote or demote permission. Leadership changes hands separately.
+func CheckRankChange(perms Permissions, actorRank, oldRank, newRank uint8) error {
+	if !CanManage(actorRank, oldRank) {
+		return ErrOutranked
+	}
+	if newRank <= actorRank || newRank > RankCount || newRank == oldRank {
+		return ErrBadRank
+	}
+	perm := PermPromote
+	if newRank > oldRank {
+		perm = PermDemote
+	}
+	if !perms.Allowed(actorRank, perm) {
+		return ErrNoPermission
+	}
+	return nil
+}
+
+// inviteTimeout is how long a guild invitation can be accepted for
+const inviteTimeout = 2 * time.Minute
+
+// Invite is a pending guild invitation
+type Invite struct {
+	GuildID     int32
+	InviterID   int32
+	InviterName string
+	expires     time.Time
+}
+
+// Invites tracks pending guild invitations by invitee character ID. They live only in memory.
+type Invites struct {
+	mu      sync.Mutex
+	pending map[int32]Invite
+}
+
+var globalInvites = &Invites{pending: make(map[int32]Invite)}
+
+// GetInvites returns the global guild invitations
+func GetInvites() *Invites {
+	return globalInvites
+}
+
+// NewInvites creates an empty set of guild invitations
+func NewInvites() *Invites {
+	return &Invites{pending: make(map[int32]Invite)}
+}
+
+// Add invites a character to a guild unless another guild's invitation is waiting on them
+func (i *Invites) Add(inviteeID int32, inv Invite, now time.Time) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if pending, ok := i.pending[inviteeID]; ok && now.Before(pending.expires) && pending.GuildID != inv.GuildID {
+		return ErrInvitePending
+	}
+	inv.expires = now.Add(inviteTimeout)
+	i.pending[inviteeID] = inv
+	return nil
+}
+
+// Take removes and returns the character's invitation if it hasn't expired
+func (i *Invites) Take(inviteeID int32, now time.Time) (Invite, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	inv, ok := i.pending[inviteeID]
+	delete(i.pending, inviteeID)
+	return inv, ok && now.Before(inv.expires)
+}
@@ -0,0 +1,94 @@
+package guild
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDefaultPermissions(t *testing.T) {
+	perms := DefaultPermissions()
+	tests := []struct {
+		rank    uint8
+		perm    int32
+		allowed bool
+	}{
+		{RankLeader, PermRemove, true},
+		{RankSeniorOfficer, PermRemove, true},
+		{RankOfficer, PermRemove, false},
+		{RankOfficer, PermInvite, true},
+		{RankMember, PermInvite, false},
+		{RankRecruit, PermGuildChat, true},
+		{RankRecruit, PermMOTD, false},
+	}
+	for _, tt := range tests {
+		if got := perms.Allowed(tt.rank, tt.perm); got != tt.allowed {
+			t.Errorf("Allowed(rank %d, perm %d) = %v, want %v", tt.rank, tt.perm, got, tt.allowed)
+		}
+	}
+
+	// The leader keeps every permission even when the guild takes it away
+	perms[PermInvite] = 0
+	if !perms.Allowed(RankLeader, PermInvite) || perms.Allowed(RankOfficer, PermInvite) {
+		t.Error("Expected only the leader to invite with the permission cleared")
+	}
+}
+
+func TestCheckRankChange(t *testing.T) {
+	perms := DefaultPermissions()
+	tests := []struct {
+		name                    string
+		actor, oldRank, newRank uint8
+		want                    error
+	}{
+		{"Leader promotes to senior officer", RankLeader, RankMember, RankSeniorOfficer, nil},
+		{"Nobody is promoted to leader", RankLeader, RankMember, RankLeader, ErrBadRank},
+		{"Senior officer demotes", RankSeniorOfficer, RankOfficer, RankRecruit, nil},
+		{"Not up to their own rank", RankSeniorOfficer, RankMember, RankSeniorOfficer, ErrBadRank},
+		{"Not a peer", RankSeniorOfficer, RankSeniorOfficer, RankMember, ErrOutranked},
+		{"Officers can't promote", RankOfficer, RankRecruit, RankMember, ErrNoPermission},
+		{"No rank below recruit", RankLeader, RankRecruit, RankCount + 1, ErrBadRank},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckRankChange(perms, tt.actor, tt.oldRank, tt.newRank); !errors.Is(err, tt.want) {
+				t.Errorf("CheckRankChange(%d, %d, %d) = %v, want %v", tt.actor, tt.oldRank, tt.newRank, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateName(t *testing.T) {
+	for _, name := range []string{"Knights of Truth", "Ally"} {
+		if err := ValidateName(name); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", name, err)
+		}
+	}
+	for _, name := range []string{"Abc", " Leading", "Two  Spaces", "Guild42", "An Extremely Long Guild Name Indeed"} {
+		if err := ValidateName(name); !errors.Is(err, ErrBadGuildName) {
+			t.Errorf("Expected %q to be rejected, got %v", name, err)
+		}
+	}
+}
+
+func TestInvites(t *testing.T) {
+	inv := NewInvites()
+	now := time.Now()
+	if err := inv.Add(5, Invite{GuildID: 1, InviterName: "Leader"}, now); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := inv.Add(5, Invite{GuildID: 2}, now); !errors.Is(err, ErrInvitePending) {
+		t.Errorf("Expected ErrInvitePending for another guild, got %v", err)
+	}
+	if got, ok := inv.Take(5, now); !ok || got.GuildID != 1 || got.InviterName != "Leader" {
+		t.Errorf("Expected guild 1's invitation, got %+v %v", got, ok)
+	}
+	if _, ok := inv.Take(5, now); ok {
+		t.Error("Expected the invitation to be used up")
+	}
+
+	_ = inv.Add(6, Invite{GuildID: 1}, now)
+	if _, ok := inv.Take(6, now.Add(inviteTimeout)); ok {
+		t.Error("Expected the invitation to expire")
+	}
+}
@@ -0,0 +1,161 @@
+package mail
+
+import (
+	"errors"
+	"fmt"
+
+	"idlequest/internal/constants"
+	"idlequest/internal/db/items"
+	"idlequest/internal/trade"
+)
+
+// Limits on one letter
+const (
+	MaxAttachments = 8
+	MaxSubject     = 64
+	MaxBody        = 2000
+)
+
+// MoneyItemID is the character_parcels item_id of attached coin, its quantity in copper
+const MoneyItemID = 99990
+
+// Letter statuses, as stored in mail.status
+const (
+	StatusUnread = 0
+	StatusRead   = 1
+)
+
+// Errors returned to the character who tried the action, worded for the client
+var (
+	ErrNoRecipient    = errors.New("There is no character by that name.")
+	ErrMailSelf       = errors.New("You cannot send mail to yourself.")
+	ErrNoSubject      = errors.New("Your letter needs a subject.")
+	ErrTooLong        = fmt.Errorf("Subjects are up to %d characters and letters up to %d.", MaxSubject, MaxBody)
+	ErrTooMany        = fmt.Errorf("You can attach up to %d items.", MaxAttachments)
+	ErrNoItem         = errors.New("There is no item in that slot.")
+	ErrAttachedTwice  = errors.New("That item is already attached.")
+	ErrNotEnoughCoin  = errors.New("You do not have the coin to send.")
+	ErrNoLetter       = errors.New("That letter is not in your mailbox.")
+	ErrNothingToTake  = errors.New("That letter has nothing attached.")
+	ErrHasAttachments = errors.New("Take the letter's attachments before deleting it.")
+	ErrNoRoom         = errors.New("You do not have room for")
+	ErrLoreConflict   = errors.New("You already have the lore item")
+)
+
+// Attachment is an item leaving the sender's inventory with a letter
+type Attachment struct {
+	Key  constants.InventoryKey
+	Item *constants.ItemWithInstance
+}
+
+// CheckSend checks the items in the slots given can be attached, and that the purse covers the
+// coin, returning the attachments in order. Nothing is changed.
+func CheckSend(inventory map[constants.InventoryKey]*constants.ItemWithInstance, keys []constants.InventoryKey, coin, purse trade.Coin) ([]Attachment, error) {
+	if len(keys) > MaxAttachments {
+		return nil, ErrTooMany
+	}
+	if !coin.Within(purse) {
+		return nil, ErrNotEnoughCoin
+	}
+	attachments := make([]Attachment, 0, len(keys))
+	seen := make(map[constants.InventoryKey]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			return nil, ErrAttachedTwice
+		}
+		seen[key] = true
+		item := inventory[key]
+		if item == nil {
+			return nil, ErrNoItem
+		}
+		if err := trade.CheckTradeable(inventory, key, item); err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, Attachment{Key: key, Item: item})
+	}
+	return attachments, nil
+}
+
+// Place finds each item taken from a letter a place in the inventory, in order. Nothing is
+// changed; the items are taken together or not at all.
+func Place(inventory map[constants.InventoryKey]*constants.ItemWithInstance, taken []*constants.ItemWithInstance) ([]constants.InventoryKey, error) {
+	held := make(map[constants.InventoryKey]*constants.ItemWithInstance, len(inventory)+len(taken))
+	for key, item := range inventory {
+		held[key] = item
+	}
+	keys := make([]constants.InventoryKey, len(taken))
+	for i, item := range taken {
+		if item.IsLore() {
+			for _, h := range held {
+				if item.CheckLoreConflict(h) {
+					return nil, fmt.Errorf("%w %s.", ErrLoreConflict, item.Item.Name)
+				}
+			}
+		}
+		bag, slot := items.FindFirstAvailableSlot(held, item.IsContainer())
+		if slot == -1 {
+			return nil, fmt.Errorf("%w %s.", ErrNoRoom, item.Item.Name)
+		}
+		keys[i] = constants.InventoryKey{Bag: bag, Slot: slot}
+		held[keys[i]] = item
+	}
+	return keys, nil
+}
+
+// Parcel is one character_parcels row of a letter: an attached item, or the coin as MoneyItemID
+// with its quantity in copper
+type Parcel struct {
+	SlotID     uint32
+	ItemID     int32
+	Quantity   int
+	Attachment *Attachment // nil for the coin
+}
+
+// Parcels lays out the character_parcels rows for a letter, the items in order and then the coin.
+// The table holds one row per recipient and slot_id, so each row takes the lowest slot the
+// recipient's other parcels, in used, leave free.
+func Parcels(attachments []Attachment, coin trade.Coin, used map[uint32]bool) []Parcel {
+	var parcels []Parcel
+	slot := uint32(0)
+	next := func() uint32 {
+		for used[slot] {
+			slot++
+		}
+		slot++
+		return slot - 1
+	}
+	for i := range attachments {
+		a := &attachments[i]
+		parcels = append(parcels, Parcel{SlotID: next(), ItemID: a.Item.Item.ID, Quantity: int(a.Item.Instance.Charges), Attachment: a})
+	}
+	if !coin.IsZero() {
+		parcels = append(parcels, Parcel{SlotID: next(), ItemID: MoneyItemID, Quantity: ToCopper(coin)})
+	}
+	return parcels
+}
+
+// ToCopper returns the coin's worth in copper, as attached coin is kept
+func ToCopper(c trade.Coin) int {
+	return c.Platinum*1000 + c.Gold*100 + c.Silver*10 + c.Copper
+}
+
+// FromCopper breaks copper down into the fewest coins
+func FromCopper(copper int) trade.Coin {
+	return trade.Coin{
+		Platinum: copper / 1000,
+		Gold:     copper % 1000 / 100,
+		Silver:   copper % 100 / 10,
+		Copper:   copper % 10,
+	}
+}
+
+// CheckLetter checks a letter's subject and body fit
+func CheckLetter(subject, body string) error {
+	if subject == "" {
+		return ErrNoSubject
+	}
+	if len(subject) > MaxSubject || len(body) > MaxBody {
+		return ErrTooLong
+	}
+	return nil
+}
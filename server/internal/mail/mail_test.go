@@ -0,0 +1,131 @@
+package mail
+
+import (
+	"errors"
+	"testing"
+
+	"idlequest/internal/constants"
+	"idlequest/internal/db/jetgen/eqgo/model"
+	"idlequest/internal/trade"
+)
+
+func general(n int8) constants.InventoryKey {
+	return constants.InventoryKey{Slot: constants.SlotGeneral1 + n}
+}
+
+// inventoryItem makes a tradeable item with the instance ID
+func inventoryItem(instanceID int32, name string) *constants.ItemWithInstance {
+	return &constants.ItemWithInstance{
+		Item:           model.Items{ID: instanceID, Name: name, Nodrop: 1},
+		ItemInstanceID: instanceID,
+	}
+}
+
+func TestCheckSend(t *testing.T) {
+	inv := map[constants.InventoryKey]*constants.ItemWithInstance{
+		general(0): inventoryItem(1, "Sword"),
+		general(1): inventoryItem(2, "Bread"),
+	}
+	inv[general(1)].Item.Nodrop = 0
+	purse := trade.Coin{Gold: 5}
+
+	attachments, err := CheckSend(inv, []constants.InventoryKey{general(0)}, trade.Coin{Gold: 5}, purse)
+	if err != nil || len(attachments) != 1 || attachments[0].Item.ItemInstanceID != 1 {
+		t.Fatalf("Expected the sword attached, got %+v, %v", attachments, err)
+	}
+
+	tests := []struct {
+		name string
+		keys []constants.InventoryKey
+		coin trade.Coin
+		want error
+	}{
+		{"Coin short", nil, trade.Coin{Gold: 6}, ErrNotEnoughCoin},
+		{"Empty slot", []constants.InventoryKey{general(4)}, trade.Coin{}, ErrNoItem},
+		{"Twice", []constants.InventoryKey{general(0), general(0)}, trade.Coin{}, ErrAttachedTwice},
+		{"NO DROP", []constants.InventoryKey{general(1)}, trade.Coin{}, trade.ErrNoDrop},
+		{"Too many", make([]constants.InventoryKey, MaxAttachments+1), trade.Coin{}, ErrTooMany},
+	}
+	for _, tt := range tests {
+		if _, err := CheckSend(inv, tt.keys, tt.coin, purse); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestPlace(t *testing.T) {
+	inv := map[constants.InventoryKey]*constants.ItemWithInstance{general(0): inventoryItem(1, "Sword")}
+	keys, err := Place(inv, []*constants.ItemWithInstance{inventoryItem(2, "Bread"), inventoryItem(3, "Water")})
+	if err != nil {
+		t.Fatalf("Place failed: %v", err)
+	}
+	if keys[0] != general(1) || keys[1] != general(2) {
+		t.Errorf("Expected the next free general slots, got %v", keys)
+	}
+	if len(inv) != 1 {
+		t.Error("Expected the inventory to be left alone")
+	}
+
+	lore := inventoryItem(4, "Sword")
+	lore.Item.ID = 1
+	lore.Item.Loregroup = -1
+	inv[general(0)].Item.Loregroup = -1
+	if _, err := Place(inv, []*constants.ItemWithInstance{lore}); !errors.Is(err, ErrLoreConflict) {
+		t.Errorf("Expected ErrLoreConflict, got %v", err)
+	}
+
+	for n := int8(1); n < 8; n++ {
+		inv[general(n)] = inventoryItem(10+int32(n), "Rock")
+	}
+	if _, err := Place(inv, []*constants.ItemWithInstance{inventoryItem(5, "Bread")}); !errors.Is(err, ErrNoRoom) {
+		t.Errorf("Expected ErrNoRoom, got %v", err)
+	}
+}
+
+func TestParcels(t *testing.T) {
+	sword, bread := inventoryItem(1, "Sword"), inventoryItem(2, "Bread")
+	bread.Instance.Charges = 5
+	attachments := []Attachment{{Key: general(0), Item: sword}, {Key: general(1), Item: bread}}
+
+	// Two items and coin to a character who already has parcels in slots 0 and 2
+	parcels := Parcels(attachments, trade.Coin{Gold: 3, Copper: 4}, map[uint32]bool{0: true, 2: true})
+	if len(parcels) != 3 {
+		t.Fatalf("Expected a parcel for each item and one for the coin, got %+v", parcels)
+	}
+	for i, want := range []uint32{1, 3, 4} {
+		if parcels[i].SlotID != want {
+			t.Errorf("Parcel %d: expected slot %d, got %d", i, want, parcels[i].SlotID)
+		}
+	}
+	if parcels[0].Attachment != &attachments[0] || parcels[1].ItemID != 2 || parcels[1].Quantity != 5 {
+		t.Errorf("Expected the items in order, got %+v and %+v", parcels[0], parcels[1])
+	}
+	if coin := parcels[2]; coin.ItemID != MoneyItemID || coin.Quantity != 304 || coin.Attachment != nil {
+		t.Errorf("Expected 304 copper last, got %+v", coin)
+	}
+	if len(Parcels(attachments, trade.Coin{}, nil)) != 2 {
+		t.Error("Expected no coin parcel without coin")
+	}
+}
+
+func TestCopper(t *testing.T) {
+	c := trade.Coin{Platinum: 1, Gold: 12, Silver: 3, Copper: 4}
+	if got := ToCopper(c); got != 2234 {
+		t.Errorf("ToCopper = %d, want 2234", got)
+	}
+	if got := FromCopper(2234); got != (trade.Coin{Platinum: 2, Gold: 2, Silver: 3, Copper: 4}) {
+		t.Errorf("FromCopper = %+v", got)
+	}
+}
+
+func TestCheckLetter(t *testing.T) {
+	if err := CheckLetter("", "hi"); !errors.Is(err, ErrNoSubject) {
+		t.Errorf("Expected ErrNoSubject, got %v", err)
+	}
+	if err := CheckLetter("Hello", string(make([]byte, MaxBody+1))); !errors.Is(err, ErrTooLong) {
+		t.Errorf("Expected ErrTooLong, got %v", err)
+	}
+	if err := CheckLetter("Hello", "See you soon"); err != nil {
+		t.Errorf("CheckLetter failed: %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package mechanics
+
+// ConColor is the color an NPC "cons" relative to the player's level
+type ConColor int
+
+const (
+	ConGray ConColor = iota
+	ConGreen
+	ConLightBlue
+	ConBlue
+	ConWhite
+	ConYellow
+	ConRed
+)
+
+// String returns the lowercase color name sent to the client
+func (c ConColor) String() string {
+	switch c {
+	case ConGray:
+		return "gray"
+	case ConGreen:
+		return "green"
+	case ConLightBlue:
+		return "lightblue"
+	case ConBlue:
+		return "blue"
+	case ConWhite:
+		return "white"
+	case ConYellow:
+		return "yellow"
+	case ConRed:
+		return "red"
+	}
+	return "white"
+}
+
+// conBracket holds the level differences (target - player) at or below which a
+// target cons gray / green / light blue for players up to MaxLevel
+type conBracket struct {
+	MaxLevel  int
+	Gray      int
+	Green     int
+	LightBlue int
+}
+
+// conBrackets is ordered by MaxLevel
+// Approximates the classic EQ con ranges (see EQEmu Mob::GetLevelCon)
+var conBrackets = []conBracket{
+	{7, -4, -4, -4},
+	{8, -5, -4, -4},
+	{12, -6, -5, -4},
+	{16, -7, -6, -5},
+	{20, -8, -7, -6},
+	{24, -9, -8, -7},
+	{28, -10, -9, -8},
+	{30, -11, -10, -9},
+	{33, -12, -11, -10},
+	{37, -13, -12, -11},
+	{41, -14, -13, -12},
+	{45, -16, -15, -13},
+	{49, -17, -16, -14},
+	{53, -18, -17, -15},
+	{57, -20, -18, -16},
+	{MaxPlayerLevel, -21, -19, -17},
+}
+
+// GetConColor returns the con color of a target for a player of the given level
+func GetConColor(playerLevel int, targetLevel int) ConColor {
+	diff := targetLevel - playerLevel
+	switch {
+	case diff == 0:
+		return ConWhite
+	case diff >= 1 && diff <= 2:
+		return ConYellow
+	case diff >= 3:
+		return ConRed
+	}
+
+	bracket := conBrackets[len(conBrackets)-1]
+	for _, b := range conBrackets {
+		if playerLevel <= b.MaxLevel {
+			bracket = b
+			break
+		}
+	}
+
+	switch {
+	case diff <= bracket.Gray:
+		return ConGray
+	case diff <= bracket.Green:
+		return ConGreen
+	case diff <= bracket.LightBlue:
+		return ConLightBlue
+	}
+	return ConBlue
+}
+
+// Faction standing thresholds (inclusive lower bounds), matching the classic EQ consider messages
+const (
+	FactionAlly           = 1100
+	FactionWarmly         = 750
+	FactionKindly         = 500
+	FactionAmiably        = 100
+	FactionIndifferently  = 0
+	FactionApprehensively = -100
+	FactionDubiously      = -500
+	FactionThreateningly  = -750
+)
+
+// GetFactionStanding returns the consider text for a faction value, e.g. "regards you as an ally"
+func GetFactionStanding(value int) string {
+	switch {
+	case value >= FactionAlly:
+		return "regards you as an ally"
+	case value >= FactionWarmly:
+		return "looks upon you warmly"
+	case value >= FactionKindly:
+		return "kindly considers you"
+	case value >= FactionAmiably:
+		return "judges you amiably"
+	case value >= FactionIndifferently:
+		return "regards you indifferently"
+	case value >= FactionApprehensively:
+		return "looks your way apprehensively"
+	case value >= FactionDubiously:
+		return "glowers at you dubiously"
+	case value >= FactionThreateningly:
+		return "glares at you threateningly"
+	}
+	return "scowls at you, ready to attack"
+}
+
+// DangerEstimate is a rough prediction of a straight melee fight between a player and an NPC
+type DangerEstimate struct {
+	PlayerRoundsToKill float64 // Expected rounds for the player to kill the NPC
+	NPCRoundsToKill    float64 // Expected rounds for the NPC to kill the player
+	Rating             string  // trivial, easy, moderate, risky or deadly
+}
+
+// EstimateDanger compares expected damage per round on both sides using the same
+// formulas the combat loop rolls against, and rates how risky the fight is
+func EstimateDanger(
+	playerLevel, playerStr, playerAC, playerHP int,
+	npcLevel int, npcHP int64, npcAC, npcMinDmg, npcMaxDmg int,
+) DangerEstimate {
+	// Player average damage per round
+	playerHitChance := float64(CalculateHitChance(playerLevel, npcLevel, 80, 20, 95)) / 100.0
+	baseDamage := CalculatePlayerATK(playerStr, playerLevel) / 10
+	if baseDamage < 1 {
+		baseDamage = 1
+	}
+	playerAvg := (float64(baseDamage) + float64(playerStr/10) + float64(baseDamage)/2.0) * (1.0 - CalculateMitigation(npcAC))
+	if playerAvg < 1 {
+		playerAvg = 1
+	}
+	playerAvg *= 1.05 // 5% crits for double damage
+	playerDPR := playerHitChance * playerAvg
+
+	// NPC average damage per round
+	npcHitChance := float64(CalculateHitChance(npcLevel, playerLevel, 70, 20, 90)) / 100.0
+	if npcMinDmg < 1 {
+		npcMinDmg = 1
+	}
+	if npcMaxDmg < npcMinDmg {
+		npcMaxDmg = npcMinDmg
+	}
+	npcAvg := float64(npcMinDmg+npcMaxDmg) / 2.0 * (1.0 - CalculateMitigation(playerAC))
+	if npcAvg < 1 {
+		npcAvg = 1
+	}
+	npcDPR := npcHitChance * npcAvg
+
+	estimate := DangerEstimate{
+		PlayerRoundsToKill: float64(npcHP) / playerDPR,
+		NPCRoundsToKill:    float64(playerHP) / npcDPR,
+	}
+
+	// How many times longer the player survives than the NPC
+	ratio := estimate.NPCRoundsToKill / estimate.PlayerRoundsToKill
+	switch {
+	case ratio >= 4:
+		estimate.Rating = "trivial"
+	case ratio >= 2:
+		estimate.Rating = "easy"
+	case ratio >= 1.25:
+		estimate.Rating = "moderate"
+	case ratio >= 1:
+		estimate.Rating = "risky"
+	default:
+		estimate.Rating = "deadly"
+	}
+	return estimate
+}
@@ -0,0 +1,67 @@
+package mechanics
+
+import "testing"
+
+func TestGetConColor(t *testing.T) {
+	tests := []struct {
+		name        string
+		playerLevel int
+		targetLevel int
+		want        ConColor
+	}{
+		{"Same level", 10, 10, ConWhite},
+		{"One above", 10, 11, ConYellow},
+		{"Two above", 10, 12, ConYellow},
+		{"Three above", 10, 13, ConRed},
+		{"Low level blue", 5, 3, ConBlue},
+		{"Low level gray", 5, 1, ConGray},
+		{"Level 10 light blue", 10, 6, ConLightBlue},
+		{"Level 10 green", 10, 5, ConGreen},
+		{"Level 10 gray", 10, 4, ConGray},
+		{"Level 60 blue", 60, 50, ConBlue},
+		{"Level 60 gray", 60, 39, ConGray},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := GetConColor(tc.playerLevel, tc.targetLevel)
+			if got != tc.want {
+				t.Errorf("GetConColor(%d, %d) = %s, want %s", tc.playerLevel, tc.targetLevel, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetFactionStanding(t *testing.T) {
+	tests := []struct {
+		value int
+		want  string
+	}{
+		{1500, "regards you as an ally"},
+		{1100, "regards you as an ally"},
+		{1099, "looks upon you warmly"},
+		{0, "regards you indifferently"},
+		{-1, "looks your way apprehensively"},
+		{-751, "scowls at you, ready to attack"},
+	}
+
+	for _, tc := range tests {
+		if got := GetFactionStanding(tc.value); got != tc.want {
+			t.Errorf("GetFactionStanding(%d) = %q, want %q", tc.value, got, tc.want)
+		}
+	}
+}
+
+func TestEstimateDanger(t *testing.T) {
+	// A level 10 warrior against a weak level 2 NPC should be trivial
+	easy := EstimateDanger(10, 75, 150, 200, 2, 20, 5, 1, 4)
+	if easy.Rating != "trivial" {
+		t.Errorf("Expected trivial fight, got %s (%+v)", easy.Rating, easy)
+	}
+
+	// The same warrior against a level 30 NPC should be deadly
+	hard := EstimateDanger(10, 75, 150, 200, 30, 3000, 150, 20, 80)
+	if hard.Rating != "deadly" {
+		t.Errorf("Expected deadly fight, got %s (%+v)", hard.Rating, hard)
+	}
+}
@@ -19,10 +19,14 @@ import (
 	"idlequest/internal/cache"
 	"idlequest/internal/cert"
 	"idlequest/internal/db"
+	db_chat "idlequest/internal/db/chat"
+	db_combat "idlequest/internal/db/combat"
 	items "idlequest/internal/db/items"
+	db_stats "idlequest/internal/db/stats"
 	db_zone "idlequest/internal/db/zone"
 	"idlequest/internal/discord"
 	"idlequest/internal/session"
+	"idlequest/internal/who"
 	"idlequest/internal/world"
 
 	"github.com/quic-go/quic-go"
@@ -299,6 +303,12 @@ func startHTTPServer(tlsConf *tls.Config) {
 	// Lightweight REST API for local dev fallback (avoids WebTransport/TLS friction)
 	mux.Handle("/api/items/", corsMiddleware(http.HandlerFunc(restGetItemByID)))
 	mux.Handle("/api/zones/byZoneId/", corsMiddleware(http.HandlerFunc(restGetZoneByZoneID)))
+	mux.Handle("/api/leaderboard", corsMiddleware(http.HandlerFunc(restGetLeaderboard)))
+	mux.Handle("/api/loot", corsMiddleware(http.HandlerFunc(restGetLootReport)))
+	mux.Handle("/api/who", corsMiddleware(http.HandlerFunc(restGetWho)))
+
+	// Admin API, for internal tools on this machine only
+	mux.HandleFunc("/api/admin/reports", adminOnly(restAdminReports))
 
 	// /api/hash returns the SHA-256 (base64) of the server certificate for WebTransport pinning
 	// This mirrors eqrequiem's hash server approach
@@ -434,6 +444,186 @@ func restGetZoneByZoneID(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// restGetLeaderboard serves GET /api/leaderboard?stat=kills&limit=25
+func restGetLeaderboard(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	stat := r.URL.Query().Get("stat")
+	if stat == "" {
+		stat = "kills"
+	}
+	if !db_stats.IsLeaderboardStat(stat) {
+		http.Error(w, "Invalid stat", http.StatusBadRequest)
+		return
+	}
+	limit := 25
+	if l := r.URL.Query().Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n <= 0 || n > 100 {
+			http.Error(w, "Invalid limit (1-100)", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+	entries, err := db_stats.GetLeaderboard(context.Background(), stat, int64(limit))
+	if err != nil {
+		log.Printf("Failed to get %s leaderboard: %v", stat, err)
+		http.Error(w, "Failed to load leaderboard", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(entries); err != nil {
+		http.Error(w, "Failed to encode JSON", http.StatusInternalServerError)
+		return
+	}
+}
+
+// maxLootTrials bounds the loot rolls one /api/loot request can ask for
+const maxLootTrials = 100000
+
+// restGetLootReport serves GET /api/loot?npc=ID, ?name=gnoll or ?zone=qeynos2. A single NPC
+// can add &trials=N to roll its loot N times instead of calculating exact rates; name and zone
+// queries cover many NPCs and only get the exact calculation.
+func restGetLootReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	q := r.URL.Query()
+	query := db_combat.LootQuery{Name: q.Get("name"), Zone: q.Get("zone")}
+	if n := q.Get("npc"); n != "" {
+		id, err := strconv.Atoi(n)
+		if err != nil || id <= 0 {
+			http.Error(w, "Invalid npc id", http.StatusBadRequest)
+			return
+		}
+		query.NPCID = int32(id)
+	}
+	if query.NPCID == 0 && query.Name == "" && query.Zone == "" {
+		http.Error(w, "One of npc, name or zone is required", http.StatusBadRequest)
+		return
+	}
+	trials := 0
+	if t := q.Get("trials"); t != "" {
+		n, err := strconv.Atoi(t)
+		if err != nil || n < 0 || n > maxLootTrials {
+			http.Error(w, fmt.Sprintf("Invalid trials (0-%d)", maxLootTrials), http.StatusBadRequest)
+			return
+		}
+		if n > 0 && query.NPCID == 0 {
+			http.Error(w, "trials needs npc", http.StatusBadRequest)
+			return
+		}
+		trials = n
+	}
+	reports, err := db_combat.GetLootReports(r.Context(), query, trials, time.Now().UnixNano())
+	if err != nil {
+		log.Printf("Failed to build loot report for %+v: %v", query, err)
+		http.Error(w, "Failed to build loot report", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(reports); err != nil {
+		http.Error(w, "Failed to encode JSON", http.StatusInternalServerError)
+		return
+	}
+}
+
+// restGetWho serves GET /api/who?zone=ID&minlevel=N&maxlevel=N&class=ID&race=ID&guild=name&name=part
+// with the players online, hiding what anonymous and roleplaying players keep to themselves
+func restGetWho(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	params := r.URL.Query()
+	q := who.Query{Name: params.Get("name"), Guild: params.Get("guild")}
+	for key, field := range map[string]*int32{
+		"zone":     &q.ZoneID,
+		"minlevel": &q.MinLevel,
+		"maxlevel": &q.MaxLevel,
+		"class":    &q.Class,
+		"race":     &q.Race,
+	} {
+		v := params.Get(key)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			http.Error(w, fmt.Sprintf("Invalid %s", key), http.StatusBadRequest)
+			return
+		}
+		*field = int32(n)
+	}
+	result := world.WhoOnline(r.Context(), q)
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		http.Error(w, "Failed to encode JSON", http.StatusInternalServerError)
+		return
+	}
+}
+
+// adminOnly refuses requests that don't come from this machine, like registerHandler
+func adminOnly(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.RemoteAddr, "127.0.0.1:") {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// restAdminReports serves GET /api/admin/reports?limit=50 with the player reports waiting for
+// review, and DELETE /api/admin/reports?id=N to close one once reviewed
+func restAdminReports(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		limit := 50
+		if l := r.URL.Query().Get("limit"); l != "" {
+			n, err := strconv.Atoi(l)
+			if err != nil || n <= 0 || n > 500 {
+				http.Error(w, "Invalid limit (1-500)", http.StatusBadRequest)
+				return
+			}
+			limit = n
+		}
+		reports, err := db_chat.GetReports(r.Context(), int64(limit))
+		if err != nil {
+			log.Printf("Failed to get reports: %v", err)
+			http.Error(w, "Failed to load reports", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(reports); err != nil {
+			http.Error(w, "Failed to encode JSON", http.StatusInternalServerError)
+			return
+		}
+	case http.MethodDelete:
+		id, err := strconv.ParseUint(r.URL.Query().Get("id"), 10, 32)
+		if err != nil || id == 0 {
+			http.Error(w, "Invalid report id", http.StatusBadRequest)
+			return
+		}
+		found, err := db_chat.DeleteReport(r.Context(), uint32(id))
+		if err != nil {
+			log.Printf("Failed to delete report %d: %v", id, err)
+			http.Error(w, "Failed to delete report", http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			http.Error(w, "Report not found", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
 // corsMiddleware enables CORS for HTTP endpoints.
 func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
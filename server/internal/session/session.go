@@ -3,6 +3,7 @@ package session
 import (
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 
 	"idlequest/internal/api/opcodes"
@@ -238,6 +239,19 @@ func (sm *SessionManager) ForEachSession(fn func(*Session)) {
 	}
 }
 
+// FindByCharacterName returns the session playing the named character, ignoring case.
+func (sm *SessionManager) FindByCharacterName(name string) (*Session, bool) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	for _, session := range sm.sessions {
+		if session.HasValidClient() && strings.EqualFold(session.Client.CharData().Name, name) {
+			return session, true
+		}
+	}
+	return nil, false
+}
+
 type capnpMessage interface {
 	Message() *capnp.Message
 }
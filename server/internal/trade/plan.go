@@ -0,0 +1,133 @@
+package trade
+
+import (
+	"errors"
+	"fmt"
+
+	"idlequest/internal/constants"
+	"idlequest/internal/db/items"
+)
+
+// Reasons an accepted trade can't go through, told to both traders after the item or trader's
+// name
+var (
+	ErrNoDrop        = errors.New("is NO DROP and cannot be traded")
+	ErrNotInPacks    = errors.New("must be in a general slot or bag to be traded")
+	ErrBagNotEmpty   = errors.New("must be empty to be traded")
+	ErrItemMoved     = errors.New("is no longer where it was offered")
+	ErrLoreConflict  = errors.New("already has the lore item")
+	ErrNoRoom        = errors.New("does not have room for")
+	ErrNotEnoughCoin = errors.New("does not have the coin offered")
+)
+
+// Transfer is one item changing hands and where it goes in its new owner's inventory
+type Transfer struct {
+	FromCharID int64
+	ToCharID   int64
+	From       constants.InventoryKey
+	To         constants.InventoryKey
+	Item       *constants.ItemWithInstance
+}
+
+// Plan checks both offers against the traders' inventories and purses, in the order of
+// t.Traders, and finds each item a place with its new owner. Nothing is changed; the transfers
+// are committed together or not at all.
+func Plan(t *Trade, inventories [2]map[constants.InventoryKey]*constants.ItemWithInstance, purses [2]Coin) ([]Transfer, error) {
+	// What each side keeps: their inventory without what they're giving away
+	kept := [2]map[constants.InventoryKey]*constants.ItemWithInstance{}
+	for side, trader := range t.Traders {
+		if !trader.Offer.Coin.Within(purses[side]) {
+			return nil, fmt.Errorf("%s %w.", trader.Name, ErrNotEnoughCoin)
+		}
+		kept[side] = make(map[constants.InventoryKey]*constants.ItemWithInstance, len(inventories[side]))
+		for key, item := range inventories[side] {
+			kept[side][key] = item
+		}
+		for _, offered := range trader.Offer.Items {
+			if err := checkOffered(inventories[side], offered); err != nil {
+				return nil, err
+			}
+			delete(kept[side], offered.Key)
+		}
+	}
+
+	var transfers []Transfer
+	for side, trader := range t.Traders {
+		to := 1 - side
+		receiver := t.Traders[to]
+		for _, offered := range trader.Offer.Items {
+			item := inventories[side][offered.Key]
+			if item.IsLore() {
+				for _, held := range kept[to] {
+					if item.CheckLoreConflict(held) {
+						return nil, fmt.Errorf("%s %w %s.", receiver.Name, ErrLoreConflict, item.Item.Name)
+					}
+				}
+			}
+			bag, slot := items.FindFirstAvailableSlot(kept[to], item.IsContainer())
+			if slot == -1 {
+				return nil, fmt.Errorf("%s %w %s.", receiver.Name, ErrNoRoom, item.Item.Name)
+			}
+			key := constants.InventoryKey{Bag: bag, Slot: slot}
+			kept[to][key] = item
+			transfers = append(transfers, Transfer{
+				FromCharID: trader.CharID,
+				ToCharID:   receiver.CharID,
+				From:       offered.Key,
+				To:         key,
+				Item:       item,
+			})
+		}
+	}
+	return transfers, nil
+}
+
+// checkOffered checks that the offered item is still in place and can change hands
+func checkOffered(inventory map[constants.InventoryKey]*constants.ItemWithInstance, offered OfferedItem) error {
+	item := inventory[offered.Key]
+	if item == nil || item.ItemInstanceID != offered.InstanceID {
+		return fmt.Errorf("An offered item %w.", ErrItemMoved)
+	}
+	return CheckTradeable(inventory, offered.Key, item)
+}
+
+// CheckTradeable checks that the item in the inventory slot can be offered: it is in a general
+// slot or a bag, not NO DROP, and empty if it is a bag
+func CheckTradeable(inventory map[constants.InventoryKey]*constants.ItemWithInstance, key constants.InventoryKey, item *constants.ItemWithInstance) error {
+	inPacks := key.Bag > 0 || (key.Bag == 0 && key.Slot >= constants.SlotGeneral1 && key.Slot <= constants.SlotGeneral8)
+	if !inPacks {
+		return fmt.Errorf("%s %w.", item.Item.Name, ErrNotInPacks)
+	}
+	if item.Item.Nodrop == 0 {
+		return fmt.Errorf("%s %w.", item.Item.Name, ErrNoDrop)
+	}
+	if item.IsContainer() {
+		bagID := key.Slot + 1
+		for slot := int8(0); slot < int8(item.Item.Bagslots); slot++ {
+			if inventory[constants.InventoryKey{Bag: bagID, Slot: slot}] != nil {
+				return fmt.Errorf("%s %w.", item.Item.Name, ErrBagNotEmpty)
+			}
+		}
+	}
+	return nil
+}
+
+// Inventory is a trader's live inventory, as Apply changes it
+type Inventory interface {
+	DeleteItem(key constants.InventoryKey) *constants.ItemWithInstance
+	SetItem(key constants.InventoryKey, item *constants.ItemWithInstance)
+}
+
+// Apply moves committed transfers between the traders' live inventories, keyed by character
+// ID. Every item is taken out before any is put in, so an item received into the slot its new
+// owner just gave up isn't removed along with the item that left it.
+func Apply(transfers []Transfer, inventories map[int64]Inventory) {
+	for _, tr := range transfers {
+		inventories[tr.FromCharID].DeleteItem(tr.From)
+	}
+	for _, tr := range transfers {
+		owner := uint32(tr.ToCharID)
+		tr.Item.Instance.OwnerID = &owner
+		inventories[tr.ToCharID].SetItem(tr.To, tr.Item)
+	}
+}
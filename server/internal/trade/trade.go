@@ -0,0 +1,352 @@
+package trade
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"idlequest/internal/constants"
+	"idlequest/internal/session"
+)
+
+// MaxItems is the most items one side can offer in a trade
+const MaxItems = 8
+
+// requestTimeout is how long a trade request can be accepted for
+const requestTimeout = time.Minute
+
+// Errors returned to the character who tried the action, worded for the client
+var (
+	ErrTradeSelf       = errors.New("You cannot trade with yourself.")
+	ErrYouAreTrading   = errors.New("You are already trading.")
+	ErrBusy            = errors.New("That player is busy trading.")
+	ErrRequestPending  = errors.New("That player is considering another trade request.")
+	ErrNoRequest       = errors.New("You have not been asked to trade.")
+	ErrNotTrading      = errors.New("You are not trading.")
+	ErrAlreadyOffered  = errors.New("You have already offered that item.")
+	ErrNotOffered      = errors.New("You have not offered that item.")
+	ErrTooManyItems    = errors.New("You cannot offer any more items.")
+	ErrTradeCompleting = errors.New("The trade is already going through.")
+)
+
+// Coin is an amount of coin by denomination. Coin is traded as offered, without converting it.
+type Coin struct {
+	Platinum int
+	Gold     int
+	Silver   int
+	Copper   int
+}
+
+// IsZero reports whether there is no coin
+func (c Coin) IsZero() bool {
+	return c == Coin{}
+}
+
+// Within reports whether every denomination is no more than the purse holds
+func (c Coin) Within(purse Coin) bool {
+	return c.Platinum <= purse.Platinum && c.Gold <= purse.Gold && c.Silver <= purse.Silver && c.Copper <= purse.Copper
+}
+
+// OfferedItem is an item put up for trade, by where it sits in the trader's inventory. The
+// instance ID catches the item being moved or replaced after it was offered.
+type OfferedItem struct {
+	Key        constants.InventoryKey
+	InstanceID int32
+}
+
+// Offer is what one side of a trade puts up
+type Offer struct {
+	Items    []OfferedItem // In the order they were offered
+	Coin     Coin
+	Accepted bool
+}
+
+// Trader is one side of a trade
+type Trader struct {
+	CharID  int64
+	Name    string
+	Session *session.Session
+	Offer   Offer
+}
+
+// Trade is a snapshot of a trade window between two characters. Changes go through the
+// Manager; a snapshot doesn't see them.
+type Trade struct {
+	ID      int64
+	State   constants.TradeState
+	Traders [2]Trader // The requester first
+}
+
+// Sides returns the character's side of the trade and their partner's
+func (t *Trade) Sides(charID int64) (me, partner *Trader) {
+	if t.Traders[0].CharID == charID {
+		return &t.Traders[0], &t.Traders[1]
+	}
+	return &t.Traders[1], &t.Traders[0]
+}
+
+func (t *Trade) clone() *Trade {
+	c := *t
+	for i := range c.Traders {
+		c.Traders[i].Offer.Items = append([]OfferedItem(nil), t.Traders[i].Offer.Items...)
+	}
+	return &c
+}
+
+// request is a trade request waiting on its target
+type request struct {
+	from    Trader
+	expires time.Time
+}
+
+// Manager tracks trade requests and open trades. Trades live only in memory; nothing changes
+// hands until both sides accept and the exchange is committed.
+type Manager struct {
+	mu       sync.Mutex
+	nextID   int64
+	trades   map[int64]*Trade
+	byChar   map[int64]int64   // Character ID -> trade ID
+	requests map[int64]request // Target character ID -> request
+}
+
+var globalManager = NewManager()
+
+// GetManager returns the global trade manager
+func GetManager() *Manager {
+	return globalManager
+}
+
+// NewManager creates an empty trade manager
+func NewManager() *Manager {
+	return &Manager{
+		trades:   make(map[int64]*Trade),
+		byChar:   make(map[int64]int64),
+		requests: make(map[int64]request),
+	}
+}
+
+// Request asks the target to trade with the requester
+func (m *Manager) Request(from, to Trader, now time.Time) error {
+	if from.CharID == to.CharID {
+		return ErrTradeSelf
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.byChar[from.CharID]; ok {
+		return ErrYouAreTrading
+	}
+	if _, ok := m.byChar[to.CharID]; ok {
+		return ErrBusy
+	}
+	if req, ok := m.requests[to.CharID]; ok && now.Before(req.expires) && req.from.CharID != from.CharID {
+		return ErrRequestPending
+	}
+	from.Offer = Offer{}
+	m.requests[to.CharID] = request{from: from, expires: now.Add(requestTimeout)}
+	return nil
+}
+
+// Open accepts the character's trade request, opening a trade with the requester
+func (m *Manager) Open(target Trader, now time.Time) (*Trade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	req, ok := m.requests[target.CharID]
+	delete(m.requests, target.CharID)
+	if !ok || !now.Before(req.expires) {
+		return nil, ErrNoRequest
+	}
+	if _, ok := m.byChar[target.CharID]; ok {
+		return nil, ErrYouAreTrading
+	}
+	if _, ok := m.byChar[req.from.CharID]; ok {
+		return nil, ErrBusy
+	}
+
+	m.nextID++
+	target.Offer = Offer{}
+	t := &Trade{ID: m.nextID, State: constants.TradeState_Trading, Traders: [2]Trader{req.from, target}}
+	m.trades[t.ID] = t
+	m.byChar[req.from.CharID] = t.ID
+	m.byChar[target.CharID] = t.ID
+	return t.clone(), nil
+}
+
+// Decline turns down the character's trade request, returning who asked
+func (m *Manager) Decline(charID int64) (Trader, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	req, ok := m.requests[charID]
+	delete(m.requests, charID)
+	return req.from, ok
+}
+
+// Get returns a snapshot of the character's trade, or nil if they aren't trading
+func (m *Manager) Get(charID int64) *Trade {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if t := m.trade(charID); t != nil {
+		return t.clone()
+	}
+	return nil
+}
+
+// trade returns the character's trade, or nil. m.mu must be held.
+func (m *Manager) trade(charID int64) *Trade {
+	if id, ok := m.byChar[charID]; ok {
+		return m.trades[id]
+	}
+	return nil
+}
+
+// change applies fn to the character's side of their open trade. Any change to an offer takes
+// back both sides' acceptance, so nobody accepts terms they haven't seen.
+func (m *Manager) change(charID int64, fn func(offer *Offer) error) (*Trade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t := m.trade(charID)
+	if t == nil {
+		return nil, ErrNotTrading
+	}
+	if t.State == constants.TradeState_TradeCompleting {
+		return nil, ErrTradeCompleting
+	}
+	me, _ := t.Sides(charID)
+	if err := fn(&me.Offer); err != nil {
+		return nil, err
+	}
+	t.reopen()
+	return t.clone(), nil
+}
+
+// reopen takes back both sides' acceptance
+func (t *Trade) reopen() {
+	t.State = constants.TradeState_Trading
+	t.Traders[0].Offer.Accepted = false
+	t.Traders[1].Offer.Accepted = false
+}
+
+// AddItem puts an item in the character's offer
+func (m *Manager) AddItem(charID int64, item OfferedItem) (*Trade, error) {
+	return m.change(charID, func(offer *Offer) error {
+		for _, offered := range offer.Items {
+			if offered.Key == item.Key {
+				return ErrAlreadyOffered
+			}
+		}
+		if len(offer.Items) >= MaxItems {
+			return ErrTooManyItems
+		}
+		offer.Items = append(offer.Items, item)
+		return nil
+	})
+}
+
+// RemoveItem takes the item in the inventory slot out of the character's offer
+func (m *Manager) RemoveItem(charID int64, key constants.InventoryKey) (*Trade, error) {
+	return m.change(charID, func(offer *Offer) error {
+		for i, offered := range offer.Items {
+			if offered.Key == key {
+				offer.Items = append(offer.Items[:i], offer.Items[i+1:]...)
+				return nil
+			}
+		}
+		return ErrNotOffered
+	})
+}
+
+// SetCoin sets the coin in the character's offer
+func (m *Manager) SetCoin(charID int64, coin Coin) (*Trade, error) {
+	return m.change(charID, func(offer *Offer) error {
+		offer.Coin = coin
+		return nil
+	})
+}
+
+// Accept accepts the trade as it stands for the character. Once both sides have accepted, the
+// trade moves to completing and ready is true: the caller commits the exchange, then calls
+// Finish, or Reopen if it failed.
+func (m *Manager) Accept(charID int64) (t *Trade, ready bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	open := m.trade(charID)
+	if open == nil {
+		return nil, false, ErrNotTrading
+	}
+	if open.State == constants.TradeState_TradeCompleting {
+		return nil, false, ErrTradeCompleting
+	}
+	me, partner := open.Sides(charID)
+	me.Offer.Accepted = true
+	open.State = constants.TradeState_TradeAccepted
+	if partner.Offer.Accepted {
+		open.State = constants.TradeState_TradeCompleting
+		ready = true
+	}
+	return open.clone(), ready, nil
+}
+
+// Reopen returns a trade whose exchange failed to trading, with neither side accepted
+func (m *Manager) Reopen(tradeID int64) *Trade {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.trades[tradeID]
+	if !ok {
+		return nil
+	}
+	t.reopen()
+	return t.clone()
+}
+
+// Finish closes a trade once its exchange is committed
+func (m *Manager) Finish(tradeID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.remove(tradeID)
+}
+
+// Cancel closes the character's trade without an exchange, returning it. A trade that is
+// completing can't be cancelled.
+func (m *Manager) Cancel(charID int64) (*Trade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t := m.trade(charID)
+	if t == nil {
+		return nil, ErrNotTrading
+	}
+	if t.State == constants.TradeState_TradeCompleting {
+		return nil, ErrTradeCompleting
+	}
+	m.remove(t.ID)
+	return t.clone(), nil
+}
+
+// Forget drops a character leaving the world: their trade is cancelled and requests to or from
+// them are dropped. It returns the cancelled trade, if any.
+func (m *Manager) Forget(charID int64) *Trade {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.requests, charID)
+	for target, req := range m.requests {
+		if req.from.CharID == charID {
+			delete(m.requests, target)
+		}
+	}
+	t := m.trade(charID)
+	if t == nil || t.State == constants.TradeState_TradeCompleting {
+		return nil
+	}
+	m.remove(t.ID)
+	return t.clone()
+}
+
+// remove closes a trade. m.mu must be held.
+func (m *Manager) remove(tradeID int64) {
+	t, ok := m.trades[tradeID]
+	if !ok {
+		return
+	}
+	delete(m.trades, tradeID)
+	for _, trader := range t.Traders {
+		delete(m.byChar, trader.CharID)
+	}
+}
@@ -0,0 +1,223 @@
+package trade
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"idlequest/internal/constants"
+	"idlequest/internal/db/jetgen/eqgo/model"
+)
+
+func TestTradeWindow(t *testing.T) {
+	m := NewManager()
+	now := time.Now()
+	alice := Trader{CharID: 1, Name: "Alice"}
+	bob := Trader{CharID: 2, Name: "Bob"}
+
+	if err := m.Request(alice, alice, now); !errors.Is(err, ErrTradeSelf) {
+		t.Errorf("Expected ErrTradeSelf, got %v", err)
+	}
+	if _, err := m.Open(bob, now); !errors.Is(err, ErrNoRequest) {
+		t.Errorf("Expected ErrNoRequest without a request, got %v", err)
+	}
+	if err := m.Request(alice, bob, now); err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	if err := m.Request(Trader{CharID: 3, Name: "Carol"}, bob, now); !errors.Is(err, ErrRequestPending) {
+		t.Errorf("Expected ErrRequestPending for a second requester, got %v", err)
+	}
+	tr, err := m.Open(bob, now)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if tr.State != constants.TradeState_Trading || tr.Traders[0].CharID != 1 || tr.Traders[1].CharID != 2 {
+		t.Errorf("Expected Alice and Bob trading, got %+v", tr)
+	}
+	if err := m.Request(Trader{CharID: 3, Name: "Carol"}, alice, now); !errors.Is(err, ErrBusy) {
+		t.Errorf("Expected ErrBusy for a character already trading, got %v", err)
+	}
+
+	// Both sides accept, but any change to an offer takes both acceptances back
+	sword := OfferedItem{Key: constants.InventoryKey{Slot: constants.SlotGeneral1}, InstanceID: 10}
+	if _, err := m.AddItem(1, sword); err != nil {
+		t.Fatalf("AddItem failed: %v", err)
+	}
+	if _, err := m.AddItem(1, sword); !errors.Is(err, ErrAlreadyOffered) {
+		t.Errorf("Expected ErrAlreadyOffered, got %v", err)
+	}
+	if _, ready, err := m.Accept(1); err != nil || ready {
+		t.Fatalf("Expected Alice's accept to wait on Bob, got ready %v, err %v", ready, err)
+	}
+	tr, err = m.SetCoin(2, Coin{Gold: 5})
+	if err != nil {
+		t.Fatalf("SetCoin failed: %v", err)
+	}
+	if tr.Traders[0].Offer.Accepted || tr.State != constants.TradeState_Trading {
+		t.Errorf("Expected Bob's new offer to take back Alice's accept, got %+v", tr)
+	}
+	m.Accept(2)
+	tr, ready, err := m.Accept(1)
+	if err != nil || !ready || tr.State != constants.TradeState_TradeCompleting {
+		t.Fatalf("Expected the trade to be completing, got %+v, ready %v, err %v", tr, ready, err)
+	}
+	if tr.Traders[0].Offer.Items[0] != sword || tr.Traders[1].Offer.Coin.Gold != 5 {
+		t.Errorf("Expected the offers as accepted, got %+v", tr)
+	}
+
+	// A completing trade is locked until it finishes or reopens
+	if _, err := m.RemoveItem(1, sword.Key); !errors.Is(err, ErrTradeCompleting) {
+		t.Errorf("Expected ErrTradeCompleting, got %v", err)
+	}
+	if _, err := m.Cancel(2); !errors.Is(err, ErrTradeCompleting) {
+		t.Errorf("Expected a completing trade not to cancel, got %v", err)
+	}
+	tr = m.Reopen(tr.ID)
+	if tr.State != constants.TradeState_Trading || tr.Traders[0].Offer.Accepted || tr.Traders[1].Offer.Accepted {
+		t.Errorf("Expected a reopened trade with no accepts, got %+v", tr)
+	}
+	if _, err := m.Cancel(2); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+	if m.Get(1) != nil || m.Get(2) != nil {
+		t.Error("Expected the trade to be closed")
+	}
+}
+
+// inventoryItem makes a tradeable item with the instance ID
+func inventoryItem(instanceID int32, name string) *constants.ItemWithInstance {
+	return &constants.ItemWithInstance{
+		Item:           model.Items{ID: instanceID, Name: name, Nodrop: 1},
+		ItemInstanceID: instanceID,
+	}
+}
+
+func TestPlan(t *testing.T) {
+	general := func(n int8) constants.InventoryKey {
+		return constants.InventoryKey{Slot: constants.SlotGeneral1 + n}
+	}
+	sword := inventoryItem(10, "Sword")
+	sword.Item.Loregroup = -1
+	aliceInv := map[constants.InventoryKey]*constants.ItemWithInstance{general(0): sword, general(1): inventoryItem(11, "Bread")}
+	bobInv := map[constants.InventoryKey]*constants.ItemWithInstance{general(0): inventoryItem(20, "Shield")}
+	tr := &Trade{Traders: [2]Trader{
+		{CharID: 1, Name: "Alice", Offer: Offer{Items: []OfferedItem{{Key: general(0), InstanceID: 10}}}},
+		{CharID: 2, Name: "Bob", Offer: Offer{Items: []OfferedItem{{Key: general(0), InstanceID: 20}}, Coin: Coin{Gold: 3}}},
+	}}
+	purses := [2]Coin{{}, {Gold: 3}}
+
+	transfers, err := Plan(tr, [2]map[constants.InventoryKey]*constants.ItemWithInstance{aliceInv, bobInv}, purses)
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if len(transfers) != 2 || transfers[0].ToCharID != 2 || transfers[0].To != general(0) ||
+		transfers[1].ToCharID != 1 || transfers[1].To != general(0) {
+		t.Errorf("Expected each item in the slot the other gave up, got %+v", transfers)
+	}
+
+	tests := []struct {
+		name  string
+		setup func(alice, bob map[constants.InventoryKey]*constants.ItemWithInstance, purses *[2]Coin)
+		want  error
+	}{
+		{"Coin short", func(_, _ map[constants.InventoryKey]*constants.ItemWithInstance, purses *[2]Coin) {
+			purses[1].Gold = 2
+		}, ErrNotEnoughCoin},
+		{"NO DROP", func(alice, _ map[constants.InventoryKey]*constants.ItemWithInstance, _ *[2]Coin) {
+			alice[general(0)].Item.Nodrop = 0
+		}, ErrNoDrop},
+		{"Item moved", func(alice, _ map[constants.InventoryKey]*constants.ItemWithInstance, _ *[2]Coin) {
+			alice[general(0)] = inventoryItem(12, "Other")
+		}, ErrItemMoved},
+		{"Lore conflict", func(_, bob map[constants.InventoryKey]*constants.ItemWithInstance, _ *[2]Coin) {
+			other := inventoryItem(21, "Sword")
+			other.Item.ID = 10
+			other.Item.Loregroup = -1
+			bob[general(5)] = other
+		}, ErrLoreConflict},
+		{"No room", func(_, bob map[constants.InventoryKey]*constants.ItemWithInstance, _ *[2]Coin) {
+			// Bob keeps his shield and his packs are full
+			for n := int8(1); n < 8; n++ {
+				bob[general(n)] = inventoryItem(30+int32(n), "Rock")
+			}
+			tr.Traders[1].Offer.Items = nil
+		}, ErrNoRoom},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alice := map[constants.InventoryKey]*constants.ItemWithInstance{}
+			for k, v := range aliceInv {
+				c := *v
+				alice[k] = &c
+			}
+			bob := map[constants.InventoryKey]*constants.ItemWithInstance{}
+			for k, v := range bobInv {
+				c := *v
+				bob[k] = &c
+			}
+			p := purses
+			saved := tr.Traders[1].Offer.Items
+			defer func() { tr.Traders[1].Offer.Items = saved }()
+			tt.setup(alice, bob, &p)
+			if _, err := Plan(tr, [2]map[constants.InventoryKey]*constants.ItemWithInstance{alice, bob}, p); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestCheckTradeable(t *testing.T) {
+	bag := inventoryItem(1, "Backpack")
+	bag.Item.Bagslots = 4
+	bagKey := constants.InventoryKey{Slot: constants.SlotGeneral1}
+	inv := map[constants.InventoryKey]*constants.ItemWithInstance{bagKey: bag}
+	if err := CheckTradeable(inv, bagKey, bag); err != nil {
+		t.Errorf("Expected an empty bag to be tradeable, got %v", err)
+	}
+	inv[constants.InventoryKey{Bag: constants.SlotGeneral1 + 1, Slot: 2}] = inventoryItem(2, "Bread")
+	if err := CheckTradeable(inv, bagKey, bag); !errors.Is(err, ErrBagNotEmpty) {
+		t.Errorf("Expected ErrBagNotEmpty, got %v", err)
+	}
+	ring := inventoryItem(4, "Ring")
+	ring.Item.Nodrop = 0
+	ring.Item.Fvnodrop = 1
+	if err := CheckTradeable(inv, constants.InventoryKey{Slot: constants.SlotGeneral2}, ring); !errors.Is(err, ErrNoDrop) {
+		t.Errorf("Expected ErrNoDrop for a NO DROP item with the FV flag, got %v", err)
+	}
+	helm := inventoryItem(3, "Helm")
+	if err := CheckTradeable(inv, constants.InventoryKey{Slot: constants.SlotHead}, helm); !errors.Is(err, ErrNotInPacks) {
+		t.Errorf("Expected ErrNotInPacks for an equipped item, got %v", err)
+	}
+}
+
+// testInventory is a live inventory for Apply
+type testInventory map[constants.InventoryKey]*constants.ItemWithInstance
+
+func (inv testInventory) DeleteItem(key constants.InventoryKey) *constants.ItemWithInstance {
+	item := inv[key]
+	delete(inv, key)
+	return item
+}
+
+func (inv testInventory) SetItem(key constants.InventoryKey, item *constants.ItemWithInstance) {
+	inv[key] = item
+}
+
+func TestApplySwap(t *testing.T) {
+	slot := constants.InventoryKey{Slot: constants.SlotGeneral1}
+	sword, shield := inventoryItem(10, "Sword"), inventoryItem(20, "Shield")
+	alice, bob := testInventory{slot: sword}, testInventory{slot: shield}
+
+	// Each item goes to the slot the other side gave up
+	Apply([]Transfer{
+		{FromCharID: 1, ToCharID: 2, From: slot, To: slot, Item: sword},
+		{FromCharID: 2, ToCharID: 1, From: slot, To: slot, Item: shield},
+	}, map[int64]Inventory{1: alice, 2: bob})
+
+	if alice[slot] != shield || bob[slot] != sword {
+		t.Fatalf("Expected the items swapped, Alice has %v and Bob has %v", alice[slot], bob[slot])
+	}
+	if *sword.Instance.OwnerID != 2 || *shield.Instance.OwnerID != 1 {
+		t.Errorf("Expected each item owned by its receiver, got %d and %d", *sword.Instance.OwnerID, *shield.Instance.OwnerID)
+	}
+}
@@ -0,0 +1,103 @@
+package who
+
+import (
+	"sort"
+	"strings"
+)
+
+// Anon flags, as stored in character_data.anon
+const (
+	AnonNone      = 0
+	AnonAnonymous = 1 // Only the name is shown
+	AnonRoleplay  = 2 // The name, race and guild are shown
+)
+
+// MaxResults is the most players one who query returns
+const MaxResults = 100
+
+// Entry is one player online, before their anon flag hides anything
+type Entry struct {
+	Name   string `json:"name"`
+	Level  int32  `json:"level"`
+	Class  int32  `json:"class"`
+	Race   int32  `json:"race"`
+	ZoneID int32  `json:"zone_id"`
+	Guild  string `json:"guild"`
+	Anon   int32  `json:"anon"`
+}
+
+// Query picks which players a who lists. Zero fields match everyone.
+type Query struct {
+	Name     string // Part of the name
+	ZoneID   int32
+	MinLevel int32
+	MaxLevel int32
+	Class    int32
+	Race     int32
+	Guild    string
+}
+
+// Result is the players a who found, sorted by name. Total counts every match, even those past
+// MaxResults.
+type Result struct {
+	Players []Entry `json:"players"`
+	Total   int     `json:"total"`
+}
+
+// Visible returns the entry as other players see it, with what its anon flag hides zeroed
+func (e Entry) Visible() Entry {
+	switch e.Anon {
+	case AnonAnonymous:
+		return Entry{Name: e.Name, Anon: e.Anon}
+	case AnonRoleplay:
+		return Entry{Name: e.Name, Race: e.Race, Guild: e.Guild, Anon: e.Anon}
+	}
+	return e
+}
+
+// Matches reports whether the query finds the entry. Only what the entry shows can match, so
+// anonymous players can't be found by their level, class, zone or guild.
+func (q Query) Matches(e Entry) bool {
+	v := e.Visible()
+	if q.Name != "" && !strings.Contains(strings.ToLower(v.Name), strings.ToLower(q.Name)) {
+		return false
+	}
+	if q.ZoneID != 0 && v.ZoneID != q.ZoneID {
+		return false
+	}
+	if q.MinLevel != 0 && v.Level < q.MinLevel {
+		return false
+	}
+	if q.MaxLevel != 0 && (v.Level == 0 || v.Level > q.MaxLevel) {
+		return false
+	}
+	if q.Class != 0 && v.Class != q.Class {
+		return false
+	}
+	if q.Race != 0 && v.Race != q.Race {
+		return false
+	}
+	if q.Guild != "" && !strings.EqualFold(v.Guild, q.Guild) {
+		return false
+	}
+	return true
+}
+
+// Find runs the query over the players online, returning what each shows
+func Find(entries []Entry, q Query) Result {
+	var found []Entry
+	for _, e := range entries {
+		if q.Matches(e) {
+			found = append(found, e.Visible())
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Name < found[j].Name })
+	result := Result{Players: found, Total: len(found)}
+	if len(found) > MaxResults {
+		result.Players = found[:MaxResults]
+	}
+	if result.Players == nil {
+		result.Players = []Entry{}
+	}
+	return result
+}
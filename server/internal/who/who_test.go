@@ -0,0 +1,55 @@
+package who
+
+import "testing"
+
+func TestFind(t *testing.T) {
+	entries := []Entry{
+		{Name: "Zed", Level: 20, Class: 1, Race: 1, ZoneID: 2, Guild: "Knights"},
+		{Name: "Alice", Level: 10, Class: 2, Race: 2, ZoneID: 2, Guild: "knights"},
+		{Name: "Shade", Level: 30, Class: 1, Race: 3, ZoneID: 2, Guild: "Knights", Anon: AnonAnonymous},
+		{Name: "Bard", Level: 15, Class: 8, Race: 1, ZoneID: 3, Guild: "Knights", Anon: AnonRoleplay},
+	}
+
+	all := Find(entries, Query{})
+	if all.Total != 4 || all.Players[0].Name != "Alice" || all.Players[3].Name != "Zed" {
+		t.Fatalf("Expected everyone sorted by name, got %+v", all)
+	}
+	for _, p := range all.Players {
+		if p.Name == "Shade" && (p.Level != 0 || p.Guild != "" || p.ZoneID != 0) {
+			t.Errorf("Expected an anonymous player to show only their name, got %+v", p)
+		}
+		if p.Name == "Bard" && (p.Level != 0 || p.ZoneID != 0 || p.Guild != "Knights" || p.Race != 1) {
+			t.Errorf("Expected a roleplayer to show their race and guild only, got %+v", p)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  int
+	}{
+		{"zone", Query{ZoneID: 2}, 2},
+		{"levels", Query{MinLevel: 12, MaxLevel: 25}, 1},
+		{"max level skips hidden levels", Query{MaxLevel: 40}, 2},
+		{"class", Query{Class: 1}, 1},
+		{"race shown by roleplayers", Query{Race: 1}, 2},
+		{"guild", Query{Guild: "KNIGHTS"}, 3},
+		{"name", Query{Name: "ha"}, 1},
+	}
+	for _, tt := range tests {
+		if got := Find(entries, tt.query); got.Total != tt.want {
+			t.Errorf("%s: expected %d players, got %+v", tt.name, tt.want, got.Players)
+		}
+	}
+}
+
+func TestFindCapsResults(t *testing.T) {
+	entries := make([]Entry, MaxResults+10)
+	for i := range entries {
+		entries[i] = Entry{Name: string(rune('A' + i%26)), Level: 1}
+	}
+	got := Find(entries, Query{})
+	if got.Total != MaxResults+10 || len(got.Players) != MaxResults {
+		t.Errorf("Expected %d of %d players, got %d of %d", MaxResults, MaxResults+10, len(got.Players), got.Total)
+	}
+}
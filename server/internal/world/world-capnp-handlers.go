@@ -10,12 +10,14 @@ import (
 	eq "idlequest/internal/api/capnp"
 	"idlequest/internal/api/opcodes"
 	db_character "idlequest/internal/db/character"
+	db_faction "idlequest/internal/db/faction"
 	"idlequest/internal/db/items"
 	"idlequest/internal/db/jetgen/eqgo/model"
 	"idlequest/internal/db/spells"
 	"idlequest/internal/db/tradeskill"
 	db_zone "idlequest/internal/db/zone"
 	"idlequest/internal/dialogue"
+	"idlequest/internal/mechanics"
 	"idlequest/internal/session"
 	"idlequest/internal/staticdata"
 
@@ -456,6 +458,7 @@ func HandleGetZoneNPCsRequest(ses *session.Session, payload []byte, wh *WorldHan
 			// Don't return error - we still want to send a response
 			return nil
 		}
+		hasClient := ses.HasValidClient()
 		i := 0
 		for _, npc := range npcMap {
 			if i >= int(npcCount) {
@@ -469,6 +472,12 @@ func HandleGetZoneNPCsRequest(ses *session.Session, payload []byte, wh *WorldHan
 			npcData.SetClass(int32(npc.Class))
 			npcData.SetHp(int32(npc.Hp))
 			npcData.SetGender(int32(npc.Gender))
+			// Con info is relative to the requesting character
+			if hasClient {
+				playerLevel := int(ses.Client.CharData().Level)
+				npcData.SetConColor(mechanics.GetConColor(playerLevel, int(npc.Level)).String())
+				npcData.SetDangerRating(estimateNPCDanger(ses, npc).Rating)
+			}
 			i++
 		}
 		resp.SetNpcs(npcList)
@@ -488,6 +497,109 @@ func HandleGetZoneNPCsRequest(ses *session.Session, payload []byte, wh *WorldHan
 	return false
 }
 
+// HandleConsider handles the Consider opcode. The request's targetid is the NPC type ID
+// of an NPC from the player's current zone list.
+func HandleConsider(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		log.Printf("No client or character data for Consider")
+		return false
+	}
+
+	req, err := session.Deserialize(ses, payload, eq.ReadRootConsider)
+	if err != nil {
+		log.Printf("Failed to read Consider: %v", err)
+		sendConsiderError(ses, "Failed to parse request")
+		return false
+	}
+	npcID := req.Targetid()
+
+	charData := ses.Client.CharData()
+	zone, err := db_zone.GetZoneById(context.Background(), int(charData.ZoneID))
+	if err != nil || zone == nil || zone.ShortName == nil {
+		log.Printf("Consider: could not get zone name for zone ID %d: %v", charData.ZoneID, err)
+		sendConsiderError(ses, "Unknown zone")
+		return false
+	}
+
+	npc := findZoneNPC(*zone.ShortName, npcID)
+	if npc == nil {
+		sendConsiderError(ses, "That NPC is not in your zone")
+		return false
+	}
+
+	// NPCs without a primary faction con indifferently
+	factionValue := 0
+	primaryFaction, err := db_faction.GetNPCPrimaryFaction(context.Background(), npc.NpcFactionID)
+	if err != nil {
+		log.Printf("Consider: failed to get primary faction for NPC %d: %v", npc.ID, err)
+	} else if primaryFaction > 0 {
+		factionValue, err = db_faction.GetCharacterFactionValue(
+			context.Background(),
+			int32(charData.ID),
+			int(charData.Race),
+			int(charData.Class),
+			int(charData.Deity),
+			primaryFaction,
+		)
+		if err != nil {
+			log.Printf("Consider: failed to get faction %d for %s: %v", primaryFaction, charData.Name, err)
+		}
+	}
+
+	conColor := mechanics.GetConColor(int(charData.Level), int(npc.Level))
+	danger := estimateNPCDanger(ses, npc)
+
+	session.QueueMessage(ses, eq.NewRootConsiderResponse, opcodes.ConsiderResponse, func(resp eq.ConsiderResponse) error {
+		resp.SetSuccess(1)
+		resp.SetNpcId(npc.ID)
+		resp.SetNpcName(npc.Name)
+		resp.SetLevel(int32(npc.Level))
+		resp.SetConColor(conColor.String())
+		resp.SetFactionValue(int32(factionValue))
+		resp.SetFactionStanding(mechanics.GetFactionStanding(factionValue))
+		resp.SetDangerRating(danger.Rating)
+		resp.SetPlayerRoundsToKill(float32(danger.PlayerRoundsToKill))
+		resp.SetNpcRoundsToKill(float32(danger.NPCRoundsToKill))
+		return nil
+	})
+	return false
+}
+
+func sendConsiderError(ses *session.Session, errMsg string) {
+	session.QueueMessage(ses, eq.NewRootConsiderResponse, opcodes.ConsiderResponse, func(resp eq.ConsiderResponse) error {
+		resp.SetSuccess(0)
+		resp.SetError(errMsg)
+		return nil
+	})
+}
+
+// findZoneNPC returns the npc_types row for npcID if it spawns in the zone, or nil
+func findZoneNPC(zoneShortName string, npcID int32) *model.NpcTypes {
+	spawnPool, err := db_zone.GetZoneSpawnPool(zoneShortName)
+	if err != nil {
+		log.Printf("GetZoneSpawnPool error for %s: %v", zoneShortName, err)
+		return nil
+	}
+	for _, entry := range spawnPool {
+		for _, spawnEntry := range entry.SpawnEntries {
+			if spawnEntry.NPCType != nil && spawnEntry.NPCType.ID == npcID {
+				return spawnEntry.NPCType
+			}
+		}
+	}
+	return nil
+}
+
+// estimateNPCDanger rates a fight against npc for the session's character
+func estimateNPCDanger(ses *session.Session, npc *model.NpcTypes) mechanics.DangerEstimate {
+	charData := ses.Client.CharData()
+	playerAC := mechanics.CalculatePlayerAC(int(charData.Level), int(charData.Race), ses.Client.GetEquippedAC())
+	return mechanics.EstimateDanger(
+		int(charData.Level), int(charData.Str), playerAC, ses.Client.GetMaxHp(),
+		int(npc.Level), npc.Hp, int(npc.Ac), int(npc.Mindmg), int(npc.Maxdmg),
+	)
+}
+
 // HandleGetAdjacentZonesRequest handles GetAdjacentZonesRequest Cap'n Proto messages
 func HandleGetAdjacentZonesRequest(ses *session.Session, payload []byte, wh *WorldHandler) bool {
 	req, err := session.Deserialize(ses, payload, eq.ReadRootGetAdjacentZonesRequest)
@@ -592,29 +704,8 @@ func HandleSendChatMessage(ses *session.Session, payload []byte, wh *WorldHandle
 
 	text, _ := req.Text()
 	messageType, _ := req.MessageType()
-
-	// Get sender name from session
-	senderName := "Unknown"
-	if ses.Client != nil && ses.Client.CharData() != nil {
-		senderName = ses.Client.CharData().Name
-	}
-
-	log.Printf("[Chat] %s: %s (type: %s)", senderName, text, messageType)
-
-	// Broadcast to all authenticated sessions
-	sm := session.GetSessionManager()
-	sm.ForEachSession(func(targetSes *session.Session) {
-		if !targetSes.Authenticated {
-			return
-		}
-		session.QueueMessage(targetSes, eq.NewRootChatMessageCapnp, opcodes.ChatMessageBroadcast, func(resp eq.ChatMessageCapnp) error {
-			resp.SetSenderName(senderName)
-			resp.SetText(text)
-			resp.SetMessageType(messageType)
-			return nil
-		})
-	})
-
+	target, _ := req.Target()
+	routeChat(ses, wh, messageType, target, text)
 	return false
 }
 
@@ -0,0 +1,232 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	"idlequest/internal/chat"
+	db_chat "idlequest/internal/db/chat"
+	db_guild "idlequest/internal/db/guild"
+	"idlequest/internal/group"
+	"idlequest/internal/session"
+)
+
+// ChatChannelAction actions, sent in ChatChannelRequest.action
+const (
+	chatChannelJoin  = 1 // name, password
+	chatChannelLeave = 2 // name
+	chatChannelList  = 3
+)
+
+// chatLine is one player's chat message as it is delivered
+type chatLine struct {
+	sender      string
+	guildName   string // The sender's guild
+	text        string
+	messageType string
+	channel     string // The user channel, or for tells the other character
+}
+
+// queueChatLine sends a chat message to one session, unless its character is ignoring the sender
+func queueChatLine(ses *session.Session, line chatLine) {
+	if chat.GetModeration().Ignores(int64(ses.Client.CharData().ID), line.sender) {
+		return
+	}
+	session.QueueMessage(ses, eq.NewRootChatMessageCapnp, opcodes.ChatMessageBroadcast, func(resp eq.ChatMessageCapnp) error {
+		resp.SetSenderName(line.sender)
+		resp.SetGuildName(line.guildName)
+		resp.SetText(line.text)
+		resp.SetMessageType(line.messageType)
+		resp.SetChannel(line.channel)
+		return nil
+	})
+}
+
+// routeChat delivers a player's chat message to whoever its channel reaches: their zone, every
+// zone, one character, their group, raid or guild, or a user channel. target names the
+// character for a tell or the user channel. Muted and spamming players are refused, and
+// filtered words are masked.
+func routeChat(ses *session.Session, wh *WorldHandler, messageType, target, text string) {
+	if !ses.HasValidClient() {
+		return
+	}
+	charData := ses.Client.CharData()
+	scope, ok := chat.ScopeOf(messageType)
+	if !ok {
+		SendSystemMessage(ses, chat.ErrUnknownChannel.Error())
+		return
+	}
+	log.Printf("[Chat] %s: %s (type: %s)", charData.Name, text, messageType)
+	text, ok = moderateChat(ses, messageType, text)
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	line := chatLine{sender: charData.Name, text: text, messageType: messageType}
+	if m, err := db_guild.GetMembership(ctx, int32(charData.ID)); err == nil {
+		line.guildName = m.GuildName
+	}
+
+	switch scope {
+	case chat.ScopeZone:
+		if ses.ZoneID == -1 {
+			SendSystemMessage(ses, "You are not in a zone.")
+			return
+		}
+		forEachInWorld(func(s *session.Session) {
+			if s.ZoneID == ses.ZoneID {
+				queueChatLine(s, line)
+			}
+		})
+	case chat.ScopeServer:
+		forEachInWorld(func(s *session.Session) {
+			queueChatLine(s, line)
+		})
+	case chat.ScopeTell:
+		sendTell(ses, wh, target, line)
+	case chat.ScopeGroup:
+		grp := group.GetManager().Get(int64(charData.ID))
+		if grp == nil {
+			SendSystemMessage(ses, group.ErrNotGrouped.Error())
+			return
+		}
+		for _, m := range grp.Members {
+			if m.Session.HasValidClient() {
+				queueChatLine(m.Session, line)
+			}
+		}
+	case chat.ScopeRaid:
+		sendRaidChat(ses, line)
+	case chat.ScopeGuild:
+		sendGuildChat(ctx, ses, line)
+	case chat.ScopeUser:
+		name, members, ok := chat.GetChannels().Members(int64(charData.ID), target)
+		if !ok {
+			SendSystemMessage(ses, chat.ErrNotInChannel.Error())
+			return
+		}
+		line.channel = name
+		for _, id := range members {
+			if s, ok := findCharacterSession(id); ok {
+				queueChatLine(s, line)
+			}
+		}
+	}
+}
+
+// forEachInWorld calls fn with every session playing a character
+func forEachInWorld(fn func(*session.Session)) {
+	session.GetSessionManager().ForEachSession(func(s *session.Session) {
+		if s.Authenticated && s.HasValidClient() {
+			fn(s)
+		}
+	})
+}
+
+// findCharacterSession returns the session playing the character
+func findCharacterSession(charID int64) (*session.Session, bool) {
+	var found *session.Session
+	forEachInWorld(func(s *session.Session) {
+		if int64(s.Client.CharData().ID) == charID {
+			found = s
+		}
+	})
+	return found, found != nil
+}
+
+// sendTell delivers a private message to the character named, echoing it back to the sender
+// with the recipient in channel
+func sendTell(ses *session.Session, wh *WorldHandler, targetName string, line chatLine) {
+	target, ok := wh.sessionManager.FindByCharacterName(targetName)
+	if !ok || !target.HasValidClient() {
+		SendSystemMessage(ses, fmt.Sprintf("%s is not online.", targetName))
+		return
+	}
+	recipient := target.Client.CharData().Name
+	line.channel = ses.Client.CharData().Name
+	queueChatLine(target, line)
+	if target != ses {
+		line.channel = recipient
+		queueChatLine(ses, line)
+	}
+}
+
+// HandleChatChannelAction joins, leaves or lists user chat channels. Joining a channel that
+// doesn't exist creates it, owned by the character, with the password given.
+func HandleChatChannelAction(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootChatChannelRequest)
+	if err != nil {
+		log.Printf("failed to read ChatChannelRequest struct: %v", err)
+		return false
+	}
+	name, _ := req.Name()
+	password, _ := req.Password()
+	charData := ses.Client.CharData()
+	channels := chat.GetChannels()
+
+	switch req.Action() {
+	case chatChannelJoin:
+		if err := chat.ValidateChannelName(name); err != nil {
+			SendSystemMessage(ses, err.Error())
+			return false
+		}
+		ctx := context.Background()
+		channel, err := db_chat.GetChannel(ctx, name)
+		if err != nil {
+			log.Printf("failed to load chat channel %q: %v", name, err)
+			return false
+		}
+		if channel == nil {
+			if err := db_chat.CreateChannel(ctx, name, charData.Name, password); err != nil {
+				log.Printf("failed to create chat channel %q: %v", name, err)
+				return false
+			}
+		} else {
+			if channel.Password != password {
+				SendSystemMessage(ses, chat.ErrWrongPassword.Error())
+				return false
+			}
+			name = channel.Name
+		}
+		if err := channels.Join(int64(charData.ID), name); err != nil {
+			SendSystemMessage(ses, err.Error())
+			return false
+		}
+		SendSystemMessage(ses, fmt.Sprintf("You have joined %s.", name))
+	case chatChannelLeave:
+		if err := channels.Leave(int64(charData.ID), name); err != nil {
+			SendSystemMessage(ses, err.Error())
+			return false
+		}
+		SendSystemMessage(ses, fmt.Sprintf("You have left %s.", name))
+	case chatChannelList:
+	default:
+		return false
+	}
+	sendChatChannels(ses)
+	return false
+}
+
+// sendChatChannels sends the user channels the character is in
+func sendChatChannels(ses *session.Session) {
+	joined := chat.GetChannels().Joined(int64(ses.Client.CharData().ID))
+	session.QueueMessage(ses, eq.NewRootChatChannelList, opcodes.ChatChannelList, func(msg eq.ChatChannelList) error {
+		list, err := msg.NewChannels(int32(len(joined)))
+		if err != nil {
+			return err
+		}
+		for i, name := range joined {
+			if err := list.Set(i, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
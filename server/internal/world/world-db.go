@@ -16,6 +16,7 @@ import (
 	"idlequest/internal/db"
 
 	db_character "idlequest/internal/db/character"
+	db_guild "idlequest/internal/db/guild"
 	"idlequest/internal/db/items"
 	"idlequest/internal/db/jetgen/eqgo/model"
 	"idlequest/internal/db/jetgen/eqgo/table"
@@ -231,6 +232,16 @@ func GetCharSelectInfo(ses *session.Session, ctx context.Context, accountID int6
 		return eq.CharacterSelect{}, fmt.Errorf("get character select message: %w", err)
 	}
 	info.SetCharacters(characters)
+
+	charIDs := make([]int32, len(chars))
+	for i, c := range chars {
+		charIDs[i] = int32(c.ID)
+	}
+	guildNames, err := db_guild.GetGuildNames(ctx, charIDs)
+	if err != nil {
+		log.Printf("GetCharSelectInfo: failed to load guild names: %v", err)
+	}
+
 	for i, c := range chars {
 		characterSelectEntry := characters.At(i)
 		characterSelectEntry.SetName(c.Name)
@@ -245,6 +256,7 @@ func GetCharSelectInfo(ses *session.Session, ctx context.Context, accountID int6
 		characterSelectEntry.SetEnabled(1)
 		characterSelectEntry.SetId(int32(c.ID))
 		characterSelectEntry.SetExp(int32(c.Exp))
+		characterSelectEntry.SetGuildName(guildNames[int32(c.ID)])
 		// Clamp HP to max before sending (using EQ formula)
 		maxHP := mechanics.CalculateMaxHPFromStats(int(c.Level), int(c.Sta), int(c.Class))
 		curHp := int(c.CurHp)
@@ -0,0 +1,168 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	db_friends "idlequest/internal/db/friends"
+	"idlequest/internal/friends"
+	"idlequest/internal/session"
+	"idlequest/internal/who"
+)
+
+// FriendAction actions, sent in FriendRequest.action
+const (
+	friendAdd    = 1 // name
+	friendRemove = 2 // name
+)
+
+// friendsOnline loads the character's friends list as they enter the world, tells those who
+// have them as a friend and sends them their list
+func friendsOnline(ctx context.Context, ses *session.Session) {
+	charData := ses.Client.CharData()
+	names, err := db_friends.List(ctx, int32(charData.ID), db_friends.Friend)
+	if err != nil {
+		log.Printf("failed to load friends list for %s: %v", charData.Name, err)
+	}
+	if friends.GetLists().Online(int64(charData.ID), charData.Name, names) {
+		notifyFriends(charData.Name, fmt.Sprintf("Your friend %s has come online.", charData.Name))
+	}
+	sendFriendList(ctx, ses)
+}
+
+// friendsOffline drops the character's friends list as they leave the world, telling those who
+// have them as a friend
+func friendsOffline(ses *session.Session) {
+	charData := ses.Client.CharData()
+	if friends.GetLists().Offline(int64(charData.ID)) {
+		notifyFriends(charData.Name, fmt.Sprintf("Your friend %s has gone offline.", charData.Name))
+	}
+}
+
+// notifyFriends sends a notice to every character online who has the named character as a friend
+func notifyFriends(name, text string) {
+	for _, id := range friends.GetLists().FriendedBy(name) {
+		if s, ok := findCharacterSession(id); ok {
+			SendSystemMessage(s, text)
+		}
+	}
+}
+
+// HandleFriendAction adds or removes a friend. Ignore lists are managed with ChatModeration.
+func HandleFriendAction(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootFriendRequest)
+	if err != nil {
+		log.Printf("failed to read FriendRequest struct: %v", err)
+		return false
+	}
+	name, _ := req.Name()
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+	charData := ses.Client.CharData()
+	lists := friends.GetLists()
+	ctx := context.Background()
+
+	switch req.Action() {
+	case friendAdd:
+		if err := lists.Add(int64(charData.ID), name); err != nil {
+			SendSystemMessage(ses, err.Error())
+			return false
+		}
+		if err := db_friends.Add(ctx, int32(charData.ID), db_friends.Friend, name); err != nil {
+			log.Printf("failed to save friend for %s: %v", charData.Name, err)
+		}
+		SendSystemMessage(ses, fmt.Sprintf("%s is now your friend.", name))
+	case friendRemove:
+		if err := lists.Remove(int64(charData.ID), name); err != nil {
+			SendSystemMessage(ses, err.Error())
+			return false
+		}
+		if err := db_friends.Remove(ctx, int32(charData.ID), db_friends.Friend, name); err != nil {
+			log.Printf("failed to remove friend for %s: %v", charData.Name, err)
+		}
+		SendSystemMessage(ses, fmt.Sprintf("%s is no longer your friend.", name))
+	default:
+		return false
+	}
+	sendFriendList(ctx, ses)
+	return false
+}
+
+// HandleFriendsWho sends the character their friends with who is online, where and at what level
+func HandleFriendsWho(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	sendFriendList(context.Background(), ses)
+	return false
+}
+
+// sendFriendList sends the character's friends. Those online show their current zone and level,
+// those offline where they last were, both hidden as who hides them for anonymous players.
+func sendFriendList(ctx context.Context, ses *session.Session) {
+	names := friends.GetLists().Friends(int64(ses.Client.CharData().ID))
+	infos := make([]who.Entry, len(names))
+	online := make([]bool, len(names))
+	var offline []string
+	for i, name := range names {
+		infos[i].Name = name
+		s, ok := session.GetSessionManager().FindByCharacterName(name)
+		if !ok || !s.Authenticated {
+			offline = append(offline, name)
+			continue
+		}
+		charData := s.Client.CharData()
+		online[i] = true
+		infos[i] = who.Entry{
+			Name:   charData.Name,
+			Level:  int32(charData.Level),
+			Class:  int32(charData.Class),
+			ZoneID: int32(max(s.ZoneID, 0)),
+			Anon:   int32(charData.Anon),
+		}
+	}
+	if len(offline) > 0 {
+		chars, err := db_friends.GetCharacters(ctx, offline)
+		if err != nil {
+			log.Printf("failed to load friends of %s: %v", ses.Client.CharData().Name, err)
+		}
+		for i, name := range names {
+			if c, ok := chars[strings.ToLower(name)]; ok && !online[i] {
+				infos[i] = who.Entry{
+					Name:   c.Name,
+					Level:  int32(c.Level),
+					Class:  int32(c.Class),
+					ZoneID: int32(c.ZoneID),
+					Anon:   int32(c.Anon),
+				}
+			}
+		}
+	}
+
+	session.QueueMessage(ses, eq.NewRootFriendList, opcodes.FriendList, func(msg eq.FriendList) error {
+		list, err := msg.NewFriends(int32(len(infos)))
+		if err != nil {
+			return err
+		}
+		for i, info := range infos {
+			v := info.Visible()
+			f := list.At(i)
+			f.SetName(v.Name)
+			f.SetOnline(boolToInt32(online[i]))
+			f.SetZone(v.ZoneID)
+			f.SetLevel(v.Level)
+			f.SetCharClass(v.Class)
+			f.SetAnon(v.Anon)
+		}
+		return nil
+	})
+}
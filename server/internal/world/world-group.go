@@ -0,0 +1,244 @@
+package world
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	"idlequest/internal/combat"
+	db_combat "idlequest/internal/db/combat"
+	"idlequest/internal/group"
+	"idlequest/internal/session"
+)
+
+// groupMember identifies the session's character to the group manager
+func groupMember(ses *session.Session) group.Member {
+	charData := ses.Client.CharData()
+	return group.Member{CharID: int64(charData.ID), Name: charData.Name, Session: ses}
+}
+
+// groupFightCallbacks relays a fight to a group member a groupmate pulled into it
+func groupFightCallbacks(ses *session.Session) combat.FightCallbacks {
+	onRound, onEnd, onLoot := combatCallbacks(ses)
+	return combat.FightCallbacks{
+		OnStarted: func(npc *db_combat.NPCForCombat, pet *combat.Pet) {
+			sendCombatStarted(ses, npc, pet)
+		},
+		OnRound: onRound,
+		OnEnd:   onEnd,
+		OnLoot:  onLoot,
+	}
+}
+
+// HandleGroupInvite invites an online character to the inviter's group, forwarding the invite
+// to them with the legacy GroupInvite opcode
+func HandleGroupInvite(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGroupInvite)
+	if err != nil {
+		log.Printf("failed to read GroupInvite struct: %v", err)
+		return false
+	}
+	inviteeName, _ := req.InviteeName()
+
+	invitee, ok := wh.sessionManager.FindByCharacterName(inviteeName)
+	if !ok {
+		SendSystemMessage(ses, fmt.Sprintf("%s is not online.", inviteeName))
+		return false
+	}
+	inviter := groupMember(ses)
+	if err := group.GetManager().Invite(inviter, groupMember(invitee), time.Now()); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+
+	inviteeName = invitee.Client.CharData().Name
+	session.QueueMessage(invitee, eq.NewRootGroupInvite, opcodes.GroupInvite, func(msg eq.GroupInvite) error {
+		if err := msg.SetInviteeName(inviteeName); err != nil {
+			return err
+		}
+		return msg.SetInviterName(inviter.Name)
+	})
+	SendSystemMessage(ses, fmt.Sprintf("You invite %s to join your group.", inviteeName))
+	return false
+}
+
+// HandleGroupFollow accepts a group invitation. name1 is the inviter.
+func HandleGroupFollow(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGroupGeneric)
+	if err != nil {
+		log.Printf("failed to read GroupGeneric struct: %v", err)
+		return false
+	}
+	inviterName, _ := req.Name1()
+
+	member := groupMember(ses)
+	grp, err := group.GetManager().Accept(member, inviterName, time.Now())
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+
+	for _, m := range grp.Members {
+		if m.CharID != member.CharID && m.Session.HasValidClient() {
+			SendSystemMessage(m.Session, fmt.Sprintf("%s has joined the group.", member.Name))
+		}
+	}
+	SendSystemMessage(ses, fmt.Sprintf("You have joined %s's group.", grp.LeaderName()))
+	broadcastGroupStatus(grp)
+	return false
+}
+
+// HandleGroupCancelInvite declines a group invitation
+func HandleGroupCancelInvite(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	inviter, ok := group.GetManager().Decline(int64(ses.Client.CharData().ID))
+	if ok && inviter.Session.HasValidClient() {
+		SendSystemMessage(inviter.Session, fmt.Sprintf("%s declines your invitation.", ses.Client.CharData().Name))
+	}
+	return false
+}
+
+// HandleGroupDisband leaves the group, or, for the leader, removes the member named in name2
+func HandleGroupDisband(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGroupGeneric)
+	if err != nil {
+		log.Printf("failed to read GroupGeneric struct: %v", err)
+		return false
+	}
+	targetName, _ := req.Name2()
+	charData := ses.Client.CharData()
+
+	if targetName == "" || strings.EqualFold(targetName, charData.Name) {
+		leaveGroup(ses)
+		return false
+	}
+
+	grp, kicked, err := group.GetManager().Kick(int64(charData.ID), targetName)
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	if kicked.Session.HasValidClient() {
+		SendSystemMessage(kicked.Session, "You have been removed from the group.")
+		sendGroupStatus(kicked.Session, nil)
+	}
+	notifyGroupChange(grp, fmt.Sprintf("%s has been removed from the group.", kicked.Name))
+	return false
+}
+
+// leaveGroup takes the session's character out of their group, if any, and tells the rest
+func leaveGroup(ses *session.Session) {
+	charData := ses.Client.CharData()
+	grp := group.GetManager().Leave(int64(charData.ID))
+	if grp == nil {
+		return
+	}
+	sendGroupStatus(ses, nil)
+	notifyGroupChange(grp, fmt.Sprintf("%s has left the group.", charData.Name))
+}
+
+// notifyGroupChange tells the members left in a group that someone is gone, or that the group
+// has disbanded if only one is left
+func notifyGroupChange(grp *group.Group, text string) {
+	if len(grp.Members) < 2 {
+		for _, m := range grp.Members {
+			if m.Session.HasValidClient() {
+				SendSystemMessage(m.Session, "Your group has been disbanded.")
+				sendGroupStatus(m.Session, nil)
+			}
+		}
+		return
+	}
+	for _, m := range grp.Members {
+		if m.Session.HasValidClient() {
+			SendSystemMessage(m.Session, text)
+		}
+	}
+	broadcastGroupStatus(grp)
+}
+
+// HandleSetGroupLootMode lets the leader choose how drops are shared
+func HandleSetGroupLootMode(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+
+	// Payload is a single byte: 0 = round robin, 1 = free-for-all
+	if len(payload) < 1 {
+		log.Printf("SetGroupLootMode: invalid payload length")
+		return false
+	}
+	mode := group.LootRoundRobin
+	if payload[0] != 0 {
+		mode = group.LootFreeForAll
+	}
+
+	grp, err := group.GetManager().SetLootMode(int64(ses.Client.CharData().ID), mode)
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	broadcastGroupStatus(grp)
+	return false
+}
+
+// broadcastGroupStatus sends the group's status to every member online
+func broadcastGroupStatus(grp *group.Group) {
+	for _, m := range grp.Members {
+		if m.Session.HasValidClient() {
+			sendGroupStatus(m.Session, grp)
+		}
+	}
+}
+
+// sendGroupStatus sends the group's members, leader and loot mode. A nil group tells the client
+// it is no longer grouped.
+func sendGroupStatus(ses *session.Session, grp *group.Group) {
+	session.QueueMessage(ses, eq.NewRootGroupStatus, opcodes.GroupStatus, func(msg eq.GroupStatus) error {
+		if grp == nil {
+			return nil
+		}
+		msg.SetGroupId(grp.ID)
+		if err := msg.SetLeader(grp.LeaderName()); err != nil {
+			return err
+		}
+		if err := msg.SetLootMode(grp.LootMode); err != nil {
+			return err
+		}
+		members, err := msg.NewMembers(int32(len(grp.Members)))
+		if err != nil {
+			return err
+		}
+		for i, m := range grp.Members {
+			info := members.At(i)
+			if err := info.SetName(m.Name); err != nil {
+				return err
+			}
+			if !m.Session.HasValidClient() {
+				continue
+			}
+			c := m.Session.Client
+			charData := c.CharData()
+			info.SetLevel(int32(charData.Level))
+			info.SetClass(int32(charData.Class))
+			info.SetHp(int32(c.GetCurrentHp()))
+			info.SetMaxHp(int32(c.GetMaxHp()))
+			info.SetZoneId(int32(charData.ZoneID))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,716 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	db_guild "idlequest/internal/db/guild"
+	"idlequest/internal/guild"
+	"idlequest/internal/session"
+)
+
+// guildMembership loads the session's character's guild and rank, telling them if they aren't
+// in one. ok is false if they aren't.
+func guildMembership(ctx context.Context, ses *session.Session) (m db_guild.Membership, ok bool) {
+	m, err := db_guild.GetMembership(ctx, int32(ses.Client.CharData().ID))
+	if err != nil {
+		log.Printf("failed to load guild membership: %v", err)
+		return m, false
+	}
+	if m.GuildID == 0 {
+		SendSystemMessage(ses, guild.ErrNotInGuild.Error())
+		return m, false
+	}
+	return m, true
+}
+
+// guildMemberByName finds a member on the guild's roster, ignoring case
+func guildMemberByName(ctx context.Context, guildID int32, name string) (*db_guild.Member, error) {
+	members, err := db_guild.GetMembers(ctx, guildID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range members {
+		if strings.EqualFold(members[i].Character.Name, name) {
+			return &members[i], nil
+		}
+	}
+	return nil, guild.ErrNotGuildMember
+}
+
+// forEachOnlineGuildMember calls fn with the session of every member of the guild online
+func forEachOnlineGuildMember(ctx context.Context, guildID int32, fn func(*session.Session)) {
+	session.GetSessionManager().ForEachSession(func(s *session.Session) {
+		if !s.HasValidClient() {
+			return
+		}
+		if m, err := db_guild.GetMembership(ctx, int32(s.Client.CharData().ID)); err == nil && m.GuildID == guildID {
+			fn(s)
+		}
+	})
+}
+
+// notifyGuild sends a system message to every member of the guild online and refreshes their
+// guild info
+func notifyGuild(ctx context.Context, guildID int32, text string) {
+	forEachOnlineGuildMember(ctx, guildID, func(s *session.Session) {
+		SendSystemMessage(s, text)
+		sendGuildInfo(ctx, s)
+	})
+}
+
+// notifyCharacter tells a character by name, if they are online, and refreshes their guild info
+func notifyCharacter(ctx context.Context, wh *WorldHandler, name, text string) {
+	if target, ok := wh.sessionManager.FindByCharacterName(name); ok {
+		SendSystemMessage(target, text)
+		sendGuildInfo(ctx, target)
+	}
+}
+
+// HandleGuildCreate founds a guild led by the character
+func HandleGuildCreate(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildCreateRequest)
+	if err != nil {
+		log.Printf("failed to read GuildCreateRequest struct: %v", err)
+		return false
+	}
+	name, _ := req.Name()
+	ctx := context.Background()
+	charID := int32(ses.Client.CharData().ID)
+
+	if m, err := db_guild.GetMembership(ctx, charID); err != nil || m.GuildID != 0 {
+		SendSystemMessage(ses, guild.ErrYouAreInGuild.Error())
+		return false
+	}
+	if err := guild.ValidateName(name); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	if taken, err := db_guild.GuildNameTaken(ctx, name); err != nil || taken {
+		if err != nil {
+			log.Printf("GuildCreate: %v", err)
+		}
+		SendSystemMessage(ses, guild.ErrGuildNameTaken.Error())
+		return false
+	}
+
+	if _, err := db_guild.CreateGuild(ctx, name, charID); err != nil {
+		log.Printf("failed to create guild %q for character %d: %v", name, charID, err)
+		SendSystemMessage(ses, "The guild could not be created.")
+		return false
+	}
+	SendSystemMessage(ses, fmt.Sprintf("You have founded %s.", name))
+	sendGuildInfo(ctx, ses)
+	return false
+}
+
+// HandleGuildInvite invites an online character to the inviter's guild. othername is the
+// invitee; the invite is forwarded to them with myname set to the inviter.
+func HandleGuildInvite(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildcommand)
+	if err != nil {
+		log.Printf("failed to read Guildcommand struct: %v", err)
+		return false
+	}
+	inviteeName, _ := req.Othername()
+	ctx := context.Background()
+
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+	perms, err := db_guild.GetPermissions(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("GuildInvite: %v", err)
+		return false
+	}
+	if !perms.Allowed(m.Rank, guild.PermInvite) {
+		SendSystemMessage(ses, guild.ErrNoPermission.Error())
+		return false
+	}
+
+	invitee, ok := wh.sessionManager.FindByCharacterName(inviteeName)
+	if !ok {
+		SendSystemMessage(ses, fmt.Sprintf("%s is not online.", inviteeName))
+		return false
+	}
+	inviteeID := int32(invitee.Client.CharData().ID)
+	if other, err := db_guild.GetMembership(ctx, inviteeID); err != nil || other.GuildID != 0 {
+		SendSystemMessage(ses, guild.ErrAlreadyInGuild.Error())
+		return false
+	}
+	inviter := ses.Client.CharData()
+	invite := guild.Invite{GuildID: m.GuildID, InviterID: int32(inviter.ID), InviterName: inviter.Name}
+	if err := guild.GetInvites().Add(inviteeID, invite, time.Now()); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+
+	inviteeName = invitee.Client.CharData().Name
+	session.QueueMessage(invitee, eq.NewRootGuildcommand, opcodes.GuildInvite, func(msg eq.Guildcommand) error {
+		if err := msg.SetOthername(inviteeName); err != nil {
+			return err
+		}
+		msg.SetGuildeqid(m.GuildID)
+		return msg.SetMyname(inviter.Name)
+	})
+	SendSystemMessage(invitee, fmt.Sprintf("%s invites you to join %s.", inviter.Name, m.GuildName))
+	SendSystemMessage(ses, fmt.Sprintf("You invite %s to join %s.", inviteeName, m.GuildName))
+	return false
+}
+
+// HandleGuildInviteAccept accepts (response 1) or declines a guild invitation. New members join
+// as recruits.
+func HandleGuildInviteAccept(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildInviteAccept)
+	if err != nil {
+		log.Printf("failed to read GuildInviteAccept struct: %v", err)
+		return false
+	}
+	ctx := context.Background()
+	charData := ses.Client.CharData()
+
+	invite, ok := guild.GetInvites().Take(int32(charData.ID), time.Now())
+	if !ok {
+		SendSystemMessage(ses, guild.ErrNoGuildInvite.Error())
+		return false
+	}
+	if req.Response() != 1 {
+		if inviter, ok := wh.sessionManager.FindByCharacterName(invite.InviterName); ok {
+			SendSystemMessage(inviter, fmt.Sprintf("%s declines your guild invitation.", charData.Name))
+		}
+		return false
+	}
+
+	if m, err := db_guild.GetMembership(ctx, int32(charData.ID)); err != nil || m.GuildID != 0 {
+		SendSystemMessage(ses, guild.ErrYouAreInGuild.Error())
+		return false
+	}
+	if err := db_guild.AddMember(ctx, invite.GuildID, int32(charData.ID), guild.RankRecruit); err != nil {
+		log.Printf("failed to add character %d to guild %d: %v", charData.ID, invite.GuildID, err)
+		SendSystemMessage(ses, "You could not join the guild.")
+		return false
+	}
+	notifyGuild(ctx, invite.GuildID, fmt.Sprintf("%s has joined the guild.", charData.Name))
+	sendGuildMOTD(ctx, ses, opcodes.GuildMOTD)
+	return false
+}
+
+// HandleGuildRemove removes target from the guild, or leaves it if target is the character
+// themselves or empty
+func HandleGuildRemove(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildRemove)
+	if err != nil {
+		log.Printf("failed to read GuildRemove struct: %v", err)
+		return false
+	}
+	targetName, _ := req.Target()
+	ctx := context.Background()
+	charData := ses.Client.CharData()
+
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+
+	if targetName == "" || strings.EqualFold(targetName, charData.Name) {
+		members, err := db_guild.GetMembers(ctx, m.GuildID)
+		if err != nil {
+			log.Printf("GuildRemove: %v", err)
+			return false
+		}
+		if m.Rank == guild.RankLeader && len(members) > 1 {
+			SendSystemMessage(ses, guild.ErrLeaderLeaving.Error())
+			return false
+		}
+		if m.Rank == guild.RankLeader {
+			// The last member leaving disbands the guild
+			if _, err := db_guild.DeleteGuild(ctx, m.GuildID); err != nil {
+				log.Printf("failed to disband guild %d: %v", m.GuildID, err)
+				return false
+			}
+		} else if err := db_guild.RemoveMember(ctx, int32(charData.ID)); err != nil {
+			log.Printf("failed to remove character %d from guild: %v", charData.ID, err)
+			return false
+		}
+		SendSystemMessage(ses, fmt.Sprintf("You have left %s.", m.GuildName))
+		sendGuildInfo(ctx, ses)
+		notifyGuild(ctx, m.GuildID, fmt.Sprintf("%s has left the guild.", charData.Name))
+		return false
+	}
+
+	target, err := guildMemberByName(ctx, m.GuildID, targetName)
+	if err != nil {
+		SendSystemMessage(ses, guild.ErrNotGuildMember.Error())
+		return false
+	}
+	perms, err := db_guild.GetPermissions(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("GuildRemove: %v", err)
+		return false
+	}
+	if !perms.Allowed(m.Rank, guild.PermRemove) {
+		SendSystemMessage(ses, guild.ErrNoPermission.Error())
+		return false
+	}
+	if !guild.CanManage(m.Rank, target.Rank) {
+		SendSystemMessage(ses, guild.ErrOutranked.Error())
+		return false
+	}
+	if err := db_guild.RemoveMember(ctx, target.CharID); err != nil {
+		log.Printf("failed to remove character %d from guild: %v", target.CharID, err)
+		return false
+	}
+	notifyCharacter(ctx, wh, target.Character.Name, fmt.Sprintf("You have been removed from %s.", m.GuildName))
+	notifyGuild(ctx, m.GuildID, fmt.Sprintf("%s has been removed from the guild by %s.", target.Character.Name, charData.Name))
+	return false
+}
+
+// HandleSetGuildRank promotes or demotes the member named to newrank
+func HandleSetGuildRank(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildManageStatus)
+	if err != nil {
+		log.Printf("failed to read GuildManageStatus struct: %v", err)
+		return false
+	}
+	targetName, _ := req.Name()
+	newRank := req.Newrank()
+	ctx := context.Background()
+
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+	target, err := guildMemberByName(ctx, m.GuildID, targetName)
+	if err != nil {
+		SendSystemMessage(ses, guild.ErrNotGuildMember.Error())
+		return false
+	}
+	perms, err := db_guild.GetPermissions(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("SetGuildRank: %v", err)
+		return false
+	}
+	if newRank < 1 || newRank > guild.RankCount {
+		SendSystemMessage(ses, guild.ErrBadRank.Error())
+		return false
+	}
+	if err := guild.CheckRankChange(perms, m.Rank, target.Rank, uint8(newRank)); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	if err := db_guild.SetRank(ctx, target.CharID, uint8(newRank)); err != nil {
+		log.Printf("failed to set guild rank of character %d: %v", target.CharID, err)
+		return false
+	}
+
+	titles, _ := db_guild.GetRanks(ctx, m.GuildID)
+	verb := "promoted"
+	if uint8(newRank) > target.Rank {
+		verb = "demoted"
+	}
+	notifyGuild(ctx, m.GuildID, fmt.Sprintf("%s has been %s to %s.", target.Character.Name, verb, titles[newRank]))
+	return false
+}
+
+// HandleGuildLeader hands the guild to another member. The old leader becomes a senior officer.
+func HandleGuildLeader(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildMakeLeader)
+	if err != nil {
+		log.Printf("failed to read GuildMakeLeader struct: %v", err)
+		return false
+	}
+	targetName, _ := req.Target()
+	ctx := context.Background()
+	charID := int32(ses.Client.CharData().ID)
+
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+	if m.Rank != guild.RankLeader {
+		SendSystemMessage(ses, guild.ErrNoPermission.Error())
+		return false
+	}
+	target, err := guildMemberByName(ctx, m.GuildID, targetName)
+	if err != nil || target.CharID == charID {
+		SendSystemMessage(ses, guild.ErrNotGuildMember.Error())
+		return false
+	}
+	if err := db_guild.SetLeader(ctx, m.GuildID, charID, target.CharID); err != nil {
+		log.Printf("failed to change leader of guild %d: %v", m.GuildID, err)
+		return false
+	}
+	notifyGuild(ctx, m.GuildID, fmt.Sprintf("%s is now the leader of %s.", target.Character.Name, m.GuildName))
+	return false
+}
+
+// HandleGuildDelete disbands the leader's guild
+func HandleGuildDelete(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	ctx := context.Background()
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+	if m.Rank != guild.RankLeader {
+		SendSystemMessage(ses, guild.ErrNoPermission.Error())
+		return false
+	}
+
+	// Tell the members before the guild is gone, then refresh them once it is
+	var online []*session.Session
+	forEachOnlineGuildMember(ctx, m.GuildID, func(s *session.Session) {
+		online = append(online, s)
+	})
+	if _, err := db_guild.DeleteGuild(ctx, m.GuildID); err != nil {
+		log.Printf("failed to disband guild %d: %v", m.GuildID, err)
+		return false
+	}
+	for _, s := range online {
+		SendSystemMessage(s, fmt.Sprintf("%s has been disbanded.", m.GuildName))
+		sendGuildInfo(ctx, s)
+	}
+	return false
+}
+
+// HandleSetGuildMOTD sets the guild's message of the day and sends it to every member online
+func HandleSetGuildMOTD(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildMOTD)
+	if err != nil {
+		log.Printf("failed to read GuildMOTD struct: %v", err)
+		return false
+	}
+	motd, _ := req.Motd()
+	ctx := context.Background()
+
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+	perms, err := db_guild.GetPermissions(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("SetGuildMOTD: %v", err)
+		return false
+	}
+	if !perms.Allowed(m.Rank, guild.PermMOTD) {
+		SendSystemMessage(ses, guild.ErrNoPermission.Error())
+		return false
+	}
+	if err := db_guild.SetMOTD(ctx, m.GuildID, motd, ses.Client.CharData().Name); err != nil {
+		log.Printf("failed to set motd of guild %d: %v", m.GuildID, err)
+		return false
+	}
+	forEachOnlineGuildMember(ctx, m.GuildID, func(s *session.Session) {
+		sendGuildMOTD(ctx, s, opcodes.GuildMOTD)
+	})
+	return false
+}
+
+// HandleGetGuildMOTD replies with the guild's message of the day
+func HandleGetGuildMOTD(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	sendGuildMOTD(context.Background(), ses, opcodes.GetGuildMOTDReply)
+	return false
+}
+
+// sendGuildMOTD sends the character's guild's message of the day, if they are in a guild that
+// has one
+func sendGuildMOTD(ctx context.Context, ses *session.Session, opcode opcodes.OpCode) {
+	m, err := db_guild.GetMembership(ctx, int32(ses.Client.CharData().ID))
+	if err != nil || m.GuildID == 0 {
+		return
+	}
+	g, err := db_guild.GetGuild(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("failed to load guild %d: %v", m.GuildID, err)
+		return
+	}
+	if g.Motd == "" {
+		return
+	}
+	session.QueueMessage(ses, eq.NewRootGuildMOTD, opcode, func(msg eq.GuildMOTD) error {
+		if err := msg.SetName(g.Name); err != nil {
+			return err
+		}
+		if err := msg.SetSetbyName(g.MotdSetter); err != nil {
+			return err
+		}
+		return msg.SetMotd(g.Motd)
+	})
+}
+
+// HandleGuildPublicNote sets a member's public note. Anyone can set their own; others' need
+// the public notes permission and a more senior rank.
+func HandleGuildPublicNote(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildUpdatePublicNote)
+	if err != nil {
+		log.Printf("failed to read GuildUpdatePublicNote struct: %v", err)
+		return false
+	}
+	targetName, _ := req.Target()
+	note, _ := req.Note()
+	ctx := context.Background()
+	charData := ses.Client.CharData()
+
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+	targetID := int32(charData.ID)
+	if targetName != "" && !strings.EqualFold(targetName, charData.Name) {
+		target, err := guildMemberByName(ctx, m.GuildID, targetName)
+		if err != nil {
+			SendSystemMessage(ses, guild.ErrNotGuildMember.Error())
+			return false
+		}
+		perms, err := db_guild.GetPermissions(ctx, m.GuildID)
+		if err != nil {
+			log.Printf("GuildPublicNote: %v", err)
+			return false
+		}
+		if !perms.Allowed(m.Rank, guild.PermPublicNotes) {
+			SendSystemMessage(ses, guild.ErrNoPermission.Error())
+			return false
+		}
+		if !guild.CanManage(m.Rank, target.Rank) {
+			SendSystemMessage(ses, guild.ErrOutranked.Error())
+			return false
+		}
+		targetID = target.CharID
+	}
+
+	if err := db_guild.SetPublicNote(ctx, targetID, note); err != nil {
+		log.Printf("failed to set public note of character %d: %v", targetID, err)
+		return false
+	}
+	sendGuildRoster(ctx, ses, m.GuildID)
+	return false
+}
+
+// HandleGuildMemberList replies with the guild's roster
+func HandleGuildMemberList(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	ctx := context.Background()
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+	sendGuildRoster(ctx, ses, m.GuildID)
+	return false
+}
+
+// sendGuildRoster sends the guild's members with their rank, note and whether they are online
+func sendGuildRoster(ctx context.Context, ses *session.Session, guildID int32) {
+	members, err := db_guild.GetMembers(ctx, guildID)
+	if err != nil {
+		log.Printf("failed to load roster of guild %d: %v", guildID, err)
+		return
+	}
+	online := make(map[int32]*session.Session)
+	session.GetSessionManager().ForEachSession(func(s *session.Session) {
+		if s.HasValidClient() {
+			online[int32(s.Client.CharData().ID)] = s
+		}
+	})
+
+	session.QueueMessage(ses, eq.NewRootGuildMembers, opcodes.GuildMemberList, func(msg eq.GuildMembers) error {
+		if err := msg.SetPlayerName(ses.Client.CharData().Name); err != nil {
+			return err
+		}
+		msg.SetCount(int32(len(members)))
+		list, err := msg.NewMember(int32(len(members)))
+		if err != nil {
+			return err
+		}
+		for i, member := range members {
+			entry := list.At(i)
+			if err := entry.SetName(member.Character.Name); err != nil {
+				return err
+			}
+			if err := entry.SetPublicNote(member.PublicNote); err != nil {
+				return err
+			}
+			entry.SetRank(int32(member.Rank))
+			entry.SetLevel(int32(member.Character.Level))
+			entry.SetCharClass(int32(member.Character.Class))
+			entry.SetZoneId(int32(member.Character.ZoneID))
+			entry.SetTimeLastOn(int32(member.Character.LastLogin))
+			if s, ok := online[member.CharID]; ok {
+				charData := s.Client.CharData()
+				entry.SetOnline(1)
+				entry.SetLevel(int32(charData.Level))
+				entry.SetZoneId(int32(charData.ZoneID))
+			}
+		}
+		return nil
+	})
+}
+
+// HandleSetGuildPermission lets the leader grant or revoke a permission for a rank
+func HandleSetGuildPermission(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGuildPermissionUpdate)
+	if err != nil {
+		log.Printf("failed to read GuildPermissionUpdate struct: %v", err)
+		return false
+	}
+	ctx := context.Background()
+
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return false
+	}
+	if m.Rank != guild.RankLeader {
+		SendSystemMessage(ses, guild.ErrNoPermission.Error())
+		return false
+	}
+	perm, rank := req.Permission(), req.Rank()
+	if perm < 1 || perm > guild.PermissionCount {
+		SendSystemMessage(ses, guild.ErrBadPermission.Error())
+		return false
+	}
+	if rank <= int32(guild.RankLeader) || rank > guild.RankCount {
+		SendSystemMessage(ses, guild.ErrBadRank.Error())
+		return false
+	}
+
+	perms, err := db_guild.GetPermissions(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("SetGuildPermission: %v", err)
+		return false
+	}
+	mask := perms[perm] &^ guild.RankBit(uint8(rank))
+	if req.Allowed() != 0 {
+		mask |= guild.RankBit(uint8(rank))
+	}
+	if err := db_guild.SetPermission(ctx, m.GuildID, perm, mask); err != nil {
+		log.Printf("failed to set permission %d of guild %d: %v", perm, m.GuildID, err)
+		return false
+	}
+	forEachOnlineGuildMember(ctx, m.GuildID, func(s *session.Session) {
+		sendGuildInfo(ctx, s)
+	})
+	return false
+}
+
+// sendGuildInfo sends the character's guild, rank, MOTD and the guild's ranks with their
+// permissions. A guild ID of 0 tells the client they are not in a guild.
+func sendGuildInfo(ctx context.Context, ses *session.Session) {
+	m, err := db_guild.GetMembership(ctx, int32(ses.Client.CharData().ID))
+	if err != nil {
+		log.Printf("failed to load guild membership: %v", err)
+		return
+	}
+	if m.GuildID == 0 {
+		session.QueueMessage(ses, eq.NewRootGuildInfo, opcodes.GuildInfo, func(msg eq.GuildInfo) error {
+			return nil
+		})
+		return
+	}
+
+	g, err := db_guild.GetGuild(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("failed to load guild %d: %v", m.GuildID, err)
+		return
+	}
+	titles, err := db_guild.GetRanks(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("failed to load ranks of guild %d: %v", m.GuildID, err)
+	}
+	perms, err := db_guild.GetPermissions(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("failed to load permissions of guild %d: %v", m.GuildID, err)
+	}
+
+	session.QueueMessage(ses, eq.NewRootGuildInfo, opcodes.GuildInfo, func(msg eq.GuildInfo) error {
+		msg.SetGuildId(m.GuildID)
+		if err := msg.SetName(g.Name); err != nil {
+			return err
+		}
+		msg.SetRank(int32(m.Rank))
+		if err := msg.SetMotd(g.Motd); err != nil {
+			return err
+		}
+		if err := msg.SetMotdSetter(g.MotdSetter); err != nil {
+			return err
+		}
+		ranks, err := msg.NewRanks(guild.RankCount)
+		if err != nil {
+			return err
+		}
+		for rank := uint8(1); rank <= guild.RankCount; rank++ {
+			info := ranks.At(int(rank - 1))
+			info.SetRank(int32(rank))
+			if err := info.SetTitle(titles[rank]); err != nil {
+				return err
+			}
+			var held int32
+			for perm := int32(1); perm <= guild.PermissionCount; perm++ {
+				if perms.Allowed(rank, perm) {
+					held |= 1 << (perm - 1)
+				}
+			}
+			info.SetPermissions(held)
+		}
+		return nil
+	})
+}
+
+// sendGuildChat sends a guild chat message to every member of the sender's guild online, if
+// their rank may speak in it
+func sendGuildChat(ctx context.Context, ses *session.Session, line chatLine) {
+	m, ok := guildMembership(ctx, ses)
+	if !ok {
+		return
+	}
+	perms, err := db_guild.GetPermissions(ctx, m.GuildID)
+	if err != nil {
+		log.Printf("guild chat: %v", err)
+		return
+	}
+	if !perms.Allowed(m.Rank, guild.PermGuildChat) {
+		SendSystemMessage(ses, guild.ErrNoPermission.Error())
+		return
+	}
+	forEachOnlineGuildMember(ctx, m.GuildID, func(s *session.Session) {
+		queueChatLine(s, line)
+	})
+}
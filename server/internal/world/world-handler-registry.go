@@ -33,6 +33,7 @@ func NewWorldOpCodeRegistry() *HandlerRegistry {
 		opcodes.GetItemRequest:          HandleGetItemRequest,
 		opcodes.GetZoneRequest:          HandleGetZoneRequest,
 		opcodes.GetZoneNPCsRequest:      HandleGetZoneNPCsRequest,
+		opcodes.Consider:                HandleConsider,
 		opcodes.GetAdjacentZonesRequest: HandleGetAdjacentZonesRequest,
 		opcodes.GetAllZonesRequest:      HandleGetAllZonesRequest,
 		opcodes.StaticDataRequest:       HandleStaticDataRequest,
@@ -49,14 +50,65 @@ func NewWorldOpCodeRegistry() *HandlerRegistry {
 		opcodes.Animation:      HandleClientAnimation,
 		opcodes.GMCommand:      HandleGMCommand,
 		// Combat handlers
-		opcodes.StartCombat: HandleStartCombat,
-		opcodes.StopCombat:  HandleStopCombat,
+		opcodes.StartCombat:           HandleStartCombat,
+		opcodes.StopCombat:            HandleStopCombat,
+		opcodes.GetCombatStatsRequest: HandleGetCombatStats,
+		opcodes.SetAutoHunt:           HandleSetAutoHunt,
 		// Bind handlers
 		opcodes.UpdateBind: HandleUpdateBind,
 		// Auto-equip handlers
 		opcodes.AutoPlaceCursorItem: HandleAutoPlaceCursorItem,
 		// Auto-sell toggle
-		opcodes.SetAutoSell: HandleSetAutoSell,
+		opcodes.SetAutoSell:    HandleSetAutoSell,
+		opcodes.SetAutoRetreat: HandleSetAutoRetreat,
+		// Group handlers
+		opcodes.GroupInvite:       HandleGroupInvite,
+		opcodes.GroupFollow:       HandleGroupFollow,
+		opcodes.GroupCancelInvite: HandleGroupCancelInvite,
+		opcodes.GroupDisband:      HandleGroupDisband,
+		opcodes.SetGroupLootMode:  HandleSetGroupLootMode,
+		// Raid handlers
+		opcodes.RaidInvite: HandleRaidInvite,
+		opcodes.RaidJoin:   HandleRaidJoin,
+		opcodes.RaidAction: HandleRaidAction,
+		// Guild handlers
+		opcodes.GuildCreate:        HandleGuildCreate,
+		opcodes.GuildInvite:        HandleGuildInvite,
+		opcodes.GuildInviteAccept:  HandleGuildInviteAccept,
+		opcodes.GuildRemove:        HandleGuildRemove,
+		opcodes.SetGuildRank:       HandleSetGuildRank,
+		opcodes.GuildLeader:        HandleGuildLeader,
+		opcodes.GuildDelete:        HandleGuildDelete,
+		opcodes.SetGuildMOTD:       HandleSetGuildMOTD,
+		opcodes.GetGuildMOTD:       HandleGetGuildMOTD,
+		opcodes.GuildPublicNote:    HandleGuildPublicNote,
+		opcodes.GuildMemberList:    HandleGuildMemberList,
+		opcodes.SetGuildPermission: HandleSetGuildPermission,
+		// Trade handlers
+		opcodes.TradeRequest:     HandleTradeRequest,
+		opcodes.TradeRequestAck:  HandleTradeRequestAck,
+		opcodes.CancelTrade:      HandleCancelTrade,
+		opcodes.TradeItem:        HandleTradeItem,
+		opcodes.TradeCoins:       HandleTradeCoins,
+		opcodes.TradeAcceptClick: HandleTradeAcceptClick,
+		// Chat channel handlers
+		opcodes.ChatChannelAction: HandleChatChannelAction,
+		// Chat moderation handlers
+		opcodes.ChatModeration: HandleChatModeration,
+		opcodes.Report:         HandleReport,
+		// Who handlers
+		opcodes.WhoAllRequest: HandleWhoAllRequest,
+		opcodes.SetAnon:       HandleSetAnon,
+		// Friends handlers
+		opcodes.FriendAction: HandleFriendAction,
+		opcodes.FriendsWho:   HandleFriendsWho,
+		// Mail handlers
+		opcodes.MailSend:   HandleMailSend,
+		opcodes.MailAction: HandleMailAction,
+		// Inspect handlers
+		opcodes.InspectRequest:       HandleInspectRequest,
+		opcodes.InspectMessageUpdate: HandleInspectMessageUpdate,
+		opcodes.SetInspectOptOut:     HandleSetInspectOptOut,
 		// Tradeskill recipe handlers
 		opcodes.GetRecipesRequest:       HandleGetRecipesRequest,
 		opcodes.GetRecipeDetailsRequest: HandleGetRecipeDetailsRequest,
@@ -3,19 +3,23 @@ package world
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	eq "idlequest/internal/api/capnp"
 	"idlequest/internal/api/opcodes"
+	"idlequest/internal/chat"
 	"idlequest/internal/combat"
 	"idlequest/internal/config"
 	"idlequest/internal/constants"
 	db_character "idlequest/internal/db/character"
 	db_combat "idlequest/internal/db/combat"
 	"idlequest/internal/db/items"
+	db_stats "idlequest/internal/db/stats"
 	db_zone "idlequest/internal/db/zone"
 	"idlequest/internal/discord"
 	"idlequest/internal/mechanics"
@@ -346,9 +350,15 @@ func HandleZoneSession(ses *session.Session, payload []byte, wh *WorldHandler) b
 	log.Printf("[CharData] Loaded from DB: ID=%d, Name=%s, Level=%d, CurHp=%d, Exp=%d, Zone=%d",
 		charData.ID, charData.Name, charData.Level, charData.CurHp, charData.Exp, charData.ZoneID)
 
-	// Ensure combat is stopped on login
+	// Ensure combat and hunting are stopped on login
+	combat.GetManager().StopHunt(int64(charData.ID), combat.HuntStopRequested, "")
 	combat.GetManager().StopCombat(int64(charData.ID))
 
+	// Restore the pet saved at camp, if any
+	if err := combat.GetManager().LoadPet(context.Background(), int64(charData.ID)); err != nil {
+		log.Printf("failed to load pet for character %q: %v", ses.CharacterName, err)
+	}
+
 	ses.Client, err = client.NewClient(charData)
 	if err != nil {
 		log.Printf("failed to create client for character %q: %v", ses.CharacterName, err)
@@ -363,6 +373,12 @@ func HandleZoneSession(ses *session.Session, payload []byte, wh *WorldHandler) b
 		ses.Client.SetCurrentHp(maxHP)
 	}
 
+	// Award the hunting done while logged out; saving also records this login as last seen
+	offline, looted, lost := applyOfflineProgress(ses)
+	if err := db_character.UpdateCharacter(charData, ses.AccountID); err != nil {
+		log.Printf("failed to save character %q after offline progress: %v", ses.CharacterName, err)
+	}
+
 	ses.ZoneID = int(req.ZoneId())
 	ses.InstanceID = int(req.InstanceId())
 
@@ -373,6 +389,21 @@ func HandleZoneSession(ses *session.Session, payload []byte, wh *WorldHandler) b
 	}
 	enterWorld.SetValue(1)
 	ses.SendData(enterWorld.Message(), opcodes.ZoneSessionValid)
+
+	if offline != nil {
+		sendOfflineProgress(ses, offline, looted, lost)
+		sendUpdatedCharacterState(ses)
+	}
+
+	// The guild and its message of the day greet the character on entering the world
+	ctx := context.Background()
+	sendGuildInfo(ctx, ses)
+	sendGuildMOTD(ctx, ses, opcodes.GuildMOTD)
+
+	// Then their chat settings, friends and mail
+	loadChatModeration(ctx, ses)
+	friendsOnline(ctx, ses)
+	notifyMail(ctx, ses)
 	return false
 }
 
@@ -681,6 +712,33 @@ func HandleSetAutoSell(ses *session.Session, payload []byte, wh *WorldHandler) b
 	return false
 }
 
+// HandleSetAutoRetreat sets the HP percent at which the character retreats from combat
+func HandleSetAutoRetreat(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+
+	// Payload is a single byte: the HP percent to retreat at, 0 = never retreat
+	if len(payload) < 1 {
+		log.Printf("SetAutoRetreat: invalid payload length")
+		return false
+	}
+
+	percent := combat.ClampAutoRetreatPercent(int(payload[0]))
+	ses.Client.SetAutoRetreatPercent(percent)
+
+	// Persist to database
+	charID := ses.Client.CharData().ID
+	ctx := context.Background()
+	if err := db_character.SetAutoRetreatPercent(ctx, int32(charID), uint8(percent)); err != nil {
+		log.Printf("SetAutoRetreat: failed to persist to database for character %d: %v", charID, err)
+	}
+
+	log.Printf("SetAutoRetreat: auto-retreat at %d%% HP for character %s", percent, ses.Client.CharData().Name)
+
+	return false
+}
+
 // HandleCamp saves player data and sends updated character info
 func HandleCamp(ses *session.Session, payload []byte, wh *WorldHandler) bool {
 	if !ses.HasValidClient() {
@@ -689,8 +747,16 @@ func HandleCamp(ses *session.Session, payload []byte, wh *WorldHandler) bool {
 
 	charData := ses.Client.CharData()
 
-	// Stop combat when camping
+	// Stop hunting and combat when camping; a hunt carries on while they are logged out
+	saveOfflineHunt(ses)
+	combat.GetManager().StopHunt(int64(charData.ID), combat.HuntStopRequested, "")
 	combat.GetManager().StopCombat(int64(charData.ID))
+	leaveGroup(ses)
+	leaveRaid(ses)
+	cancelTrade(ses)
+	chat.GetChannels().LeaveAll(int64(charData.ID))
+	chat.GetModeration().Forget(int64(charData.ID))
+	friendsOffline(ses)
 
 	// Clamp HP to max before saving using synchronized methods
 	maxHP := ses.Client.GetMaxHp()
@@ -698,18 +764,37 @@ func HandleCamp(ses *session.Session, payload []byte, wh *WorldHandler) bool {
 		ses.Client.SetCurrentHp(maxHP)
 	}
 
+	charData.LastLogin = uint32(time.Now().Unix()) // Offline progress counts from here
 	if err := db_character.UpdateCharacter(charData, ses.AccountID); err != nil {
 		log.Printf("failed to save player data on camp: %v", err)
 	}
+	if err := combat.GetManager().ReleasePet(context.Background(), int64(charData.ID)); err != nil {
+		log.Printf("failed to save pet on camp: %v", err)
+	}
+	if err := combat.GetStatsRecorder().FlushCharacter(context.Background(), charData.ID); err != nil {
+		log.Printf("failed to save combat stats on camp: %v", err)
+	}
 	// Send updated character info so character select shows current zone
 	sendCharInfo(ses, ses.AccountID)
 	return false
 }
 
-// HandleChannelMessage handles legacy EQ channel messages.
-// Note: IdleQuest uses HandleSendChatMessage for chat broadcasting instead.
+// HandleChannelMessage handles legacy EQ channel messages, routing chanNum to the chat channel
+// HandleSendChatMessage would use. targetname is the character a tell is for.
 func HandleChannelMessage(ses *session.Session, payload []byte, wh *WorldHandler) bool {
-	// Legacy opcode - chat is handled by HandleSendChatMessage
+	req, err := session.Deserialize(ses, payload, eq.ReadRootChannelMessage)
+	if err != nil {
+		log.Printf("failed to read ChannelMessage struct: %v", err)
+		return false
+	}
+	messageType, ok := chat.LegacyChannel(req.ChanNum())
+	if !ok {
+		SendSystemMessage(ses, chat.ErrUnknownChannel.Error())
+		return false
+	}
+	target, _ := req.Targetname()
+	text, _ := req.Message_()
+	routeChat(ses, wh, messageType, target, text)
 	return false
 }
 
@@ -858,17 +943,61 @@ func HandleStartCombat(ses *session.Session, payload []byte, wh *WorldHandler) b
 	}
 	zoneShortName := *zone.ShortName
 
-	// Define callbacks for combat events
-	onRound := func(result *combat.RoundResult) {
+	// An optional target; older clients send an empty request for a random NPC
+	var target combat.CombatTarget
+	if req, err := session.Deserialize(ses, payload, eq.ReadRootStartCombatRequest); err == nil {
+		target.NPCID = req.NpcId()
+		target.SpawnGroupID = req.SpawnGroupId()
+	}
+
+	onRound, onEnd, onLoot := combatCallbacks(ses)
+
+	// Start combat
+	npc, err := combat.GetManager().StartCombat(
+		ses,
+		zoneShortName,
+		int(charData.Level),
+		target,
+		onRound,
+		onEnd,
+		onLoot,
+	)
+
+	var unavailable *combat.TargetUnavailableError
+	if errors.As(err, &unavailable) {
+		sendCombatTargetUnavailable(ses, unavailable)
+		return false
+	}
+	if err != nil {
+		log.Printf("Failed to start combat: %v", err)
+		sendCombatStartedError(ses, err.Error())
+		return false
+	}
+
+	// Send combat started response
+	sendCombatStarted(ses, npc, combat.GetManager().GetPet(int64(charData.ID)))
+	return false
+}
+
+// combatCallbacks returns the callbacks that relay a fight's rounds, end and loot to the client
+// and save the character afterwards
+func combatCallbacks(ses *session.Session) (
+	onRound func(*combat.RoundResult),
+	onEnd func(*combat.EndResult),
+	onLoot func([]db_combat.LootDropItem, db_combat.MoneyDrop),
+) {
+	charData := ses.Client.CharData()
+
+	onRound = func(result *combat.RoundResult) {
 		sendCombatRound(ses, result)
 	}
 
-	onEnd := func(result *combat.EndResult) {
+	onEnd = func(result *combat.EndResult) {
 		// Include bind info in combat ended message for client-side teleport
 		sendCombatEnded(ses, result)
 
 		// If player died, move them to their bind point
-		if !result.Victory {
+		if result.Outcome == combat.OutcomeDeath {
 			charData.ZoneID = uint32(result.BindZoneID)
 			charData.X = result.BindX
 			charData.Y = result.BindY
@@ -881,51 +1010,178 @@ func HandleStartCombat(ses *session.Session, payload []byte, wh *WorldHandler) b
 
 		// Save changes (Exp gained, HP update, new Zone/Pos) to DB
 		// Note: HP/Mana are already synchronized via combat's use of Client methods
+		// Last seen is updated so a closed tab earns offline progress from the last fight
+		charData.LastLogin = uint32(time.Now().Unix())
 		if err := db_character.UpdateCharacter(charData, int64(charData.AccountID)); err != nil {
 			log.Printf("Failed to save character state after combat: %v", err)
 		}
+		if err := combat.GetManager().SavePet(context.Background(), int64(charData.ID)); err != nil {
+			log.Printf("Failed to save pet after combat: %v", err)
+		}
 
 		// Sync client with full state using existing client (preserves correct HP/Mana)
 		sendUpdatedCharacterState(ses)
 	}
 
-	onLoot := func(loot []db_combat.LootDropItem, money db_combat.MoneyDrop) {
+	onLoot = func(loot []db_combat.LootDropItem, money db_combat.MoneyDrop) {
 		sendLootGenerated(ses, loot, money)
 	}
 
-	// Start combat
-	npc, err := combat.GetManager().StartCombat(
-		ses,
-		zoneShortName,
-		int(charData.Level),
-		onRound,
-		onEnd,
-		onLoot,
-	)
+	return onRound, onEnd, onLoot
+}
 
-	if err != nil {
-		log.Printf("Failed to start combat: %v", err)
-		sendCombatStartedError(ses, err.Error())
+// HandleStopCombat stops server-side combat for the player
+func HandleStopCombat(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
 		return false
 	}
 
-	// Send combat started response
-	sendCombatStarted(ses, npc)
+	charID := int64(ses.Client.CharData().ID)
+	combat.GetManager().StopHunt(charID, combat.HuntStopRequested, "")
+	combat.GetManager().StopCombat(charID)
 	return false
 }
 
-// HandleStopCombat stops server-side combat for the player
-func HandleStopCombat(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+// HandleSetAutoHunt turns server-side hunting on or off. While on, the server rests the character
+// after each fight and pulls the next NPC itself, so the client doesn't send StartCombat per kill.
+func HandleSetAutoHunt(ses *session.Session, payload []byte, wh *WorldHandler) bool {
 	if !ses.HasValidClient() {
 		return false
 	}
 
+	req, err := session.Deserialize(ses, payload, eq.ReadRootSetAutoHuntRequest)
+	if err != nil {
+		log.Printf("Failed to read SetAutoHuntRequest: %v", err)
+		return false
+	}
+
 	charID := int64(ses.Client.CharData().ID)
-	combat.GetManager().StopCombat(charID)
+	if req.Enabled() == 0 {
+		combat.GetManager().StopHunt(charID, combat.HuntStopRequested, "")
+		return false
+	}
+
+	onRound, onEnd, onLoot := combatCallbacks(ses)
+	combat.GetManager().StartHunt(ses, combat.HuntSettings{
+		RestHPPercent:         int(req.RestHpPercent()),
+		RestManaPercent:       int(req.RestManaPercent()),
+		StopAtLevel:           int(req.StopAtLevel()),
+		MaxDeaths:             int(req.MaxDeaths()),
+		StopWhenInventoryFull: req.StopWhenInventoryFull() != 0,
+	}, combat.HuntCallbacks{
+		OnRound: onRound,
+		OnEnd:   onEnd,
+		OnLoot:  onLoot,
+		OnStarted: func(npc *db_combat.NPCForCombat, pet *combat.Pet) {
+			sendCombatStarted(ses, npc, pet)
+		},
+		OnStatus: func(status combat.HuntStatus) {
+			sendAutoHuntStatus(ses, status)
+		},
+	})
 	return false
 }
 
-func sendCombatStarted(ses *session.Session, npc *db_combat.NPCForCombat) {
+func sendAutoHuntStatus(ses *session.Session, status combat.HuntStatus) {
+	session.QueueMessage(ses, eq.NewRootAutoHuntStatus, opcodes.AutoHuntStatus, func(msg eq.AutoHuntStatus) error {
+		msg.SetActive(boolToInt32(status.Active))
+		msg.SetResting(boolToInt32(status.Resting))
+		msg.SetKills(int32(status.Kills))
+		msg.SetDeaths(int32(status.Deaths))
+		msg.SetHp(int32(status.HP))
+		msg.SetMaxHp(int32(status.MaxHP))
+		msg.SetMana(int32(status.Mana))
+		msg.SetMaxMana(int32(status.MaxMana))
+		if err := msg.SetStopReason(status.StopReason); err != nil {
+			return err
+		}
+		return msg.SetDetail(status.Detail)
+	})
+}
+
+// defaultNPCKillLimit is how many NPC types GetCombatStats returns when the request doesn't say
+const defaultNPCKillLimit = 50
+
+// HandleGetCombatStats sends the character's combat statistics and kill history for the statistics window
+func HandleGetCombatStats(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+
+	req, err := session.Deserialize(ses, payload, eq.ReadRootGetCombatStatsRequest)
+	if err != nil {
+		log.Printf("Failed to read GetCombatStatsRequest: %v", err)
+		sendCombatStatsError(ses, "Failed to parse request")
+		return false
+	}
+	npcLimit := int64(req.NpcLimit())
+	if npcLimit <= 0 || npcLimit > defaultNPCKillLimit {
+		npcLimit = defaultNPCKillLimit
+	}
+
+	charID := ses.Client.CharData().ID
+	ctx := context.Background()
+
+	// Write out buffered fights so the window includes the most recent kills
+	if err := combat.GetStatsRecorder().FlushCharacter(ctx, charID); err != nil {
+		log.Printf("Failed to flush combat stats for character %d: %v", charID, err)
+	}
+
+	totals, err := db_stats.GetCharacterCombatStats(ctx, charID)
+	if err != nil {
+		log.Printf("Failed to get combat stats for character %d: %v", charID, err)
+		sendCombatStatsError(ses, "Failed to load statistics")
+		return false
+	}
+	npcKills, err := db_stats.GetCharacterNPCKills(ctx, charID, npcLimit)
+	if err != nil {
+		log.Printf("Failed to get NPC kills for character %d: %v", charID, err)
+		sendCombatStatsError(ses, "Failed to load statistics")
+		return false
+	}
+
+	session.QueueMessage(ses, eq.NewRootGetCombatStatsResponse, opcodes.GetCombatStatsResponse, func(resp eq.GetCombatStatsResponse) error {
+		resp.SetSuccess(1)
+		resp.SetKills(int32(totals.Kills))
+		resp.SetDeaths(int32(totals.Deaths))
+		resp.SetDamageDealt(int64(totals.DamageDealt))
+		resp.SetDamageTaken(int64(totals.DamageTaken))
+		resp.SetHighestHit(int32(totals.HighestHit))
+		resp.SetExpEarned(int64(totals.ExpEarned))
+		resp.SetCoinEarned(int64(totals.CoinEarned))
+
+		killList, err := eq.NewNPCKillStats_List(resp.Segment(), int32(len(npcKills)))
+		if err != nil {
+			return err
+		}
+		for i, k := range npcKills {
+			entry := killList.At(i)
+			entry.SetNpcId(k.NpcTypeID)
+			entry.SetNpcName(k.NpcName)
+			entry.SetKills(int32(k.Kills))
+			entry.SetDeaths(int32(k.Deaths))
+			entry.SetDamageDealt(int64(k.DamageDealt))
+			entry.SetDamageTaken(int64(k.DamageTaken))
+			entry.SetExpEarned(int64(k.ExpEarned))
+			entry.SetCoinEarned(int64(k.CoinEarned))
+			if k.LastKilledAt != nil {
+				entry.SetLastKilledAt(k.LastKilledAt.Unix())
+			}
+		}
+		return resp.SetNpcKills(killList)
+	})
+	return false
+}
+
+func sendCombatStatsError(ses *session.Session, errMsg string) {
+	session.QueueMessage(ses, eq.NewRootGetCombatStatsResponse, opcodes.GetCombatStatsResponse, func(resp eq.GetCombatStatsResponse) error {
+		resp.SetSuccess(0)
+		resp.SetError(errMsg)
+		return nil
+	})
+}
+
+func sendCombatStarted(ses *session.Session, npc *db_combat.NPCForCombat, pet *combat.Pet) {
 	msg, err := session.NewMessage(ses, eq.NewRootCombatStartedResponse)
 	if err != nil {
 		log.Printf("Failed to create CombatStartedResponse: %v", err)
@@ -951,6 +1207,18 @@ func sendCombatStarted(ses *session.Session, npc *db_combat.NPCForCombat) {
 	npcMsg.SetMaxDmg(int32(npc.MaxDmg))
 	npcMsg.SetAttackDelay(int32(npc.AttackDelay))
 
+	if pet.Alive() {
+		petMsg, err := msg.NewPet()
+		if err != nil {
+			log.Printf("Failed to create CombatPet: %v", err)
+			return
+		}
+		petMsg.SetName(pet.Name)
+		petMsg.SetLevel(int32(pet.Level))
+		petMsg.SetHp(int32(pet.CurrentHP))
+		petMsg.SetMaxHp(int32(pet.MaxHP))
+	}
+
 	ses.SendStream(msg.Message(), opcodes.CombatStarted)
 }
 
@@ -967,6 +1235,18 @@ func sendCombatStartedError(ses *session.Session, errMsg string) {
 	ses.SendStream(msg.Message(), opcodes.CombatStarted)
 }
 
+// sendCombatTargetUnavailable tells the client why its chosen target can't be fought
+func sendCombatTargetUnavailable(ses *session.Session, unavailable *combat.TargetUnavailableError) {
+	session.QueueMessage(ses, eq.NewRootCombatStartedResponse, opcodes.CombatStarted, func(msg eq.CombatStartedResponse) error {
+		msg.SetSuccess(0)
+		msg.SetRespawnSeconds(int32(unavailable.RespawnIn.Seconds()))
+		if err := msg.SetError(unavailable.Message); err != nil {
+			return err
+		}
+		return msg.SetReason(unavailable.Reason)
+	})
+}
+
 func sendCombatRound(ses *session.Session, result *combat.RoundResult) {
 	if ses == nil || ses.Client == nil {
 		return
@@ -988,6 +1268,64 @@ func sendCombatRound(ses *session.Session, result *combat.RoundResult) {
 	msg.SetNpcMaxHp(int32(result.NPCMaxHP))
 	msg.SetRoundNumber(int32(result.RoundNumber))
 	msg.SetNpcDied(boolToInt32(result.NPCDied))
+	msg.SetPetHit(boolToInt32(result.PetHit))
+	msg.SetPetDamage(int32(result.PetDamage))
+	msg.SetNpcTargetPet(boolToInt32(result.NPCTargetPet))
+	msg.SetPetHp(int32(result.PetHP))
+	msg.SetPetMaxHp(int32(result.PetMaxHP))
+	msg.SetPetDied(boolToInt32(result.PetDied))
+	msg.SetNpcStunned(boolToInt32(result.NPCStunned))
+	msg.SetNpcFleeing(boolToInt32(result.NPCFleeing))
+	msg.SetNpcClosing(boolToInt32(result.NPCClosing))
+	msg.SetGroupDamage(int32(result.GroupDamage))
+	if result.NPCTarget != "" {
+		if err := msg.SetNpcTarget(result.NPCTarget); err != nil {
+			log.Printf("Failed to set NPC target: %v", err)
+			return
+		}
+	}
+
+	if r := result.Ranged; r != nil {
+		ranged, err := msg.NewRanged()
+		if err != nil {
+			log.Printf("Failed to create ranged attack: %v", err)
+			return
+		}
+		ranged.SetSkill(int32(r.Skill))
+		if err := ranged.SetName(r.Name); err != nil {
+			log.Printf("Failed to set ranged attack name: %v", err)
+			return
+		}
+		ranged.SetHit(boolToInt32(r.Hit))
+		ranged.SetDamage(int32(r.Damage))
+		ranged.SetAmmoBag(int32(r.AmmoBag))
+		ranged.SetAmmoSlot(int32(r.AmmoSlot))
+		ranged.SetAmmoLeft(int32(r.AmmoLeft))
+	}
+
+	if len(result.Specials) > 0 {
+		specials, err := eq.NewSpecialAttackHit_List(msg.Segment(), int32(len(result.Specials)))
+		if err != nil {
+			log.Printf("Failed to create special attack list: %v", err)
+			return
+		}
+		for i, s := range result.Specials {
+			hit := specials.At(i)
+			hit.SetSkill(int32(s.Skill))
+			if err := hit.SetName(s.Name); err != nil {
+				log.Printf("Failed to set special attack name: %v", err)
+				return
+			}
+			hit.SetHit(boolToInt32(s.Hit))
+			hit.SetDamage(int32(s.Damage))
+			hit.SetStunned(boolToInt32(s.Stunned))
+			hit.SetBehind(boolToInt32(s.Behind))
+		}
+		if err := msg.SetSpecials(specials); err != nil {
+			log.Printf("Failed to set special attacks: %v", err)
+			return
+		}
+	}
 
 	ses.SendStream(msg.Message(), opcodes.CombatRound)
 }
@@ -1003,13 +1341,14 @@ func sendCombatEnded(ses *session.Session, result *combat.EndResult) {
 	}
 
 	msg.SetVictory(boolToInt32(result.Victory))
+	msg.SetOutcome(result.Outcome)
 	msg.SetNpcName(result.NPCName)
 	msg.SetExpGained(int32(result.ExpGained))
 	msg.SetPlayerHp(int32(result.PlayerHP))
 	msg.SetPlayerMaxHp(int32(result.PlayerMaxHP))
 
 	// Include bind zone info for death respawn
-	if !result.Victory {
+	if result.Outcome == combat.OutcomeDeath {
 		msg.SetBindZoneId(int32(result.BindZoneID))
 		msg.SetBindX(float32(result.BindX))
 		msg.SetBindY(float32(result.BindY))
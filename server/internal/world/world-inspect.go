@@ -0,0 +1,170 @@
+package world
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	"idlequest/internal/constants"
+	db_character "idlequest/internal/db/character"
+	db_guild "idlequest/internal/db/guild"
+	"idlequest/internal/db/items"
+	"idlequest/internal/session"
+	"idlequest/internal/who"
+)
+
+// maxInspectMessage is the longest inspect message character_inspect_messages holds
+const maxInspectMessage = 255
+
+// HandleInspectRequest sends the character what another online character is wearing, with their
+// level, class, race, guild and inspect message, unless they refuse inspection
+func HandleInspectRequest(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootInspect)
+	if err != nil {
+		log.Printf("failed to read Inspect struct: %v", err)
+		return false
+	}
+	name, _ := req.TargetName()
+	name = strings.TrimSpace(name)
+	target, ok := wh.sessionManager.FindByCharacterName(name)
+	if name == "" || !ok || !target.HasValidClient() {
+		SendSystemMessage(ses, fmt.Sprintf("%s is not online.", name))
+		return false
+	}
+
+	charData := ses.Client.CharData()
+	targetData := target.Client.CharData()
+	self := targetData.ID == charData.ID
+	if targetData.InspectOptOut != 0 && !self {
+		SendSystemMessage(ses, fmt.Sprintf("%s does not wish to be inspected.", targetData.Name))
+		return false
+	}
+
+	ctx := context.Background()
+	entry := who.Entry{
+		Name:  targetData.Name,
+		Level: int32(targetData.Level),
+		Class: int32(targetData.Class),
+		Race:  int32(targetData.Race),
+		Anon:  int32(targetData.Anon),
+	}
+	if m, err := db_guild.GetMembership(ctx, int32(targetData.ID)); err == nil {
+		entry.Guild = m.GuildName
+	}
+	text, err := db_character.GetInspectMessage(ctx, int32(targetData.ID))
+	if err != nil {
+		log.Printf("failed to load inspect message of %s: %v", targetData.Name, err)
+	}
+	sendInspectInfo(ses, entry.Visible(), text, inventorySnapshot(target))
+
+	if !self {
+		SendSystemMessage(target, fmt.Sprintf("%s is looking at your equipment.", charData.Name))
+	}
+	return false
+}
+
+// sendInspectInfo sends what the inspected character shows and the items they have equipped
+func sendInspectInfo(ses *session.Session, v who.Entry, text string, inventory map[constants.InventoryKey]*constants.ItemWithInstance) {
+	var equipped []constants.InventoryKey
+	for _, slot := range constants.EquipmentSlots {
+		if key := (constants.InventoryKey{Slot: slot}); inventory[key] != nil {
+			equipped = append(equipped, key)
+		}
+	}
+
+	session.QueueMessage(ses, eq.NewRootInspectInfo, opcodes.InspectAnswer, func(msg eq.InspectInfo) error {
+		if err := msg.SetName(v.Name); err != nil {
+			return err
+		}
+		msg.SetLevel(v.Level)
+		msg.SetCharClass(v.Class)
+		msg.SetRace(v.Race)
+		msg.SetAnon(v.Anon)
+		if err := msg.SetGuild(v.Guild); err != nil {
+			return err
+		}
+		if err := msg.SetText(text); err != nil {
+			return err
+		}
+		list, err := msg.NewItems(int32(len(equipped)))
+		if err != nil {
+			return err
+		}
+		for i, key := range equipped {
+			charItem := inventory[key]
+			mods, err := json.Marshal(charItem.Instance.Mods)
+			if err != nil {
+				return err
+			}
+			item := list.At(i)
+			item.SetCharges(uint32(charItem.Instance.Charges))
+			item.SetQuantity(uint32(charItem.Instance.Quantity))
+			item.SetMods(string(mods))
+			item.SetSlot(int32(key.Slot))
+			item.SetBagSlot(int32(key.Bag))
+			items.ConvertItemTemplateToCapnp(ses, &charItem.Item, &item)
+		}
+		return nil
+	})
+}
+
+// HandleInspectMessageUpdate sets the message those inspecting the character read
+func HandleInspectMessageUpdate(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootInspectMessage)
+	if err != nil {
+		log.Printf("failed to read InspectMessage struct: %v", err)
+		return false
+	}
+	text, _ := req.Text()
+	text = strings.TrimSpace(text)
+	if len(text) > maxInspectMessage {
+		SendSystemMessage(ses, fmt.Sprintf("Inspect messages are up to %d characters.", maxInspectMessage))
+		return false
+	}
+	charData := ses.Client.CharData()
+	if err := db_character.SetInspectMessage(context.Background(), int32(charData.ID), text); err != nil {
+		log.Printf("failed to save inspect message for %s: %v", charData.Name, err)
+		return false
+	}
+	SendSystemMessage(ses, "Your inspect message has been set.")
+	return false
+}
+
+// HandleSetInspectOptOut sets whether the character refuses inspection by other players
+func HandleSetInspectOptOut(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+
+	// Payload is a single byte: 1 refuse inspection, 0 allow it
+	if len(payload) < 1 {
+		log.Printf("SetInspectOptOut: invalid payload length")
+		return false
+	}
+
+	charData := ses.Client.CharData()
+	charData.InspectOptOut = 0
+	if payload[0] != 0 {
+		charData.InspectOptOut = 1
+	}
+	if err := db_character.SetInspectOptOut(context.Background(), int32(charData.ID), charData.InspectOptOut); err != nil {
+		log.Printf("SetInspectOptOut: failed to persist to database for character %d: %v", charData.ID, err)
+	}
+
+	if charData.InspectOptOut != 0 {
+		SendSystemMessage(ses, "Other players can no longer inspect you.")
+	} else {
+		SendSystemMessage(ses, "Other players can now inspect you.")
+	}
+	return false
+}
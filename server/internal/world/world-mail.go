@@ -0,0 +1,322 @@
+package world
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	"idlequest/internal/constants"
+	db_character "idlequest/internal/db/character"
+	db_mail "idlequest/internal/db/mail"
+	"idlequest/internal/mail"
+	"idlequest/internal/session"
+	"idlequest/internal/trade"
+)
+
+// MailAction actions, sent in MailActionRequest.action
+const (
+	mailList   = 1
+	mailRead   = 2 // id
+	mailTake   = 3 // id
+	mailDelete = 4 // id
+)
+
+// HandleMailSend sends a letter, with any items and coin attached, to a character who may be
+// offline. Attachments leave the sender together with the letter or not at all.
+func HandleMailSend(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootMailSendRequest)
+	if err != nil {
+		log.Printf("failed to read MailSendRequest struct: %v", err)
+		return false
+	}
+	to, _ := req.To()
+	subject, _ := req.Subject()
+	body, _ := req.Body()
+	to, subject, body = strings.TrimSpace(to), strings.TrimSpace(subject), strings.TrimSpace(body)
+	if err := mail.CheckLetter(subject, body); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	coin := trade.Coin{
+		Platinum: int(req.Platinum()),
+		Gold:     int(req.Gold()),
+		Silver:   int(req.Silver()),
+		Copper:   int(req.Copper()),
+	}
+	if coin.Platinum < 0 || coin.Gold < 0 || coin.Silver < 0 || coin.Copper < 0 {
+		return false
+	}
+	slots, err := req.Items()
+	if err != nil {
+		log.Printf("failed to read mail attachments: %v", err)
+		return false
+	}
+	keys := make([]constants.InventoryKey, slots.Len())
+	for i := range keys {
+		s := slots.At(i)
+		keys[i] = constants.InventoryKey{Bag: int8(s.BagSlot()), Slot: int8(s.Slot())}
+	}
+
+	charData := ses.Client.CharData()
+	recipient, err := db_character.GetCharacterByName(to)
+	if err != nil || to == "" {
+		SendSystemMessage(ses, mail.ErrNoRecipient.Error())
+		return false
+	}
+	if recipient.ID == charData.ID {
+		SendSystemMessage(ses, mail.ErrMailSelf.Error())
+		return false
+	}
+
+	ctx := context.Background()
+	attachments, err := mail.CheckSend(inventorySnapshot(ses), keys, coin, purse(ctx, int64(charData.ID)))
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	_, err = db_mail.Send(ctx, db_mail.Outgoing{
+		FromCharID:  int64(charData.ID),
+		FromName:    charData.Name,
+		ToCharID:    int64(recipient.ID),
+		ToName:      recipient.Name,
+		Subject:     subject,
+		Body:        body,
+		Attachments: attachments,
+		Coin:        coin,
+	})
+	if err != nil {
+		log.Printf("mail from %s to %s failed: %v", charData.Name, recipient.Name, err)
+		SendSystemMessage(ses, fmt.Sprintf("Your letter could not be sent. %s", mailError(err)))
+		return false
+	}
+
+	// The letter is committed; take the attachments out of memory to match
+	for _, a := range attachments {
+		ses.Client.DeleteItem(a.Key)
+	}
+	log.Printf("[Mail] %s sent %s a letter with %d items and %+v", charData.Name, recipient.Name, len(attachments), coin)
+	SendSystemMessage(ses, fmt.Sprintf("Your letter to %s has been sent.", recipient.Name))
+	if len(attachments) > 0 || !coin.IsZero() {
+		sendUpdatedCharacterState(ses)
+	}
+	if target, ok := findCharacterSession(int64(recipient.ID)); ok {
+		SendSystemMessage(target, fmt.Sprintf("You have new mail from %s.", charData.Name))
+	}
+	return false
+}
+
+// mailError words a failed send or take for the client, hiding database errors
+func mailError(err error) string {
+	for _, known := range []error{mail.ErrNotEnoughCoin, mail.ErrNothingToTake, trade.ErrItemMoved} {
+		if errors.Is(err, known) {
+			return err.Error()
+		}
+	}
+	return "Please try again later."
+}
+
+// HandleMailAction lists, reads, empties or deletes letters in the character's mailbox
+func HandleMailAction(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootMailActionRequest)
+	if err != nil {
+		log.Printf("failed to read MailActionRequest struct: %v", err)
+		return false
+	}
+	ctx := context.Background()
+	charID := int32(ses.Client.CharData().ID)
+	letters, err := db_mail.GetMailbox(ctx, charID)
+	if err != nil {
+		log.Printf("failed to load mailbox of char %d: %v", charID, err)
+		return false
+	}
+	if req.Action() == mailList {
+		sendMailbox(ses, letters)
+		return false
+	}
+
+	var letter *db_mail.Letter
+	for i := range letters {
+		if letters[i].Msgid == uint32(req.Id()) {
+			letter = &letters[i]
+		}
+	}
+	if letter == nil {
+		SendSystemMessage(ses, mail.ErrNoLetter.Error())
+		return false
+	}
+
+	switch req.Action() {
+	case mailRead:
+		if err := db_mail.MarkRead(ctx, charID, letter.Msgid); err != nil {
+			log.Printf("failed to mark mail %d read: %v", letter.Msgid, err)
+			return false
+		}
+		letter.Status = mail.StatusRead
+	case mailTake:
+		if !takeMail(ctx, ses, letter) {
+			return false
+		}
+	case mailDelete:
+		if letter.HasAttachments() {
+			SendSystemMessage(ses, mail.ErrHasAttachments.Error())
+			return false
+		}
+		if err := db_mail.Delete(ctx, charID, letter.Msgid); err != nil {
+			log.Printf("failed to delete mail %d: %v", letter.Msgid, err)
+			return false
+		}
+		letters = removeLetter(letters, letter.Msgid)
+	default:
+		return false
+	}
+	sendMailbox(ses, letters)
+	return false
+}
+
+// takeMail moves everything attached to the letter into the character's inventory and purse,
+// all together or not at all
+func takeMail(ctx context.Context, ses *session.Session, letter *db_mail.Letter) bool {
+	if !letter.HasAttachments() {
+		SendSystemMessage(ses, mail.ErrNothingToTake.Error())
+		return false
+	}
+	taken := make([]*constants.ItemWithInstance, len(letter.Items))
+	for i, p := range letter.Items {
+		taken[i] = p.Item
+	}
+	keys, err := mail.Place(inventorySnapshot(ses), taken)
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	charData := ses.Client.CharData()
+	if err := db_mail.Take(ctx, int32(charData.ID), letter, keys); err != nil {
+		log.Printf("taking mail %d for %s failed: %v", letter.Msgid, charData.Name, err)
+		SendSystemMessage(ses, fmt.Sprintf("The attachments could not be taken. %s", mailError(err)))
+		return false
+	}
+
+	// The items are committed to the inventory; put them there in memory to match
+	for i, item := range taken {
+		item.Instance.OwnerID = ownerID(int64(charData.ID))
+		item.Instance.OwnerType = constants.OwnerTypeCharacter
+		ses.Client.SetItem(keys[i], item)
+	}
+	if letter.Copper > 0 {
+		SendSystemMessage(ses, fmt.Sprintf("You take %s from the letter.", formatCoin(mail.FromCopper(letter.Copper))))
+	}
+	letter.Items = nil
+	letter.Copper = 0
+	sendUpdatedCharacterState(ses)
+	return true
+}
+
+// ownerID returns a character ID as an item instance's owner
+func ownerID(charID int64) *uint32 {
+	id := uint32(charID)
+	return &id
+}
+
+// formatCoin words an amount of coin, largest coins first
+func formatCoin(c trade.Coin) string {
+	var parts []string
+	for _, d := range []struct {
+		n    int
+		name string
+	}{{c.Platinum, "platinum"}, {c.Gold, "gold"}, {c.Silver, "silver"}, {c.Copper, "copper"}} {
+		if d.n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", d.n, d.name))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// removeLetter returns the mailbox without the letter
+func removeLetter(letters []db_mail.Letter, msgID uint32) []db_mail.Letter {
+	kept := letters[:0]
+	for _, l := range letters {
+		if l.Msgid != msgID {
+			kept = append(kept, l)
+		}
+	}
+	return kept
+}
+
+// notifyMail tells the character entering the world about letters they haven't read
+func notifyMail(ctx context.Context, ses *session.Session) {
+	letters, err := db_mail.GetMailbox(ctx, int32(ses.Client.CharData().ID))
+	if err != nil {
+		log.Printf("failed to load mailbox of %s: %v", ses.Client.CharData().Name, err)
+		return
+	}
+	if unread := countUnread(letters); unread == 1 {
+		SendSystemMessage(ses, "You have an unread letter.")
+	} else if unread > 1 {
+		SendSystemMessage(ses, fmt.Sprintf("You have %d unread letters.", unread))
+	}
+}
+
+func countUnread(letters []db_mail.Letter) int {
+	unread := 0
+	for _, l := range letters {
+		if l.Status == mail.StatusUnread {
+			unread++
+		}
+	}
+	return unread
+}
+
+// sendMailbox sends the character their letters and what is attached to each
+func sendMailbox(ses *session.Session, letters []db_mail.Letter) {
+	session.QueueMessage(ses, eq.NewRootMailbox, opcodes.Mailbox, func(msg eq.Mailbox) error {
+		msg.SetUnread(int32(countUnread(letters)))
+		list, err := msg.NewLetters(int32(len(letters)))
+		if err != nil {
+			return err
+		}
+		for i, l := range letters {
+			entry := list.At(i)
+			entry.SetId(int32(l.Msgid))
+			entry.SetTimestamp(int64(l.Timestamp))
+			entry.SetRead(boolToInt32(l.Status != mail.StatusUnread))
+			if err := entry.SetFrom(l.From); err != nil {
+				return err
+			}
+			if err := entry.SetSubject(l.Subject); err != nil {
+				return err
+			}
+			if err := entry.SetBody(l.Body); err != nil {
+				return err
+			}
+			coin := mail.FromCopper(l.Copper)
+			entry.SetPlatinum(int32(coin.Platinum))
+			entry.SetGold(int32(coin.Gold))
+			entry.SetSilver(int32(coin.Silver))
+			entry.SetCopper(int32(coin.Copper))
+			items, err := entry.NewItems(int32(len(l.Items)))
+			if err != nil {
+				return err
+			}
+			for j, p := range l.Items {
+				item := items.At(j)
+				item.SetItemId(p.Item.Item.ID)
+				item.SetIcon(p.Item.Item.Icon)
+				item.SetCharges(int32(p.Item.Instance.Charges))
+				if err := item.SetName(p.Item.Item.Name); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,235 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	"idlequest/internal/chat"
+	db_character "idlequest/internal/db/character"
+	db_chat "idlequest/internal/db/chat"
+	db_friends "idlequest/internal/db/friends"
+	"idlequest/internal/session"
+)
+
+// ChatModeration actions, sent in ChatModerationRequest.action
+const (
+	chatIgnore      = 1 // name
+	chatUnignore    = 2 // name
+	chatListIgnores = 3
+	chatMute        = 4 // name, minutes; GM only
+)
+
+// maxMuteMinutes is the longest a GM can mute for, 30 days
+const maxMuteMinutes = 30 * 24 * 60
+
+// loadProfanityList fills the chat filter from the profanity_list table
+func loadProfanityList() {
+	words, err := db_chat.GetProfanity(context.Background())
+	if err != nil {
+		log.Printf("failed to load profanity list: %v", err)
+		return
+	}
+	chat.GetModeration().SetWords(words)
+	log.Printf("[Chat] Loaded %d filtered words", len(words))
+}
+
+// loadChatModeration loads the character's ignore list and their account's mute as they enter
+// the world
+func loadChatModeration(ctx context.Context, ses *session.Session) {
+	charData := ses.Client.CharData()
+	if names, err := db_friends.List(ctx, int32(charData.ID), db_friends.Ignore); err != nil {
+		log.Printf("failed to load ignore list for %s: %v", charData.Name, err)
+	} else {
+		chat.GetModeration().SetIgnores(int64(charData.ID), names)
+	}
+	if until, err := db_chat.GetMute(ctx, int32(ses.AccountID)); err != nil {
+		log.Printf("failed to load mute for account %d: %v", ses.AccountID, err)
+	} else {
+		chat.GetModeration().SetMute(ses.AccountID, until)
+	}
+}
+
+// moderateChat checks a character may say text on the channel, telling them why not if they
+// can't, and returns it with filtered words masked. The unfiltered text is kept for reports.
+func moderateChat(ses *session.Session, messageType, text string) (string, bool) {
+	mod := chat.GetModeration()
+	charData := ses.Client.CharData()
+	if until, muted := mod.MutedUntil(ses.AccountID); muted {
+		minutes := int(math.Ceil(time.Until(until).Minutes()))
+		SendSystemMessage(ses, fmt.Sprintf("You are muted for another %d minutes.", minutes))
+		return "", false
+	}
+	if err := mod.Check(int64(charData.ID), text); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return "", false
+	}
+	mod.Record(charData.Name, messageType, text)
+	return mod.Filter(text), true
+}
+
+// HandleChatModeration manages the character's ignore list, and lets GMs mute other players
+func HandleChatModeration(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootChatModerationRequest)
+	if err != nil {
+		log.Printf("failed to read ChatModerationRequest struct: %v", err)
+		return false
+	}
+	name, _ := req.Name()
+	name = strings.TrimSpace(name)
+	charData := ses.Client.CharData()
+	mod := chat.GetModeration()
+	ctx := context.Background()
+
+	switch req.Action() {
+	case chatIgnore:
+		if name == "" {
+			return false
+		}
+		if err := mod.Ignore(int64(charData.ID), charData.Name, name); err != nil {
+			SendSystemMessage(ses, err.Error())
+			return false
+		}
+		if err := db_friends.Add(ctx, int32(charData.ID), db_friends.Ignore, name); err != nil {
+			log.Printf("failed to save ignore for %s: %v", charData.Name, err)
+		}
+		SendSystemMessage(ses, fmt.Sprintf("You are now ignoring %s.", name))
+	case chatUnignore:
+		if err := mod.Unignore(int64(charData.ID), name); err != nil {
+			SendSystemMessage(ses, err.Error())
+			return false
+		}
+		if err := db_friends.Remove(ctx, int32(charData.ID), db_friends.Ignore, name); err != nil {
+			log.Printf("failed to remove ignore for %s: %v", charData.Name, err)
+		}
+		SendSystemMessage(ses, fmt.Sprintf("You are no longer ignoring %s.", name))
+	case chatListIgnores:
+	case chatMute:
+		muteCharacter(ctx, ses, wh, name, req.Minutes())
+		return false
+	default:
+		return false
+	}
+	sendChatIgnoreList(ses)
+	return false
+}
+
+// muteCharacter mutes the account playing the named character for minutes, or lifts its mute
+// for 0. Only GMs may mute.
+func muteCharacter(ctx context.Context, ses *session.Session, wh *WorldHandler, name string, minutes int32) {
+	status, err := db_chat.GetAccountStatus(ctx, int32(ses.AccountID))
+	if err != nil {
+		log.Printf("failed to load status for account %d: %v", ses.AccountID, err)
+		return
+	}
+	if status < chat.GMStatus {
+		SendSystemMessage(ses, chat.ErrNotGM.Error())
+		return
+	}
+	if minutes < 0 || minutes > maxMuteMinutes {
+		SendSystemMessage(ses, fmt.Sprintf("Mutes last up to %d minutes.", maxMuteMinutes))
+		return
+	}
+
+	var accountID int64
+	target, online := wh.sessionManager.FindByCharacterName(name)
+	if online && target.HasValidClient() {
+		accountID = target.AccountID
+		name = target.Client.CharData().Name
+	} else {
+		online = false
+		character, err := db_character.GetCharacterByName(name)
+		if err != nil {
+			SendSystemMessage(ses, fmt.Sprintf("There is no character named %s.", name))
+			return
+		}
+		accountID = int64(character.AccountID)
+		name = character.Name
+	}
+
+	var until time.Time
+	if minutes > 0 {
+		until = time.Now().Add(time.Duration(minutes) * time.Minute)
+	}
+	if err := db_chat.SetMute(ctx, int32(accountID), until); err != nil {
+		log.Printf("failed to save mute for account %d: %v", accountID, err)
+		return
+	}
+	chat.GetModeration().SetMute(accountID, until)
+	log.Printf("[Chat] %s set a %d minute mute on %s (account %d)", ses.Client.CharData().Name, minutes, name, accountID)
+
+	if minutes == 0 {
+		SendSystemMessage(ses, fmt.Sprintf("%s is no longer muted.", name))
+		if online {
+			SendSystemMessage(target, "You are no longer muted.")
+		}
+		return
+	}
+	SendSystemMessage(ses, fmt.Sprintf("%s is muted for %d minutes.", name, minutes))
+	if online {
+		SendSystemMessage(target, fmt.Sprintf("You have been muted for %d minutes.", minutes))
+	}
+}
+
+// sendChatIgnoreList sends the names the character is ignoring
+func sendChatIgnoreList(ses *session.Session) {
+	names := chat.GetModeration().IgnoreList(int64(ses.Client.CharData().ID))
+	session.QueueMessage(ses, eq.NewRootChatIgnoreList, opcodes.ChatIgnoreList, func(msg eq.ChatIgnoreList) error {
+		list, err := msg.NewNames(int32(len(names)))
+		if err != nil {
+			return err
+		}
+		for i, name := range names {
+			if err := list.Set(i, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// HandleReport files a player's report of another, with the reported player's recent chat, for
+// review through the admin API
+func HandleReport(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootChatReport)
+	if err != nil {
+		log.Printf("failed to read ChatReport struct: %v", err)
+		return false
+	}
+	name, _ := req.Name()
+	reason, _ := req.Reason()
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+	if target, ok := wh.sessionManager.FindByCharacterName(name); ok && target.HasValidClient() {
+		name = target.Client.CharData().Name
+	}
+
+	var text strings.Builder
+	text.WriteString(strings.TrimSpace(reason))
+	for _, line := range chat.GetModeration().History(name) {
+		text.WriteString("\n")
+		text.WriteString(line.String())
+	}
+	reporter := ses.Client.CharData().Name
+	if err := db_chat.SaveReport(context.Background(), reporter, name, text.String()); err != nil {
+		log.Printf("failed to save report of %s by %s: %v", name, reporter, err)
+		SendSystemMessage(ses, "Your report could not be filed. Please try again later.")
+		return false
+	}
+	log.Printf("[Chat] %s reported %s", reporter, name)
+	SendSystemMessage(ses, fmt.Sprintf("Thank you. Your report of %s has been filed.", name))
+	return false
+}
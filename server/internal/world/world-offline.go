@@ -0,0 +1,168 @@
+package world
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	"idlequest/internal/combat"
+	"idlequest/internal/config"
+	db_character "idlequest/internal/db/character"
+	db_combat "idlequest/internal/db/combat"
+	"idlequest/internal/db/items"
+	db_zone "idlequest/internal/db/zone"
+	"idlequest/internal/session"
+)
+
+// saveOfflineHunt records the hunt the character is leaving running as they log out, if any,
+// for applyOfflineProgress to carry on at their next login
+func saveOfflineHunt(ses *session.Session) {
+	charData := ses.Client.CharData()
+	hunt := combat.GetManager().GetHunt(int64(charData.ID))
+	if hunt == nil {
+		return
+	}
+	data, err := json.Marshal(hunt.OfflineHunt())
+	if err != nil {
+		log.Printf("Offline progress: failed to encode hunt for character %d: %v", charData.ID, err)
+		return
+	}
+	value := string(data)
+	charData.OfflineHunt = &value
+	charData.LastLogin = uint32(time.Now().Unix()) // Offline progress counts from here
+	if err := db_character.SetOfflineHunt(context.Background(), int32(charData.ID), charData.OfflineHunt, charData.LastLogin); err != nil {
+		log.Printf("Offline progress: failed to save hunt for character %d: %v", charData.ID, err)
+	}
+}
+
+// applyOfflineProgress carries on the hunt the character left running when they logged out
+// (character_data.offline_hunt) for the time since they were last seen (character_data.last_login),
+// then marks them seen now and no longer hunting. Returns nil if there was nothing to award.
+func applyOfflineProgress(ses *session.Session) (*combat.OfflineReport, []string, int) {
+	charData := ses.Client.CharData()
+	lastSeen := charData.LastLogin
+	now := time.Now()
+	charData.LastLogin = uint32(now.Unix())
+	saved := charData.OfflineHunt
+	charData.OfflineHunt = nil
+
+	ctx := context.Background()
+	if saved == nil {
+		return nil, nil, 0
+	}
+	if err := db_character.SetOfflineHunt(ctx, int32(charData.ID), nil, charData.LastLogin); err != nil {
+		log.Printf("Offline progress: failed to clear hunt for character %d: %v", charData.ID, err)
+	}
+	var hunt combat.OfflineHunt
+	if err := json.Unmarshal([]byte(*saved), &hunt); err != nil {
+		log.Printf("Offline progress: bad hunt %q for character %d: %v", *saved, charData.ID, err)
+		return nil, nil, 0
+	}
+
+	// Characters that have never been saved have no absence to award
+	if lastSeen == 0 {
+		return nil, nil, 0
+	}
+	away := now.Sub(time.Unix(int64(lastSeen), 0))
+	if away < combat.OfflineMinWindow {
+		return nil, nil, 0
+	}
+
+	serverConfig, err := config.Get()
+	if err != nil {
+		log.Printf("Offline progress: failed to read config: %v", err)
+		return nil, nil, 0
+	}
+	if maxAway := time.Duration(serverConfig.OfflineMaxHours) * time.Hour; away > maxAway {
+		away = maxAway
+	}
+
+	zone, err := db_zone.GetZoneById(ctx, hunt.ZoneID)
+	if err != nil || zone == nil || zone.ShortName == nil {
+		log.Printf("Offline progress: could not get zone name for zone ID %d: %v", hunt.ZoneID, err)
+		return nil, nil, 0
+	}
+	level := int(charData.Level)
+	npcs, err := db_combat.GetZoneNPCsForCombat(ctx, *zone.ShortName, max(level-5, 1), level+5, false)
+	if err != nil {
+		log.Printf("Offline progress: failed to get NPCs for %s: %v", *zone.ShortName, err)
+		return nil, nil, 0
+	}
+
+	report := combat.SimulateOffline(ses, npcs, combat.GetManager().GetPet(int64(charData.ID)), hunt, away, serverConfig.OfflineEfficiency, now.UnixNano())
+	if report.Fights == 0 {
+		return nil, nil, 0
+	}
+	log.Printf("Offline progress for %s: %s away in %s, %d fights, %d exp, %d items",
+		charData.Name, away.Round(time.Minute), *zone.ShortName, report.Fights, report.ExpGained, len(report.Loot))
+
+	money := report.Money
+	if money.Platinum > 0 || money.Gold > 0 || money.Silver > 0 || money.Copper > 0 {
+		if err := db_character.AddCurrency(ctx, int32(charData.ID), money.Platinum, money.Gold, money.Silver, money.Copper); err != nil {
+			log.Printf("Offline progress: failed to add currency to character %d: %v", charData.ID, err)
+		}
+	}
+
+	// Loot goes through the same auto-loot rules as live drops; whatever doesn't fit is left behind
+	var looted []string
+	var lost int
+	for _, drop := range report.Loot {
+		itemTemplate, err := items.GetItemTemplateByID(drop.ItemID)
+		if err != nil {
+			log.Printf("Offline progress: failed to get item template for ID %d: %v", drop.ItemID, err)
+			continue
+		}
+		_, _, soldInfo, err := items.ProcessAutoLoot(
+			int32(charData.ID),
+			int(charData.Class),
+			int(charData.Race),
+			ses.Client.Items(),
+			itemTemplate,
+			uint8(drop.ItemCharges),
+			ses.Client.AutoSellEnabled(),
+		)
+		if soldInfo != nil {
+			if err := db_character.AddCurrency(ctx, int32(charData.ID), soldInfo.Platinum, soldInfo.Gold, soldInfo.Silver, soldInfo.Copper); err != nil {
+				log.Printf("Offline progress: failed to add auto-sell currency: %v", err)
+			}
+		}
+		if err != nil {
+			lost++
+			continue
+		}
+		looted = append(looted, drop.Name)
+	}
+
+	return &report, looted, lost
+}
+
+func sendOfflineProgress(ses *session.Session, report *combat.OfflineReport, looted []string, lost int) {
+	session.QueueMessage(ses, eq.NewRootOfflineProgressSummary, opcodes.OfflineProgress, func(msg eq.OfflineProgressSummary) error {
+		msg.SetAwaySeconds(int64(report.Away.Seconds()))
+		msg.SetHuntedSeconds(int64(report.Hunted.Seconds()))
+		msg.SetFights(int32(report.Fights))
+		msg.SetKills(int32(report.Kills))
+		msg.SetDeaths(int32(report.Deaths))
+		msg.SetExpGained(int32(report.ExpGained))
+		msg.SetLevelsGained(int32(report.LevelsGained))
+		msg.SetPlatinum(int32(report.Money.Platinum))
+		msg.SetGold(int32(report.Money.Gold))
+		msg.SetSilver(int32(report.Money.Silver))
+		msg.SetCopper(int32(report.Money.Copper))
+		msg.SetItemsLost(int32(lost))
+
+		itemList, err := msg.NewItems(int32(len(looted)))
+		if err != nil {
+			return err
+		}
+		for i, name := range looted {
+			if err := itemList.Set(i, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
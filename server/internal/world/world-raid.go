@@ -0,0 +1,276 @@
+package world
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	"idlequest/internal/config"
+	"idlequest/internal/group"
+	"idlequest/internal/session"
+)
+
+// RaidAction actions, sent in RaidGeneral.action
+const (
+	raidActionDecline      = 1
+	raidActionLeave        = 2
+	raidActionRemove       = 3 // playerName
+	raidActionDisband      = 4
+	raidActionMakeLeader   = 5 // playerName
+	raidActionLootMode     = 6 // parameter: 0 = leader, 1 = looters, 2 = raid
+	raidActionToggleLooter = 7 // playerName
+)
+
+// raidLootModes maps the RaidAction loot mode parameter to the raid's loot mode
+var raidLootModes = []string{group.RaidLootLeader, group.RaidLootLooters, group.RaidLootRaid}
+
+// raidMinMembers returns how many members of a raid must be in a zone to engage its raid targets
+func raidMinMembers() int {
+	serverConfig, err := config.Get()
+	if err != nil {
+		return 0
+	}
+	return serverConfig.RaidMinMembers
+}
+
+// HandleRaidInvite invites an online group leader, or a character without a group, to the
+// inviter's raid. playerName is the invitee; the invite is forwarded to them with leaderName set
+// to the inviter.
+func HandleRaidInvite(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootRaidGeneral)
+	if err != nil {
+		log.Printf("failed to read RaidGeneral struct: %v", err)
+		return false
+	}
+	inviteeName, _ := req.PlayerName()
+
+	invitee, ok := wh.sessionManager.FindByCharacterName(inviteeName)
+	if !ok {
+		SendSystemMessage(ses, fmt.Sprintf("%s is not online.", inviteeName))
+		return false
+	}
+	inviter := groupMember(ses)
+	if err := group.GetManager().RaidInvite(inviter, groupMember(invitee), time.Now()); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+
+	inviteeName = invitee.Client.CharData().Name
+	session.QueueMessage(invitee, eq.NewRootRaidGeneral, opcodes.RaidInvite, func(msg eq.RaidGeneral) error {
+		if err := msg.SetPlayerName(inviteeName); err != nil {
+			return err
+		}
+		return msg.SetLeaderName(inviter.Name)
+	})
+	SendSystemMessage(ses, fmt.Sprintf("You invite %s to join your raid.", inviteeName))
+	return false
+}
+
+// HandleRaidJoin accepts a raid invitation, bringing the character's group if they lead one.
+// leaderName is the inviter.
+func HandleRaidJoin(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootRaidGeneral)
+	if err != nil {
+		log.Printf("failed to read RaidGeneral struct: %v", err)
+		return false
+	}
+	inviterName, _ := req.LeaderName()
+
+	member := groupMember(ses)
+	raid, err := group.GetManager().RaidAccept(member, inviterName, time.Now())
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+
+	text := fmt.Sprintf("%s has joined the raid.", member.Name)
+	if grp := group.GetManager().Get(member.CharID); grp != nil {
+		text = fmt.Sprintf("%s's group has joined the raid.", member.Name)
+	}
+	for _, m := range raid.Members {
+		if m.Session.HasValidClient() {
+			SendSystemMessage(m.Session, text)
+		}
+	}
+	broadcastRaidStatus(raid)
+	return false
+}
+
+// HandleRaidAction handles raid leaving, declining and the leader's controls
+func HandleRaidAction(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootRaidGeneral)
+	if err != nil {
+		log.Printf("failed to read RaidGeneral struct: %v", err)
+		return false
+	}
+	playerName, _ := req.PlayerName()
+	charData := ses.Client.CharData()
+	charID := int64(charData.ID)
+	manager := group.GetManager()
+
+	var raid *group.Raid
+	switch req.Action() {
+	case raidActionDecline:
+		inviter, ok := manager.RaidDecline(charID)
+		if ok && inviter.Session.HasValidClient() {
+			SendSystemMessage(inviter.Session, fmt.Sprintf("%s declines your raid invitation.", charData.Name))
+		}
+		return false
+	case raidActionLeave:
+		leaveRaid(ses)
+		return false
+	case raidActionRemove:
+		var kicked group.RaidMember
+		raid, kicked, err = manager.RaidKick(charID, playerName)
+		if err == nil {
+			if kicked.Session.HasValidClient() {
+				SendSystemMessage(kicked.Session, "You have been removed from the raid.")
+				sendRaidStatus(kicked.Session, nil)
+			}
+			notifyRaidChange(raid, fmt.Sprintf("%s has been removed from the raid.", kicked.Name))
+			return false
+		}
+	case raidActionDisband:
+		raid, err = manager.RaidDisband(charID)
+		if err == nil {
+			for _, m := range raid.Members {
+				if m.Session.HasValidClient() {
+					SendSystemMessage(m.Session, "Your raid has been disbanded.")
+					sendRaidStatus(m.Session, nil)
+				}
+			}
+			return false
+		}
+	case raidActionMakeLeader:
+		raid, err = manager.SetRaidLeader(charID, playerName)
+		if err == nil {
+			notifyRaidChange(raid, fmt.Sprintf("%s is now the raid leader.", raid.LeaderName()))
+			return false
+		}
+	case raidActionLootMode:
+		mode := ""
+		if p := int(req.Parameter()); p >= 0 && p < len(raidLootModes) {
+			mode = raidLootModes[p]
+		}
+		raid, err = manager.SetRaidLootMode(charID, mode)
+	case raidActionToggleLooter:
+		raid, err = manager.ToggleRaidLooter(charID, playerName)
+	default:
+		log.Printf("RaidAction: unknown action %d", req.Action())
+		return false
+	}
+
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	broadcastRaidStatus(raid)
+	return false
+}
+
+// leaveRaid takes the session's character out of their raid, if any, and tells the rest
+func leaveRaid(ses *session.Session) {
+	charData := ses.Client.CharData()
+	raid := group.GetManager().RaidLeave(int64(charData.ID))
+	if raid == nil {
+		return
+	}
+	sendRaidStatus(ses, nil)
+	notifyRaidChange(raid, fmt.Sprintf("%s has left the raid.", charData.Name))
+}
+
+// notifyRaidChange tells the members left in a raid what changed, or that the raid has
+// disbanded if only one is left
+func notifyRaidChange(raid *group.Raid, text string) {
+	if len(raid.Members) < 2 {
+		for _, m := range raid.Members {
+			if m.Session.HasValidClient() {
+				SendSystemMessage(m.Session, "Your raid has been disbanded.")
+				sendRaidStatus(m.Session, nil)
+			}
+		}
+		return
+	}
+	for _, m := range raid.Members {
+		if m.Session.HasValidClient() {
+			SendSystemMessage(m.Session, text)
+		}
+	}
+	broadcastRaidStatus(raid)
+}
+
+// sendRaidChat sends a raid chat message to every raid member online
+func sendRaidChat(ses *session.Session, line chatLine) {
+	raid := group.GetManager().GetRaid(int64(ses.Client.CharData().ID))
+	if raid == nil {
+		SendSystemMessage(ses, group.ErrNotInRaid.Error())
+		return
+	}
+	for _, m := range raid.Members {
+		if !m.Session.HasValidClient() {
+			continue
+		}
+		queueChatLine(m.Session, line)
+	}
+}
+
+// broadcastRaidStatus sends the raid's status to every member online
+func broadcastRaidStatus(raid *group.Raid) {
+	for _, m := range raid.Members {
+		if m.Session.HasValidClient() {
+			sendRaidStatus(m.Session, raid)
+		}
+	}
+}
+
+// sendRaidStatus sends the raid's members, leader and loot rules. A nil raid tells the client it
+// is no longer raiding.
+func sendRaidStatus(ses *session.Session, raid *group.Raid) {
+	session.QueueMessage(ses, eq.NewRootRaidStatus, opcodes.RaidStatus, func(msg eq.RaidStatus) error {
+		if raid == nil {
+			return nil
+		}
+		msg.SetRaidId(raid.ID)
+		if err := msg.SetLeader(raid.LeaderName()); err != nil {
+			return err
+		}
+		if err := msg.SetLootMode(raid.LootMode); err != nil {
+			return err
+		}
+		msg.SetMinMembers(int32(raidMinMembers()))
+		members, err := msg.NewMembers(int32(len(raid.Members)))
+		if err != nil {
+			return err
+		}
+		for i, m := range raid.Members {
+			info := members.At(i)
+			if err := info.SetName(m.Name); err != nil {
+				return err
+			}
+			info.SetGroupId(m.GroupID)
+			if m.Looter {
+				info.SetLooter(1)
+			}
+			if !m.Session.HasValidClient() {
+				continue
+			}
+			charData := m.Session.Client.CharData()
+			info.SetOnline(1)
+			info.SetLevel(int32(charData.Level))
+			info.SetClass(int32(charData.Class))
+			info.SetZoneId(int32(charData.ZoneID))
+		}
+		return nil
+	})
+}
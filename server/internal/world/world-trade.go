@@ -0,0 +1,375 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	"idlequest/internal/constants"
+	db_character "idlequest/internal/db/character"
+	db_trade "idlequest/internal/db/trade"
+	"idlequest/internal/session"
+	"idlequest/internal/trade"
+)
+
+// TradeCoins coin slots, sent in TradeCoin.slot
+const (
+	tradeCoinCopper   = 0
+	tradeCoinSilver   = 1
+	tradeCoinGold     = 2
+	tradeCoinPlatinum = 3
+)
+
+// trader identifies the session's character to the trade manager
+func trader(ses *session.Session) trade.Trader {
+	charData := ses.Client.CharData()
+	return trade.Trader{CharID: int64(charData.ID), Name: charData.Name, Session: ses}
+}
+
+// purse loads the coin the character carries
+func purse(ctx context.Context, charID int64) trade.Coin {
+	currency, err := db_character.GetCharacterCurrency(ctx, uint32(charID))
+	if err != nil {
+		log.Printf("failed to load currency of character %d: %v", charID, err)
+		return trade.Coin{}
+	}
+	return trade.Coin{
+		Platinum: int(currency.Platinum),
+		Gold:     int(currency.Gold),
+		Silver:   int(currency.Silver),
+		Copper:   int(currency.Copper),
+	}
+}
+
+// inventorySnapshot copies the character's inventory so a trade can be checked against it
+func inventorySnapshot(ses *session.Session) map[constants.InventoryKey]*constants.ItemWithInstance {
+	snapshot := make(map[constants.InventoryKey]*constants.ItemWithInstance)
+	ses.Client.WithItems(func(items map[constants.InventoryKey]*constants.ItemWithInstance) {
+		for key, item := range items {
+			if item != nil {
+				snapshot[key] = item
+			}
+		}
+	})
+	return snapshot
+}
+
+// HandleTradeRequest asks an online character to trade. playerName is the target; the request
+// is forwarded to them with playerName set to the requester.
+func HandleTradeRequest(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootTradeRequest)
+	if err != nil {
+		log.Printf("failed to read TradeRequest struct: %v", err)
+		return false
+	}
+	targetName, _ := req.PlayerName()
+
+	target, ok := wh.sessionManager.FindByCharacterName(targetName)
+	if !ok || !target.HasValidClient() {
+		SendSystemMessage(ses, fmt.Sprintf("%s is not online.", targetName))
+		return false
+	}
+	from, to := trader(ses), trader(target)
+	if err := trade.GetManager().Request(from, to, time.Now()); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+
+	session.QueueMessage(target, eq.NewRootTradeRequest, opcodes.TradeRequest, func(msg eq.TradeRequest) error {
+		msg.SetToMobId(int32(to.CharID))
+		msg.SetFromMobId(int32(from.CharID))
+		return msg.SetPlayerName(from.Name)
+	})
+	SendSystemMessage(target, fmt.Sprintf("%s wants to trade with you.", from.Name))
+	SendSystemMessage(ses, fmt.Sprintf("You ask %s to trade.", to.Name))
+	return false
+}
+
+// HandleTradeRequestAck accepts a trade request, opening the trade window for both sides
+func HandleTradeRequestAck(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	t, err := trade.GetManager().Open(trader(ses), time.Now())
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	broadcastTradeStatus(t)
+	return false
+}
+
+// HandleCancelTrade closes the character's trade, or declines the request waiting on them
+func HandleCancelTrade(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	charData := ses.Client.CharData()
+	manager := trade.GetManager()
+
+	if manager.Get(int64(charData.ID)) == nil {
+		if from, ok := manager.Decline(int64(charData.ID)); ok && from.Session.HasValidClient() {
+			SendSystemMessage(from.Session, fmt.Sprintf("%s declines to trade.", charData.Name))
+		}
+		return false
+	}
+	t, err := manager.Cancel(int64(charData.ID))
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	closeTrade(t, fmt.Sprintf("%s has cancelled the trade.", charData.Name))
+	return false
+}
+
+// HandleTradeItem puts an item from the character's packs into their offer, or takes it back
+func HandleTradeItem(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootTradeItemOffer)
+	if err != nil {
+		log.Printf("failed to read TradeItemOffer struct: %v", err)
+		return false
+	}
+	charID := int64(ses.Client.CharData().ID)
+	key := constants.InventoryKey{Bag: int8(req.BagSlot()), Slot: int8(req.Slot())}
+
+	var t *trade.Trade
+	if req.Remove() != 0 {
+		t, err = trade.GetManager().RemoveItem(charID, key)
+	} else {
+		inventory := inventorySnapshot(ses)
+		item := inventory[key]
+		if item == nil {
+			SendSystemMessage(ses, "There is no item there.")
+			return false
+		}
+		if err := trade.CheckTradeable(inventory, key, item); err != nil {
+			SendSystemMessage(ses, err.Error())
+			return false
+		}
+		t, err = trade.GetManager().AddItem(charID, trade.OfferedItem{Key: key, InstanceID: item.ItemInstanceID})
+	}
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	broadcastTradeStatus(t)
+	return false
+}
+
+// HandleTradeCoins sets how much of one coin the character offers. slot is the coin: 0 =
+// copper, 1 = silver, 2 = gold, 3 = platinum.
+func HandleTradeCoins(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootTradeCoin)
+	if err != nil {
+		log.Printf("failed to read TradeCoin struct: %v", err)
+		return false
+	}
+	charID := int64(ses.Client.CharData().ID)
+	manager := trade.GetManager()
+	t := manager.Get(charID)
+	if t == nil {
+		SendSystemMessage(ses, trade.ErrNotTrading.Error())
+		return false
+	}
+
+	me, _ := t.Sides(charID)
+	coin := me.Offer.Coin
+	amount := int(req.Amount())
+	if amount < 0 {
+		return false
+	}
+	switch req.Slot() {
+	case tradeCoinCopper:
+		coin.Copper = amount
+	case tradeCoinSilver:
+		coin.Silver = amount
+	case tradeCoinGold:
+		coin.Gold = amount
+	case tradeCoinPlatinum:
+		coin.Platinum = amount
+	default:
+		return false
+	}
+	if !coin.Within(purse(context.Background(), charID)) {
+		SendSystemMessage(ses, "You don't have that much coin.")
+		return false
+	}
+
+	if t, err = manager.SetCoin(charID, coin); err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	broadcastTradeStatus(t)
+	return false
+}
+
+// HandleTradeAcceptClick accepts the trade as it stands. When both sides have accepted, the
+// exchange is committed.
+func HandleTradeAcceptClick(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	t, ready, err := trade.GetManager().Accept(int64(ses.Client.CharData().ID))
+	if err != nil {
+		SendSystemMessage(ses, err.Error())
+		return false
+	}
+	if !ready {
+		broadcastTradeStatus(t)
+		return false
+	}
+	completeTrade(t)
+	return false
+}
+
+// completeTrade checks an accepted trade against both inventories and purses and commits it.
+// If it can't go through, the trade reopens for the traders to change their offers.
+func completeTrade(t *trade.Trade) {
+	ctx := context.Background()
+	for _, tr := range t.Traders {
+		if !tr.Session.HasValidClient() {
+			trade.GetManager().Finish(t.ID)
+			closeTrade(t, "The trade was cancelled.")
+			return
+		}
+	}
+
+	var inventories [2]map[constants.InventoryKey]*constants.ItemWithInstance
+	var purses [2]trade.Coin
+	for side, tr := range t.Traders {
+		inventories[side] = inventorySnapshot(tr.Session)
+		purses[side] = purse(ctx, tr.CharID)
+	}
+	transfers, err := trade.Plan(t, inventories, purses)
+	if err == nil {
+		err = db_trade.Exchange(ctx, t, transfers)
+	}
+	if err != nil {
+		log.Printf("trade %d between %s and %s failed: %v", t.ID, t.Traders[0].Name, t.Traders[1].Name, err)
+		t = trade.GetManager().Reopen(t.ID)
+		if t == nil {
+			return
+		}
+		for _, tr := range t.Traders {
+			SendSystemMessage(tr.Session, fmt.Sprintf("The trade could not be completed. %s", err))
+		}
+		broadcastTradeStatus(t)
+		return
+	}
+
+	// The exchange is committed; move the items in memory to match
+	trade.Apply(transfers, map[int64]trade.Inventory{
+		t.Traders[0].CharID: t.Traders[0].Session.Client,
+		t.Traders[1].CharID: t.Traders[1].Session.Client,
+	})
+	trade.GetManager().Finish(t.ID)
+	log.Printf("trade %d: %s gave %d items and %+v, %s gave %d items and %+v", t.ID,
+		t.Traders[0].Name, len(t.Traders[0].Offer.Items), t.Traders[0].Offer.Coin,
+		t.Traders[1].Name, len(t.Traders[1].Offer.Items), t.Traders[1].Offer.Coin)
+
+	for _, tr := range t.Traders {
+		_, partner := t.Sides(tr.CharID)
+		sendTradeStatus(tr.Session, nil)
+		SendSystemMessage(tr.Session, fmt.Sprintf("You have traded with %s.", partner.Name))
+		sendUpdatedCharacterState(tr.Session)
+	}
+}
+
+// cancelTrade closes the session's trade and drops trade requests to or from them, telling
+// their partner. Used when the character leaves the world.
+func cancelTrade(ses *session.Session) {
+	charData := ses.Client.CharData()
+	if t := trade.GetManager().Forget(int64(charData.ID)); t != nil {
+		closeTrade(t, fmt.Sprintf("%s has cancelled the trade.", charData.Name))
+	}
+}
+
+// closeTrade closes the trade window for both sides with a message
+func closeTrade(t *trade.Trade, text string) {
+	for _, tr := range t.Traders {
+		if tr.Session.HasValidClient() {
+			sendTradeStatus(tr.Session, nil)
+			SendSystemMessage(tr.Session, text)
+		}
+	}
+}
+
+// broadcastTradeStatus sends the trade window to both sides
+func broadcastTradeStatus(t *trade.Trade) {
+	for _, tr := range t.Traders {
+		if tr.Session.HasValidClient() {
+			sendTradeStatus(tr.Session, t)
+		}
+	}
+}
+
+// sendTradeStatus sends the character's trade window: their offer and their partner's. A nil
+// trade tells the client the window is closed.
+func sendTradeStatus(ses *session.Session, t *trade.Trade) {
+	session.QueueMessage(ses, eq.NewRootTradeStatus, opcodes.TradeStatus, func(msg eq.TradeStatus) error {
+		if t == nil {
+			return nil
+		}
+		msg.SetState(int32(t.State))
+		me, partner := t.Sides(int64(ses.Client.CharData().ID))
+		if err := msg.SetPartnerName(partner.Name); err != nil {
+			return err
+		}
+		mine, err := msg.NewMine()
+		if err != nil {
+			return err
+		}
+		if err := fillTradeOffer(mine, me); err != nil {
+			return err
+		}
+		theirs, err := msg.NewTheirs()
+		if err != nil {
+			return err
+		}
+		return fillTradeOffer(theirs, partner)
+	})
+}
+
+// fillTradeOffer describes one side's offer, looking the items up in their inventory
+func fillTradeOffer(info eq.TradeOfferInfo, tr *trade.Trader) error {
+	info.SetPlatinum(int32(tr.Offer.Coin.Platinum))
+	info.SetGold(int32(tr.Offer.Coin.Gold))
+	info.SetSilver(int32(tr.Offer.Coin.Silver))
+	info.SetCopper(int32(tr.Offer.Coin.Copper))
+	info.SetAccepted(boolToInt32(tr.Offer.Accepted))
+
+	list, err := info.NewItems(int32(len(tr.Offer.Items)))
+	if err != nil {
+		return err
+	}
+	var inventory map[constants.InventoryKey]*constants.ItemWithInstance
+	if tr.Session.HasValidClient() {
+		inventory = inventorySnapshot(tr.Session)
+	}
+	for i, offered := range tr.Offer.Items {
+		entry := list.At(i)
+		entry.SetSlot(int32(offered.Key.Slot))
+		entry.SetBagSlot(int32(offered.Key.Bag))
+		if item := inventory[offered.Key]; item != nil {
+			entry.SetItemId(item.Item.ID)
+			entry.SetIcon(item.Item.Icon)
+			entry.SetCharges(int32(item.Instance.Charges))
+			if err := entry.SetName(item.Item.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	eq "idlequest/internal/api/capnp"
+	"idlequest/internal/api/opcodes"
+	db_character "idlequest/internal/db/character"
+	db_guild "idlequest/internal/db/guild"
+	"idlequest/internal/session"
+	"idlequest/internal/who"
+)
+
+// WhoOnline runs a who query over every character in the world. It also serves the website's
+// player directory.
+func WhoOnline(ctx context.Context, q who.Query) who.Result {
+	var entries []who.Entry
+	forEachInWorld(func(s *session.Session) {
+		charData := s.Client.CharData()
+		e := who.Entry{
+			Name:   charData.Name,
+			Level:  int32(charData.Level),
+			Class:  int32(charData.Class),
+			Race:   int32(charData.Race),
+			ZoneID: int32(s.ZoneID),
+			Anon:   int32(charData.Anon),
+		}
+		if e.ZoneID < 0 {
+			e.ZoneID = 0
+		}
+		if m, err := db_guild.GetMembership(ctx, int32(charData.ID)); err == nil {
+			e.Guild = m.GuildName
+		}
+		entries = append(entries, e)
+	})
+	return who.Find(entries, q)
+}
+
+// HandleWhoAllRequest lists the players online matching the query, with what their anonymous
+// or roleplay flag hides left out
+func HandleWhoAllRequest(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+	req, err := session.Deserialize(ses, payload, eq.ReadRootWhoAll)
+	if err != nil {
+		log.Printf("failed to read WhoAll struct: %v", err)
+		return false
+	}
+	name, _ := req.Whom()
+	guild, _ := req.Guild()
+	result := WhoOnline(context.Background(), who.Query{
+		Name:     name,
+		ZoneID:   req.Zone(),
+		MinLevel: req.Lvllow(),
+		MaxLevel: req.Lvlhigh(),
+		Class:    req.Wclass(),
+		Race:     req.Wrace(),
+		Guild:    guild,
+	})
+
+	session.QueueMessage(ses, eq.NewRootWhoAllReturn, opcodes.WhoAllResponse, func(resp eq.WhoAllReturn) error {
+		resp.SetCount(int32(result.Total))
+		if result.Total > len(result.Players) {
+			resp.SetLine(fmt.Sprintf("There are %d players matching your query, showing the first %d.", result.Total, len(result.Players)))
+		} else {
+			resp.SetLine(fmt.Sprintf("There are %d players matching your query.", result.Total))
+		}
+		players, err := resp.NewPlayer(int32(len(result.Players)))
+		if err != nil {
+			return err
+		}
+		for i, p := range result.Players {
+			player := players.At(i)
+			player.SetName(p.Name)
+			player.SetLevel(p.Level)
+			player.SetCharClass(p.Class)
+			player.SetRace(p.Race)
+			player.SetZone(p.ZoneID)
+			player.SetGuild(p.Guild)
+			player.SetAnon(p.Anon)
+		}
+		return nil
+	})
+	return false
+}
+
+// HandleSetAnon sets whether the character is anonymous or roleplaying in who
+func HandleSetAnon(ses *session.Session, payload []byte, wh *WorldHandler) bool {
+	if !ses.HasValidClient() {
+		return false
+	}
+
+	// Payload is a single byte: 0 none, 1 anonymous, 2 roleplay
+	if len(payload) < 1 || payload[0] > who.AnonRoleplay {
+		log.Printf("SetAnon: invalid payload")
+		return false
+	}
+
+	charData := ses.Client.CharData()
+	charData.Anon = payload[0]
+	if err := db_character.SetAnon(context.Background(), int32(charData.ID), charData.Anon); err != nil {
+		log.Printf("SetAnon: failed to persist to database for character %d: %v", charData.ID, err)
+	}
+
+	switch charData.Anon {
+	case who.AnonAnonymous:
+		SendSystemMessage(ses, "You are now anonymous.")
+	case who.AnonRoleplay:
+		SendSystemMessage(ses, "You are now roleplaying.")
+	default:
+		SendSystemMessage(ses, "You are no longer anonymous or roleplaying.")
+	}
+	return false
+}
@@ -1,9 +1,14 @@
 package world
 
 import (
+	"context"
 	"encoding/binary"
+	"fmt"
 	"log"
 
+	"idlequest/internal/chat"
+	"idlequest/internal/combat"
+	"idlequest/internal/group"
 	"idlequest/internal/session"
 )
 
@@ -22,6 +27,9 @@ func NewWorldHandler(sessionManager *session.SessionManager) *WorldHandler {
 		globalRegistry: registry,
 	}
 	registry.WH = wh
+	combat.GetManager().SetGroupCallbacks(groupFightCallbacks)
+	combat.GetManager().SetRaidMinMembers(raidMinMembers())
+	loadProfanityList()
 	return wh
 }
 
@@ -49,12 +57,31 @@ func (wh *WorldHandler) HandlePacket(ses *session.Session, data []byte) {
 	log.Printf("unhandled opcode %d from session %d", op, ses.SessionID)
 }
 
-// RemoveSession cleans up session data.
+// RemoveSession cleans up session data. A character that disconnects leaves their group, raid and
+// chat channels and cancels any trade.
 func (wh *WorldHandler) RemoveSession(sessionID int) {
+	if ses, ok := wh.sessionManager.GetSession(sessionID); ok && ses.HasValidClient() {
+		charData := ses.Client.CharData()
+		saveOfflineHunt(ses)
+		if grp := group.GetManager().Leave(int64(charData.ID)); grp != nil {
+			notifyGroupChange(grp, fmt.Sprintf("%s has left the group.", charData.Name))
+		}
+		if raid := group.GetManager().RaidLeave(int64(charData.ID)); raid != nil {
+			notifyRaidChange(raid, fmt.Sprintf("%s has left the raid.", charData.Name))
+		}
+		cancelTrade(ses)
+		chat.GetChannels().LeaveAll(int64(charData.ID))
+		chat.GetModeration().Forget(int64(charData.ID))
+		friendsOffline(ses)
+		if err := combat.GetManager().ReleasePet(context.Background(), int64(charData.ID)); err != nil {
+			log.Printf("failed to save pet on disconnect: %v", err)
+		}
+	}
 	wh.sessionManager.RemoveSession(sessionID)
 }
 
 // Shutdown is a no-op now - no zone instances to stop.
 func (wh *WorldHandler) Shutdown() {
-	// No-op: No zone instances to shut down
+	// No zone instances to shut down; just write out buffered combat stats before the DB closes
+	combat.GetStatsRecorder().Stop()
 }
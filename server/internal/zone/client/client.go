@@ -23,6 +23,7 @@ type Client struct {
 	charData        *model.CharacterData
 	ConnectionID    string
 	autoSellEnabled bool
+	autoRetreatPct  int
 }
 
 func (c *Client) Items() map[constants.InventoryKey]*constants.ItemWithInstance {
@@ -110,6 +111,7 @@ func NewClient(charData *model.CharacterData) (entity.Client, error) {
 
 	// Load persisted auto-sell preference (1 = enabled, 0 = disabled)
 	client.autoSellEnabled = charData.AutosellEnabled != 0
+	client.autoRetreatPct = int(charData.AutoRetreatPercent)
 
 	return client, nil
 }
@@ -302,3 +304,13 @@ func (c *Client) AutoSellEnabled() bool {
 func (c *Client) SetAutoSellEnabled(enabled bool) {
 	c.autoSellEnabled = enabled
 }
+
+// AutoRetreatPercent returns the HP percent at which this client retreats from combat (0 = never).
+func (c *Client) AutoRetreatPercent() int {
+	return c.autoRetreatPct
+}
+
+// SetAutoRetreatPercent sets the HP percent at which this client retreats from combat.
+func (c *Client) SetAutoRetreatPercent(percent int) {
+	c.autoRetreatPct = percent
+}
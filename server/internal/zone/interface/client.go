@@ -47,4 +47,8 @@ type Client interface {
 	// Auto-sell state
 	AutoSellEnabled() bool
 	SetAutoSellEnabled(enabled bool)
+
+	// Auto-retreat threshold: combat ends with a retreat at or below this percent of max HP (0 = off)
+	AutoRetreatPercent() int
+	SetAutoRetreatPercent(percent int)
 }
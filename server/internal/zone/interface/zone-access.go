@@ -6,7 +6,7 @@ import (
 )
 
 // ZoneAccess provides read-only, thread-safe access to zone state.
-// Simplified for idle game - no live NPC tracking.
+// Simplified for idle game - live spawns are tracked per zone by the combat manager.
 type ZoneAccess interface {
 	// Basic zone info
 	GetZone() *model.Zone
@@ -18,7 +18,7 @@ type ZoneAccess interface {
 	ClientBySession(sessionID int) (Client, bool)
 	ClientByEntity(entityID int) (Client, bool)
 
-	// NPCs - stub methods, NPCs not tracked live in idle game
+	// NPCs - stub methods, see combat spawn tracking for what is up
 	NPCs() []NPC
 	NPCByID(npcID int) (NPC, bool)
 	NPCByName(name string) (NPC, bool)
@@ -0,0 +1,34 @@
+-- Migration: Create per-character combat statistics and kill history tables
+-- Run this against your MySQL database: mysql -u root eqgo < migrations/007_create_character_combat_stats.sql
+--
+-- character_stats_record only holds a snapshot of attributes, and player_event_killed_npc rows are
+-- individual log events without a character ID, so neither can answer "how many kills does X have".
+-- These tables hold running totals that the combat stats recorder adds to in batches.
+
+CREATE TABLE IF NOT EXISTS character_combat_stats (
+    character_id INT UNSIGNED NOT NULL PRIMARY KEY,
+    kills INT UNSIGNED NOT NULL DEFAULT 0,
+    deaths INT UNSIGNED NOT NULL DEFAULT 0,
+    damage_dealt BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    damage_taken BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    highest_hit INT UNSIGNED NOT NULL DEFAULT 0,
+    exp_earned BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    coin_earned BIGINT UNSIGNED NOT NULL DEFAULT 0, -- in copper
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_kills (kills),
+    INDEX idx_exp_earned (exp_earned)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS character_npc_kills (
+    character_id INT UNSIGNED NOT NULL,
+    npc_type_id INT NOT NULL,
+    npc_name VARCHAR(64) NOT NULL DEFAULT '',
+    kills INT UNSIGNED NOT NULL DEFAULT 0,
+    deaths INT UNSIGNED NOT NULL DEFAULT 0, -- times this NPC type killed the character
+    damage_dealt BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    damage_taken BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    exp_earned BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    coin_earned BIGINT UNSIGNED NOT NULL DEFAULT 0, -- in copper
+    last_killed_at DATETIME NULL,
+    PRIMARY KEY (character_id, npc_type_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,7 @@
+-- Migration: Add the per-character auto-retreat threshold
+-- Run this against your MySQL database: mysql -u root eqgo < migrations/008_add_auto_retreat_percent.sql
+--
+-- Combat ends with a safe retreat once the character's HP drops to this percent of max (0 = never retreat).
+ALTER TABLE character_data
+ADD COLUMN auto_retreat_percent TINYINT UNSIGNED NOT NULL DEFAULT 0
+AFTER autosell_enabled;
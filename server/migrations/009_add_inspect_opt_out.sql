@@ -0,0 +1,7 @@
+-- Migration: Add the per-character inspect opt-out
+-- Run this against your MySQL database: mysql -u root eqgo < migrations/009_add_inspect_opt_out.sql
+--
+-- Characters with this set (1) refuse inspection by other players.
+ALTER TABLE character_data
+ADD COLUMN inspect_opt_out TINYINT UNSIGNED NOT NULL DEFAULT 0
+AFTER auto_retreat_percent;
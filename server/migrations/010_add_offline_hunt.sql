@@ -0,0 +1,7 @@
+-- Migration: Add the hunt a character left running when they logged out
+-- Run this against your MySQL database: mysql -u root eqgo < migrations/010_add_offline_hunt.sql
+--
+-- Offline progress is only awarded for this hunt (JSON: zone and stop level); NULL = not hunting.
+ALTER TABLE character_data
+ADD COLUMN offline_hunt TEXT NULL DEFAULT NULL
+AFTER inspect_opt_out;
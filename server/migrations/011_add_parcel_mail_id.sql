@@ -0,0 +1,9 @@
+-- Migration: Link mail attachments to their letter
+-- Run this against your MySQL database: mysql -u root eqgo < migrations/011_add_parcel_mail_id.sql
+--
+-- character_parcels allows one row per (slot_id, char_id), so each attachment takes its own
+-- slot_id and mail_id names the letter it came with; 0 = not sent by mail.
+ALTER TABLE character_parcels
+ADD COLUMN mail_id INT UNSIGNED NOT NULL DEFAULT 0
+AFTER sent_date,
+ADD INDEX idx_mail_id (char_id, mail_id);
@@ -4449,7 +4449,7 @@ export class TradeRequest extends $.Struct {
   static readonly _capnp = {
     displayName: "TradeRequest",
     id: "dbc10a1e045d6bed",
-    size: new $.ObjectSize(8, 0),
+    size: new $.ObjectSize(8, 1),
   };
   get toMobId(): number {
     return $.utils.getInt32(0, this);
@@ -4463,6 +4463,16 @@ export class TradeRequest extends $.Struct {
   set fromMobId(value: number) {
     $.utils.setInt32(4, value, this);
   }
+  /**
+* The other character: the target of a request, the requester once forwarded
+*
+*/
+  get playerName(): string {
+    return $.utils.getText(0, this);
+  }
+  set playerName(value: string) {
+    $.utils.setText(0, value, this);
+  }
   toString(): string { return "TradeRequest_" + super.toString(); }
 }
 export class TradeAccept extends $.Struct {
@@ -4651,7 +4661,7 @@ export class WhoAll extends $.Struct {
   static readonly _capnp = {
     displayName: "WhoAll",
     id: "b513764d5512a334",
-    size: new $.ObjectSize(24, 1),
+    size: new $.ObjectSize(24, 2),
   };
   get whom(): string {
     return $.utils.getText(0, this);
@@ -4689,6 +4699,22 @@ export class WhoAll extends $.Struct {
   set gmlookup(value: number) {
     $.utils.setInt32(16, value, this);
   }
+  /**
+* 0 for any zone
+*
+*/
+  get zone(): number {
+    return $.utils.getInt32(20, this);
+  }
+  set zone(value: number) {
+    $.utils.setInt32(20, value, this);
+  }
+  get guild(): string {
+    return $.utils.getText(1, this);
+  }
+  set guild(value: string) {
+    $.utils.setText(1, value, this);
+  }
   toString(): string { return "WhoAll_" + super.toString(); }
 }
 export class Stun extends $.Struct {
@@ -4743,7 +4769,7 @@ export class Inspect extends $.Struct {
   static readonly _capnp = {
     displayName: "Inspect",
     id: "8169b9320fb13d79",
-    size: new $.ObjectSize(8, 0),
+    size: new $.ObjectSize(8, 1),
   };
   get targetId(): number {
     return $.utils.getInt32(0, this);
@@ -4757,8 +4783,32 @@ export class Inspect extends $.Struct {
   set playerId(value: number) {
     $.utils.setInt32(4, value, this);
   }
+  /**
+* The online character to inspect
+*
+*/
+  get targetName(): string {
+    return $.utils.getText(0, this);
+  }
+  set targetName(value: string) {
+    $.utils.setText(0, value, this);
+  }
   toString(): string { return "Inspect_" + super.toString(); }
 }
+export class InspectMessage extends $.Struct {
+  static readonly _capnp = {
+    displayName: "InspectMessage",
+    id: "e3788914da7f63bb",
+    size: new $.ObjectSize(0, 1),
+  };
+  get text(): string {
+    return $.utils.getText(0, this);
+  }
+  set text(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  toString(): string { return "InspectMessage_" + super.toString(); }
+}
 export class InspectResponse extends $.Struct {
   static readonly _capnp = {
     displayName: "InspectResponse",
@@ -6330,7 +6380,7 @@ export class WhoAllPlayer extends $.Struct {
   static readonly _capnp = {
     displayName: "WhoAllPlayer",
     id: "c20152b889987299",
-    size: new $.ObjectSize(32, 3),
+    size: new $.ObjectSize(40, 3),
   };
   get formatstring(): number {
     return $.utils.getInt32(0, this);
@@ -6398,6 +6448,16 @@ export class WhoAllPlayer extends $.Struct {
   set account(value: string) {
     $.utils.setText(2, value, this);
   }
+  /**
+* 1 anonymous, 2 roleplay: hidden fields are 0 or empty
+*
+*/
+  get anon(): number {
+    return $.utils.getInt32(32, this);
+  }
+  set anon(value: number) {
+    $.utils.setInt32(32, value, this);
+  }
   toString(): string { return "WhoAllPlayer_" + super.toString(); }
 }
 export class WhoAllReturn extends $.Struct {
@@ -6809,7 +6869,7 @@ export class GuildMemberEntry extends $.Struct {
   static readonly _capnp = {
     displayName: "GuildMemberEntry",
     id: "820db271715b4199",
-    size: new $.ObjectSize(40, 2),
+    size: new $.ObjectSize(48, 2),
   };
   get name(): string {
     return $.utils.getText(0, this);
@@ -6883,6 +6943,16 @@ export class GuildMemberEntry extends $.Struct {
   set zoneId(value: number) {
     $.utils.setInt32(36, value, this);
   }
+  /**
+* 1 = logged in
+*
+*/
+  get online(): number {
+    return $.utils.getInt32(40, this);
+  }
+  set online(value: number) {
+    $.utils.setInt32(40, value, this);
+  }
   toString(): string { return "GuildMemberEntry_" + super.toString(); }
 }
 export class GuildMembers extends $.Struct {
@@ -11259,7 +11329,7 @@ export class NPCData extends $.Struct {
   static readonly _capnp = {
     displayName: "NPCData",
     id: "e2a2a4743dccd2e6",
-    size: new $.ObjectSize(24, 1),
+    size: new $.ObjectSize(24, 3),
   };
   get id(): number {
     return $.utils.getInt32(0, this);
@@ -11303,6 +11373,18 @@ export class NPCData extends $.Struct {
   set gender(value: number) {
     $.utils.setInt32(20, value, this);
   }
+  get conColor(): string {
+    return $.utils.getText(1, this);
+  }
+  set conColor(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  get dangerRating(): string {
+    return $.utils.getText(2, this);
+  }
+  set dangerRating(value: string) {
+    $.utils.setText(2, value, this);
+  }
   toString(): string { return "NPCData_" + super.toString(); }
 }
 export class GetZoneNPCsResponse extends $.Struct {
@@ -11433,7 +11515,7 @@ export class ChatMessageCapnp extends $.Struct {
   static readonly _capnp = {
     displayName: "ChatMessageCapnp",
     id: "8222d6a0ebfb470b",
-    size: new $.ObjectSize(8, 3),
+    size: new $.ObjectSize(8, 5),
   };
   get text(): string {
     return $.utils.getText(0, this);
@@ -11459,13 +11541,33 @@ export class ChatMessageCapnp extends $.Struct {
   set timestamp(value: bigint) {
     $.utils.setInt64(0, value, this);
   }
+  /**
+* Sender's guild, empty if none
+*
+*/
+  get guildName(): string {
+    return $.utils.getText(3, this);
+  }
+  set guildName(value: string) {
+    $.utils.setText(3, value, this);
+  }
+  /**
+* The user channel, or for tells the other character
+*
+*/
+  get channel(): string {
+    return $.utils.getText(4, this);
+  }
+  set channel(value: string) {
+    $.utils.setText(4, value, this);
+  }
   toString(): string { return "ChatMessageCapnp_" + super.toString(); }
 }
 export class SendChatMessageRequest extends $.Struct {
   static readonly _capnp = {
     displayName: "SendChatMessageRequest",
     id: "8f6fd8b5e6c742f5",
-    size: new $.ObjectSize(0, 2),
+    size: new $.ObjectSize(0, 3),
   };
   get text(): string {
     return $.utils.getText(0, this);
@@ -11479,6 +11581,16 @@ export class SendChatMessageRequest extends $.Struct {
   set messageType(value: string) {
     $.utils.setText(1, value, this);
   }
+  /**
+* The character a tell is for, or the user channel
+*
+*/
+  get target(): string {
+    return $.utils.getText(2, this);
+  }
+  set target(value: string) {
+    $.utils.setText(2, value, this);
+  }
   toString(): string { return "SendChatMessageRequest_" + super.toString(); }
 }
 export class DialogueHistoryEntry extends $.Struct {
@@ -11603,15 +11715,35 @@ export class GetNPCDialogueResponse extends $.Struct {
   toString(): string { return "GetNPCDialogueResponse_" + super.toString(); }
 }
 /**
-* Empty - server picks NPC based on player's zone and level
+* Both unset: server picks a random NPC based on player's zone and level
 *
 */
 export class StartCombatRequest extends $.Struct {
   static readonly _capnp = {
     displayName: "StartCombatRequest",
     id: "ef0730519259eaed",
-    size: new $.ObjectSize(0, 0),
+    size: new $.ObjectSize(8, 0),
   };
+  /**
+* Fight this npc_types ID from the zone
+*
+*/
+  get npcId(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set npcId(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  /**
+* Fight whatever is up at this spawn group's spawn points
+*
+*/
+  get spawnGroupId(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set spawnGroupId(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
   toString(): string { return "StartCombatRequest_" + super.toString(); }
 }
 /**
@@ -11688,11 +11820,43 @@ export class CombatNPC extends $.Struct {
   }
   toString(): string { return "CombatNPC_" + super.toString(); }
 }
+export class CombatPet extends $.Struct {
+  static readonly _capnp = {
+    displayName: "CombatPet",
+    id: "9b165192c3123c99",
+    size: new $.ObjectSize(16, 1),
+  };
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get level(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set level(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get hp(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set hp(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get maxHp(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set maxHp(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  toString(): string { return "CombatPet_" + super.toString(); }
+}
 export class CombatStartedResponse extends $.Struct {
   static readonly _capnp = {
     displayName: "CombatStartedResponse",
     id: "9099feab65cf05fe",
-    size: new $.ObjectSize(8, 2),
+    size: new $.ObjectSize(8, 4),
   };
   /**
 * 1 = success, 0 = failure
@@ -11728,6 +11892,48 @@ export class CombatStartedResponse extends $.Struct {
   set npc(value: CombatNPC) {
     $.utils.copyFrom(value, $.utils.getPointer(1, this));
   }
+  /**
+* Unset when the player has no pet
+*
+*/
+  _adoptPet(value: $.Orphan<CombatPet>): void {
+    $.utils.adopt(value, $.utils.getPointer(2, this));
+  }
+  _disownPet(): $.Orphan<CombatPet> {
+    return $.utils.disown(this.pet);
+  }
+  get pet(): CombatPet {
+    return $.utils.getStruct(2, CombatPet, this);
+  }
+  _hasPet(): boolean {
+    return !$.utils.isNull($.utils.getPointer(2, this));
+  }
+  _initPet(): CombatPet {
+    return $.utils.initStructAt(2, CombatPet, this);
+  }
+  set pet(value: CombatPet) {
+    $.utils.copyFrom(value, $.utils.getPointer(2, this));
+  }
+  /**
+* Why a chosen target is unavailable: not_in_zone, not_attackable, not_up, respawning
+*
+*/
+  get reason(): string {
+    return $.utils.getText(3, this);
+  }
+  set reason(value: string) {
+    $.utils.setText(3, value, this);
+  }
+  /**
+* For respawning: seconds until the next spawn point repops
+*
+*/
+  get respawnSeconds(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set respawnSeconds(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
   toString(): string { return "CombatStartedResponse_" + super.toString(); }
 }
 /**
@@ -11738,8 +11944,9 @@ export class CombatRoundUpdate extends $.Struct {
   static readonly _capnp = {
     displayName: "CombatRoundUpdate",
     id: "f70ec2d8ba38e7dd",
-    size: new $.ObjectSize(48, 0),
+    size: new $.ObjectSize(88, 3),
   };
+  static _Specials: $.ListCtor<SpecialAttackHit>;
   /**
 * 1 = hit, 0 = miss
 *
@@ -11827,6 +12034,7 @@ export class CombatRoundUpdate extends $.Struct {
   }
   /**
 * 1 = NPC died this round (don't show NPC attack)
+* Pet info (all 0 when the player has no pet)
 *
 */
   get npcDied(): number {
@@ -11835,81 +12043,231 @@ export class CombatRoundUpdate extends $.Struct {
   set npcDied(value: number) {
     $.utils.setInt32(40, value, this);
   }
-  toString(): string { return "CombatRoundUpdate_" + super.toString(); }
-}
-export class CombatEndedResponse extends $.Struct {
-  static readonly _capnp = {
-    displayName: "CombatEndedResponse",
-    id: "b3307c029a338d76",
-    size: new $.ObjectSize(40, 1),
-  };
   /**
-* 1 = victory, 0 = defeat
+* 1 = hit, 0 = miss
 *
 */
-  get victory(): number {
-    return $.utils.getInt32(0, this);
+  get petHit(): number {
+    return $.utils.getInt32(44, this);
   }
-  set victory(value: number) {
-    $.utils.setInt32(0, value, this);
+  set petHit(value: number) {
+    $.utils.setInt32(44, value, this);
   }
-  get npcName(): string {
-    return $.utils.getText(0, this);
+  get petDamage(): number {
+    return $.utils.getInt32(48, this);
   }
-  set npcName(value: string) {
-    $.utils.setText(0, value, this);
+  set petDamage(value: number) {
+    $.utils.setInt32(48, value, this);
   }
-  get expGained(): number {
-    return $.utils.getInt32(4, this);
+  /**
+* 1 = NPC attack above was against the pet
+*
+*/
+  get npcTargetPet(): number {
+    return $.utils.getInt32(52, this);
   }
-  set expGained(value: number) {
-    $.utils.setInt32(4, value, this);
+  set npcTargetPet(value: number) {
+    $.utils.setInt32(52, value, this);
   }
-  get playerHp(): number {
-    return $.utils.getInt32(8, this);
+  get petHp(): number {
+    return $.utils.getInt32(56, this);
   }
-  set playerHp(value: number) {
-    $.utils.setInt32(8, value, this);
+  set petHp(value: number) {
+    $.utils.setInt32(56, value, this);
+  }
+  get petMaxHp(): number {
+    return $.utils.getInt32(60, this);
+  }
+  set petMaxHp(value: number) {
+    $.utils.setInt32(60, value, this);
   }
   /**
-* Bind zone info for death respawn
+* Class special attacks (kick, bash, backstab) made this round
 *
 */
-  get playerMaxHp(): number {
-    return $.utils.getInt32(12, this);
+  get petDied(): number {
+    return $.utils.getInt32(64, this);
   }
-  set playerMaxHp(value: number) {
-    $.utils.setInt32(12, value, this);
+  set petDied(value: number) {
+    $.utils.setInt32(64, value, this);
   }
-  get bindZoneId(): number {
-    return $.utils.getInt32(16, this);
+  _adoptSpecials(value: $.Orphan<$.List<SpecialAttackHit>>): void {
+    $.utils.adopt(value, $.utils.getPointer(0, this));
   }
-  set bindZoneId(value: number) {
-    $.utils.setInt32(16, value, this);
+  _disownSpecials(): $.Orphan<$.List<SpecialAttackHit>> {
+    return $.utils.disown(this.specials);
   }
-  get bindX(): number {
-    return $.utils.getFloat32(20, this);
+  get specials(): $.List<SpecialAttackHit> {
+    return $.utils.getList(0, CombatRoundUpdate._Specials, this);
   }
-  set bindX(value: number) {
-    $.utils.setFloat32(20, value, this);
+  _hasSpecials(): boolean {
+    return !$.utils.isNull($.utils.getPointer(0, this));
   }
-  get bindY(): number {
-    return $.utils.getFloat32(24, this);
+  _initSpecials(length: number): $.List<SpecialAttackHit> {
+    return $.utils.initList(0, CombatRoundUpdate._Specials, length, this);
   }
-  set bindY(value: number) {
-    $.utils.setFloat32(24, value, this);
+  set specials(value: $.List<SpecialAttackHit>) {
+    $.utils.copyFrom(value, $.utils.getPointer(0, this));
   }
-  get bindZ(): number {
-    return $.utils.getFloat32(28, this);
+  /**
+* 1 = NPC was stunned and lost its attack this round
+*
+*/
+  get npcStunned(): number {
+    return $.utils.getInt32(68, this);
   }
-  set bindZ(value: number) {
-    $.utils.setFloat32(28, value, this);
+  set npcStunned(value: number) {
+    $.utils.setInt32(68, value, this);
   }
-  get bindHeading(): number {
-    return $.utils.getFloat32(32, this);
+  /**
+* 1 = NPC is fleeing at low HP and didn't attack this round
+* Ranged pulls: the NPC closes to melee over the first rounds while the player shoots
+*
+*/
+  get npcFleeing(): number {
+    return $.utils.getInt32(72, this);
   }
-  set bindHeading(value: number) {
-    $.utils.setFloat32(32, value, this);
+  set npcFleeing(value: number) {
+    $.utils.setInt32(72, value, this);
+  }
+  /**
+* Unset when no shot was fired this round
+*
+*/
+  _adoptRanged(value: $.Orphan<RangedAttackHit>): void {
+    $.utils.adopt(value, $.utils.getPointer(1, this));
+  }
+  _disownRanged(): $.Orphan<RangedAttackHit> {
+    return $.utils.disown(this.ranged);
+  }
+  get ranged(): RangedAttackHit {
+    return $.utils.getStruct(1, RangedAttackHit, this);
+  }
+  _hasRanged(): boolean {
+    return !$.utils.isNull($.utils.getPointer(1, this));
+  }
+  _initRanged(): RangedAttackHit {
+    return $.utils.initStructAt(1, RangedAttackHit, this);
+  }
+  set ranged(value: RangedAttackHit) {
+    $.utils.copyFrom(value, $.utils.getPointer(1, this));
+  }
+  /**
+* 1 = NPC is still closing to melee and didn't attack
+* Group fights
+*
+*/
+  get npcClosing(): number {
+    return $.utils.getInt32(76, this);
+  }
+  set npcClosing(value: number) {
+    $.utils.setInt32(76, value, this);
+  }
+  /**
+* Groupmate the NPC attacked this round, unset when it attacked you or your pet
+*
+*/
+  get npcTarget(): string {
+    return $.utils.getText(2, this);
+  }
+  set npcTarget(value: string) {
+    $.utils.setText(2, value, this);
+  }
+  /**
+* Damage the rest of the group and their pets did this round
+*
+*/
+  get groupDamage(): number {
+    return $.utils.getInt32(80, this);
+  }
+  set groupDamage(value: number) {
+    $.utils.setInt32(80, value, this);
+  }
+  toString(): string { return "CombatRoundUpdate_" + super.toString(); }
+}
+export class CombatEndedResponse extends $.Struct {
+  static readonly _capnp = {
+    displayName: "CombatEndedResponse",
+    id: "b3307c029a338d76",
+    size: new $.ObjectSize(40, 2),
+  };
+  /**
+* 1 = victory, 0 = any other outcome
+*
+*/
+  get victory(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set victory(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get npcName(): string {
+    return $.utils.getText(0, this);
+  }
+  set npcName(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get expGained(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set expGained(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get playerHp(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set playerHp(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  /**
+* Bind zone info for death respawn
+*
+*/
+  get playerMaxHp(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set playerMaxHp(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  get bindZoneId(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set bindZoneId(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  get bindX(): number {
+    return $.utils.getFloat32(20, this);
+  }
+  set bindX(value: number) {
+    $.utils.setFloat32(20, value, this);
+  }
+  get bindY(): number {
+    return $.utils.getFloat32(24, this);
+  }
+  set bindY(value: number) {
+    $.utils.setFloat32(24, value, this);
+  }
+  get bindZ(): number {
+    return $.utils.getFloat32(28, this);
+  }
+  set bindZ(value: number) {
+    $.utils.setFloat32(28, value, this);
+  }
+  get bindHeading(): number {
+    return $.utils.getFloat32(32, this);
+  }
+  set bindHeading(value: number) {
+    $.utils.setFloat32(32, value, this);
+  }
+  /**
+* "victory", "death", "npc_fled" (no exp or loot) or "retreated" (auto-retreat HP reached)
+*
+*/
+  get outcome(): string {
+    return $.utils.getText(1, this);
+  }
+  set outcome(value: string) {
+    $.utils.setText(1, value, this);
   }
   toString(): string { return "CombatEndedResponse_" + super.toString(); }
 }
@@ -13412,6 +13770,1697 @@ export class CraftRecipeResponse extends $.Struct {
   }
   toString(): string { return "CraftRecipeResponse_" + super.toString(); }
 }
+export class ConsiderResponse extends $.Struct {
+  static readonly _capnp = {
+    displayName: "ConsiderResponse",
+    id: "f9f5042b9a64b7e6",
+    size: new $.ObjectSize(24, 5),
+  };
+  get success(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set success(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get error(): string {
+    return $.utils.getText(0, this);
+  }
+  set error(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get npcId(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set npcId(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get npcName(): string {
+    return $.utils.getText(1, this);
+  }
+  set npcName(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  get level(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set level(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get conColor(): string {
+    return $.utils.getText(2, this);
+  }
+  set conColor(value: string) {
+    $.utils.setText(2, value, this);
+  }
+  get factionValue(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set factionValue(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  get factionStanding(): string {
+    return $.utils.getText(3, this);
+  }
+  set factionStanding(value: string) {
+    $.utils.setText(3, value, this);
+  }
+  get dangerRating(): string {
+    return $.utils.getText(4, this);
+  }
+  set dangerRating(value: string) {
+    $.utils.setText(4, value, this);
+  }
+  get playerRoundsToKill(): number {
+    return $.utils.getFloat32(16, this);
+  }
+  set playerRoundsToKill(value: number) {
+    $.utils.setFloat32(16, value, this);
+  }
+  get npcRoundsToKill(): number {
+    return $.utils.getFloat32(20, this);
+  }
+  set npcRoundsToKill(value: number) {
+    $.utils.setFloat32(20, value, this);
+  }
+  toString(): string { return "ConsiderResponse_" + super.toString(); }
+}
+export class GetCombatStatsRequest extends $.Struct {
+  static readonly _capnp = {
+    displayName: "GetCombatStatsRequest",
+    id: "ea06c39fbc9cb2d5",
+    size: new $.ObjectSize(8, 0),
+  };
+  /**
+* Max NPC types in the kill history (0 = server default)
+*
+*/
+  get npcLimit(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set npcLimit(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  toString(): string { return "GetCombatStatsRequest_" + super.toString(); }
+}
+export class NPCKillStats extends $.Struct {
+  static readonly _capnp = {
+    displayName: "NPCKillStats",
+    id: "d68f1dab8580fed6",
+    size: new $.ObjectSize(56, 1),
+  };
+  get npcId(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set npcId(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get npcName(): string {
+    return $.utils.getText(0, this);
+  }
+  set npcName(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get kills(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set kills(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get deaths(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set deaths(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get damageDealt(): bigint {
+    return $.utils.getInt64(16, this);
+  }
+  set damageDealt(value: bigint) {
+    $.utils.setInt64(16, value, this);
+  }
+  get damageTaken(): bigint {
+    return $.utils.getInt64(24, this);
+  }
+  set damageTaken(value: bigint) {
+    $.utils.setInt64(24, value, this);
+  }
+  get expEarned(): bigint {
+    return $.utils.getInt64(32, this);
+  }
+  set expEarned(value: bigint) {
+    $.utils.setInt64(32, value, this);
+  }
+  /**
+* in copper
+*
+*/
+  get coinEarned(): bigint {
+    return $.utils.getInt64(40, this);
+  }
+  set coinEarned(value: bigint) {
+    $.utils.setInt64(40, value, this);
+  }
+  /**
+* unix seconds, 0 if never killed
+*
+*/
+  get lastKilledAt(): bigint {
+    return $.utils.getInt64(48, this);
+  }
+  set lastKilledAt(value: bigint) {
+    $.utils.setInt64(48, value, this);
+  }
+  toString(): string { return "NPCKillStats_" + super.toString(); }
+}
+export class GetCombatStatsResponse extends $.Struct {
+  static readonly _capnp = {
+    displayName: "GetCombatStatsResponse",
+    id: "fa21bf17a15f69d1",
+    size: new $.ObjectSize(48, 2),
+  };
+  static _NpcKills: $.ListCtor<NPCKillStats>;
+  get success(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set success(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get error(): string {
+    return $.utils.getText(0, this);
+  }
+  set error(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get kills(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set kills(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get deaths(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set deaths(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get damageDealt(): bigint {
+    return $.utils.getInt64(16, this);
+  }
+  set damageDealt(value: bigint) {
+    $.utils.setInt64(16, value, this);
+  }
+  get damageTaken(): bigint {
+    return $.utils.getInt64(24, this);
+  }
+  set damageTaken(value: bigint) {
+    $.utils.setInt64(24, value, this);
+  }
+  get highestHit(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set highestHit(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  get expEarned(): bigint {
+    return $.utils.getInt64(32, this);
+  }
+  set expEarned(value: bigint) {
+    $.utils.setInt64(32, value, this);
+  }
+  /**
+* in copper
+*
+*/
+  get coinEarned(): bigint {
+    return $.utils.getInt64(40, this);
+  }
+  set coinEarned(value: bigint) {
+    $.utils.setInt64(40, value, this);
+  }
+  _adoptNpcKills(value: $.Orphan<$.List<NPCKillStats>>): void {
+    $.utils.adopt(value, $.utils.getPointer(1, this));
+  }
+  _disownNpcKills(): $.Orphan<$.List<NPCKillStats>> {
+    return $.utils.disown(this.npcKills);
+  }
+  get npcKills(): $.List<NPCKillStats> {
+    return $.utils.getList(1, GetCombatStatsResponse._NpcKills, this);
+  }
+  _hasNpcKills(): boolean {
+    return !$.utils.isNull($.utils.getPointer(1, this));
+  }
+  _initNpcKills(length: number): $.List<NPCKillStats> {
+    return $.utils.initList(1, GetCombatStatsResponse._NpcKills, length, this);
+  }
+  set npcKills(value: $.List<NPCKillStats>) {
+    $.utils.copyFrom(value, $.utils.getPointer(1, this));
+  }
+  toString(): string { return "GetCombatStatsResponse_" + super.toString(); }
+}
+export class SetAutoHuntRequest extends $.Struct {
+  static readonly _capnp = {
+    displayName: "SetAutoHuntRequest",
+    id: "f880e264f3b349d0",
+    size: new $.ObjectSize(24, 0),
+  };
+  get enabled(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set enabled(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  /**
+* Rest between fights until HP reaches this percent (0 = full)
+*
+*/
+  get restHpPercent(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set restHpPercent(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  /**
+* Same for mana (0 = full)
+*
+*/
+  get restManaPercent(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set restManaPercent(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  /**
+* 0 = never
+*
+*/
+  get stopAtLevel(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set stopAtLevel(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  /**
+* 0 = never
+*
+*/
+  get maxDeaths(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set maxDeaths(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  get stopWhenInventoryFull(): number {
+    return $.utils.getInt32(20, this);
+  }
+  set stopWhenInventoryFull(value: number) {
+    $.utils.setInt32(20, value, this);
+  }
+  toString(): string { return "SetAutoHuntRequest_" + super.toString(); }
+}
+export class AutoHuntStatus extends $.Struct {
+  static readonly _capnp = {
+    displayName: "AutoHuntStatus",
+    id: "f30b9ceadbcbce7a",
+    size: new $.ObjectSize(32, 2),
+  };
+  get active(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set active(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get resting(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set resting(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get kills(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set kills(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get deaths(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set deaths(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  /**
+* requested, inventory_full, level_reached, max_deaths, error
+*
+*/
+  get stopReason(): string {
+    return $.utils.getText(0, this);
+  }
+  set stopReason(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  /**
+* Human-readable explanation of the stop reason
+*
+*/
+  get detail(): string {
+    return $.utils.getText(1, this);
+  }
+  set detail(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  get hp(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set hp(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  get maxHp(): number {
+    return $.utils.getInt32(20, this);
+  }
+  set maxHp(value: number) {
+    $.utils.setInt32(20, value, this);
+  }
+  get mana(): number {
+    return $.utils.getInt32(24, this);
+  }
+  set mana(value: number) {
+    $.utils.setInt32(24, value, this);
+  }
+  get maxMana(): number {
+    return $.utils.getInt32(28, this);
+  }
+  set maxMana(value: number) {
+    $.utils.setInt32(28, value, this);
+  }
+  toString(): string { return "AutoHuntStatus_" + super.toString(); }
+}
+export class OfflineProgressSummary extends $.Struct {
+  static readonly _capnp = {
+    displayName: "OfflineProgressSummary",
+    id: "e344a4b7d9ec90b6",
+    size: new $.ObjectSize(56, 1),
+  };
+  /**
+* Time away, capped at the server's maximum window
+*
+*/
+  get awaySeconds(): bigint {
+    return $.utils.getInt64(0, this);
+  }
+  set awaySeconds(value: bigint) {
+    $.utils.setInt64(0, value, this);
+  }
+  /**
+* Simulated hunting time after the efficiency cap
+*
+*/
+  get huntedSeconds(): bigint {
+    return $.utils.getInt64(8, this);
+  }
+  set huntedSeconds(value: bigint) {
+    $.utils.setInt64(8, value, this);
+  }
+  get fights(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set fights(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  get kills(): number {
+    return $.utils.getInt32(20, this);
+  }
+  set kills(value: number) {
+    $.utils.setInt32(20, value, this);
+  }
+  get deaths(): number {
+    return $.utils.getInt32(24, this);
+  }
+  set deaths(value: number) {
+    $.utils.setInt32(24, value, this);
+  }
+  get expGained(): number {
+    return $.utils.getInt32(28, this);
+  }
+  set expGained(value: number) {
+    $.utils.setInt32(28, value, this);
+  }
+  get levelsGained(): number {
+    return $.utils.getInt32(32, this);
+  }
+  set levelsGained(value: number) {
+    $.utils.setInt32(32, value, this);
+  }
+  get platinum(): number {
+    return $.utils.getInt32(36, this);
+  }
+  set platinum(value: number) {
+    $.utils.setInt32(36, value, this);
+  }
+  get gold(): number {
+    return $.utils.getInt32(40, this);
+  }
+  set gold(value: number) {
+    $.utils.setInt32(40, value, this);
+  }
+  get silver(): number {
+    return $.utils.getInt32(44, this);
+  }
+  set silver(value: number) {
+    $.utils.setInt32(44, value, this);
+  }
+  get copper(): number {
+    return $.utils.getInt32(48, this);
+  }
+  set copper(value: number) {
+    $.utils.setInt32(48, value, this);
+  }
+  /**
+* Names of items looted into the inventory
+*
+*/
+  _adoptItems(value: $.Orphan<$.List<string>>): void {
+    $.utils.adopt(value, $.utils.getPointer(0, this));
+  }
+  _disownItems(): $.Orphan<$.List<string>> {
+    return $.utils.disown(this.items);
+  }
+  get items(): $.List<string> {
+    return $.utils.getList(0, $.TextList, this);
+  }
+  _hasItems(): boolean {
+    return !$.utils.isNull($.utils.getPointer(0, this));
+  }
+  _initItems(length: number): $.List<string> {
+    return $.utils.initList(0, $.TextList, length, this);
+  }
+  set items(value: $.List<string>) {
+    $.utils.copyFrom(value, $.utils.getPointer(0, this));
+  }
+  /**
+* Drops left behind because the inventory was full
+*
+*/
+  get itemsLost(): number {
+    return $.utils.getInt32(52, this);
+  }
+  set itemsLost(value: number) {
+    $.utils.setInt32(52, value, this);
+  }
+  toString(): string { return "OfflineProgressSummary_" + super.toString(); }
+}
+export class SpecialAttackHit extends $.Struct {
+  static readonly _capnp = {
+    displayName: "SpecialAttackHit",
+    id: "f4a38d4d317b81ba",
+    size: new $.ObjectSize(24, 1),
+  };
+  /**
+* Skill ID
+*
+*/
+  get skill(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set skill(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  /**
+* 1 = hit, 0 = miss
+*
+*/
+  get hit(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set hit(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get damage(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set damage(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  /**
+* 1 = bash stunned the NPC
+*
+*/
+  get stunned(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set stunned(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  /**
+* 1 = backstab from behind
+*
+*/
+  get behind(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set behind(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  toString(): string { return "SpecialAttackHit_" + super.toString(); }
+}
+export class RangedAttackHit extends $.Struct {
+  static readonly _capnp = {
+    displayName: "RangedAttackHit",
+    id: "b4b9900a1260c0a8",
+    size: new $.ObjectSize(24, 1),
+  };
+  /**
+* Archery or Throwing skill ID
+*
+*/
+  get skill(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set skill(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  /**
+* Arrow or throwing item used
+*
+*/
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  /**
+* 1 = hit, 0 = miss
+*
+*/
+  get hit(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set hit(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get damage(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set damage(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  /**
+* Inventory location of the ammo used
+*
+*/
+  get ammoBag(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set ammoBag(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  get ammoSlot(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set ammoSlot(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  /**
+* Charges left in that stack, 0 = used up and removed
+*
+*/
+  get ammoLeft(): number {
+    return $.utils.getInt32(20, this);
+  }
+  set ammoLeft(value: number) {
+    $.utils.setInt32(20, value, this);
+  }
+  toString(): string { return "RangedAttackHit_" + super.toString(); }
+}
+export class GroupMemberInfo extends $.Struct {
+  static readonly _capnp = {
+    displayName: "GroupMemberInfo",
+    id: "a160c0ca525d295c",
+    size: new $.ObjectSize(24, 1),
+  };
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get level(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set level(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get class(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set class(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get hp(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set hp(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get maxHp(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set maxHp(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  get zoneId(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set zoneId(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  toString(): string { return "GroupMemberInfo_" + super.toString(); }
+}
+export class GroupStatus extends $.Struct {
+  static readonly _capnp = {
+    displayName: "GroupStatus",
+    id: "e39f1f4235358285",
+    size: new $.ObjectSize(8, 3),
+  };
+  static _Members: $.ListCtor<GroupMemberInfo>;
+  /**
+* 0 = not in a group
+*
+*/
+  get groupId(): bigint {
+    return $.utils.getInt64(0, this);
+  }
+  set groupId(value: bigint) {
+    $.utils.setInt64(0, value, this);
+  }
+  get leader(): string {
+    return $.utils.getText(0, this);
+  }
+  set leader(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  /**
+* round_robin or free_for_all
+*
+*/
+  get lootMode(): string {
+    return $.utils.getText(1, this);
+  }
+  set lootMode(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  /**
+* In join order
+*
+*/
+  _adoptMembers(value: $.Orphan<$.List<GroupMemberInfo>>): void {
+    $.utils.adopt(value, $.utils.getPointer(2, this));
+  }
+  _disownMembers(): $.Orphan<$.List<GroupMemberInfo>> {
+    return $.utils.disown(this.members);
+  }
+  get members(): $.List<GroupMemberInfo> {
+    return $.utils.getList(2, GroupStatus._Members, this);
+  }
+  _hasMembers(): boolean {
+    return !$.utils.isNull($.utils.getPointer(2, this));
+  }
+  _initMembers(length: number): $.List<GroupMemberInfo> {
+    return $.utils.initList(2, GroupStatus._Members, length, this);
+  }
+  set members(value: $.List<GroupMemberInfo>) {
+    $.utils.copyFrom(value, $.utils.getPointer(2, this));
+  }
+  toString(): string { return "GroupStatus_" + super.toString(); }
+}
+export class RaidMemberStatus extends $.Struct {
+  static readonly _capnp = {
+    displayName: "RaidMemberStatus",
+    id: "cc32c9cd75e06fc9",
+    size: new $.ObjectSize(32, 1),
+  };
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get level(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set level(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get class(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set class(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get zoneId(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set zoneId(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  /**
+* Group the member raids with, 0 if none
+*
+*/
+  get groupId(): bigint {
+    return $.utils.getInt64(16, this);
+  }
+  set groupId(value: bigint) {
+    $.utils.setInt64(16, value, this);
+  }
+  /**
+* 1 = picked by the leader to share drops in the looters loot mode
+*
+*/
+  get looter(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set looter(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  /**
+* 1 = connected
+*
+*/
+  get online(): number {
+    return $.utils.getInt32(24, this);
+  }
+  set online(value: number) {
+    $.utils.setInt32(24, value, this);
+  }
+  toString(): string { return "RaidMemberStatus_" + super.toString(); }
+}
+export class RaidStatus extends $.Struct {
+  static readonly _capnp = {
+    displayName: "RaidStatus",
+    id: "f5ea129a0bfa1035",
+    size: new $.ObjectSize(16, 3),
+  };
+  static _Members: $.ListCtor<RaidMemberStatus>;
+  /**
+* 0 = not in a raid
+*
+*/
+  get raidId(): bigint {
+    return $.utils.getInt64(0, this);
+  }
+  set raidId(value: bigint) {
+    $.utils.setInt64(0, value, this);
+  }
+  get leader(): string {
+    return $.utils.getText(0, this);
+  }
+  set leader(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  /**
+* leader, looters or raid
+*
+*/
+  get lootMode(): string {
+    return $.utils.getText(1, this);
+  }
+  set lootMode(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  /**
+* Members needed in a zone to engage its raid targets
+*
+*/
+  get minMembers(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set minMembers(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  /**
+* In join order, each group together
+*
+*/
+  _adoptMembers(value: $.Orphan<$.List<RaidMemberStatus>>): void {
+    $.utils.adopt(value, $.utils.getPointer(2, this));
+  }
+  _disownMembers(): $.Orphan<$.List<RaidMemberStatus>> {
+    return $.utils.disown(this.members);
+  }
+  get members(): $.List<RaidMemberStatus> {
+    return $.utils.getList(2, RaidStatus._Members, this);
+  }
+  _hasMembers(): boolean {
+    return !$.utils.isNull($.utils.getPointer(2, this));
+  }
+  _initMembers(length: number): $.List<RaidMemberStatus> {
+    return $.utils.initList(2, RaidStatus._Members, length, this);
+  }
+  set members(value: $.List<RaidMemberStatus>) {
+    $.utils.copyFrom(value, $.utils.getPointer(2, this));
+  }
+  toString(): string { return "RaidStatus_" + super.toString(); }
+}
+export class GuildCreateRequest extends $.Struct {
+  static readonly _capnp = {
+    displayName: "GuildCreateRequest",
+    id: "fd88ee75ec56953a",
+    size: new $.ObjectSize(0, 1),
+  };
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  toString(): string { return "GuildCreateRequest_" + super.toString(); }
+}
+export class GuildRankInfo extends $.Struct {
+  static readonly _capnp = {
+    displayName: "GuildRankInfo",
+    id: "8dc15a5530df753d",
+    size: new $.ObjectSize(8, 1),
+  };
+  /**
+* 1 = leader down to 8 = recruit
+*
+*/
+  get rank(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set rank(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get title(): string {
+    return $.utils.getText(0, this);
+  }
+  set title(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  /**
+* Bit (permission - 1) set for each permission the rank holds
+*
+*/
+  get permissions(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set permissions(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  toString(): string { return "GuildRankInfo_" + super.toString(); }
+}
+export class GuildInfo extends $.Struct {
+  static readonly _capnp = {
+    displayName: "GuildInfo",
+    id: "f78f5141a05c8566",
+    size: new $.ObjectSize(8, 4),
+  };
+  static _Ranks: $.ListCtor<GuildRankInfo>;
+  /**
+* 0 = not in a guild
+*
+*/
+  get guildId(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set guildId(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  /**
+* The character's own rank
+*
+*/
+  get rank(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set rank(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get motd(): string {
+    return $.utils.getText(1, this);
+  }
+  set motd(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  get motdSetter(): string {
+    return $.utils.getText(2, this);
+  }
+  set motdSetter(value: string) {
+    $.utils.setText(2, value, this);
+  }
+  _adoptRanks(value: $.Orphan<$.List<GuildRankInfo>>): void {
+    $.utils.adopt(value, $.utils.getPointer(3, this));
+  }
+  _disownRanks(): $.Orphan<$.List<GuildRankInfo>> {
+    return $.utils.disown(this.ranks);
+  }
+  get ranks(): $.List<GuildRankInfo> {
+    return $.utils.getList(3, GuildInfo._Ranks, this);
+  }
+  _hasRanks(): boolean {
+    return !$.utils.isNull($.utils.getPointer(3, this));
+  }
+  _initRanks(length: number): $.List<GuildRankInfo> {
+    return $.utils.initList(3, GuildInfo._Ranks, length, this);
+  }
+  set ranks(value: $.List<GuildRankInfo>) {
+    $.utils.copyFrom(value, $.utils.getPointer(3, this));
+  }
+  toString(): string { return "GuildInfo_" + super.toString(); }
+}
+export class GuildPermissionUpdate extends $.Struct {
+  static readonly _capnp = {
+    displayName: "GuildPermissionUpdate",
+    id: "abedabc85e222f6e",
+    size: new $.ObjectSize(16, 0),
+  };
+  get permission(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set permission(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get rank(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set rank(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  /**
+* 1 = grant, 0 = revoke
+*
+*/
+  get allowed(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set allowed(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  toString(): string { return "GuildPermissionUpdate_" + super.toString(); }
+}
+export class TradeItemOffer extends $.Struct {
+  static readonly _capnp = {
+    displayName: "TradeItemOffer",
+    id: "b09bd8a1ad1ed10f",
+    size: new $.ObjectSize(16, 0),
+  };
+  get slot(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set slot(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get bagSlot(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set bagSlot(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  /**
+* 1 = take the item back out of the offer
+*
+*/
+  get remove(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set remove(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  toString(): string { return "TradeItemOffer_" + super.toString(); }
+}
+export class TradeItemInfo extends $.Struct {
+  static readonly _capnp = {
+    displayName: "TradeItemInfo",
+    id: "8b4a5b60a0196e49",
+    size: new $.ObjectSize(24, 1),
+  };
+  /**
+* Where the item sits in its owner's inventory
+*
+*/
+  get slot(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set slot(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get bagSlot(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set bagSlot(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get itemId(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set itemId(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get icon(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set icon(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  get charges(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set charges(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  toString(): string { return "TradeItemInfo_" + super.toString(); }
+}
+export class TradeOfferInfo extends $.Struct {
+  static readonly _capnp = {
+    displayName: "TradeOfferInfo",
+    id: "d228a5a668861786",
+    size: new $.ObjectSize(24, 1),
+  };
+  static _Items: $.ListCtor<TradeItemInfo>;
+  _adoptItems(value: $.Orphan<$.List<TradeItemInfo>>): void {
+    $.utils.adopt(value, $.utils.getPointer(0, this));
+  }
+  _disownItems(): $.Orphan<$.List<TradeItemInfo>> {
+    return $.utils.disown(this.items);
+  }
+  get items(): $.List<TradeItemInfo> {
+    return $.utils.getList(0, TradeOfferInfo._Items, this);
+  }
+  _hasItems(): boolean {
+    return !$.utils.isNull($.utils.getPointer(0, this));
+  }
+  _initItems(length: number): $.List<TradeItemInfo> {
+    return $.utils.initList(0, TradeOfferInfo._Items, length, this);
+  }
+  set items(value: $.List<TradeItemInfo>) {
+    $.utils.copyFrom(value, $.utils.getPointer(0, this));
+  }
+  get platinum(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set platinum(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get gold(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set gold(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get silver(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set silver(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get copper(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set copper(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  get accepted(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set accepted(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  toString(): string { return "TradeOfferInfo_" + super.toString(); }
+}
+export class TradeStatus extends $.Struct {
+  static readonly _capnp = {
+    displayName: "TradeStatus",
+    id: "912df186f42698b4",
+    size: new $.ObjectSize(8, 3),
+  };
+  get state(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set state(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get partnerName(): string {
+    return $.utils.getText(0, this);
+  }
+  set partnerName(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  _adoptMine(value: $.Orphan<TradeOfferInfo>): void {
+    $.utils.adopt(value, $.utils.getPointer(1, this));
+  }
+  _disownMine(): $.Orphan<TradeOfferInfo> {
+    return $.utils.disown(this.mine);
+  }
+  get mine(): TradeOfferInfo {
+    return $.utils.getStruct(1, TradeOfferInfo, this);
+  }
+  _hasMine(): boolean {
+    return !$.utils.isNull($.utils.getPointer(1, this));
+  }
+  _initMine(): TradeOfferInfo {
+    return $.utils.initStructAt(1, TradeOfferInfo, this);
+  }
+  set mine(value: TradeOfferInfo) {
+    $.utils.copyFrom(value, $.utils.getPointer(1, this));
+  }
+  _adoptTheirs(value: $.Orphan<TradeOfferInfo>): void {
+    $.utils.adopt(value, $.utils.getPointer(2, this));
+  }
+  _disownTheirs(): $.Orphan<TradeOfferInfo> {
+    return $.utils.disown(this.theirs);
+  }
+  get theirs(): TradeOfferInfo {
+    return $.utils.getStruct(2, TradeOfferInfo, this);
+  }
+  _hasTheirs(): boolean {
+    return !$.utils.isNull($.utils.getPointer(2, this));
+  }
+  _initTheirs(): TradeOfferInfo {
+    return $.utils.initStructAt(2, TradeOfferInfo, this);
+  }
+  set theirs(value: TradeOfferInfo) {
+    $.utils.copyFrom(value, $.utils.getPointer(2, this));
+  }
+  toString(): string { return "TradeStatus_" + super.toString(); }
+}
+export class ChatChannelRequest extends $.Struct {
+  static readonly _capnp = {
+    displayName: "ChatChannelRequest",
+    id: "b646c486ebcbf5e6",
+    size: new $.ObjectSize(8, 2),
+  };
+  get action(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set action(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get password(): string {
+    return $.utils.getText(1, this);
+  }
+  set password(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  toString(): string { return "ChatChannelRequest_" + super.toString(); }
+}
+export class ChatChannelList extends $.Struct {
+  static readonly _capnp = {
+    displayName: "ChatChannelList",
+    id: "b332c883f556a2c0",
+    size: new $.ObjectSize(0, 1),
+  };
+  /**
+* The user channels the character is in
+*
+*/
+  _adoptChannels(value: $.Orphan<$.List<string>>): void {
+    $.utils.adopt(value, $.utils.getPointer(0, this));
+  }
+  _disownChannels(): $.Orphan<$.List<string>> {
+    return $.utils.disown(this.channels);
+  }
+  get channels(): $.List<string> {
+    return $.utils.getList(0, $.TextList, this);
+  }
+  _hasChannels(): boolean {
+    return !$.utils.isNull($.utils.getPointer(0, this));
+  }
+  _initChannels(length: number): $.List<string> {
+    return $.utils.initList(0, $.TextList, length, this);
+  }
+  set channels(value: $.List<string>) {
+    $.utils.copyFrom(value, $.utils.getPointer(0, this));
+  }
+  toString(): string { return "ChatChannelList_" + super.toString(); }
+}
+export class ChatModerationRequest extends $.Struct {
+  static readonly _capnp = {
+    displayName: "ChatModerationRequest",
+    id: "c61e43f35673e291",
+    size: new $.ObjectSize(8, 1),
+  };
+  get action(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set action(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get minutes(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set minutes(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  toString(): string { return "ChatModerationRequest_" + super.toString(); }
+}
+export class ChatIgnoreList extends $.Struct {
+  static readonly _capnp = {
+    displayName: "ChatIgnoreList",
+    id: "87b505340222092e",
+    size: new $.ObjectSize(0, 1),
+  };
+  _adoptNames(value: $.Orphan<$.List<string>>): void {
+    $.utils.adopt(value, $.utils.getPointer(0, this));
+  }
+  _disownNames(): $.Orphan<$.List<string>> {
+    return $.utils.disown(this.names);
+  }
+  get names(): $.List<string> {
+    return $.utils.getList(0, $.TextList, this);
+  }
+  _hasNames(): boolean {
+    return !$.utils.isNull($.utils.getPointer(0, this));
+  }
+  _initNames(length: number): $.List<string> {
+    return $.utils.initList(0, $.TextList, length, this);
+  }
+  set names(value: $.List<string>) {
+    $.utils.copyFrom(value, $.utils.getPointer(0, this));
+  }
+  toString(): string { return "ChatIgnoreList_" + super.toString(); }
+}
+export class ChatReport extends $.Struct {
+  static readonly _capnp = {
+    displayName: "ChatReport",
+    id: "e8f4085bcd9c6dcd",
+    size: new $.ObjectSize(0, 2),
+  };
+  /**
+* The player reported
+*
+*/
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get reason(): string {
+    return $.utils.getText(1, this);
+  }
+  set reason(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  toString(): string { return "ChatReport_" + super.toString(); }
+}
+export class FriendRequest extends $.Struct {
+  static readonly _capnp = {
+    displayName: "FriendRequest",
+    id: "c7de66ce8d7e08ad",
+    size: new $.ObjectSize(8, 1),
+  };
+  get action(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set action(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  toString(): string { return "FriendRequest_" + super.toString(); }
+}
+export class FriendInfo extends $.Struct {
+  static readonly _capnp = {
+    displayName: "FriendInfo",
+    id: "c683efbf7bffa2b4",
+    size: new $.ObjectSize(24, 1),
+  };
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  /**
+* 1 if online
+*
+*/
+  get online(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set online(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  /**
+* Current zone if online, else last zone; 0 if anonymous
+*
+*/
+  get zone(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set zone(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  /**
+* 0 if anonymous or roleplaying
+*
+*/
+  get level(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set level(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get charClass(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set charClass(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  get anon(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set anon(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  toString(): string { return "FriendInfo_" + super.toString(); }
+}
+export class FriendList extends $.Struct {
+  static readonly _capnp = {
+    displayName: "FriendList",
+    id: "dea59dd927f499c3",
+    size: new $.ObjectSize(0, 1),
+  };
+  static _Friends: $.ListCtor<FriendInfo>;
+  _adoptFriends(value: $.Orphan<$.List<FriendInfo>>): void {
+    $.utils.adopt(value, $.utils.getPointer(0, this));
+  }
+  _disownFriends(): $.Orphan<$.List<FriendInfo>> {
+    return $.utils.disown(this.friends);
+  }
+  get friends(): $.List<FriendInfo> {
+    return $.utils.getList(0, FriendList._Friends, this);
+  }
+  _hasFriends(): boolean {
+    return !$.utils.isNull($.utils.getPointer(0, this));
+  }
+  _initFriends(length: number): $.List<FriendInfo> {
+    return $.utils.initList(0, FriendList._Friends, length, this);
+  }
+  set friends(value: $.List<FriendInfo>) {
+    $.utils.copyFrom(value, $.utils.getPointer(0, this));
+  }
+  toString(): string { return "FriendList_" + super.toString(); }
+}
+export class MailAttachmentSlot extends $.Struct {
+  static readonly _capnp = {
+    displayName: "MailAttachmentSlot",
+    id: "965581a04adbcfe6",
+    size: new $.ObjectSize(8, 0),
+  };
+  get slot(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set slot(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get bagSlot(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set bagSlot(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  toString(): string { return "MailAttachmentSlot_" + super.toString(); }
+}
+export class MailSendRequest extends $.Struct {
+  static readonly _capnp = {
+    displayName: "MailSendRequest",
+    id: "c61bb50b732910ae",
+    size: new $.ObjectSize(16, 4),
+  };
+  static _Items: $.ListCtor<MailAttachmentSlot>;
+  get to(): string {
+    return $.utils.getText(0, this);
+  }
+  set to(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get subject(): string {
+    return $.utils.getText(1, this);
+  }
+  set subject(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  get body(): string {
+    return $.utils.getText(2, this);
+  }
+  set body(value: string) {
+    $.utils.setText(2, value, this);
+  }
+  _adoptItems(value: $.Orphan<$.List<MailAttachmentSlot>>): void {
+    $.utils.adopt(value, $.utils.getPointer(3, this));
+  }
+  _disownItems(): $.Orphan<$.List<MailAttachmentSlot>> {
+    return $.utils.disown(this.items);
+  }
+  get items(): $.List<MailAttachmentSlot> {
+    return $.utils.getList(3, MailSendRequest._Items, this);
+  }
+  _hasItems(): boolean {
+    return !$.utils.isNull($.utils.getPointer(3, this));
+  }
+  _initItems(length: number): $.List<MailAttachmentSlot> {
+    return $.utils.initList(3, MailSendRequest._Items, length, this);
+  }
+  set items(value: $.List<MailAttachmentSlot>) {
+    $.utils.copyFrom(value, $.utils.getPointer(3, this));
+  }
+  get platinum(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set platinum(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get gold(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set gold(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get silver(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set silver(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get copper(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set copper(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  toString(): string { return "MailSendRequest_" + super.toString(); }
+}
+export class MailActionRequest extends $.Struct {
+  static readonly _capnp = {
+    displayName: "MailActionRequest",
+    id: "e8e34f0364644d08",
+    size: new $.ObjectSize(8, 0),
+  };
+  get action(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set action(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get id(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set id(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  toString(): string { return "MailActionRequest_" + super.toString(); }
+}
+export class MailItemInfo extends $.Struct {
+  static readonly _capnp = {
+    displayName: "MailItemInfo",
+    id: "ffd2adfdcf38c652",
+    size: new $.ObjectSize(16, 1),
+  };
+  get itemId(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set itemId(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get icon(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set icon(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get charges(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set charges(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  toString(): string { return "MailItemInfo_" + super.toString(); }
+}
+export class MailLetter extends $.Struct {
+  static readonly _capnp = {
+    displayName: "MailLetter",
+    id: "c947f6cd1e07d9da",
+    size: new $.ObjectSize(32, 4),
+  };
+  static _Items: $.ListCtor<MailItemInfo>;
+  get id(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set id(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get from(): string {
+    return $.utils.getText(0, this);
+  }
+  set from(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get subject(): string {
+    return $.utils.getText(1, this);
+  }
+  set subject(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  get body(): string {
+    return $.utils.getText(2, this);
+  }
+  set body(value: string) {
+    $.utils.setText(2, value, this);
+  }
+  /**
+* Unix seconds
+*
+*/
+  get timestamp(): bigint {
+    return $.utils.getInt64(8, this);
+  }
+  set timestamp(value: bigint) {
+    $.utils.setInt64(8, value, this);
+  }
+  get read(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set read(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  _adoptItems(value: $.Orphan<$.List<MailItemInfo>>): void {
+    $.utils.adopt(value, $.utils.getPointer(3, this));
+  }
+  _disownItems(): $.Orphan<$.List<MailItemInfo>> {
+    return $.utils.disown(this.items);
+  }
+  get items(): $.List<MailItemInfo> {
+    return $.utils.getList(3, MailLetter._Items, this);
+  }
+  _hasItems(): boolean {
+    return !$.utils.isNull($.utils.getPointer(3, this));
+  }
+  _initItems(length: number): $.List<MailItemInfo> {
+    return $.utils.initList(3, MailLetter._Items, length, this);
+  }
+  set items(value: $.List<MailItemInfo>) {
+    $.utils.copyFrom(value, $.utils.getPointer(3, this));
+  }
+  get platinum(): number {
+    return $.utils.getInt32(16, this);
+  }
+  set platinum(value: number) {
+    $.utils.setInt32(16, value, this);
+  }
+  get gold(): number {
+    return $.utils.getInt32(20, this);
+  }
+  set gold(value: number) {
+    $.utils.setInt32(20, value, this);
+  }
+  get silver(): number {
+    return $.utils.getInt32(24, this);
+  }
+  set silver(value: number) {
+    $.utils.setInt32(24, value, this);
+  }
+  get copper(): number {
+    return $.utils.getInt32(28, this);
+  }
+  set copper(value: number) {
+    $.utils.setInt32(28, value, this);
+  }
+  toString(): string { return "MailLetter_" + super.toString(); }
+}
+export class Mailbox extends $.Struct {
+  static readonly _capnp = {
+    displayName: "Mailbox",
+    id: "bb3d1850b12cd31b",
+    size: new $.ObjectSize(8, 1),
+  };
+  static _Letters: $.ListCtor<MailLetter>;
+  _adoptLetters(value: $.Orphan<$.List<MailLetter>>): void {
+    $.utils.adopt(value, $.utils.getPointer(0, this));
+  }
+  _disownLetters(): $.Orphan<$.List<MailLetter>> {
+    return $.utils.disown(this.letters);
+  }
+  get letters(): $.List<MailLetter> {
+    return $.utils.getList(0, Mailbox._Letters, this);
+  }
+  _hasLetters(): boolean {
+    return !$.utils.isNull($.utils.getPointer(0, this));
+  }
+  _initLetters(length: number): $.List<MailLetter> {
+    return $.utils.initList(0, Mailbox._Letters, length, this);
+  }
+  set letters(value: $.List<MailLetter>) {
+    $.utils.copyFrom(value, $.utils.getPointer(0, this));
+  }
+  get unread(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set unread(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  toString(): string { return "Mailbox_" + super.toString(); }
+}
 Spawns._Spawns = $.CompositeList(Spawn);
 Bandolier._Items = $.CompositeList(BandolierItem);
 PotionBelt._Items = $.CompositeList(PotionBeltItem);
@@ -13443,6 +15492,7 @@ WebLoginServerResponse._Servers = $.CompositeList(WebLoginWorldServer);
 GetZoneNPCsResponse._Npcs = $.CompositeList(NPCData);
 GetAdjacentZonesResponse._Zones = $.CompositeList(AdjacentZone);
 GetNPCDialogueRequest._DialogueHistory = $.CompositeList(DialogueHistoryEntry);
+CombatRoundUpdate._Specials = $.CompositeList(SpecialAttackHit);
 LootGeneratedResponse._Items = $.CompositeList(LootItem);
 GetAllZonesResponse._Zones = $.CompositeList(ZoneData);
 StaticDataResponse._Zones = $.CompositeList(ZoneData);
@@ -13458,3 +15508,12 @@ GetRecipesResponse._Recipes = $.CompositeList(RecipeData);
 GetRecipeDetailsResponse._Components = $.CompositeList(RecipeComponent);
 GetRecipeDetailsResponse._Outputs = $.CompositeList(RecipeComponent);
 CraftRecipeResponse._ProducedItems = $.CompositeList(RecipeComponent);
+GetCombatStatsResponse._NpcKills = $.CompositeList(NPCKillStats);
+GroupStatus._Members = $.CompositeList(GroupMemberInfo);
+RaidStatus._Members = $.CompositeList(RaidMemberStatus);
+GuildInfo._Ranks = $.CompositeList(GuildRankInfo);
+TradeOfferInfo._Items = $.CompositeList(TradeItemInfo);
+FriendList._Friends = $.CompositeList(FriendInfo);
+MailSendRequest._Items = $.CompositeList(MailAttachmentSlot);
+MailLetter._Items = $.CompositeList(MailItemInfo);
+Mailbox._Letters = $.CompositeList(MailLetter);
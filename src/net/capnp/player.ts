@@ -356,7 +356,7 @@ export class CharacterSelectEntry extends $.Struct {
   static readonly _capnp = {
     displayName: "CharacterSelectEntry",
     id: "ca9e1a4afe3dca58",
-    size: new $.ObjectSize(56, 2),
+    size: new $.ObjectSize(56, 3),
   };
   static _Items: $.ListCtor<ItemInstance>;
   get name(): string {
@@ -461,6 +461,16 @@ export class CharacterSelectEntry extends $.Struct {
   set lastLogin(value: number) {
     $.utils.setInt32(48, value, this);
   }
+  /**
+* Empty if not in a guild
+*
+*/
+  get guildName(): string {
+    return $.utils.getText(2, this);
+  }
+  set guildName(value: string) {
+    $.utils.setText(2, value, this);
+  }
   toString(): string {
     return "CharacterSelectEntry_" + super.toString();
   }
@@ -1437,6 +1447,85 @@ export class Tint extends $.Struct {
     return "Tint_" + super.toString();
   }
 }
+export class InspectInfo extends $.Struct {
+  static readonly _capnp = {
+    displayName: "InspectInfo",
+    id: "986cc0963ee4cde6",
+    size: new $.ObjectSize(16, 4),
+  };
+  static _Items: $.ListCtor<ItemInstance>;
+  get name(): string {
+    return $.utils.getText(0, this);
+  }
+  set name(value: string) {
+    $.utils.setText(0, value, this);
+  }
+  get level(): number {
+    return $.utils.getInt32(0, this);
+  }
+  set level(value: number) {
+    $.utils.setInt32(0, value, this);
+  }
+  get charClass(): number {
+    return $.utils.getInt32(4, this);
+  }
+  set charClass(value: number) {
+    $.utils.setInt32(4, value, this);
+  }
+  get race(): number {
+    return $.utils.getInt32(8, this);
+  }
+  set race(value: number) {
+    $.utils.setInt32(8, value, this);
+  }
+  get guild(): string {
+    return $.utils.getText(1, this);
+  }
+  set guild(value: string) {
+    $.utils.setText(1, value, this);
+  }
+  get anon(): number {
+    return $.utils.getInt32(12, this);
+  }
+  set anon(value: number) {
+    $.utils.setInt32(12, value, this);
+  }
+  /**
+* The character's inspect message
+*
+*/
+  get text(): string {
+    return $.utils.getText(2, this);
+  }
+  set text(value: string) {
+    $.utils.setText(2, value, this);
+  }
+  /**
+* Equipped items only
+*
+*/
+  _adoptItems(value: $.Orphan<$.List<ItemInstance>>): void {
+    $.utils.adopt(value, $.utils.getPointer(3, this));
+  }
+  _disownItems(): $.Orphan<$.List<ItemInstance>> {
+    return $.utils.disown(this.items);
+  }
+  get items(): $.List<ItemInstance> {
+    return $.utils.getList(3, InspectInfo._Items, this);
+  }
+  _hasItems(): boolean {
+    return !$.utils.isNull($.utils.getPointer(3, this));
+  }
+  _initItems(length: number): $.List<ItemInstance> {
+    return $.utils.initList(3, InspectInfo._Items, length, this);
+  }
+  set items(value: $.List<ItemInstance>) {
+    $.utils.copyFrom(value, $.utils.getPointer(3, this));
+  }
+  toString(): string {
+    return "InspectInfo_" + super.toString();
+  }
+}
 // CharacterState - unified character state message from server
 export class CharacterState extends $.Struct {
   static readonly _capnp = {
@@ -1696,3 +1785,4 @@ PlayerProfile._Buffs = $.CompositeList(SpellBuff);
 PlayerProfile._Bandoliers = $.CompositeList(Bandolier);
 PlayerProfile._GroupMembers = $.CompositeList(StringList);
 PlayerProfile._InventoryItems = $.CompositeList(ItemInstance);
+InspectInfo._Items = $.CompositeList(ItemInstance);